@@ -446,10 +446,11 @@ type AllocatedSharedResources struct {
 }
 
 type PortMapping struct {
-	Label  string
-	Value  int
-	To     int
-	HostIP string
+	Label           string
+	Value           int
+	To              int
+	HostIP          string
+	DualStackHostIP string
 }
 
 type AllocatedCpuResources struct {
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+const (
+	// EventSinkTypeWebhook is an event sink that POSTs batches of events to
+	// an HTTP endpoint.
+	EventSinkTypeWebhook = "webhook"
+
+	// EventSinkTypeFile is an event sink that appends batches of events to a
+	// local NDJSON file on the leader.
+	EventSinkTypeFile = "file"
+)
+
+// EventSinks is used to access the event sinks endpoints.
+type EventSinks struct {
+	client *Client
+}
+
+// EventSinks returns a handle on the event sinks endpoints.
+func (c *Client) EventSinks() *EventSinks {
+	return &EventSinks{client: c}
+}
+
+// List is used to list all event sinks.
+func (e *EventSinks) List(q *QueryOptions) ([]*EventSink, *QueryMeta, error) {
+	var resp []*EventSink
+	qm, err := e.client.query("/v1/event/sinks", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// PrefixList is used to list event sinks that match a given ID prefix.
+func (e *EventSinks) PrefixList(prefix string, q *QueryOptions) ([]*EventSink, *QueryMeta, error) {
+	if q == nil {
+		q = &QueryOptions{}
+	}
+	q.Prefix = prefix
+	return e.List(q)
+}
+
+// Info is used to fetch details of a specific event sink.
+func (e *EventSinks) Info(id string, q *QueryOptions) (*EventSink, *QueryMeta, error) {
+	if id == "" {
+		return nil, nil, errors.New("missing event sink ID")
+	}
+
+	var resp EventSink
+	qm, err := e.client.query("/v1/event/sink/"+url.PathEscape(id), &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Register is used to create or update an event sink.
+func (e *EventSinks) Register(sink *EventSink, w *WriteOptions) (*WriteMeta, error) {
+	if sink == nil {
+		return nil, errors.New("missing event sink")
+	}
+	if sink.ID == "" {
+		return nil, errors.New("missing event sink ID")
+	}
+
+	wm, err := e.client.put("/v1/event/sinks", sink, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Delete is used to delete an event sink.
+func (e *EventSinks) Delete(id string, w *WriteOptions) (*WriteMeta, error) {
+	if id == "" {
+		return nil, errors.New("missing event sink ID")
+	}
+
+	wm, err := e.client.delete("/v1/event/sink/"+url.PathEscape(id), nil, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// EventSink is used to serialize an event sink. The leader delivers every
+// event matching Topics and Namespace to the sink at least once, and records
+// the index of the last delivered batch in LatestIndex.
+type EventSink struct {
+	ID          string
+	Type        string
+	Topics      map[Topic][]string
+	Namespace   string
+	Webhook     *EventSinkWebhookConfig
+	File        *EventSinkFileConfig
+	LatestIndex uint64
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// EventSinkWebhookConfig configures the delivery of events to an HTTP
+// endpoint.
+type EventSinkWebhookConfig struct {
+	Address string            `hcl:"address"`
+	Headers map[string]string `hcl:"headers,optional"`
+	Timeout time.Duration     `hcl:"timeout,optional"`
+}
+
+// EventSinkFileConfig configures the delivery of events to a rotating local
+// file on the leader.
+type EventSinkFileConfig struct {
+	Path     string `hcl:"path"`
+	MaxBytes int64  `hcl:"max_bytes,optional"`
+	MaxFiles int    `hcl:"max_files,optional"`
+}
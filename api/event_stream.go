@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -21,6 +22,7 @@ const (
 	TopicNode       Topic = "Node"
 	TopicNodePool   Topic = "NodePool"
 	TopicService    Topic = "Service"
+	TopicPreemption Topic = "Preemption"
 	TopicAll        Topic = "*"
 )
 
@@ -131,6 +133,29 @@ func (e *Event) Service() (*ServiceRegistration, error) {
 	return out.Service, nil
 }
 
+// PreemptionEvent is the payload of events on the Preemption topic. It
+// describes an allocation that was preempted and the allocation and job that
+// preempted it.
+type PreemptionEvent struct {
+	Allocation             *Allocation `mapstructure:"Allocation"`
+	JobPriority            int         `mapstructure:"JobPriority"`
+	PreemptedByAllocation  string      `mapstructure:"PreemptedByAllocation"`
+	PreemptedByJobID       string      `mapstructure:"PreemptedByJobID"`
+	PreemptedByNamespace   string      `mapstructure:"PreemptedByNamespace"`
+	PreemptedByJobPriority int         `mapstructure:"PreemptedByJobPriority"`
+	Reason                 string      `mapstructure:"Reason"`
+}
+
+// Preemption returns a PreemptionEvent struct from a given event payload. If
+// the Event Topic is Preemption this will return a valid PreemptionEvent.
+func (e *Event) Preemption() (*PreemptionEvent, error) {
+	var out PreemptionEvent
+	if err := e.decodePayloadInto(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 type eventPayload struct {
 	Allocation *Allocation          `mapstructure:"Allocation"`
 	Deployment *Deployment          `mapstructure:"Deployment"`
@@ -144,21 +169,24 @@ type eventPayload struct {
 
 func (e *Event) decodePayload() (*eventPayload, error) {
 	var out eventPayload
+	if err := e.decodePayloadInto(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (e *Event) decodePayloadInto(out any) error {
 	cfg := &mapstructure.DecoderConfig{
-		Result:     &out,
+		Result:     out,
 		DecodeHook: mapstructure.StringToTimeHookFunc(time.RFC3339),
 	}
 
 	dec, err := mapstructure.NewDecoder(cfg)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := dec.Decode(e.Payload); err != nil {
-		return nil, err
-	}
-
-	return &out, nil
+	return dec.Decode(e.Payload)
 }
 
 // IsHeartbeat specifies if the event is an empty heartbeat used to
@@ -177,6 +205,43 @@ func (c *Client) EventStream() *EventStream {
 	return &EventStream{client: c}
 }
 
+// Replay returns a page of past events starting at index from the event
+// journal of the servers. If QueryMeta.NextToken is set, more events can be
+// read by passing it as QueryOptions.NextToken.
+func (e *EventStream) Replay(topics map[Topic][]string, index uint64, q *QueryOptions) ([]*Events, *QueryMeta, error) {
+	params := url.Values{}
+	params.Set("index", strconv.FormatUint(index, 10))
+	return e.replay(topics, params, q)
+}
+
+// ReplaySince returns a page of past events published within the since
+// duration from the event journal of the servers. If QueryMeta.NextToken is
+// set, more events can be read by passing it as QueryOptions.NextToken.
+func (e *EventStream) ReplaySince(topics map[Topic][]string, since time.Duration, q *QueryOptions) ([]*Events, *QueryMeta, error) {
+	params := url.Values{}
+	params.Set("since", since.String())
+	return e.replay(topics, params, q)
+}
+
+func (e *EventStream) replay(topics map[Topic][]string, params url.Values, q *QueryOptions) ([]*Events, *QueryMeta, error) {
+	for topic, keys := range topics {
+		if len(keys) == 0 {
+			params.Add("topic", string(topic))
+			continue
+		}
+		for _, k := range keys {
+			params.Add("topic", fmt.Sprintf("%s:%s", topic, k))
+		}
+	}
+
+	var resp []*Events
+	qm, err := e.client.query("/v1/event/replay?"+params.Encode(), &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
 // Stream establishes a new subscription to Nomad's event stream and streams
 // results back to the returned channel.
 //
@@ -107,6 +107,77 @@ func (a *AllocFS) Stat(alloc *Allocation, path string, q *QueryOptions) (*AllocF
 	return &resp, qm, nil
 }
 
+// LogSearchOptions are the options used to search the logs of a task.
+type LogSearchOptions struct {
+	// Pattern is the regular expression matched against each log line.
+	Pattern string
+
+	// Since skips the log files that were last written to before this long
+	// ago, if set.
+	Since time.Duration
+
+	// Context is the number of lines returned before and after each match.
+	Context int
+
+	// Limit is the maximum number of matches returned. The client defaults to
+	// 100 matches if it is not set.
+	Limit int
+}
+
+// LogSearchResult holds the log lines matching a search, in the order they
+// were logged.
+type LogSearchResult struct {
+	Matches []*LogMatch
+
+	// Truncated is set if the search stopped after reaching the limit.
+	Truncated bool
+}
+
+// LogMatch is a log line matching a search, along with its context.
+type LogMatch struct {
+	File   string
+	Line   int
+	Text   string
+	Before []string
+	After  []string
+}
+
+// SearchLogs is used to search the logs of a task for lines matching a
+// regular expression. The search runs on the client running the allocation,
+// across the rotated log files of the task.
+func (a *AllocFS) SearchLogs(alloc *Allocation, task, logType string, opts *LogSearchOptions,
+	q *QueryOptions) (*LogSearchResult, *QueryMeta, error) {
+	if q == nil {
+		q = &QueryOptions{}
+	}
+	if q.Params == nil {
+		q.Params = make(map[string]string)
+	}
+	if opts == nil {
+		opts = &LogSearchOptions{}
+	}
+
+	q.Params["task"] = task
+	q.Params["type"] = logType
+	q.Params["pattern"] = opts.Pattern
+	if opts.Since > 0 {
+		q.Params["since"] = opts.Since.String()
+	}
+	if opts.Context > 0 {
+		q.Params["context"] = strconv.Itoa(opts.Context)
+	}
+	if opts.Limit > 0 {
+		q.Params["limit"] = strconv.Itoa(opts.Limit)
+	}
+
+	var resp LogSearchResult
+	qm, err := a.client.query(fmt.Sprintf("/v1/client/fs/logs/search/%s", alloc.ID), &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
 // ReadAt is used to read bytes at a given offset until limit at the given path
 // in an allocation directory. If limit is <= 0, there is no limit.
 // Note: for cluster topologies where API consumers don't have network access to
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"maps"
+	"net/http"
 	"net/url"
 	"sort"
 	"strconv"
@@ -37,6 +38,13 @@ const (
 	// PeriodicSpecCron is used for a cron spec.
 	PeriodicSpecCron = "cron"
 
+	// PeriodicCatchUpSkip, PeriodicCatchUpRunOnce and PeriodicCatchUpRunAll
+	// are the policies for launches of a periodic job that were missed while
+	// there was no leader.
+	PeriodicCatchUpSkip    = "skip"
+	PeriodicCatchUpRunOnce = "run-once"
+	PeriodicCatchUpRunAll  = "run-all"
+
 	// DefaultNamespace is the default namespace.
 	DefaultNamespace = "default"
 
@@ -315,6 +323,18 @@ func (j *Jobs) VersionsOpts(jobID string, opts *VersionsOptions, q *QueryOptions
 	return resp.Versions, resp.Diffs, qm, nil
 }
 
+// Dependencies is used to retrieve the dependency graph of a job: the status
+// of the jobs it depends on, recursively, and the IDs of the jobs that depend
+// on it.
+func (j *Jobs) Dependencies(jobID string, q *QueryOptions) (*JobDependenciesResponse, *QueryMeta, error) {
+	var resp JobDependenciesResponse
+	qm, err := j.client.query("/v1/job/"+url.PathEscape(jobID)+"/dependencies", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
 // Submission is used to retrieve the original submitted source of a job given its
 // namespace, jobID, and version number. The original source might not be available,
 // which case nil is returned with no error.
@@ -621,15 +641,16 @@ type periodicForceResponse struct {
 
 // UpdateStrategy defines a task groups update strategy.
 type UpdateStrategy struct {
-	Stagger          *time.Duration `mapstructure:"stagger" hcl:"stagger,optional"`
-	MaxParallel      *int           `mapstructure:"max_parallel" hcl:"max_parallel,optional"`
-	HealthCheck      *string        `mapstructure:"health_check" hcl:"health_check,optional"`
-	MinHealthyTime   *time.Duration `mapstructure:"min_healthy_time" hcl:"min_healthy_time,optional"`
-	HealthyDeadline  *time.Duration `mapstructure:"healthy_deadline" hcl:"healthy_deadline,optional"`
-	ProgressDeadline *time.Duration `mapstructure:"progress_deadline" hcl:"progress_deadline,optional"`
-	Canary           *int           `mapstructure:"canary" hcl:"canary,optional"`
-	AutoRevert       *bool          `mapstructure:"auto_revert" hcl:"auto_revert,optional"`
-	AutoPromote      *bool          `mapstructure:"auto_promote" hcl:"auto_promote,optional"`
+	Stagger          *time.Duration  `mapstructure:"stagger" hcl:"stagger,optional"`
+	MaxParallel      *int            `mapstructure:"max_parallel" hcl:"max_parallel,optional"`
+	HealthCheck      *string         `mapstructure:"health_check" hcl:"health_check,optional"`
+	MinHealthyTime   *time.Duration  `mapstructure:"min_healthy_time" hcl:"min_healthy_time,optional"`
+	HealthyDeadline  *time.Duration  `mapstructure:"healthy_deadline" hcl:"healthy_deadline,optional"`
+	ProgressDeadline *time.Duration  `mapstructure:"progress_deadline" hcl:"progress_deadline,optional"`
+	Canary           *int            `mapstructure:"canary" hcl:"canary,optional"`
+	AutoRevert       *bool           `mapstructure:"auto_revert" hcl:"auto_revert,optional"`
+	AutoPromote      *bool           `mapstructure:"auto_promote" hcl:"auto_promote,optional"`
+	Analysis         *CanaryAnalysis `mapstructure:"analysis" hcl:"analysis,block"`
 }
 
 // DefaultUpdateStrategy provides a baseline that can be used to upgrade
@@ -691,6 +712,8 @@ func (u *UpdateStrategy) Copy() *UpdateStrategy {
 		copy.AutoPromote = pointerOf(*u.AutoPromote)
 	}
 
+	copy.Analysis = u.Analysis.Copy()
+
 	return copy
 }
 
@@ -734,6 +757,10 @@ func (u *UpdateStrategy) Merge(o *UpdateStrategy) {
 	if o.AutoPromote != nil {
 		u.AutoPromote = pointerOf(*o.AutoPromote)
 	}
+
+	if o.Analysis != nil {
+		u.Analysis = o.Analysis.Copy()
+	}
 }
 
 func (u *UpdateStrategy) Canonicalize() {
@@ -774,6 +801,10 @@ func (u *UpdateStrategy) Canonicalize() {
 	if u.AutoPromote == nil {
 		u.AutoPromote = d.AutoPromote
 	}
+
+	if u.Analysis != nil {
+		u.Analysis.Canonicalize()
+	}
 }
 
 // Empty returns whether the UpdateStrategy is empty or has user defined values.
@@ -818,9 +849,100 @@ func (u *UpdateStrategy) Empty() bool {
 		return false
 	}
 
+	if u.Analysis != nil {
+		return false
+	}
+
 	return true
 }
 
+// CanaryAnalysis is an analysis run against the canaries of a deployment once
+// they are healthy. The deployment is promoted if the analysis passes and
+// failed otherwise.
+type CanaryAnalysis struct {
+	Duration     *time.Duration          `mapstructure:"duration" hcl:"duration,optional"`
+	Interval     *time.Duration          `mapstructure:"interval" hcl:"interval,optional"`
+	FailureLimit *int                    `mapstructure:"failure_limit" hcl:"failure_limit,optional"`
+	HTTP         *CanaryAnalysisHTTP     `mapstructure:"http" hcl:"http,block"`
+	Variable     *CanaryAnalysisVariable `mapstructure:"variable" hcl:"variable,block"`
+}
+
+// CanaryAnalysisHTTP is an HTTP query checked by a canary analysis. A check
+// passes when the query returns a 2xx status code.
+type CanaryAnalysisHTTP struct {
+	URL     string            `mapstructure:"url" hcl:"url,optional"`
+	Method  string            `mapstructure:"method" hcl:"method,optional"`
+	Header  map[string]string `mapstructure:"header" hcl:"header,optional"`
+	Timeout *time.Duration    `mapstructure:"timeout" hcl:"timeout,optional"`
+}
+
+// CanaryAnalysisVariable is a threshold on the value of a Nomad variable item
+// checked by a canary analysis. A check passes when the item is a number
+// within the min and max bounds.
+type CanaryAnalysisVariable struct {
+	Path string   `mapstructure:"path" hcl:"path,optional"`
+	Key  string   `mapstructure:"key" hcl:"key,optional"`
+	Min  *float64 `mapstructure:"min" hcl:"min,optional"`
+	Max  *float64 `mapstructure:"max" hcl:"max,optional"`
+}
+
+func (a *CanaryAnalysis) Canonicalize() {
+	if a.Duration == nil {
+		a.Duration = pointerOf(5 * time.Minute)
+	}
+	if a.Interval == nil {
+		a.Interval = pointerOf(30 * time.Second)
+	}
+	if a.FailureLimit == nil {
+		a.FailureLimit = pointerOf(0)
+	}
+	if a.HTTP != nil {
+		if a.HTTP.Method == "" {
+			a.HTTP.Method = http.MethodGet
+		}
+		if a.HTTP.Timeout == nil {
+			a.HTTP.Timeout = pointerOf(time.Duration(0))
+		}
+	}
+}
+
+func (a *CanaryAnalysis) Copy() *CanaryAnalysis {
+	if a == nil {
+		return nil
+	}
+
+	copy := new(CanaryAnalysis)
+	*copy = *a
+	if a.Duration != nil {
+		copy.Duration = pointerOf(*a.Duration)
+	}
+	if a.Interval != nil {
+		copy.Interval = pointerOf(*a.Interval)
+	}
+	if a.FailureLimit != nil {
+		copy.FailureLimit = pointerOf(*a.FailureLimit)
+	}
+	if a.HTTP != nil {
+		h := *a.HTTP
+		h.Header = maps.Clone(a.HTTP.Header)
+		if a.HTTP.Timeout != nil {
+			h.Timeout = pointerOf(*a.HTTP.Timeout)
+		}
+		copy.HTTP = &h
+	}
+	if a.Variable != nil {
+		v := *a.Variable
+		if a.Variable.Min != nil {
+			v.Min = pointerOf(*a.Variable.Min)
+		}
+		if a.Variable.Max != nil {
+			v.Max = pointerOf(*a.Variable.Max)
+		}
+		copy.Variable = &v
+	}
+	return copy
+}
+
 type Multiregion struct {
 	Strategy *MultiregionStrategy `hcl:"strategy,block"`
 	Regions  []*MultiregionRegion `hcl:"region,block"`
@@ -900,6 +1022,21 @@ type PeriodicConfig struct {
 	SpecType        *string
 	ProhibitOverlap *bool   `mapstructure:"prohibit_overlap" hcl:"prohibit_overlap,optional"`
 	TimeZone        *string `mapstructure:"time_zone" hcl:"time_zone,optional"`
+	CatchUp         *string `mapstructure:"catch_up" hcl:"catch_up,optional"`
+
+	// Exclusions suppress any launch that falls within them.
+	Exclusions []*PeriodicExclusion `hcl:"exclude,block"`
+}
+
+// PeriodicExclusion is a window during which a periodic job is not launched.
+// It is either a range of time, from Start up to but excluding End, or a cron
+// expression matching the launch times to skip. Start and End are either
+// RFC3339 timestamps or dates and times in the job's time zone, such as
+// "2025-12-24" or "2025-12-24 18:00".
+type PeriodicExclusion struct {
+	Start string `hcl:"start,optional"`
+	End   string `hcl:"end,optional"`
+	Cron  string `hcl:"cron,optional"`
 }
 
 func (p *PeriodicConfig) Canonicalize() {
@@ -921,13 +1058,100 @@ func (p *PeriodicConfig) Canonicalize() {
 	if p.TimeZone == nil || *p.TimeZone == "" {
 		p.TimeZone = pointerOf("UTC")
 	}
+	if p.CatchUp == nil || *p.CatchUp == "" {
+		p.CatchUp = pointerOf(PeriodicCatchUpRunOnce)
+	}
 }
 
 // Next returns the closest time instant matching the spec that is after the
-// passed time. If no matching instance exists, the zero value of time.Time is
-// returned. The `time.Location` of the returned value matches that of the
-// passed time.
+// passed time and not excluded. If no matching instance exists, the zero value
+// of time.Time is returned. The `time.Location` of the returned value matches
+// that of the passed time.
+// ---  THIS FUNCTION IS REPLICATED IN nomad/structs/structs.go
+// and should be kept in sync.
 func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
+	next, err := p.nextSpec(fromTime)
+	for i := 0; err == nil && !next.IsZero(); i++ {
+		excluded, until := p.excludedUntil(next)
+		if !excluded {
+			return next, nil
+		}
+		if i == periodicExclusionSkipLimit {
+			return time.Time{}, fmt.Errorf("no launch within %d launches that isn't excluded", periodicExclusionSkipLimit)
+		}
+		next, err = p.nextSpec(until)
+	}
+	return next, err
+}
+
+// periodicExclusionSkipLimit is the maximum number of consecutive excluded
+// launch times Next skips before giving up.
+const periodicExclusionSkipLimit = 10000
+
+// periodicExclusionTimeFormats are the formats accepted for the start and end
+// of an exclusion window, other than RFC3339.
+var periodicExclusionTimeFormats = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// excludedUntil returns whether t is excluded and, if so, the latest time
+// after which the next launch may occur.
+func (p *PeriodicConfig) excludedUntil(t time.Time) (bool, time.Time) {
+	loc, err := p.GetLocation()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	var excluded bool
+	var until time.Time
+	for _, e := range p.Exclusions {
+		if ok, u := e.excludedUntil(t, loc); ok && (!excluded || u.After(until)) {
+			excluded, until = true, u
+		}
+	}
+	return excluded, until
+}
+
+func (e *PeriodicExclusion) excludedUntil(t time.Time, loc *time.Location) (bool, time.Time) {
+	if e == nil {
+		return false, time.Time{}
+	}
+	if e.Cron != "" {
+		match, err := cronParseNext(t.Add(-time.Second), e.Cron)
+		if err != nil || !match.Equal(t) {
+			return false, time.Time{}
+		}
+		return true, t
+	}
+
+	start, err := parsePeriodicExclusionTime(e.Start, loc)
+	if err != nil {
+		return false, time.Time{}
+	}
+	end, err := parsePeriodicExclusionTime(e.End, loc)
+	if err != nil || t.Before(start) || !t.Before(end) {
+		return false, time.Time{}
+	}
+	return true, end.Add(-time.Nanosecond).In(t.Location())
+}
+
+func parsePeriodicExclusionTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, format := range periodicExclusionTimeFormats {
+		if t, err := time.ParseInLocation(format, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid exclusion time %q", value)
+}
+
+// nextSpec returns the closest time instant matching the spec that is after
+// the passed time, ignoring exclusions.
+func (p *PeriodicConfig) nextSpec(fromTime time.Time) (time.Time, error) {
 	// Single spec parsing
 	if p != nil && *p.SpecType == PeriodicSpecCron {
 		if p.Spec != nil && *p.Spec != "" {
@@ -982,6 +1206,36 @@ type ParameterizedJobConfig struct {
 	MetaOptional []string `mapstructure:"meta_optional" hcl:"meta_optional,optional"`
 }
 
+// GangConfig is used to configure all-or-nothing placement for a set of task
+// groups. If Groups is empty, every task group of the job is part of the gang.
+// Task groups outside of the gang are placed independently.
+type GangConfig struct {
+	Groups []string `hcl:"groups,optional"`
+}
+
+// JobDependencies defers evaluating a batch job until the jobs it depends on,
+// in the same namespace, have completed successfully. A periodic or
+// parameterized job completes once all of its children have.
+type JobDependencies struct {
+	Jobs []string `hcl:"jobs"`
+}
+
+// JobDependencyStatus is the status of a job that another job depends on.
+type JobDependencyStatus struct {
+	ID        string
+	Namespace string
+
+	// Status is the status of the job, or "missing" if it doesn't exist.
+	Status string
+
+	// Complete is whether the job has completed successfully, which
+	// satisfies the dependency.
+	Complete bool
+
+	// DependsOn are the statuses of the job's own dependencies.
+	DependsOn []*JobDependencyStatus
+}
+
 // JobSubmission is used to hold information about the original content of a job
 // specification being submitted to Nomad.
 //
@@ -1120,6 +1374,8 @@ type Job struct {
 	Spreads          []*Spread               `hcl:"spread,block"`
 	Periodic         *PeriodicConfig         `hcl:"periodic,block"`
 	ParameterizedJob *ParameterizedJobConfig `hcl:"parameterized,block"`
+	Gang             *GangConfig             `hcl:"gang,block"`
+	DependsOn        *JobDependencies        `mapstructure:"depends_on" hcl:"depends_on,block"`
 	Reschedule       *ReschedulePolicy       `hcl:"reschedule,block"`
 	Migrate          *MigrateStrategy        `hcl:"migrate,block"`
 	Meta             map[string]string       `hcl:"meta,block"`
@@ -1626,6 +1882,13 @@ type JobVersionsResponse struct {
 	QueryMeta
 }
 
+// JobDependenciesResponse is used for a job get dependencies request
+type JobDependenciesResponse struct {
+	Dependencies []*JobDependencyStatus
+	Dependents   []string
+	QueryMeta
+}
+
 // JobSubmissionResponse is used for a job get submission request
 type JobSubmissionResponse struct {
 	Submission *JobSubmission
@@ -425,9 +425,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Name:  pointerOf(""),
 						Count: pointerOf(1),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						RestartPolicy: &RestartPolicy{
 							Delay:           pointerOf(15 * time.Second),
@@ -506,9 +507,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Name:  pointerOf(""),
 						Count: pointerOf(1),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						RestartPolicy: &RestartPolicy{
 							Delay:           pointerOf(15 * time.Second),
@@ -592,9 +594,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Name:  pointerOf("bar"),
 						Count: pointerOf(1),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						RestartPolicy: &RestartPolicy{
 							Delay:           pointerOf(15 * time.Second),
@@ -776,9 +779,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 							Unlimited:     pointerOf(true),
 						},
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						Update: &UpdateStrategy{
 							Stagger:          pointerOf(30 * time.Second),
@@ -930,6 +934,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					SpecType:        pointerOf(PeriodicSpecCron),
 					ProhibitOverlap: pointerOf(false),
 					TimeZone:        pointerOf("UTC"),
+					CatchUp:         pointerOf(PeriodicCatchUpRunOnce),
 				},
 			},
 		},
@@ -1018,9 +1023,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Name:  pointerOf("bar"),
 						Count: pointerOf(1),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						RestartPolicy: &RestartPolicy{
 							Delay:           pointerOf(15 * time.Second),
@@ -1067,9 +1073,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Name:  pointerOf("baz"),
 						Count: pointerOf(1),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						RestartPolicy: &RestartPolicy{
 							Delay:           pointerOf(15 * time.Second),
@@ -1157,9 +1164,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Count:         pointerOf(1),
 						RestartPolicy: defaultServiceJobRestartPolicy(),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						Update: DefaultUpdateStrategy(),
 						Tasks: []*Task{
@@ -1257,9 +1265,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Name:  pointerOf("bar"),
 						Count: pointerOf(1),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						RestartPolicy: &RestartPolicy{
 							Delay:           pointerOf(15 * time.Second),
@@ -1308,9 +1317,10 @@ func TestJobs_Canonicalize(t *testing.T) {
 						Name:  pointerOf("baz"),
 						Count: pointerOf(1),
 						EphemeralDisk: &EphemeralDisk{
-							Sticky:  pointerOf(false),
-							Migrate: pointerOf(false),
-							SizeMB:  pointerOf(300),
+							Sticky:     pointerOf(false),
+							Migrate:    pointerOf(false),
+							Checkpoint: pointerOf(false),
+							SizeMB:     pointerOf(300),
 						},
 						RestartPolicy: &RestartPolicy{
 							Delay:           pointerOf(20 * time.Second),
@@ -2163,6 +2173,26 @@ func TestJobs_ForceEvaluate(t *testing.T) {
 	t.Fatalf("evaluation %q missing", evalID)
 }
 
+func TestPeriodicConfig_Next_Exclusions(t *testing.T) {
+	testutil.Parallel(t)
+
+	p := &PeriodicConfig{
+		Spec:     pointerOf("0 2 * * *"),
+		TimeZone: pointerOf("America/New_York"),
+		Exclusions: []*PeriodicExclusion{
+			{Start: "2025-12-24", End: "2025-12-27"},
+			{Cron: "* * * * 0,6"},
+		},
+	}
+	p.Canonicalize()
+	loc, err := p.GetLocation()
+	must.NoError(t, err)
+
+	next, err := p.Next(time.Date(2025, time.December, 23, 3, 0, 0, 0, loc))
+	must.NoError(t, err)
+	must.Eq(t, time.Date(2025, time.December, 29, 2, 0, 0, 0, loc), next)
+}
+
 func TestJobs_PeriodicForce(t *testing.T) {
 	testutil.Parallel(t)
 
@@ -70,18 +70,19 @@ func (n *Namespaces) Delete(namespace string, q *WriteOptions) (*WriteMeta, erro
 
 // Namespace is used to serialize a namespace.
 type Namespace struct {
-	Name                  string
-	Description           string
-	Quota                 string
-	Capabilities          *NamespaceCapabilities          `hcl:"capabilities,block"`
-	NodePoolConfiguration *NamespaceNodePoolConfiguration `hcl:"node_pool_config,block"`
-	VaultConfiguration    *NamespaceVaultConfiguration    `hcl:"vault,block"`
-	ConsulConfiguration   *NamespaceConsulConfiguration   `hcl:"consul,block"`
-	Meta                  map[string]string
-	CreateIndex           uint64
-	ModifyIndex           uint64
-	RequiredExtraClaims   map[string]string
-	OptionalExtraClaims   map[string]string
+	Name                    string
+	Description             string
+	Quota                   string
+	Capabilities            *NamespaceCapabilities            `hcl:"capabilities,block"`
+	NodePoolConfiguration   *NamespaceNodePoolConfiguration   `hcl:"node_pool_config,block"`
+	VaultConfiguration      *NamespaceVaultConfiguration      `hcl:"vault,block"`
+	ConsulConfiguration     *NamespaceConsulConfiguration     `hcl:"consul,block"`
+	PreemptionConfiguration *NamespacePreemptionConfiguration `hcl:"preemption_config,block"`
+	Meta                    map[string]string
+	CreateIndex             uint64
+	ModifyIndex             uint64
+	RequiredExtraClaims     map[string]string
+	OptionalExtraClaims     map[string]string
 }
 
 // NamespaceCapabilities represents a set of capabilities allowed for this
@@ -101,6 +102,15 @@ type NamespaceNodePoolConfiguration struct {
 	Denied  []string
 }
 
+// NamespacePreemptionConfiguration stores configuration about the preemption
+// performed by the scheduler on behalf of the jobs in a namespace.
+type NamespacePreemptionConfiguration struct {
+	// MaxPreemptionsPerEval is the maximum number of lower priority
+	// allocations that may be preempted while processing a single evaluation
+	// of a job in this namespace. Zero means there is no limit.
+	MaxPreemptionsPerEval int `mapstructure:"max_preemptions_per_eval" hcl:"max_preemptions_per_eval,optional"`
+}
+
 // NamespaceVaultConfiguration stores configuration about permissions to Vault
 // clusters for a namespace, for use with Nomad Enterprise.
 type NamespaceVaultConfiguration struct {
@@ -192,6 +192,14 @@ type SchedulerConfiguration struct {
 	// spread and/or affinity.
 	NodeLimitForFeasibilityChecks uint
 
+	// FairShareConfig specifies whether the eval broker dequeues evaluations
+	// using weighted fair-share queues across namespaces.
+	FairShareConfig FairShareConfig
+
+	// DisableImageLocality disables the scoring boost of nodes which already
+	// hold the images of a task group.
+	DisableImageLocality bool
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -221,8 +229,10 @@ type SchedulerSetConfigurationResponse struct {
 type SchedulerAlgorithm string
 
 const (
-	SchedulerAlgorithmBinpack SchedulerAlgorithm = "binpack"
-	SchedulerAlgorithmSpread  SchedulerAlgorithm = "spread"
+	SchedulerAlgorithmBinpack               SchedulerAlgorithm = "binpack"
+	SchedulerAlgorithmSpread                SchedulerAlgorithm = "spread"
+	SchedulerAlgorithmTetris                SchedulerAlgorithm = "tetris"
+	SchedulerAlgorithmLeastAllocatedByClass SchedulerAlgorithm = "least-allocated-by-class"
 )
 
 // PreemptionConfig specifies whether preemption is enabled based on scheduler type
@@ -233,6 +243,35 @@ type PreemptionConfig struct {
 	ServiceSchedulerEnabled  bool
 }
 
+// FairShareConfig configures weighted fair-share queueing of evaluations
+// across namespaces.
+type FairShareConfig struct {
+	Enabled bool
+
+	// Weights is the relative share of each namespace. Namespaces without a
+	// weight get a weight of 1.
+	Weights map[string]int
+}
+
+// SchedulerQueue is the fair-share queue state of a namespace in the eval
+// broker.
+type SchedulerQueue struct {
+	Namespace string
+	Weight    int
+	Usage     float64
+	Ready     int
+	Unacked   int
+}
+
+// SchedulerQueuesResponse is the response object for the eval broker's
+// fair-share queue state.
+type SchedulerQueuesResponse struct {
+	FairShareEnabled bool
+	Queues           []*SchedulerQueue
+
+	QueryMeta
+}
+
 // SchedulerGetConfiguration is used to query the current Scheduler configuration.
 func (op *Operator) SchedulerGetConfiguration(q *QueryOptions) (*SchedulerConfigurationResponse, *QueryMeta, error) {
 	var resp SchedulerConfigurationResponse
@@ -243,6 +282,17 @@ func (op *Operator) SchedulerGetConfiguration(q *QueryOptions) (*SchedulerConfig
 	return &resp, qm, nil
 }
 
+// SchedulerGetQueues is used to query the fair-share queue state of the eval
+// broker.
+func (op *Operator) SchedulerGetQueues(q *QueryOptions) (*SchedulerQueuesResponse, *QueryMeta, error) {
+	var resp SchedulerQueuesResponse
+	qm, err := op.c.query("/v1/operator/scheduler/queues", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
 // SchedulerSetConfiguration is used to set the current Scheduler configuration.
 func (op *Operator) SchedulerSetConfiguration(conf *SchedulerConfiguration, q *WriteOptions) (*SchedulerSetConfigurationResponse, *WriteMeta, error) {
 	var out SchedulerSetConfigurationResponse
@@ -161,6 +161,27 @@ type CNIConfig struct {
 	Args map[string]string `hcl:"args,optional"`
 }
 
+// BandwidthConfig is the rate limit of the traffic of an allocation in a
+// bridge or CNI network, in megabits per second. A zero rate is unlimited.
+type BandwidthConfig struct {
+	IngressMBits int `mapstructure:"ingress_mbits" hcl:"ingress_mbits,optional"`
+	EgressMBits  int `mapstructure:"egress_mbits" hcl:"egress_mbits,optional"`
+}
+
+// EgressConfig is the egress policy of an allocation in a bridge or CNI
+// network. Egress traffic is denied unless it is allowed by a rule.
+type EgressConfig struct {
+	Allow []*EgressRule `hcl:"allow,block"`
+}
+
+// EgressRule allows the egress traffic of an allocation to either a CIDR,
+// optionally restricted to ports, or to the registrations of a Nomad service.
+type EgressRule struct {
+	CIDR    string   `mapstructure:"cidr" hcl:"cidr,optional"`
+	Service string   `mapstructure:"service" hcl:"service,optional"`
+	Ports   []string `mapstructure:"ports" hcl:"ports,optional"`
+}
+
 // NetworkResource is used to describe required network
 // resources of a given task.
 type NetworkResource struct {
@@ -177,8 +198,10 @@ type NetworkResource struct {
 	// XXX Deprecated. Please do not use. The field will be removed in Nomad
 	// 0.13 and is only being kept to allow any references to be removed before
 	// then.
-	MBits *int       `hcl:"mbits,optional"`
-	CNI   *CNIConfig `hcl:"cni,block"`
+	MBits     *int             `hcl:"mbits,optional"`
+	CNI       *CNIConfig       `hcl:"cni,block"`
+	Bandwidth *BandwidthConfig `hcl:"bandwidth,block"`
+	Egress    *EgressConfig    `hcl:"egress,block"`
 }
 
 // Megabits should not be used.
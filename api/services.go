@@ -55,10 +55,20 @@ type ServiceRegistration struct {
 	// on cluster network topology.
 	Address string
 
+	// DualStackAddress is the IP address of the other IP family than Address
+	// of this service registration, if the allocation is dual-stack. It uses
+	// the same port as Address.
+	DualStackAddress string
+
 	// Port is the port number on which this service registration is bound. It
 	// is determined by a combination of factors on the client.
 	Port int
 
+	// CheckStatus is the aggregate status of the checks of this service, as
+	// observed by the client running it. It is empty if the service has no
+	// checks.
+	CheckStatus string
+
 	CreateIndex uint64
 	ModifyIndex uint64
 }
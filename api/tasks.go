@@ -74,9 +74,20 @@ type TaskResourceUsage struct {
 type AllocResourceUsage struct {
 	ResourceUsage *ResourceUsage
 	Tasks         map[string]*TaskResourceUsage
+	NetworkStats  *NetworkStats
 	Timestamp     int64
 }
 
+// NetworkStats holds the traffic counters of an allocation network namespace
+type NetworkStats struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	RxDropped uint64
+	TxDropped uint64
+}
+
 // AllocCheckStatus contains the current status of a nomad service discovery check.
 type AllocCheckStatus struct {
 	ID         string
@@ -358,16 +369,18 @@ func (s *Spread) Canonicalize() {
 
 // EphemeralDisk is an ephemeral disk object
 type EphemeralDisk struct {
-	Sticky  *bool `hcl:"sticky,optional"`
-	Migrate *bool `hcl:"migrate,optional"`
-	SizeMB  *int  `mapstructure:"size" hcl:"size,optional"`
+	Sticky     *bool `hcl:"sticky,optional"`
+	Migrate    *bool `hcl:"migrate,optional"`
+	Checkpoint *bool `hcl:"checkpoint,optional"`
+	SizeMB     *int  `mapstructure:"size" hcl:"size,optional"`
 }
 
 func DefaultEphemeralDisk() *EphemeralDisk {
 	return &EphemeralDisk{
-		Sticky:  pointerOf(false),
-		Migrate: pointerOf(false),
-		SizeMB:  pointerOf(300),
+		Sticky:     pointerOf(false),
+		Migrate:    pointerOf(false),
+		Checkpoint: pointerOf(false),
+		SizeMB:     pointerOf(300),
 	}
 }
 
@@ -378,6 +391,9 @@ func (e *EphemeralDisk) Canonicalize() {
 	if e.Migrate == nil {
 		e.Migrate = pointerOf(false)
 	}
+	if e.Checkpoint == nil {
+		e.Checkpoint = pointerOf(false)
+	}
 	if e.SizeMB == nil {
 		e.SizeMB = pointerOf(300)
 	}
@@ -716,6 +732,32 @@ type LogConfig struct {
 	Enabled *bool `mapstructure:"enabled" hcl:"enabled,optional"`
 
 	Disabled *bool `mapstructure:"disabled" hcl:"disabled,optional"`
+
+	// MaxAge is the age after which rotated log files are deleted.
+	MaxAge *time.Duration `mapstructure:"max_age" hcl:"max_age,optional"`
+
+	// Compress is the compression of rotated log files, "gzip" or "zstd".
+	Compress *string `mapstructure:"compress" hcl:"compress,optional"`
+
+	// Sinks ship the logs of the task to external destinations, in addition
+	// to the log files.
+	Sinks []*LogSink `mapstructure:"sink" hcl:"sink,block"`
+}
+
+// LogSink ships the stdout and stderr of a task to a syslog server, a
+// journald socket, or an HTTP endpoint.
+type LogSink struct {
+	Name       string            `hcl:",label"`
+	Type       string            `mapstructure:"type" hcl:"type,optional"`
+	Address    string            `mapstructure:"address" hcl:"address,optional"`
+	Headers    map[string]string `mapstructure:"headers" hcl:"headers,block"`
+	BufferSize *int              `mapstructure:"buffer_size" hcl:"buffer_size,optional"`
+}
+
+func (s *LogSink) Canonicalize() {
+	if s.BufferSize == nil {
+		s.BufferSize = pointerOf(1024)
+	}
 }
 
 func DefaultLogConfig() *LogConfig {
@@ -736,6 +778,9 @@ func (l *LogConfig) Canonicalize() {
 	if l.Disabled == nil {
 		l.Disabled = pointerOf(false)
 	}
+	for _, sink := range l.Sinks {
+		sink.Canonicalize()
+	}
 }
 
 // DispatchPayloadConfig configures how a task gets its input from a job dispatch
@@ -1159,6 +1204,8 @@ const (
 	TaskLeaderDead             = "Leader Task Dead"
 	TaskBuildingTaskDir        = "Building Task Directory"
 	TaskClientReconnected      = "Reconnected"
+	TaskCheckpointed           = "Checkpointed"
+	TaskRestoredFromCheckpoint = "Restored from checkpoint"
 )
 
 // TaskEvent is an event that effects the state of a task and contains meta-data
@@ -77,6 +77,11 @@ var (
 	// directory
 	TaskPrivate = "private"
 
+	// CheckpointDirName is the name of the directory inside each alloc
+	// directory holding the checkpoint images of its tasks. It is not
+	// accessible to tasks and is included in snapshots.
+	CheckpointDirName = "checkpoint"
+
 	// TaskDirs is the set of directories created in each tasks directory.
 	TaskDirs = map[string]os.FileMode{TmpDirName: os.ModeSticky | fileMode777}
 
@@ -189,7 +194,8 @@ func (a *AllocDir) NewTaskDir(task *structs.Task) *TaskDir {
 }
 
 // Snapshot creates an archive of the files and directories in the data dir of
-// the allocation and the task local directories
+// the allocation, the task local directories and the checkpoint images of the
+// tasks
 //
 // Since a valid tar may have been written even when an error occurs, a special
 // file "NOMAD-${ALLOC_ID}-ERROR.log" will be appended to the tar with the
@@ -203,6 +209,9 @@ func (a *AllocDir) Snapshot(w io.Writer) error {
 	for _, taskdir := range a.TaskDirs {
 		rootPaths = append(rootPaths, taskdir.LocalDir)
 	}
+	if checkpointDir := filepath.Join(a.AllocDir, CheckpointDirName); pathExists(checkpointDir) {
+		rootPaths = append(rootPaths, checkpointDir)
+	}
 
 	tw := tar.NewWriter(w)
 	defer tw.Close()
@@ -273,7 +282,8 @@ func (a *AllocDir) Snapshot(w io.Writer) error {
 	return nil
 }
 
-// Move other alloc directory's shared path and local dir to this alloc dir.
+// Move other alloc directory's shared path, local dirs and checkpoint images to
+// this alloc dir.
 func (a *AllocDir) Move(other Interface, tasks []*structs.Task) error {
 	a.mu.RLock()
 	if !a.built {
@@ -315,6 +325,15 @@ func (a *AllocDir) Move(other Interface, tasks []*structs.Task) error {
 		}
 	}
 
+	// Move the checkpoint images
+	otherCheckpointDir := filepath.Join(other.AllocDirPath(), CheckpointDirName)
+	if fileInfo, err := os.Stat(otherCheckpointDir); fileInfo != nil && err == nil {
+		checkpointDir := filepath.Join(a.AllocDir, CheckpointDirName)
+		if err := os.Rename(otherCheckpointDir, checkpointDir); err != nil {
+			return fmt.Errorf("error moving checkpoint dir: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -483,6 +502,11 @@ func (a *AllocDir) ReadAt(path string, offset int64) (io.ReadCloser, error) {
 	}
 	a.mu.RUnlock()
 
+	// Checkpoint images hold the memory of tasks
+	if caseInsensitiveHasPrefix(p, filepath.Join(a.AllocDir, CheckpointDirName)) {
+		return nil, fmt.Errorf("Reading checkpoint file prohibited: %s", path)
+	}
+
 	f, err := os.Open(p)
 	if err != nil {
 		return nil, err
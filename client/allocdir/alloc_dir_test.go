@@ -180,6 +180,10 @@ func TestAllocDir_Snapshot(t *testing.T) {
 	link1 := "baz"
 	must.NoError(t, os.Symlink("bar", filepath.Join(td1.LocalDir, link1)))
 
+	// Write a checkpoint image of the task
+	must.NoError(t, os.MkdirAll(td1.CheckpointDir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(td1.CheckpointDir, "pages-1.img"), exp, 0o600))
+
 	var b bytes.Buffer
 	must.NoError(t, d.Snapshot(&b))
 
@@ -201,7 +205,7 @@ func TestAllocDir_Snapshot(t *testing.T) {
 		}
 	}
 
-	must.SliceLen(t, 2, files)
+	must.SliceLen(t, 3, files)
 	must.SliceLen(t, 2, links)
 }
 
@@ -239,6 +243,11 @@ func TestAllocDir_Move(t *testing.T) {
 	file2 := "lol"
 	must.NoError(t, os.WriteFile(filepath.Join(td1.LocalDir, file2), exp2, 0o666))
 
+	// Write a checkpoint image of the task
+	file3 := "pages-1.img"
+	must.NoError(t, os.MkdirAll(td1.CheckpointDir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(td1.CheckpointDir, file3), exp2, 0o600))
+
 	// Move the d1 allocdir to d2
 	must.NoError(t, d2.Move(d1, []*structs.Task{t1}))
 
@@ -250,6 +259,10 @@ func TestAllocDir_Move(t *testing.T) {
 	fi, err = os.Stat(filepath.Join(d2.TaskDirs[t1.Name].LocalDir, file2))
 	must.NoError(t, err)
 	must.NotNil(t, fi)
+
+	fi, err = os.Stat(filepath.Join(d2.TaskDirs[t1.Name].CheckpointDir, file3))
+	must.NoError(t, err)
+	must.NotNil(t, fi)
 }
 
 func TestAllocDir_EscapeChecking(t *testing.T) {
@@ -312,6 +325,25 @@ func TestAllocDir_ReadAt_SecretDir(t *testing.T) {
 	must.EqError(t, err, "Reading secret file prohibited: web/secrets/test_file")
 }
 
+func TestAllocDir_ReadAt_CheckpointDir(t *testing.T) {
+	ci.Parallel(t)
+	tmp := t.TempDir()
+
+	d := NewAllocDir(testlog.HCLogger(t), tmp, tmp, "test")
+	must.NoError(t, d.Build())
+	defer func() { _ = d.Destroy() }()
+
+	td := d.NewTaskDir(t1)
+	must.NoError(t, os.MkdirAll(td.CheckpointDir, 0o700))
+
+	target := filepath.Join(CheckpointDirName, t1.Name, "pages-1.img")
+	must.NoError(t, os.WriteFile(filepath.Join(d.AllocDir, target), []byte("hi"), 0o600))
+
+	// ReadAt of a checkpoint image should fail
+	_, err := d.ReadAt(target, 0)
+	must.EqError(t, err, "Reading checkpoint file prohibited: checkpoint/web/pages-1.img")
+}
+
 func TestAllocDir_SplitPath(t *testing.T) {
 	ci.Parallel(t)
 
@@ -86,6 +86,12 @@ type TaskDir struct {
 	// <task_dir>/private/
 	PrivateDir string
 
+	// CheckpointDir is the path to the directory on the host holding the
+	// checkpoint image of the task. It is not created by Build.
+	//
+	// <alloc_dir>/checkpoint/<task>/
+	CheckpointDir string
+
 	// skip embedding these paths in chroots. Used for avoiding embedding
 	// client.alloc_dir and client.mounts_dir recursively.
 	skip *set.Set[string]
@@ -115,6 +121,7 @@ func (a *AllocDir) newTaskDir(taskName string, secretsInMB int) *TaskDir {
 		LocalDir:         filepath.Join(taskDir, TaskLocal),
 		SecretsDir:       filepath.Join(taskDir, TaskSecrets),
 		PrivateDir:       filepath.Join(taskDir, TaskPrivate),
+		CheckpointDir:    filepath.Join(a.AllocDir, CheckpointDirName, taskName),
 		MountsAllocDir:   filepath.Join(a.clientAllocMountsDir, taskUnique, "alloc"),
 		MountsTaskDir:    filepath.Join(a.clientAllocMountsDir, taskUnique),
 		MountsSecretsDir: filepath.Join(a.clientAllocMountsDir, taskUnique, "secrets"),
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/nomad/client/consul"
 	"github.com/hashicorp/nomad/client/devicemanager"
 	"github.com/hashicorp/nomad/client/dynamicplugins"
+	"github.com/hashicorp/nomad/client/hoststats"
 	cinterfaces "github.com/hashicorp/nomad/client/interfaces"
 	"github.com/hashicorp/nomad/client/lib/idset"
 	"github.com/hashicorp/nomad/client/lib/numalib/hw"
@@ -136,6 +137,11 @@ type allocRunner struct {
 	alloc     *structs.Allocation
 	allocLock sync.RWMutex
 
+	// networkIsolationSpec is the network namespace of the alloc, if any,
+	// which is used to collect network stats
+	networkIsolationSpec *drivers.NetworkIsolationSpec
+	networkIsolationLock sync.RWMutex
+
 	// state is the alloc runner's state
 	state     *state.State
 	stateLock sync.RWMutex
@@ -1375,9 +1381,26 @@ func (ar *allocRunner) LatestAllocStats(taskFilter string) (*cstructs.AllocResou
 		}
 	}
 
+	if spec := ar.NetworkIsolation(); spec != nil && spec.Path != "" {
+		networkStats, err := hoststats.CollectNetworkStats(spec.Path)
+		if err != nil {
+			ar.logger.Debug("failed to collect network stats", "error", err)
+		} else {
+			astat.NetworkStats = networkStats
+		}
+	}
+
 	return astat, nil
 }
 
+// NetworkIsolation returns the network namespace of the alloc, or nil if the
+// alloc doesn't use network isolation or it is not set up yet.
+func (ar *allocRunner) NetworkIsolation() *drivers.NetworkIsolationSpec {
+	ar.networkIsolationLock.RLock()
+	defer ar.networkIsolationLock.RUnlock()
+	return ar.networkIsolationSpec
+}
+
 func (ar *allocRunner) GetTaskEventHandler(taskName string) drivermanager.EventHandler {
 	if tr, ok := ar.tasks[taskName]; ok {
 		return func(ev *drivers.TaskEvent) {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -84,6 +85,9 @@ type checksHook struct {
 	checker checks.Checker
 	allocID string
 
+	// hookResources provides the task executors used by script checks
+	hookResources *cstructs.AllocHookResources
+
 	// fields that get re-initialized on allocation update
 	lock      sync.RWMutex
 	ctx       context.Context
@@ -97,14 +101,16 @@ func newChecksHook(
 	alloc *structs.Allocation,
 	shim checkstore.Shim,
 	network structs.NetworkStatus,
+	hookResources *cstructs.AllocHookResources,
 ) *checksHook {
 	h := &checksHook{
-		logger:  logger.Named(checksHookName),
-		allocID: alloc.ID,
-		alloc:   alloc,
-		shim:    shim,
-		network: network,
-		checker: checks.New(logger),
+		logger:        logger.Named(checksHookName),
+		allocID:       alloc.ID,
+		alloc:         alloc,
+		shim:          shim,
+		network:       network,
+		checker:       checks.New(logger),
+		hookResources: hookResources,
 	}
 	h.initialize(alloc)
 	return h
@@ -192,6 +198,7 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 					Task:             service.TaskName,
 					Service:          service.Name,
 					Check:            check.Name,
+					ScriptExecutor:   h.scriptExecutor,
 				},
 			}
 
@@ -222,6 +229,16 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 	}
 }
 
+// scriptExecutor returns the executor of the task for script checks, or nil if
+// the task isn't running.
+func (h *checksHook) scriptExecutor(task string) checks.ScriptExecutor {
+	exec := h.hookResources.GetScriptExecutor(task)
+	if exec == nil {
+		return nil
+	}
+	return exec
+}
+
 func (h *checksHook) Name() string {
 	return checksHookName
 }
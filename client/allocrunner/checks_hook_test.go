@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/state"
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/mock"
@@ -166,7 +167,7 @@ func TestCheckHook_Checks_ResultsSet(t *testing.T) {
 
 		env := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region).Build()
 
-		h := newChecksHook(logger, alloc, checkStore, network)
+		h := newChecksHook(logger, alloc, checkStore, network, cstructs.NewAllocHookResources())
 
 		// initialize is called; observers are created but not started yet
 		must.MapEmpty(t, h.observers)
@@ -233,7 +234,7 @@ func TestCheckHook_Checks_UpdateSet(t *testing.T) {
 
 	env := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region).Build()
 
-	h := newChecksHook(logger, alloc, shim, network)
+	h := newChecksHook(logger, alloc, shim, network, cstructs.NewAllocHookResources())
 
 	// calling pre-run starts the observers
 	err := h.Prerun(env)
@@ -25,6 +25,11 @@ type NomadBridgeConfig struct {
 	IPv6Subnet     string
 	HairpinMode    bool
 	ConsulCNI      bool
+
+	// Nftables configures the plugins to use nftables rather than iptables.
+	// The firewall plugin only supports iptables, so it is replaced by the
+	// forwarding rules of the client.
+	Nftables bool
 }
 
 // NewNomadBridgeConflist produces a full Conflist from the config.
@@ -46,36 +51,43 @@ func NewNomadBridgeConflist(conf NomadBridgeConfig) Conflist {
 		ipRoutes = append(ipRoutes, Route{Dst: "::/0"})
 	}
 
+	bridge := Bridge{
+		Type:         "bridge",
+		Bridgename:   conf.BridgeName,
+		IpMasq:       true,
+		IsGateway:    true,
+		ForceAddress: true,
+		HairpinMode:  conf.HairpinMode,
+		Ipam: IPAM{
+			Type:    "host-local",
+			Ranges:  ipRanges,
+			Routes:  ipRoutes,
+			DataDir: "/var/run/cni",
+		},
+	}
+	portmap := Portmap{
+		Type: "portmap",
+		Capabilities: PortmapCapabilities{
+			Portmappings: true,
+		},
+		Snat: true,
+	}
+
 	plugins := []any{
 		Generic{
 			Type: "loopback",
 		},
-		Bridge{
-			Type:         "bridge",
-			Bridgename:   conf.BridgeName,
-			IpMasq:       true,
-			IsGateway:    true,
-			ForceAddress: true,
-			HairpinMode:  conf.HairpinMode,
-			Ipam: IPAM{
-				Type:    "host-local",
-				Ranges:  ipRanges,
-				Routes:  ipRoutes,
-				DataDir: "/var/run/cni",
-			},
-		},
-		Firewall{
+	}
+	if conf.Nftables {
+		bridge.IPMasqBackend = "nftables"
+		portmap.Backend = "nftables"
+		plugins = append(plugins, bridge, portmap)
+	} else {
+		plugins = append(plugins, bridge, Firewall{
 			Type:           "firewall",
 			Backend:        "iptables",
 			AdminChainName: conf.AdminChainName,
-		},
-		Portmap{
-			Type: "portmap",
-			Capabilities: PortmapCapabilities{
-				Portmappings: true,
-			},
-			Snat: true,
-		},
+		}, portmap)
 	}
 	if conf.ConsulCNI {
 		plugins = append(plugins, ConsulCNI{
@@ -18,6 +18,9 @@ type Bridge struct {
 	ForceAddress bool   `json:"forceAddress"`
 	HairpinMode  bool   `json:"hairpinMode"`
 	Ipam         IPAM   `json:"ipam"`
+
+	// IPMasqBackend requires bridge plugin v1.5.0+
+	IPMasqBackend string `json:"ipMasqBackend,omitempty"`
 }
 type IPAM struct {
 	Type    string    `json:"type"`
@@ -46,6 +49,9 @@ type Portmap struct {
 	Type         string              `json:"type"`
 	Capabilities PortmapCapabilities `json:"capabilities"`
 	Snat         bool                `json:"snat"`
+
+	// Backend requires portmap plugin v1.5.0+
+	Backend string `json:"backend,omitempty"`
 }
 type PortmapCapabilities struct {
 	Portmappings bool `json:"portMappings"`
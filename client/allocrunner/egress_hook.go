@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package allocrunner
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/client/taskenv"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+const (
+	// egressQueryTime is the maximum time of the blocking queries watching
+	// the services an egress policy allows traffic to.
+	egressQueryTime = 5 * time.Minute
+
+	// egressRetryInterval is how long to wait before retrying a failed
+	// service query.
+	egressRetryInterval = 5 * time.Second
+)
+
+var (
+	_ interfaces.RunnerPrerunHook  = (*egressHook)(nil)
+	_ interfaces.RunnerUpdateHook  = (*egressHook)(nil)
+	_ interfaces.RunnerPostrunHook = (*egressHook)(nil)
+	_ interfaces.ShutdownHook      = (*egressHook)(nil)
+)
+
+// egressFirewall enforces the egress policy in the network namespace of an
+// alloc.
+type egressFirewall interface {
+	// Apply denies the egress traffic of the alloc, except to the given
+	// destinations.
+	Apply([]egressRule) error
+
+	// Remove allows all the egress traffic of the alloc.
+	Remove() error
+}
+
+// egressRule is a destination the egress traffic of an alloc is allowed to.
+type egressRule struct {
+	prefix netip.Prefix
+
+	// ports are the allowed port ranges. All ports are allowed if empty.
+	ports []egressPortRange
+}
+
+type egressPortRange struct {
+	from, to uint16
+}
+
+func (r egressRule) equal(o egressRule) bool {
+	return r.prefix == o.prefix && slices.Equal(r.ports, o.ports)
+}
+
+// networkIsolationGetter returns the network namespace of the alloc.
+type networkIsolationGetter interface {
+	NetworkIsolation() *drivers.NetworkIsolationSpec
+}
+
+// egressHook enforces the egress policy of the group network of the alloc,
+// and keeps the allowed destinations up to date as the Nomad services it
+// allows traffic to move.
+type egressHook struct {
+	logger    hclog.Logger
+	rpcClient config.RPCer
+	isolation networkIsolationGetter
+
+	newFirewall func(netnsPath string) (egressFirewall, error)
+
+	// lock guards the fields below and serializes changes to the firewall
+	lock     sync.Mutex
+	alloc    *structs.Allocation
+	firewall egressFirewall
+	applied  []egressRule
+	services map[string][]*structs.ServiceRegistration
+	watches  map[string]context.CancelFunc
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newEgressHook(logger hclog.Logger, alloc *structs.Allocation, rpcClient config.RPCer,
+	clientConfig *config.Config, isolation networkIsolationGetter) *egressHook {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &egressHook{
+		logger:    logger.Named("egress"),
+		rpcClient: rpcClient,
+		isolation: isolation,
+		newFirewall: func(netnsPath string) (egressFirewall, error) {
+			return newEgressFirewall(clientConfig, netnsPath)
+		},
+		alloc:    alloc,
+		services: make(map[string][]*structs.ServiceRegistration),
+		watches:  make(map[string]context.CancelFunc),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+func (h *egressHook) Name() string {
+	return "egress"
+}
+
+func (h *egressHook) Prerun(*taskenv.TaskEnv) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.reconcileLocked()
+}
+
+func (h *egressHook) Update(req *interfaces.RunnerUpdateRequest) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.alloc = req.Alloc
+	return h.reconcileLocked()
+}
+
+func (h *egressHook) Postrun() error {
+	h.cancel()
+	return nil
+}
+
+func (h *egressHook) Shutdown() {
+	h.cancel()
+}
+
+// egressConfig returns the egress policy of the group network of the alloc.
+func (h *egressHook) egressConfig() *structs.EgressConfig {
+	tg := h.alloc.Job.LookupTaskGroup(h.alloc.TaskGroup)
+	if tg == nil || len(tg.Networks) == 0 {
+		return nil
+	}
+	return tg.Networks[0].Egress
+}
+
+// reconcileLocked applies the egress policy of the alloc and watches the
+// services it allows traffic to. Must be called with the lock held.
+func (h *egressHook) reconcileLocked() error {
+	egress := h.egressConfig()
+	if egress == nil {
+		h.watchLocked(nil)
+		if h.firewall == nil {
+			return nil
+		}
+		// the egress policy was removed by an in-place update
+		if err := h.firewall.Remove(); err != nil {
+			return err
+		}
+		h.firewall, h.applied = nil, nil
+		return nil
+	}
+
+	if h.firewall == nil {
+		spec := h.isolation.NetworkIsolation()
+		if spec == nil || spec.Path == "" {
+			return errors.New("egress policy requires a network namespace")
+		}
+		firewall, err := h.newFirewall(spec.Path)
+		if err != nil {
+			return err
+		}
+		h.firewall = firewall
+	}
+
+	h.watchLocked(egress.Services())
+	return h.applyLocked(true)
+}
+
+// watchLocked starts watching the given services and stops watching the
+// others. The current registrations of new services are read before
+// returning, so they are allowed before the tasks start. Must be called with
+// the lock held.
+func (h *egressHook) watchLocked(services []string) {
+	for name, cancel := range h.watches {
+		if !slices.Contains(services, name) {
+			cancel()
+			delete(h.watches, name)
+			delete(h.services, name)
+		}
+	}
+
+	for _, name := range services {
+		if _, ok := h.watches[name]; ok {
+			continue
+		}
+
+		var index uint64
+		registrations, newIndex, err := h.readService(h.alloc, name, 0)
+		if err != nil {
+			h.logger.Warn("failed to read service registrations", "service", name, "error", err)
+		} else {
+			h.services[name], index = registrations, newIndex
+		}
+
+		ctx, cancel := context.WithCancel(h.ctx)
+		h.watches[name] = cancel
+		go h.watch(ctx, name, index)
+	}
+}
+
+// watch keeps the registrations of the service up to date until ctx is
+// cancelled, applying the egress policy when they change.
+func (h *egressHook) watch(ctx context.Context, name string, index uint64) {
+	for {
+		h.lock.Lock()
+		alloc := h.alloc
+		h.lock.Unlock()
+
+		registrations, newIndex, err := h.readService(alloc, name, index)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			h.logger.Warn("failed to read service registrations", "service", name, "error", err)
+			timer, stop := helper.NewSafeTimer(egressRetryInterval)
+			select {
+			case <-ctx.Done():
+				stop()
+				return
+			case <-timer.C:
+			}
+			stop()
+			continue
+		}
+		if newIndex == index {
+			continue
+		}
+		index = newIndex
+
+		h.lock.Lock()
+		if ctx.Err() == nil {
+			h.services[name] = registrations
+			if err := h.applyLocked(false); err != nil {
+				h.logger.Error("failed to apply egress policy", "service", name, "error", err)
+			}
+		}
+		h.lock.Unlock()
+	}
+}
+
+// readService reads the registrations of the service in the namespace of the
+// alloc with its workload identity, blocking until they change after index.
+func (h *egressHook) readService(alloc *structs.Allocation, name string, index uint64) ([]*structs.ServiceRegistration, uint64, error) {
+	args := structs.ServiceRegistrationByNameRequest{
+		ServiceName: name,
+		QueryOptions: structs.QueryOptions{
+			Region:        alloc.Job.Region,
+			Namespace:     alloc.Namespace,
+			AuthToken:     alloc.DefaultIdentityToken(),
+			AllowStale:    true,
+			MinQueryIndex: index,
+			MaxQueryTime:  egressQueryTime,
+		},
+	}
+	var resp structs.ServiceRegistrationByNameResponse
+	if err := h.rpcClient.RPC(structs.ServiceRegistrationGetServiceRPCMethod, &args, &resp); err != nil {
+		return nil, 0, err
+	}
+	return resp.Services, max(resp.Index, 1), nil
+}
+
+// applyLocked applies the egress policy with the current registrations of
+// its services. Unless force is set, the firewall is only changed if the
+// allowed destinations changed. Must be called with the lock held.
+func (h *egressHook) applyLocked(force bool) error {
+	if h.firewall == nil {
+		return nil
+	}
+
+	rules := buildEgressRules(h.logger, h.egressConfig(), h.services)
+	if !force && slices.EqualFunc(rules, h.applied, egressRule.equal) {
+		return nil
+	}
+
+	h.logger.Debug("applying egress policy", "rules", len(rules))
+	if err := h.firewall.Apply(rules); err != nil {
+		return err
+	}
+	h.applied = rules
+	return nil
+}
+
+// buildEgressRules returns the destinations allowed by the egress policy, given
+// the registrations of the services it allows traffic to. The rules are in the
+// order of the policy, and the registrations of a service are sorted.
+func buildEgressRules(logger hclog.Logger, egress *structs.EgressConfig,
+	services map[string][]*structs.ServiceRegistration) []egressRule {
+	if egress == nil {
+		return nil
+	}
+
+	var rules []egressRule
+	for _, allow := range egress.Allow {
+		if allow.Service == "" {
+			// the rule was validated when the job was registered
+			prefix, err := allow.Prefix()
+			if err != nil {
+				logger.Warn("ignoring invalid egress rule", "cidr", allow.CIDR, "error", err)
+				continue
+			}
+			rule := egressRule{prefix: prefix}
+			for _, port := range allow.Ports {
+				from, to, err := structs.ParseEgressPortRange(port)
+				if err != nil {
+					logger.Warn("ignoring invalid egress port", "port", port, "error", err)
+					continue
+				}
+				rule.ports = append(rule.ports, egressPortRange{from: from, to: to})
+			}
+			rules = append(rules, rule)
+			continue
+		}
+
+		var serviceRules []egressRule
+		for _, registration := range services[allow.Service] {
+			addresses := []string{registration.Address}
+			if registration.DualStackAddress != "" {
+				addresses = append(addresses, registration.DualStackAddress)
+			}
+			for _, address := range addresses {
+				addr, err := netip.ParseAddr(address)
+				if err != nil {
+					logger.Warn("ignoring service registration with invalid address",
+						"service", allow.Service, "address", address)
+					continue
+				}
+				addr = addr.Unmap()
+				rule := egressRule{prefix: netip.PrefixFrom(addr, addr.BitLen())}
+				if registration.Port > 0 {
+					port := uint16(registration.Port)
+					rule.ports = []egressPortRange{{from: port, to: port}}
+				}
+				serviceRules = append(serviceRules, rule)
+			}
+		}
+		slices.SortFunc(serviceRules, compareEgressRules)
+		rules = append(rules, slices.CompactFunc(serviceRules, egressRule.equal)...)
+	}
+	return rules
+}
+
+func compareEgressRules(a, b egressRule) int {
+	if c := a.prefix.Addr().Compare(b.prefix.Addr()); c != 0 {
+		return c
+	}
+	if c := a.prefix.Bits() - b.prefix.Bits(); c != 0 {
+		return c
+	}
+	return slices.CompareFunc(a.ports, b.ports, func(x, y egressPortRange) int {
+		if x.from != y.from {
+			return int(x.from) - int(y.from)
+		}
+		return int(x.to) - int(y.to)
+	})
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package allocrunner
+
+import (
+	"net/netip"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+func TestEgressHook_buildEgressRules(t *testing.T) {
+	ci.Parallel(t)
+
+	egress := &structs.EgressConfig{
+		Allow: []*structs.EgressRule{
+			{CIDR: "10.0.0.0/8", Ports: []string{"443", "8000-8100"}},
+			{Service: "db"},
+			{CIDR: "2001:db8::1"},
+			{Service: "missing"},
+		},
+	}
+	services := map[string][]*structs.ServiceRegistration{
+		"db": {
+			{Address: "192.168.1.20", Port: 5432},
+			{Address: "::ffff:192.168.1.10", Port: 5432},
+			{Address: "192.168.1.20", Port: 5432},
+			{Address: "db.example.com", Port: 5432},
+			{Address: "192.168.1.30", DualStackAddress: "2001:db8::30", Port: 5432},
+		},
+	}
+
+	must.EqFunc(t, []egressRule{
+		{
+			prefix: netip.MustParsePrefix("10.0.0.0/8"),
+			ports:  []egressPortRange{{from: 443, to: 443}, {from: 8000, to: 8100}},
+		},
+		{
+			prefix: netip.MustParsePrefix("192.168.1.10/32"),
+			ports:  []egressPortRange{{from: 5432, to: 5432}},
+		},
+		{
+			prefix: netip.MustParsePrefix("192.168.1.20/32"),
+			ports:  []egressPortRange{{from: 5432, to: 5432}},
+		},
+		{
+			prefix: netip.MustParsePrefix("192.168.1.30/32"),
+			ports:  []egressPortRange{{from: 5432, to: 5432}},
+		},
+		{
+			prefix: netip.MustParsePrefix("2001:db8::30/128"),
+			ports:  []egressPortRange{{from: 5432, to: 5432}},
+		},
+		{
+			prefix: netip.MustParsePrefix("2001:db8::1/128"),
+		},
+	}, buildEgressRules(testlog.HCLogger(t), egress, services),
+		func(a, b []egressRule) bool { return slices.EqualFunc(a, b, egressRule.equal) })
+
+	must.Nil(t, buildEgressRules(testlog.HCLogger(t), nil, services))
+}
+
+func TestEgressHook_Reconcile(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].Networks = structs.Networks{{
+		Mode: "bridge",
+		Egress: &structs.EgressConfig{
+			Allow: []*structs.EgressRule{
+				{CIDR: "10.0.0.0/8"},
+				{Service: "db"},
+			},
+		},
+	}}
+	alloc.SignedIdentities = map[string]string{"web": "web-identity"}
+
+	// services are read with the workload identity of the alloc
+	rpc := newMockEgressRPCer()
+	rpc.token = "web-identity"
+	rpc.setService("db", &structs.ServiceRegistration{Address: "192.168.1.10", Port: 5432})
+	firewall := &mockEgressFirewall{}
+
+	h := newEgressHook(testlog.HCLogger(t), alloc, rpc, testEgressClientConfig(),
+		&mockNetworkIsolationGetter{spec: &drivers.NetworkIsolationSpec{Path: "/var/run/netns/test"}})
+	h.newFirewall = func(netnsPath string) (egressFirewall, error) {
+		must.Eq(t, "/var/run/netns/test", netnsPath)
+		return firewall, nil
+	}
+	t.Cleanup(h.Shutdown)
+
+	// the registrations of services are allowed before the tasks start
+	must.NoError(t, h.Prerun(nil))
+	must.Eq(t, []string{"10.0.0.0/8", "192.168.1.10/32"}, firewall.prefixes())
+
+	// the policy follows the service as it moves
+	rpc.setService("db", &structs.ServiceRegistration{Address: "192.168.1.11", Port: 5432})
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			return slices.Equal([]string{"10.0.0.0/8", "192.168.1.11/32"}, firewall.prefixes())
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+
+	// the policy is removed by an in-place update
+	alloc = alloc.Copy()
+	alloc.Job.TaskGroups[0].Networks[0].Egress = nil
+	must.NoError(t, h.Update(&interfaces.RunnerUpdateRequest{Alloc: alloc}))
+	must.True(t, firewall.isRemoved())
+	must.MapEmpty(t, h.watches)
+
+	must.NoError(t, h.Postrun())
+}
+
+func TestEgressHook_NoNetworkNamespace(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	alloc.Job.TaskGroups[0].Networks = structs.Networks{{
+		Mode:   "bridge",
+		Egress: &structs.EgressConfig{},
+	}}
+
+	h := newEgressHook(testlog.HCLogger(t), alloc, newMockEgressRPCer(), testEgressClientConfig(),
+		&mockNetworkIsolationGetter{})
+	t.Cleanup(h.Shutdown)
+	must.ErrorContains(t, h.Prerun(nil), "egress policy requires a network namespace")
+
+	// allocs without an egress policy are not affected
+	alloc.Job.TaskGroups[0].Networks[0].Egress = nil
+	must.NoError(t, h.Prerun(nil))
+}
+
+func testEgressClientConfig() *config.Config {
+	return &config.Config{Node: mock.Node()}
+}
+
+type mockNetworkIsolationGetter struct {
+	spec *drivers.NetworkIsolationSpec
+}
+
+func (m *mockNetworkIsolationGetter) NetworkIsolation() *drivers.NetworkIsolationSpec {
+	return m.spec
+}
+
+type mockEgressFirewall struct {
+	lock    sync.Mutex
+	rules   []egressRule
+	removed bool
+}
+
+func (m *mockEgressFirewall) Apply(rules []egressRule) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.rules, m.removed = rules, false
+	return nil
+}
+
+func (m *mockEgressFirewall) Remove() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.rules, m.removed = nil, true
+	return nil
+}
+
+func (m *mockEgressFirewall) prefixes() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var prefixes []string
+	for _, rule := range m.rules {
+		prefixes = append(prefixes, rule.prefix.String())
+	}
+	return prefixes
+}
+
+func (m *mockEgressFirewall) isRemoved() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.removed
+}
+
+// mockEgressRPCer serves service registrations with blocking queries, to
+// requests authenticated with token if it is set
+type mockEgressRPCer struct {
+	lock     sync.Mutex
+	token    string
+	index    uint64
+	services map[string][]*structs.ServiceRegistration
+	changeCh chan struct{}
+}
+
+func newMockEgressRPCer() *mockEgressRPCer {
+	return &mockEgressRPCer{
+		index:    1,
+		services: make(map[string][]*structs.ServiceRegistration),
+		changeCh: make(chan struct{}),
+	}
+}
+
+func (m *mockEgressRPCer) setService(name string, registrations ...*structs.ServiceRegistration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.index++
+	m.services[name] = registrations
+	close(m.changeCh)
+	m.changeCh = make(chan struct{})
+}
+
+func (m *mockEgressRPCer) RPC(method string, args any, reply any) error {
+	req := args.(*structs.ServiceRegistrationByNameRequest)
+	resp := reply.(*structs.ServiceRegistrationByNameResponse)
+
+	m.lock.Lock()
+	if m.token != "" && req.AuthToken != m.token {
+		m.lock.Unlock()
+		return structs.ErrPermissionDenied
+	}
+	if req.MinQueryIndex >= m.index {
+		changeCh := m.changeCh
+		m.lock.Unlock()
+		select {
+		case <-changeCh:
+		case <-time.After(100 * time.Millisecond):
+		}
+		m.lock.Lock()
+	}
+	defer m.lock.Unlock()
+
+	resp.Services = m.services[req.ServiceName]
+	resp.Index = m.index
+	return nil
+}
@@ -193,7 +193,7 @@ func newNetworkConfigurator(log hclog.Logger, alloc *structs.Allocation, config
 	case netMode == "bridge":
 		c, err := newBridgeNetworkConfigurator(log, alloc,
 			config.BridgeNetworkName, config.BridgeNetworkAllocSubnet, config.BridgeNetworkAllocSubnetIPv6, config.CNIPath,
-			config.BridgeNetworkHairpinMode, ignorePortMappingHostIP,
+			firewallBackend(config), config.BridgeNetworkHairpinMode, ignorePortMappingHostIP,
 			config.Node)
 		if err != nil {
 			return nil, err
@@ -204,6 +204,7 @@ func newNetworkConfigurator(log hclog.Logger, alloc *structs.Allocation, config
 		if err != nil {
 			return nil, err
 		}
+		c.firewallBackend = firewallBackend(config)
 		return &synchronizedNetworkConfigurator{c}, nil
 	default:
 		return &hostNetworkConfigurator{}, nil
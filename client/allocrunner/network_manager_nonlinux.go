@@ -6,6 +6,8 @@
 package allocrunner
 
 import (
+	"errors"
+
 	hclog "github.com/hashicorp/go-hclog"
 	clientconfig "github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
@@ -33,3 +35,7 @@ func newNetworkManager(alloc *structs.Allocation, driverManager drivermanager.Ma
 func newNetworkConfigurator(log hclog.Logger, alloc *structs.Allocation, config *clientconfig.Config) (NetworkConfigurator, error) {
 	return &hostNetworkConfigurator{}, nil
 }
+
+func newEgressFirewall(*clientconfig.Config, string) (egressFirewall, error) {
+	return nil, errors.New("egress policies are only supported on linux")
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package allocrunner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/nomad/client/lib/nsutil"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	// bandwidthMinBurst is the minimum burst of the token bucket of a rate
+	// limit, in bytes. It must fit segmentation offloaded packets, which
+	// are larger than the MTU.
+	bandwidthMinBurst = 64 * 1024
+
+	// bandwidthLatencyMs is the maximum time a packet waits in the queue of
+	// a rate limit before being dropped.
+	bandwidthLatencyMs = 25
+)
+
+// setupBandwidth rate limits the traffic of the interface of the alloc
+// network namespace with token bucket filters. Egress traffic is shaped on the
+// interface itself, and ingress traffic on its veth peer in the host network
+// namespace.
+func setupBandwidth(netnsPath, ifName string, bandwidth *structs.BandwidthConfig) error {
+	if bandwidth == nil || (bandwidth.IngressMBits == 0 && bandwidth.EgressMBits == 0) {
+		return nil
+	}
+
+	var peerIndex int
+	err := nsutil.WithNetNSPath(netnsPath, func(nsutil.NetNS) error {
+		link, err := netlink.LinkByName(ifName)
+		if err != nil {
+			return fmt.Errorf("failed to find interface %q: %w", ifName, err)
+		}
+
+		if bandwidth.IngressMBits > 0 {
+			veth, ok := link.(*netlink.Veth)
+			if !ok {
+				return fmt.Errorf("ingress bandwidth requires a veth interface, %q is a %s", ifName, link.Type())
+			}
+			if peerIndex, err = netlink.VethPeerIndex(veth); err != nil {
+				return fmt.Errorf("failed to find peer of interface %q: %w", ifName, err)
+			}
+		}
+
+		if bandwidth.EgressMBits > 0 {
+			return addTBF(link, bandwidth.EgressMBits)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if bandwidth.IngressMBits > 0 {
+		peer, err := netlink.LinkByIndex(peerIndex)
+		if err != nil {
+			return fmt.Errorf("failed to find host peer of interface %q: %w", ifName, err)
+		}
+		return addTBF(peer, bandwidth.IngressMBits)
+	}
+	return nil
+}
+
+// addTBF replaces the root qdisc of the link with a token bucket filter
+// limiting its egress traffic to mbits.
+func addTBF(link netlink.Link, mbits int) error {
+	qdisc, err := newTBF(link.Attrs().Index, mbits)
+	if err != nil {
+		return err
+	}
+	if err := netlink.QdiscReplace(qdisc); err != nil {
+		return fmt.Errorf("failed to rate limit interface %q: %w", link.Attrs().Name, err)
+	}
+	return nil
+}
+
+// newTBF returns a token bucket filter limiting the egress traffic of the
+// link to mbits, equivalent to:
+//
+//	tc qdisc replace dev <link> root tbf rate <mbits>mbit burst <burst> latency 25ms
+func newTBF(linkIndex, mbits int) (*netlink.Tbf, error) {
+	if mbits <= 0 {
+		return nil, errors.New("rate must be positive")
+	}
+
+	rate := uint64(mbits) * 1000 * 1000 / 8
+	burst := max(rate/100, bandwidthMinBurst)
+
+	// the buffer is the time to send the burst at the rate, in ticks
+	buffer := float64(burst) * netlink.TIME_UNITS_PER_SEC / float64(rate)
+	latency := float64(netlink.TIME_UNITS_PER_SEC) * bandwidthLatencyMs / 1000
+
+	return &netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Handle:    netlink.MakeHandle(1, 0),
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		Rate:   rate,
+		Limit:  uint32(float64(rate)*latency/netlink.TIME_UNITS_PER_SEC) + uint32(burst),
+		Buffer: uint32(buffer * netlink.TickInUsec()),
+	}, nil
+}
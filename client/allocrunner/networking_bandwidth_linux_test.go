@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package allocrunner
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+	"github.com/vishvananda/netlink"
+)
+
+func TestSetupBandwidth_newTBF(t *testing.T) {
+	ci.Parallel(t)
+
+	_, err := newTBF(2, 0)
+	must.ErrorContains(t, err, "rate must be positive")
+
+	// 8 mbit/s is 1MB/s, so the burst is the minimum burst and the limit
+	// adds 25ms of traffic to it
+	tbf, err := newTBF(2, 8)
+	must.NoError(t, err)
+	must.Eq(t, 2, tbf.LinkIndex)
+	must.Eq(t, netlink.HANDLE_ROOT, tbf.Parent)
+	must.Eq(t, 1_000_000, tbf.Rate)
+	must.Eq(t, 25_000+bandwidthMinBurst, tbf.Limit)
+
+	// 10 gbit/s is 1.25GB/s, so the burst is 1% of the rate
+	tbf, err = newTBF(2, 10_000)
+	must.NoError(t, err)
+	must.Eq(t, 1_250_000_000, tbf.Rate)
+	must.Eq(t, 31_250_000+12_500_000, tbf.Limit)
+	must.Positive(t, tbf.Buffer)
+}
@@ -9,6 +9,7 @@ import (
 
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/allocrunner/cni"
+	clientconfig "github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/plugins/drivers"
 )
@@ -36,19 +37,23 @@ type bridgeNetworkConfigurator struct {
 	allocSubnetIPv4 string
 	bridgeName      string
 	hairpinMode     bool
+	firewallBackend string
 
 	newIPTables func(structs.NodeNetworkAF) (IPTablesChain, error)
+	newNFTables func() (NFTables, error)
 
 	logger hclog.Logger
 }
 
-func newBridgeNetworkConfigurator(log hclog.Logger, alloc *structs.Allocation, bridgeName, ipv4Range, ipv6Range, cniPath string, hairpinMode, ignorePortMappingHostIP bool, node *structs.Node) (*bridgeNetworkConfigurator, error) {
+func newBridgeNetworkConfigurator(log hclog.Logger, alloc *structs.Allocation, bridgeName, ipv4Range, ipv6Range, cniPath, firewallBackend string, hairpinMode, ignorePortMappingHostIP bool, node *structs.Node) (*bridgeNetworkConfigurator, error) {
 	b := &bridgeNetworkConfigurator{
 		bridgeName:      bridgeName,
 		hairpinMode:     hairpinMode,
+		firewallBackend: firewallBackend,
 		allocSubnetIPv4: ipv4Range,
 		allocSubnetIPv6: ipv6Range,
 		newIPTables:     newIPTablesChain,
+		newNFTables:     newNFTables,
 		logger:          log,
 	}
 
@@ -89,14 +94,23 @@ func newBridgeNetworkConfigurator(log hclog.Logger, alloc *structs.Allocation, b
 	if err != nil {
 		return nil, err
 	}
+	c.firewallBackend = b.firewallBackend
 	b.cni = c
 
 	return b, nil
 }
 
 // ensureForwardingRules ensures that a forwarding rule is added to iptables
-// to allow traffic inbound to the bridge network
+// or nftables to allow traffic inbound to the bridge network
 func (b *bridgeNetworkConfigurator) ensureForwardingRules() error {
+	if b.firewallBackend == clientconfig.FirewallBackendNftables {
+		nft, err := b.newNFTables()
+		if err != nil {
+			return err
+		}
+		return nft.Run(nftForwardingScript(b.bridgeName, b.allocSubnetIPv4, b.allocSubnetIPv6))
+	}
+
 	if b.allocSubnetIPv6 != "" {
 		ip6t, err := b.newIPTables(structs.NodeNetworkAF_IPv6)
 		if err != nil {
@@ -141,6 +155,7 @@ func buildNomadBridgeNetConfig(b bridgeNetworkConfigurator, withConsulCNI bool)
 		IPv6Subnet:     b.allocSubnetIPv6,
 		HairpinMode:    b.hairpinMode,
 		ConsulCNI:      withConsulCNI,
+		Nftables:       b.firewallBackend == clientconfig.FirewallBackendNftables,
 	})
 	return conf.Json()
 }
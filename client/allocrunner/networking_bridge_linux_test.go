@@ -64,6 +64,14 @@ func Test_buildNomadBridgeNetConfig(t *testing.T) {
 				hairpinMode:     true,
 			},
 		},
+		{
+			name: "nftables",
+			b: &bridgeNetworkConfigurator{
+				bridgeName:      defaultNomadBridgeName,
+				allocSubnetIPv4: defaultNomadAllocSubnet,
+				firewallBackend: "nftables",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -87,7 +95,7 @@ func TestBridgeNetworkConfigurator_newIPTables_default(t *testing.T) {
 
 	b, err := newBridgeNetworkConfigurator(hclog.Default(),
 		mock.MinAlloc(),
-		"", "", "", "",
+		"", "", "", "", "",
 		false, false,
 		mock.Node())
 	must.NoError(t, err)
@@ -158,7 +166,7 @@ func TestBridgeNetworkConfigurator_ensureForwardingRules(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			b, err := newBridgeNetworkConfigurator(hclog.Default(),
 				mock.MinAlloc(),
-				tc.bridgeName, tc.ip4, tc.ip6, "",
+				tc.bridgeName, tc.ip4, tc.ip6, "", "",
 				false, false,
 				mock.Node())
 			must.NoError(t, err)
@@ -29,6 +29,7 @@ import (
 	log "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-set/v3"
 	"github.com/hashicorp/go-version"
+	clientconfig "github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/envoy"
@@ -61,6 +62,11 @@ type cniNetworkConfigurator struct {
 	logger                  log.Logger
 	nsOpts                  *nsOpts
 	newIPTables             func(structs.NodeNetworkAF) (IPTablesCleanup, error)
+	newNFTables             func() (NFTables, error)
+
+	// firewallBackend selects how rules are cleaned up when the CNI plugins
+	// fail to remove them
+	firewallBackend string
 }
 
 func newCNINetworkConfigurator(logger log.Logger, cniPath, cniInterfacePrefix, cniConfDir, networkName string, ignorePortMappingHostIP bool, node *structs.Node) (*cniNetworkConfigurator, error) {
@@ -82,6 +88,7 @@ func newCNINetworkConfiguratorWithConf(logger log.Logger, cniPath, cniInterfaceP
 		nodeMeta:                node.Meta,
 		nsOpts:                  &nsOpts{},
 		newIPTables:             newIPTablesCleanup,
+		newNFTables:             newNFTables,
 	}
 	if cniPath == "" {
 		if cniPath = os.Getenv(envCNIPath); cniPath == "" {
@@ -243,6 +250,12 @@ func (c *cniNetworkConfigurator) Setup(ctx context.Context, alloc *structs.Alloc
 		return nil, err
 	}
 
+	if len(tg.Networks) > 0 && tg.Networks[0].Bandwidth != nil {
+		if err := setupBandwidth(spec.Path, allocNet.InterfaceName, tg.Networks[0].Bandwidth); err != nil {
+			return nil, fmt.Errorf("failed to configure network bandwidth: %w", err)
+		}
+	}
+
 	// overwrite the nameservers with Consul DNS, if we have it; we don't need
 	// the port because the iptables rule redirects port 53 traffic to it
 	if tproxyArgs != nil && tproxyArgs.ConsulDNSIP != "" {
@@ -594,6 +607,11 @@ func (c *cniNetworkConfigurator) Teardown(ctx context.Context, alloc *structs.Al
 	portMap := getPortMapping(alloc, c.ignorePortMappingHostIP)
 
 	if err := c.cni.Remove(ctx, alloc.ID, spec.Path, cni.WithCapabilityPortMap(portMap.ports)); err != nil {
+		if c.firewallBackend == clientconfig.FirewallBackendNftables {
+			c.logger.Warn("error from cni.Remove; attempting manual nftables cleanup", "err", err)
+			return c.forceCleanupNftables(alloc.ID)
+		}
+
 		c.logger.Warn("error from cni.Remove; attempting manual iptables cleanup", "err", err)
 
 		// best effort cleanup ipv6
@@ -696,6 +714,31 @@ func (c *cniNetworkConfigurator) forceCleanup(ipt IPTablesCleanup, allocID strin
 	return nil
 }
 
+// forceCleanupNftables is the nftables equivalent of forceCleanup, removing
+// the rules of the CNI plugins referencing the allocation.
+func (c *cniNetworkConfigurator) forceCleanupNftables(allocID string) error {
+	nft, err := c.newNFTables()
+	if err != nil {
+		return err
+	}
+	ruleset, err := nft.ListRuleset()
+	if err != nil {
+		return err
+	}
+	script, err := nftCleanupScript(ruleset, allocID)
+	if err != nil {
+		return err
+	}
+	if script == "" {
+		c.logger.Info("nftables cleanup: did not find rules for alloc", "alloc_id", allocID)
+		return nil
+	}
+	if err := nft.Run(script); err != nil {
+		return fmt.Errorf("failed to cleanup nftables rules for alloc %s: %w", allocID, err)
+	}
+	return nil
+}
+
 func (c *cniNetworkConfigurator) ensureCNIInitialized() error {
 	if err := c.cni.Status(); !cni.IsCNINotInitialized(err) {
 		return err
@@ -784,6 +827,15 @@ func getPortMapping(alloc *structs.Allocation, ignoreHostIP bool) *portMappings
 					portMapping.HostIP = port.HostIP
 				}
 				mappings.set(port.Label, portMapping)
+
+				// The portmap plugin only forwards a port to the address of
+				// the allocation of the same IP family as its host address,
+				// so dual-stack ports need a mapping for each family. The
+				// port is already forwarded on both without a host address.
+				if port.DualStackHostIP != "" && portMapping.HostIP != "" {
+					portMapping.HostIP = port.DualStackHostIP
+					mappings.set(port.Label, portMapping)
+				}
 			}
 		}
 	}
@@ -654,6 +654,39 @@ func TestCNI_cniToAllocNet_MultipleInterfaces_IPv6OnMultiple(t *testing.T) {
 	test.Eq(t, "eth0", allocNet.InterfaceName)          // first interface
 }
 
+func TestCNI_getPortMapping_DualStack(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.MinAlloc()
+	alloc.AllocatedResources.Shared.Ports = structs.AllocatedPorts{
+		{Label: "http", Value: 24098, To: 8080, HostIP: "192.168.1.10", DualStackHostIP: "2001:db8::10"},
+		{Label: "db", Value: 23098, To: 5432, HostIP: "192.168.1.10"},
+	}
+
+	portMaps := getPortMapping(alloc, false)
+	must.Eq(t, []cni.PortMapping{
+		{HostPort: 24098, ContainerPort: 8080, Protocol: "tcp", HostIP: "192.168.1.10"},
+		{HostPort: 24098, ContainerPort: 8080, Protocol: "tcp", HostIP: "2001:db8::10"},
+		{HostPort: 24098, ContainerPort: 8080, Protocol: "udp", HostIP: "192.168.1.10"},
+		{HostPort: 24098, ContainerPort: 8080, Protocol: "udp", HostIP: "2001:db8::10"},
+		{HostPort: 23098, ContainerPort: 5432, Protocol: "tcp", HostIP: "192.168.1.10"},
+		{HostPort: 23098, ContainerPort: 5432, Protocol: "udp", HostIP: "192.168.1.10"},
+	}, portMaps.ports)
+
+	port, ok := portMaps.get("http")
+	must.True(t, ok)
+	must.Eq(t, 8080, port.ContainerPort)
+
+	// without host addresses the ports are forwarded on both families
+	portMaps = getPortMapping(alloc, true)
+	must.Eq(t, []cni.PortMapping{
+		{HostPort: 24098, ContainerPort: 8080, Protocol: "tcp"},
+		{HostPort: 24098, ContainerPort: 8080, Protocol: "udp"},
+		{HostPort: 23098, ContainerPort: 5432, Protocol: "tcp"},
+		{HostPort: 23098, ContainerPort: 5432, Protocol: "udp"},
+	}, portMaps.ports)
+}
+
 func TestCNI_addCustomCNIArgs(t *testing.T) {
 	ci.Parallel(t)
 	cniArgs := map[string]string{
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package allocrunner
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/coreos/go-iptables/iptables"
+	clientconfig "github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/client/lib/nsutil"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// egressChainName is the name of the iptables chain of the alloc network
+	// namespace holding the allowed destinations of the egress policy
+	egressChainName = "NOMAD-EGRESS"
+)
+
+// egressOutputRules are the rules of the OUTPUT chain of the alloc network
+// namespace enforcing the egress policy, whose default is to drop traffic.
+var egressOutputRules = [][]string{
+	{"-o", "lo", "-j", "ACCEPT"},
+	{"-m", "conntrack", "--ctstate", "ESTABLISHED,RELATED", "-j", "ACCEPT"},
+	{"-j", egressChainName},
+}
+
+// EgressIPTables is a subset of iptables.IPTables
+type EgressIPTables interface {
+	IPTablesChain
+	Delete(table, chain string, rulespec ...string) error
+	ClearChain(table, chain string) error
+	ClearAndDeleteChain(table, chain string) error
+	ChangePolicy(table, chain, target string) error
+}
+
+func newEgressIPTables(family structs.NodeNetworkAF) (EgressIPTables, error) {
+	if family == structs.NodeNetworkAF_IPv6 {
+		return iptables.New(iptables.IPFamily(iptables.ProtocolIPv6), iptables.Timeout(5))
+	}
+	return iptables.New()
+}
+
+// iptablesEgressFirewall enforces egress policies with iptables rules in the
+// network namespace of the alloc, so they are removed along with it.
+type iptablesEgressFirewall struct {
+	netnsPath   string
+	newIPTables func(structs.NodeNetworkAF) (EgressIPTables, error)
+}
+
+func newEgressFirewall(config *clientconfig.Config, netnsPath string) (egressFirewall, error) {
+	if firewallBackend(config) == clientconfig.FirewallBackendNftables {
+		nft, err := newNFTables()
+		if err != nil {
+			return nil, err
+		}
+		return &nftEgressFirewall{netnsPath: netnsPath, nft: nft}, nil
+	}
+	return &iptablesEgressFirewall{
+		netnsPath:   netnsPath,
+		newIPTables: newEgressIPTables,
+	}, nil
+}
+
+// Apply implements egressFirewall. The allowed destinations are replaced in
+// place, so egress traffic is briefly denied while they are updated.
+func (f *iptablesEgressFirewall) Apply(rules []egressRule) error {
+	return nsutil.WithNetNSPath(f.netnsPath, func(nsutil.NetNS) error {
+		for _, family := range []structs.NodeNetworkAF{structs.NodeNetworkAF_IPv4, structs.NodeNetworkAF_IPv6} {
+			ipt, err := f.newIPTables(family)
+			if err != nil {
+				return fmt.Errorf("failed to create %s iptables: %w", family, err)
+			}
+			if err := applyEgressRules(ipt, iptablesEgressRules(rules, family)); err != nil {
+				return fmt.Errorf("failed to apply %s egress policy: %w", family, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Remove implements egressFirewall.
+func (f *iptablesEgressFirewall) Remove() error {
+	return nsutil.WithNetNSPath(f.netnsPath, func(nsutil.NetNS) error {
+		for _, family := range []structs.NodeNetworkAF{structs.NodeNetworkAF_IPv4, structs.NodeNetworkAF_IPv6} {
+			ipt, err := f.newIPTables(family)
+			if err != nil {
+				return fmt.Errorf("failed to create %s iptables: %w", family, err)
+			}
+			if err := removeEgressRules(ipt); err != nil {
+				return fmt.Errorf("failed to remove %s egress policy: %w", family, err)
+			}
+		}
+		return nil
+	})
+}
+
+// nftEgressFirewall enforces egress policies with an nftables table in the
+// network namespace of the alloc, so it is removed along with it.
+type nftEgressFirewall struct {
+	netnsPath string
+	nft       NFTables
+}
+
+// Apply implements egressFirewall. The allowed destinations are replaced
+// atomically.
+func (f *nftEgressFirewall) Apply(rules []egressRule) error {
+	return nsutil.WithNetNSPath(f.netnsPath, func(nsutil.NetNS) error {
+		if err := f.nft.Run(nftEgressScript(rules)); err != nil {
+			return fmt.Errorf("failed to apply egress policy: %w", err)
+		}
+		return nil
+	})
+}
+
+// Remove implements egressFirewall.
+func (f *nftEgressFirewall) Remove() error {
+	return nsutil.WithNetNSPath(f.netnsPath, func(nsutil.NetNS) error {
+		if err := f.nft.Run(nftRemoveEgressScript()); err != nil {
+			return fmt.Errorf("failed to remove egress policy: %w", err)
+		}
+		return nil
+	})
+}
+
+// applyEgressRules replaces the rules of the egress chain and ensures the
+// OUTPUT chain drops the traffic the egress chain doesn't accept.
+func applyEgressRules(ipt EgressIPTables, rules [][]string) error {
+	if err := ensureChain(ipt, "filter", egressChainName); err != nil {
+		return err
+	}
+	if err := ipt.ClearChain("filter", egressChainName); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if err := ipt.Append("filter", egressChainName, rule...); err != nil {
+			return err
+		}
+	}
+	for _, rule := range egressOutputRules {
+		if err := appendChainRule(ipt, "OUTPUT", rule); err != nil {
+			return err
+		}
+	}
+	return ipt.ChangePolicy("filter", "OUTPUT", "DROP")
+}
+
+// removeEgressRules restores the default policy of the OUTPUT chain and
+// removes the egress chain.
+func removeEgressRules(ipt EgressIPTables) error {
+	if err := ipt.ChangePolicy("filter", "OUTPUT", "ACCEPT"); err != nil {
+		return err
+	}
+	for _, rule := range egressOutputRules {
+		exists, err := ipt.Exists("filter", "OUTPUT", rule...)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if err := ipt.Delete("filter", "OUTPUT", rule...); err != nil {
+				return err
+			}
+		}
+	}
+	return ipt.ClearAndDeleteChain("filter", egressChainName)
+}
+
+// iptablesEgressRules returns the rules of the egress chain accepting the
+// traffic to the destinations of the given address family.
+func iptablesEgressRules(rules []egressRule, family structs.NodeNetworkAF) [][]string {
+	var out [][]string
+	for _, rule := range rules {
+		if rule.prefix.Addr().Is6() != (family == structs.NodeNetworkAF_IPv6) {
+			continue
+		}
+
+		dest := rule.prefix.String()
+		if len(rule.ports) == 0 {
+			out = append(out, []string{"-d", dest, "-j", "ACCEPT"})
+			continue
+		}
+		for _, ports := range rule.ports {
+			dport := strconv.Itoa(int(ports.from))
+			if ports.to != ports.from {
+				dport += ":" + strconv.Itoa(int(ports.to))
+			}
+			for _, proto := range []string{"tcp", "udp"} {
+				out = append(out, []string{"-d", dest, "-p", proto, "--dport", dport, "-j", "ACCEPT"})
+			}
+		}
+	}
+	return out
+}
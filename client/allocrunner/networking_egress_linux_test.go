@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package allocrunner
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestEgress_iptablesEgressRules(t *testing.T) {
+	ci.Parallel(t)
+
+	rules := []egressRule{
+		{
+			prefix: netip.MustParsePrefix("10.0.0.0/8"),
+			ports:  []egressPortRange{{from: 443, to: 443}, {from: 8000, to: 8100}},
+		},
+		{prefix: netip.MustParsePrefix("192.168.1.10/32")},
+		{prefix: netip.MustParsePrefix("2001:db8::/32")},
+	}
+
+	must.Eq(t, [][]string{
+		{"-d", "10.0.0.0/8", "-p", "tcp", "--dport", "443", "-j", "ACCEPT"},
+		{"-d", "10.0.0.0/8", "-p", "udp", "--dport", "443", "-j", "ACCEPT"},
+		{"-d", "10.0.0.0/8", "-p", "tcp", "--dport", "8000:8100", "-j", "ACCEPT"},
+		{"-d", "10.0.0.0/8", "-p", "udp", "--dport", "8000:8100", "-j", "ACCEPT"},
+		{"-d", "192.168.1.10/32", "-j", "ACCEPT"},
+	}, iptablesEgressRules(rules, structs.NodeNetworkAF_IPv4))
+
+	must.Eq(t, [][]string{
+		{"-d", "2001:db8::/32", "-j", "ACCEPT"},
+	}, iptablesEgressRules(rules, structs.NodeNetworkAF_IPv6))
+}
+
+func TestEgress_applyEgressRules(t *testing.T) {
+	ci.Parallel(t)
+
+	ipt := newMockEgressIPTables()
+	rule := []string{"-d", "10.0.0.0/8", "-j", "ACCEPT"}
+
+	// applying the rules is idempotent and replaces the allowed destinations
+	must.NoError(t, applyEgressRules(ipt, [][]string{rule}))
+	must.NoError(t, applyEgressRules(ipt, [][]string{rule}))
+	must.Eq(t, [][]string{rule}, ipt.rules[egressChainName])
+	must.Eq(t, egressOutputRules, ipt.rules["OUTPUT"])
+	must.Eq(t, "DROP", ipt.policies["OUTPUT"])
+
+	must.NoError(t, applyEgressRules(ipt, nil))
+	must.SliceEmpty(t, ipt.rules[egressChainName])
+
+	must.NoError(t, removeEgressRules(ipt))
+	must.MapNotContainsKey(t, ipt.rules, egressChainName)
+	must.SliceEmpty(t, ipt.rules["OUTPUT"])
+	must.Eq(t, "ACCEPT", ipt.policies["OUTPUT"])
+}
+
+// mockEgressIPTables keeps the rules of the chains of the filter table
+type mockEgressIPTables struct {
+	rules    map[string][][]string
+	policies map[string]string
+}
+
+func newMockEgressIPTables() *mockEgressIPTables {
+	return &mockEgressIPTables{
+		rules:    map[string][][]string{"OUTPUT": nil},
+		policies: map[string]string{"OUTPUT": "ACCEPT"},
+	}
+}
+
+func (m *mockEgressIPTables) ListChains(string) ([]string, error) {
+	var chains []string
+	for chain := range m.rules {
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+func (m *mockEgressIPTables) NewChain(_, chain string) error {
+	m.rules[chain] = nil
+	return nil
+}
+
+func (m *mockEgressIPTables) Exists(_, chain string, rulespec ...string) (bool, error) {
+	return slices.ContainsFunc(m.rules[chain], func(rule []string) bool {
+		return slices.Equal(rule, rulespec)
+	}), nil
+}
+
+func (m *mockEgressIPTables) Append(_, chain string, rulespec ...string) error {
+	m.rules[chain] = append(m.rules[chain], rulespec)
+	return nil
+}
+
+func (m *mockEgressIPTables) Delete(_, chain string, rulespec ...string) error {
+	m.rules[chain] = slices.DeleteFunc(m.rules[chain], func(rule []string) bool {
+		return slices.Equal(rule, rulespec)
+	})
+	return nil
+}
+
+func (m *mockEgressIPTables) ClearChain(_, chain string) error {
+	m.rules[chain] = nil
+	return nil
+}
+
+func (m *mockEgressIPTables) ClearAndDeleteChain(_, chain string) error {
+	delete(m.rules, chain)
+	return nil
+}
+
+func (m *mockEgressIPTables) ChangePolicy(_, chain, target string) error {
+	m.policies[chain] = target
+	return nil
+}
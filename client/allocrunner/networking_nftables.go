@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package allocrunner
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-version"
+	clientconfig "github.com/hashicorp/nomad/client/config"
+)
+
+const (
+	// nftTableName is the name of the nftables table holding the forwarding
+	// rules of the bridge network
+	nftTableName = "nomad"
+
+	// nftEgressTableName is the name of the nftables table of the alloc
+	// network namespace enforcing the egress policy
+	nftEgressTableName = "nomad_egress"
+
+	// nftCNITablePrefix is the prefix of the tables of the CNI plugins
+	nftCNITablePrefix = "cni_"
+)
+
+var (
+	// nftablesCNIVersion is the first version of the bridge and portmap CNI
+	// plugins supporting nftables
+	nftablesCNIVersion = version.Must(version.NewVersion("1.5.0"))
+
+	// detectIPTables returns whether iptables is installed on the host
+	detectIPTables = sync.OnceValue(func() bool {
+		_, err := exec.LookPath("iptables")
+		return err == nil
+	})
+
+	// detectNftables returns whether nftables is installed on the host
+	detectNftables = sync.OnceValue(func() bool {
+		_, err := exec.LookPath("nft")
+		return err == nil
+	})
+)
+
+// firewallBackend returns the configured firewall backend or detects it. The
+// nftables backend is only detected on hosts without iptables, and if the CNI
+// plugins support it, so the rules of existing allocs are not orphaned on
+// hosts where both are installed.
+func firewallBackend(config *clientconfig.Config) string {
+	if config.FirewallBackend != "" {
+		return config.FirewallBackend
+	}
+	if detectIPTables() || !detectNftables() {
+		return clientconfig.FirewallBackendIPTables
+	}
+	for _, plugin := range []string{"bridge", "portmap"} {
+		v, err := version.NewVersion(config.Node.Attributes["plugins.cni.version."+plugin])
+		if err != nil || v.LessThan(nftablesCNIVersion) {
+			return clientconfig.FirewallBackendIPTables
+		}
+	}
+	return clientconfig.FirewallBackendNftables
+}
+
+// NFTables runs nft commands
+type NFTables interface {
+	// Run runs the nft script atomically
+	Run(script string) error
+
+	// ListRuleset returns the ruleset in the JSON format
+	ListRuleset() ([]byte, error)
+}
+
+type nftCommand struct {
+	path string
+}
+
+func newNFTables() (NFTables, error) {
+	path, err := exec.LookPath("nft")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find nft: %w", err)
+	}
+	return &nftCommand{path: path}, nil
+}
+
+func (n *nftCommand) Run(script string) error {
+	cmd := exec.Command(n.path, "-f", "-")
+	cmd.Stdin = strings.NewReader(script)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to run nft: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (n *nftCommand) ListRuleset() ([]byte, error) {
+	out, err := exec.Command(n.path, "--json", "list", "ruleset").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nftables ruleset: %w", err)
+	}
+	return out, nil
+}
+
+// nftForwardingScript returns the nft script replacing the forwarding rules
+// of the bridge network, equivalent to the admin chain rule and the rules of
+// the firewall CNI plugin of the iptables backend.
+func nftForwardingScript(bridgeName string, subnets ...string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "add table inet %s\n", nftTableName)
+	fmt.Fprintf(&b, "add chain inet %s forward { type filter hook forward priority filter; policy accept; }\n", nftTableName)
+	fmt.Fprintf(&b, "flush chain inet %s forward\n", nftTableName)
+	for _, subnet := range subnets {
+		if subnet == "" {
+			continue
+		}
+		family := "ip"
+		if strings.Contains(subnet, ":") {
+			family = "ip6"
+		}
+		fmt.Fprintf(&b, "add rule inet %s forward oifname %s %s daddr %s accept\n",
+			nftTableName, strconv.Quote(bridgeName), family, subnet)
+		fmt.Fprintf(&b, "add rule inet %s forward iifname %s %s saddr %s accept\n",
+			nftTableName, strconv.Quote(bridgeName), family, subnet)
+	}
+	return b.String()
+}
+
+// nftEgressScript returns the nft script replacing the egress policy of the
+// alloc network namespace. The table is deleted and created in the same
+// transaction, so the policy is never partially applied.
+func nftEgressScript(rules []egressRule) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "add table inet %s\n", nftEgressTableName)
+	fmt.Fprintf(&b, "delete table inet %s\n", nftEgressTableName)
+	fmt.Fprintf(&b, "table inet %s {\n", nftEgressTableName)
+	b.WriteString("\tchain output {\n")
+	b.WriteString("\t\ttype filter hook output priority filter; policy drop;\n")
+	b.WriteString("\t\toifname \"lo\" accept\n")
+	b.WriteString("\t\tct state established,related accept\n")
+	for _, rule := range rules {
+		dest := nftDestination(rule.prefix)
+		if len(rule.ports) == 0 {
+			fmt.Fprintf(&b, "\t\t%s accept\n", dest)
+			continue
+		}
+		ports := make([]string, 0, len(rule.ports))
+		for _, r := range rule.ports {
+			port := strconv.Itoa(int(r.from))
+			if r.to != r.from {
+				port += "-" + strconv.Itoa(int(r.to))
+			}
+			ports = append(ports, port)
+		}
+		for _, proto := range []string{"tcp", "udp"} {
+			fmt.Fprintf(&b, "\t\t%s %s dport { %s } accept\n", dest, proto, strings.Join(ports, ", "))
+		}
+	}
+	b.WriteString("\t}\n")
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// nftRemoveEgressScript returns the nft script removing the egress policy of
+// the alloc network namespace, if any.
+func nftRemoveEgressScript() string {
+	return fmt.Sprintf("add table inet %[1]s\ndelete table inet %[1]s\n", nftEgressTableName)
+}
+
+func nftDestination(prefix netip.Prefix) string {
+	if prefix.Addr().Is4() {
+		return "ip daddr " + prefix.String()
+	}
+	return "ip6 daddr " + prefix.String()
+}
+
+// nftRuleset is the subset of the JSON format of the nftables ruleset
+// required to find the rules of an alloc
+type nftRuleset struct {
+	Nftables []struct {
+		Rule *struct {
+			Family  string `json:"family"`
+			Table   string `json:"table"`
+			Chain   string `json:"chain"`
+			Handle  int    `json:"handle"`
+			Comment string `json:"comment"`
+		} `json:"rule"`
+	} `json:"nftables"`
+}
+
+// nftCleanupScript returns the nft script deleting the rules of the CNI
+// plugins referencing the alloc in their comment.
+func nftCleanupScript(ruleset []byte, allocID string) (string, error) {
+	var rs nftRuleset
+	if err := json.Unmarshal(ruleset, &rs); err != nil {
+		return "", fmt.Errorf("failed to parse nftables ruleset: %w", err)
+	}
+
+	var b strings.Builder
+	for _, obj := range rs.Nftables {
+		rule := obj.Rule
+		if rule == nil || !strings.HasPrefix(rule.Table, nftCNITablePrefix) ||
+			!strings.Contains(rule.Comment, allocID) {
+			continue
+		}
+		fmt.Fprintf(&b, "delete rule %s %s %s handle %d\n", rule.Family, rule.Table, rule.Chain, rule.Handle)
+	}
+	return b.String(), nil
+}
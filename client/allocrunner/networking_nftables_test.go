@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package allocrunner
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/ci"
+	clientconfig "github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/shoenig/test/must"
+)
+
+func TestNftables_firewallBackend(t *testing.T) {
+	ci.Parallel(t)
+
+	// the configured backend is always used
+	config := &clientconfig.Config{
+		Node:            mock.Node(),
+		FirewallBackend: clientconfig.FirewallBackendNftables,
+	}
+	must.Eq(t, clientconfig.FirewallBackendNftables, firewallBackend(config))
+
+	config.FirewallBackend = clientconfig.FirewallBackendIPTables
+	must.Eq(t, clientconfig.FirewallBackendIPTables, firewallBackend(config))
+}
+
+func TestNftables_nftForwardingScript(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Eq(t, `add table inet nomad
+add chain inet nomad forward { type filter hook forward priority filter; policy accept; }
+flush chain inet nomad forward
+add rule inet nomad forward oifname "nomad" ip daddr 172.26.64.0/20 accept
+add rule inet nomad forward iifname "nomad" ip saddr 172.26.64.0/20 accept
+add rule inet nomad forward oifname "nomad" ip6 daddr fd00:a110:c8::/80 accept
+add rule inet nomad forward iifname "nomad" ip6 saddr fd00:a110:c8::/80 accept
+`, nftForwardingScript("nomad", "172.26.64.0/20", "fd00:a110:c8::/80"))
+
+	// the ipv6 subnet is optional
+	must.Eq(t, `add table inet nomad
+add chain inet nomad forward { type filter hook forward priority filter; policy accept; }
+flush chain inet nomad forward
+add rule inet nomad forward oifname "nomad" ip daddr 172.26.64.0/20 accept
+add rule inet nomad forward iifname "nomad" ip saddr 172.26.64.0/20 accept
+`, nftForwardingScript("nomad", "172.26.64.0/20", ""))
+}
+
+func TestNftables_nftEgressScript(t *testing.T) {
+	ci.Parallel(t)
+
+	rules := []egressRule{
+		{
+			prefix: netip.MustParsePrefix("10.0.0.0/8"),
+			ports:  []egressPortRange{{from: 443, to: 443}, {from: 8000, to: 8100}},
+		},
+		{prefix: netip.MustParsePrefix("2001:db8::/32")},
+	}
+
+	must.Eq(t, `add table inet nomad_egress
+delete table inet nomad_egress
+table inet nomad_egress {
+	chain output {
+		type filter hook output priority filter; policy drop;
+		oifname "lo" accept
+		ct state established,related accept
+		ip daddr 10.0.0.0/8 tcp dport { 443, 8000-8100 } accept
+		ip daddr 10.0.0.0/8 udp dport { 443, 8000-8100 } accept
+		ip6 daddr 2001:db8::/32 accept
+	}
+}
+`, nftEgressScript(rules))
+
+	must.Eq(t, "add table inet nomad_egress\ndelete table inet nomad_egress\n", nftRemoveEgressScript())
+}
+
+func TestNftables_nftCleanupScript(t *testing.T) {
+	ci.Parallel(t)
+
+	allocID := "4ce8f8a4-6e62-2a05-e1d4-ab1bdd3a2d5c"
+	ruleset := []byte(`{"nftables": [
+  {"metainfo": {"version": "1.0.9", "json_schema_version": 1}},
+  {"table": {"family": "inet", "name": "cni_plugins_masquerade", "handle": 1}},
+  {"rule": {"family": "inet", "table": "cni_plugins_masquerade", "chain": "masq_checks", "handle": 5,
+    "comment": "` + allocID + `", "expr": []}},
+  {"rule": {"family": "inet", "table": "cni_plugins_masquerade", "chain": "masq_checks", "handle": 6,
+    "comment": "other-alloc", "expr": []}},
+  {"rule": {"family": "ip", "table": "cni_hostport", "chain": "hostports", "handle": 12,
+    "comment": "nomad/` + allocID + `/eth0", "expr": []}},
+  {"rule": {"family": "inet", "table": "nomad", "chain": "forward", "handle": 3,
+    "comment": "` + allocID + `", "expr": []}}
+]}`)
+
+	script, err := nftCleanupScript(ruleset, allocID)
+	must.NoError(t, err)
+	must.Eq(t, `delete rule inet cni_plugins_masquerade masq_checks handle 5
+delete rule ip cni_hostport hostports handle 12
+`, script)
+
+	script, err = nftCleanupScript(ruleset, "missing")
+	must.NoError(t, err)
+	must.Eq(t, "", script)
+
+	_, err = nftCleanupScript([]byte("not json"), allocID)
+	must.ErrorContains(t, err, "failed to parse nftables ruleset")
+}
+
+func TestNftables_ensureForwardingRules(t *testing.T) {
+	ci.Parallel(t)
+
+	nft := &mockNFTables{}
+	b := &bridgeNetworkConfigurator{
+		bridgeName:      "nomad",
+		allocSubnetIPv4: "172.26.64.0/20",
+		firewallBackend: clientconfig.FirewallBackendNftables,
+		newNFTables:     func() (NFTables, error) { return nft, nil },
+		newIPTables:     newIPTablesChain,
+		logger:          hclog.NewNullLogger(),
+	}
+
+	must.NoError(t, b.ensureForwardingRules())
+	must.Eq(t, []string{nftForwardingScript("nomad", "172.26.64.0/20", "")}, nft.scripts)
+
+	nft.err = errors.New("nft failed")
+	must.ErrorIs(t, b.ensureForwardingRules(), nft.err)
+}
+
+func TestNftables_forceCleanup(t *testing.T) {
+	ci.Parallel(t)
+
+	nft := &mockNFTables{ruleset: []byte(`{"nftables": [
+  {"rule": {"family": "inet", "table": "cni_plugins_masquerade", "chain": "masq_checks", "handle": 5,
+    "comment": "alloc-id", "expr": []}}
+]}`)}
+	c := &cniNetworkConfigurator{
+		firewallBackend: clientconfig.FirewallBackendNftables,
+		newNFTables:     func() (NFTables, error) { return nft, nil },
+		logger:          hclog.NewNullLogger(),
+	}
+
+	must.NoError(t, c.forceCleanupNftables("alloc-id"))
+	must.Eq(t, []string{"delete rule inet cni_plugins_masquerade masq_checks handle 5\n"}, nft.scripts)
+
+	// nothing is run when the alloc has no rules left
+	must.NoError(t, c.forceCleanupNftables("other-id"))
+	must.SliceLen(t, 1, nft.scripts)
+}
+
+// mockNFTables records the scripts it runs
+type mockNFTables struct {
+	scripts []string
+	ruleset []byte
+	err     error
+}
+
+func (m *mockNFTables) Run(script string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.scripts = append(m.scripts, script)
+	return nil
+}
+
+func (m *mockNFTables) ListRuleset() ([]byte, error) {
+	return m.ruleset, nil
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"os"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+// checkpointer returns the driver of the task as a DriverCheckpointer if the
+// task group enables checkpoints and the driver supports them.
+func (tr *TaskRunner) checkpointer() (drivers.DriverCheckpointer, bool) {
+	alloc := tr.Alloc()
+	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	if tg == nil || tg.EphemeralDisk == nil || !tg.EphemeralDisk.Checkpoint {
+		return nil, false
+	}
+
+	if tr.driverCapabilities == nil || !tr.driverCapabilities.Checkpoint {
+		return nil, false
+	}
+	cp, ok := tr.driver.(drivers.DriverCheckpointer)
+	return cp, ok
+}
+
+// checkpointTask writes a checkpoint image of the task to its checkpoint
+// directory before it is killed, if its allocation is migrating to another
+// node. The image is carried along with the alloc dir and the task is
+// restored from it by the replacement allocation. The task is stopped once
+// checkpointed.
+func (tr *TaskRunner) checkpointTask(handle *DriverHandle) {
+	if !tr.Alloc().DesiredTransition.ShouldMigrate() {
+		return
+	}
+	cp, ok := tr.checkpointer()
+	if !ok {
+		return
+	}
+
+	// Remove any stale image so a failed checkpoint is never restored
+	imageDir := tr.taskDir.CheckpointDir
+	if err := os.RemoveAll(imageDir); err != nil {
+		tr.logger.Warn("failed to remove stale checkpoint", "error", err)
+		return
+	}
+
+	tr.logger.Debug("checkpointing task before migration", "image_dir", imageDir)
+	if err := cp.CheckpointTask(handle.ID(), &drivers.CheckpointOptions{ImageDir: imageDir}); err != nil {
+		tr.logger.Warn("failed to checkpoint task, killing it instead", "error", err)
+		_ = os.RemoveAll(imageDir)
+		return
+	}
+
+	tr.EmitEvent(structs.NewTaskEvent(structs.TaskCheckpointed))
+}
+
+// restoreTask restores the task from the checkpoint image migrated from the
+// previous allocation, if there is one. The image is removed afterwards so
+// restarts of the task start it from scratch. Returns a nil handle if the task
+// was not restored and must be started instead.
+func (tr *TaskRunner) restoreTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drivers.DriverNetwork) {
+	imageDir := tr.taskDir.CheckpointDir
+	if _, err := os.Stat(imageDir); err != nil {
+		return nil, nil
+	}
+	defer func() {
+		if err := os.RemoveAll(imageDir); err != nil {
+			tr.logger.Warn("failed to remove checkpoint", "error", err)
+		}
+	}()
+
+	cp, ok := tr.checkpointer()
+	if !ok {
+		tr.logger.Debug("checkpoint is not enabled, starting task instead")
+		return nil, nil
+	}
+
+	handle, net, err := cp.RestoreTask(cfg, imageDir)
+	if err != nil {
+		tr.logger.Warn("failed to restore task from checkpoint, starting it instead", "error", err)
+		return nil, nil
+	}
+
+	tr.EmitEvent(structs.NewTaskEvent(structs.TaskRestoredFromCheckpoint))
+	return handle, net
+}
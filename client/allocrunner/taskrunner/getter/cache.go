@@ -0,0 +1,556 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package getter
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-getter"
+	"github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
+)
+
+const (
+	// cacheDataName is the name of the artifact contents within a cache
+	// entry directory
+	cacheDataName = "data"
+
+	// cacheStagingPrefix is the prefix of the directories in which artifacts
+	// are staged before being added to the cache
+	cacheStagingPrefix = ".staging-"
+
+	// cacheSecretName is the name of the file holding the secret the cache
+	// keys are derived with
+	cacheSecretName = ".secret"
+
+	// cacheSecretSize is the size of the secret in bytes
+	cacheSecretSize = 32
+)
+
+// errCacheTooLarge is returned when an artifact does not fit in the cache.
+var errCacheTooLarge = errors.New("artifact is larger than the cache size limit")
+
+// Cache is a node level, content-addressed cache of downloaded artifacts.
+// Only artifacts whose contents are pinned by a checksum or an OCI digest are
+// cached, and entries are keyed by the namespace and source of the artifact,
+// so the cache never serves content the task did not ask for, nor content
+// downloaded by tasks of other namespaces.
+// Cached files are reflinked into task directories where the filesystem
+// supports it, and copied otherwise, so tasks never share the cached files.
+//
+// Each entry is stored as <dir>/<key>/data, where data is a file for
+// artifacts downloaded in file mode and a directory otherwise. The
+// modification time of the entry directory records when it was last used, so
+// the least recently used entries can be evicted across agent restarts.
+type Cache struct {
+	dir    string
+	limit  int64
+	logger hclog.Logger
+
+	// secret keys the HMAC naming the entries, so the credentials the key
+	// depends on can't be recovered from the entry names
+	secret []byte
+
+	lock    sync.Mutex
+	entries map[string]*cacheEntry
+	size    int64
+}
+
+// cacheEntry tracks an artifact stored in the cache.
+type cacheEntry struct {
+	size     int64
+	lastUsed time.Time
+
+	// refs is the number of artifacts currently being placed from the entry;
+	// entries in use are never evicted
+	refs int
+}
+
+// NewCache creates a Cache storing at most limit bytes of artifacts in dir,
+// restoring the entries left by a previous agent.
+func NewCache(dir string, limit int64, logger hclog.Logger) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create artifact cache dir: %w", err)
+	}
+
+	secret, err := loadCacheSecret(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cache{
+		dir:     dir,
+		limit:   limit,
+		logger:  logger.Named("artifact_cache"),
+		secret:  secret,
+		entries: make(map[string]*cacheEntry),
+	}
+
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact cache dir: %w", err)
+	}
+	for _, de := range dirEntries {
+		if de.Name() == cacheSecretName {
+			continue
+		}
+		path := filepath.Join(dir, de.Name())
+
+		info, err := de.Info()
+		if err == nil && de.IsDir() && !strings.HasPrefix(de.Name(), cacheStagingPrefix) {
+			var size int64
+			if size, err = treeSize(filepath.Join(path, cacheDataName)); err == nil {
+				c.entries[de.Name()] = &cacheEntry{size: size, lastUsed: info.ModTime()}
+				c.size += size
+				continue
+			}
+		}
+
+		// remove interrupted stagings and anything else that is not a
+		// valid entry
+		c.logger.Debug("removing invalid artifact cache entry", "path", path, "error", err)
+		if err := os.RemoveAll(path); err != nil {
+			c.logger.Warn("failed to remove invalid artifact cache entry", "path", path, "error", err)
+		}
+	}
+
+	c.lock.Lock()
+	c.evictLocked()
+	c.lock.Unlock()
+
+	return c, nil
+}
+
+// loadCacheSecret returns the secret stored in the cache dir, creating it if
+// there is none yet.
+func loadCacheSecret(dir string) ([]byte, error) {
+	path := filepath.Join(dir, cacheSecretName)
+	secret, err := os.ReadFile(path)
+	if err == nil && len(secret) == cacheSecretSize {
+		return secret, nil
+	} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read artifact cache secret: %w", err)
+	}
+
+	// the entries keyed with a lost secret are never used again, and are
+	// evicted as the cache fills up
+	secret = make([]byte, cacheSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, secret, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write artifact cache secret: %w", err)
+	}
+	return secret, nil
+}
+
+// key returns the cache key of the artifact described by p for a task in the
+// given namespace, and whether the artifact can be cached at all. Artifacts
+// are cacheable if their contents are pinned by a checksum or an OCI digest.
+func (c *Cache) key(namespace string, p *parameters) (string, bool) {
+	u, err := url.Parse(p.Source)
+	if err != nil {
+		return "", false
+	}
+
+	if u.Scheme == ociScheme {
+		// only OCI artifacts pinned by digest are immutable
+		if !strings.Contains(u.Path, "@") {
+			return "", false
+		}
+	} else if checksum := u.Query().Get("checksum"); checksum == "" || strings.HasPrefix(checksum, "file:") {
+		// without a checksum, or with a checksum read from a remote file,
+		// the contents may change between downloads
+		return "", false
+	}
+
+	h := hmac.New(sha256.New, c.secret)
+	// artifacts may be downloaded with credentials inherited from the client,
+	// so a checksum alone doesn't prove a task could download the artifact;
+	// the cache is never shared between namespaces
+	fmt.Fprintf(h, "namespace=%s\nmode=%d\nsource=%s\n", namespace, p.Mode, p.Source)
+
+	names := make([]string, 0, len(p.Headers))
+	for name := range p.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(h, "header=%s:%s\n", name, strings.Join(p.Headers[name], ","))
+	}
+
+	// artifacts pulled with different credentials are cached separately, so
+	// the cache never serves an artifact to a task that could not pull it
+	if p.RegistryAuth != (registryAuth{}) {
+		auth, _ := json.Marshal(p.RegistryAuth)
+		fmt.Fprintf(h, "registry_auth=%s\n", auth)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// acquire returns the path of the cached contents for key. The entry must be
+// released once the contents have been placed.
+func (c *Cache) acquire(key string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		metrics.IncrCounter([]string{"client", "artifact_cache", "miss"}, 1)
+		return "", false
+	}
+	metrics.IncrCounter([]string{"client", "artifact_cache", "hit"}, 1)
+
+	entry.refs++
+	c.touchLocked(key, entry)
+	return filepath.Join(c.dir, key, cacheDataName), true
+}
+
+// release releases an entry returned by acquire or add.
+func (c *Cache) release(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if entry, ok := c.entries[key]; ok {
+		entry.refs--
+	}
+	c.evictLocked()
+}
+
+// add moves the downloaded artifact at src into the cache under key, and
+// returns the path of the cached contents. As with acquire, the entry must be
+// released once the contents have been placed. If the artifact could not be
+// cached, src is left in place.
+func (c *Cache) add(key, src string) (_ string, err error) {
+	size, err := treeSize(src)
+	if err != nil {
+		return "", err
+	}
+	if size > c.limit {
+		return "", errCacheTooLarge
+	}
+
+	staging, err := os.MkdirTemp(c.dir, cacheStagingPrefix)
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(staging)
+
+	data := filepath.Join(staging, cacheDataName)
+	if err := os.Rename(src, data); err != nil {
+		// the alloc dir is on another filesystem
+		if err := copyTree(src, data); err != nil {
+			return "", err
+		}
+	} else {
+		defer func() {
+			if err != nil {
+				os.Rename(data, src)
+			}
+		}()
+	}
+
+	// make the cached files read-only, so they are never modified in place
+	if err := filepath.WalkDir(data, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return os.Chmod(path, info.Mode().Perm()&^0o222)
+	}); err != nil {
+		return "", err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		// another task may have added the same artifact concurrently,
+		// in which case its entry is used instead
+		if err := os.Rename(staging, filepath.Join(c.dir, key)); err != nil {
+			return "", err
+		}
+		entry = &cacheEntry{size: size}
+		c.entries[key] = entry
+		c.size += size
+	}
+	entry.refs++
+	c.touchLocked(key, entry)
+	c.evictLocked()
+
+	return filepath.Join(c.dir, key, cacheDataName), nil
+}
+
+// touchLocked marks the entry as used. Must be called with the lock held.
+func (c *Cache) touchLocked(key string, entry *cacheEntry) {
+	entry.lastUsed = time.Now()
+	if err := os.Chtimes(filepath.Join(c.dir, key), entry.lastUsed, entry.lastUsed); err != nil {
+		c.logger.Warn("failed to update artifact cache entry", "key", key, "error", err)
+	}
+}
+
+// evictLocked removes the least recently used entries until the cache is
+// within its size limit. Entries in use are skipped. Must be called with the
+// lock held.
+func (c *Cache) evictLocked() {
+	for c.size > c.limit {
+		var oldestKey string
+		var oldest *cacheEntry
+		for key, entry := range c.entries {
+			if entry.refs == 0 && (oldest == nil || entry.lastUsed.Before(oldest.lastUsed)) {
+				oldestKey, oldest = key, entry
+			}
+		}
+		if oldest == nil {
+			break
+		}
+
+		c.logger.Debug("evicting artifact", "key", oldestKey, "size", oldest.size)
+		if err := os.RemoveAll(filepath.Join(c.dir, oldestKey)); err != nil {
+			c.logger.Warn("failed to evict artifact", "key", oldestKey, "error", err)
+		}
+		delete(c.entries, oldestKey)
+		c.size -= oldest.size
+		metrics.IncrCounter([]string{"client", "artifact_cache", "evict"}, 1)
+	}
+
+	metrics.SetGauge([]string{"client", "artifact_cache", "size"}, float32(c.size))
+	metrics.SetGauge([]string{"client", "artifact_cache", "entries"}, float32(len(c.entries)))
+}
+
+// getCached downloads the artifact described by env through the cache.
+func (s *Sandbox) getCached(env *parameters, key string) error {
+	src, cached := s.cache.acquire(key)
+	if !cached {
+		tmpDir, err := os.MkdirTemp(env.AllocDir, "artifact-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		// download into the alloc dir with the usual sandboxing, but
+		// leave inspection and ownership to the placement below
+		download := *env
+		download.Destination = filepath.Join(tmpDir, "artifact")
+		download.DisableArtifactInspection = true
+		download.Chown = false
+		if err := s.runCmd(&download); err != nil {
+			return err
+		}
+
+		if needsInspection(env) {
+			artifactInspector, err := genWalkInspector(download.Destination)
+			if err != nil {
+				return err
+			}
+			if err := filepath.WalkDir(download.Destination, artifactInspector); err != nil {
+				return err
+			}
+		}
+
+		if src, err = s.cache.add(key, download.Destination); err != nil {
+			s.logger.Warn("failed to cache artifact", "source", env.Source, "error", err)
+			src = download.Destination
+		} else {
+			cached = true
+		}
+	}
+	if cached {
+		defer s.cache.release(key)
+	}
+
+	if err := placeArtifact(env, src); err != nil {
+		return err
+	}
+
+	if env.Chown {
+		return chownDestination(env.Destination, env.User)
+	}
+	return nil
+}
+
+// placeArtifact places the artifact contents at src into the destination of
+// env. Files are reflinked where the filesystem supports it, and copied
+// otherwise. In file mode the destination is replaced, otherwise the contents
+// are merged into it.
+func placeArtifact(env *parameters, src string) error {
+	at, err := os.OpenRoot(env.AllocDir)
+	if err != nil {
+		return err
+	}
+	defer at.Close()
+
+	dst, err := filepath.Rel(env.AllocDir, env.Destination)
+	if err != nil {
+		return err
+	}
+
+	if env.Mode == getter.ClientModeFile {
+		if err := at.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return err
+		}
+		if err := at.RemoveAll(dst); err != nil {
+			return err
+		}
+		info, err := os.Lstat(src)
+		if err != nil {
+			return err
+		}
+		return placeFile(at, src, dst, info)
+	}
+
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			if st, err := at.Lstat(target); err == nil && !st.IsDir() {
+				if err := at.Remove(target); err != nil {
+					return err
+				}
+			}
+			return at.MkdirAll(target, 0o755)
+		}
+
+		if err := at.RemoveAll(target); err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return placeFile(at, path, target, info)
+	})
+}
+
+// placeFile places the file at src, outside of the root, at dst within it.
+// Cached files are never hard linked, as a task able to write to the link
+// would modify the cached file served to other tasks.
+func placeFile(at *os.Root, src, dst string, info fs.FileInfo) error {
+	if info.Mode()&fs.ModeSymlink != 0 {
+		target, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		return at.Symlink(target, dst)
+	}
+
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("artifact contains irregular file %q", src)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	mode := info.Mode().Perm() | 0o200
+	out, err := at.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+
+	// prefer a reflink, which shares the data without sharing the file
+	if err := cloneFile(out, in); err == nil {
+		return out.Close()
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// copyTree copies the file or directory at src to dst.
+func copyTree(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, 0o755)
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case !d.Type().IsRegular():
+			return fmt.Errorf("artifact contains irregular file %q", path)
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
+
+// treeSize returns the total size of the files at path.
+func treeSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package getter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-getter"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/shoenig/test/must"
+)
+
+func testCache(t *testing.T, dir string, limit int64) *Cache {
+	t.Helper()
+
+	c, err := NewCache(dir, limit, testlog.HCLogger(t))
+	must.NoError(t, err)
+	return c
+}
+
+// writeArtifact writes a downloaded artifact with the given files to a new
+// temporary directory and returns its path.
+func writeArtifact(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "artifact")
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		must.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		must.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestCache_key(t *testing.T) {
+	ci.Parallel(t)
+
+	c := testCache(t, t.TempDir(), 1024)
+
+	checksummed := &parameters{
+		Mode:   getter.ClientModeAny,
+		Source: "s3::https://bucket.s3.amazonaws.com/app.tgz?checksum=sha256%3Aabc",
+	}
+	key, ok := c.key("default", checksummed)
+	must.True(t, ok)
+	must.Eq(t, 64, len(key))
+
+	t.Run("mode", func(t *testing.T) {
+		p := *checksummed
+		p.Mode = getter.ClientModeFile
+		other, ok := c.key("default", &p)
+		must.True(t, ok)
+		must.NotEq(t, key, other)
+	})
+
+	t.Run("namespace", func(t *testing.T) {
+		other, ok := c.key("other", checksummed)
+		must.True(t, ok)
+		must.NotEq(t, key, other)
+	})
+
+	t.Run("headers", func(t *testing.T) {
+		p := *checksummed
+		p.Headers = map[string][]string{"Authorization": {"Bearer abc"}}
+		other, ok := c.key("default", &p)
+		must.True(t, ok)
+		must.NotEq(t, key, other)
+	})
+
+	t.Run("no checksum", func(t *testing.T) {
+		_, ok := c.key("default", &parameters{Source: "git::https://example.com/repo.git"})
+		must.False(t, ok)
+	})
+
+	t.Run("remote checksum file", func(t *testing.T) {
+		_, ok := c.key("default", &parameters{Source: "s3::https://bucket/app.tgz?checksum=file%3A.%2Fsums"})
+		must.False(t, ok)
+	})
+
+	t.Run("http", func(t *testing.T) {
+		// the contents served for a URL may change between downloads
+		_, ok := c.key("default", &parameters{Source: "https://example.com/app.tgz"})
+		must.False(t, ok)
+	})
+
+	t.Run("oci", func(t *testing.T) {
+		_, ok := c.key("default", &parameters{Source: "oci://ghcr.io/org/app:1.0"})
+		must.False(t, ok)
+
+		p := &parameters{Source: "oci://ghcr.io/org/app@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}
+		pinned, ok := c.key("default", p)
+		must.True(t, ok)
+
+		p.RegistryAuth = registryAuth{Username: "user", Password: "pass"}
+		authed, ok := c.key("default", p)
+		must.True(t, ok)
+		must.NotEq(t, pinned, authed)
+	})
+
+	t.Run("secret", func(t *testing.T) {
+		// keys are stable across restarts, but differ between caches
+		restored := testCache(t, c.dir, 1024)
+		same, ok := restored.key("default", checksummed)
+		must.True(t, ok)
+		must.Eq(t, key, same)
+
+		other := testCache(t, t.TempDir(), 1024)
+		different, ok := other.key("default", checksummed)
+		must.True(t, ok)
+		must.NotEq(t, key, different)
+	})
+}
+
+func TestCache_addAcquire(t *testing.T) {
+	ci.Parallel(t)
+
+	c := testCache(t, t.TempDir(), 1024)
+
+	_, ok := c.acquire("abc")
+	must.False(t, ok)
+
+	src := writeArtifact(t, map[string]string{"a.txt": "hello", "sub/b.txt": "world"})
+	path, err := c.add("abc", src)
+	must.NoError(t, err)
+	must.DirNotExists(t, src)
+	c.release("abc")
+
+	b, err := os.ReadFile(filepath.Join(path, "sub", "b.txt"))
+	must.NoError(t, err)
+	must.Eq(t, "world", string(b))
+
+	// cached files are read-only
+	info, err := os.Stat(filepath.Join(path, "a.txt"))
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o444), info.Mode().Perm())
+
+	hit, ok := c.acquire("abc")
+	must.True(t, ok)
+	must.Eq(t, path, hit)
+	c.release("abc")
+	must.Eq(t, 10, c.size)
+
+	t.Run("too large", func(t *testing.T) {
+		src := writeArtifact(t, map[string]string{"big": string(make([]byte, 2048))})
+		_, err := c.add("big", src)
+		must.ErrorIs(t, err, errCacheTooLarge)
+		must.DirExists(t, src)
+	})
+
+	t.Run("restored", func(t *testing.T) {
+		must.NoError(t, os.Mkdir(filepath.Join(c.dir, cacheStagingPrefix+"123"), 0o700))
+
+		restored := testCache(t, c.dir, 1024)
+		must.MapLen(t, 1, restored.entries)
+		must.Eq(t, 10, restored.size)
+		must.DirNotExists(t, filepath.Join(c.dir, cacheStagingPrefix+"123"))
+		must.FileExists(t, filepath.Join(c.dir, cacheSecretName))
+
+		_, ok := restored.acquire("abc")
+		must.True(t, ok)
+		restored.release("abc")
+	})
+}
+
+func TestCache_evict(t *testing.T) {
+	ci.Parallel(t)
+
+	c := testCache(t, t.TempDir(), 10)
+
+	add := func(key string) {
+		t.Helper()
+		_, err := c.add(key, writeArtifact(t, map[string]string{"f": "1234"}))
+		must.NoError(t, err)
+	}
+
+	add("a")
+	c.release("a")
+	add("b")
+	c.release("b")
+
+	// use a so b becomes the least recently used
+	time.Sleep(10 * time.Millisecond)
+	_, ok := c.acquire("a")
+	must.True(t, ok)
+	c.release("a")
+
+	// c is still in use while the cache is over its limit, so b is evicted
+	add("c")
+	must.MapLen(t, 2, c.entries)
+	must.MapContainsKeys(t, c.entries, []string{"a", "c"})
+	must.DirNotExists(t, filepath.Join(c.dir, "b"))
+	must.Eq(t, 8, c.size)
+
+	// entries in use are never evicted
+	_, ok = c.acquire("a")
+	must.True(t, ok)
+	add("d")
+	must.MapContainsKeys(t, c.entries, []string{"a", "c"})
+	c.release("c")
+	c.release("d")
+	must.MapContainsKeys(t, c.entries, []string{"a", "d"})
+	c.release("a")
+	must.Eq(t, 8, c.size)
+}
+
+func TestCache_placeArtifact(t *testing.T) {
+	ci.Parallel(t)
+
+	src := writeArtifact(t, map[string]string{"a.txt": "new", "sub/b.txt": "sub"})
+	must.NoError(t, os.Symlink("a.txt", filepath.Join(src, "link")))
+
+	allocDir, taskDir := SetupDir(t)
+	dest := filepath.Join(taskDir, "local", "app")
+
+	// existing contents are merged with the artifact
+	must.NoError(t, os.MkdirAll(dest, 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(dest, "a.txt"), []byte("old"), 0o644))
+	must.NoError(t, os.WriteFile(filepath.Join(dest, "keep.txt"), []byte("keep"), 0o644))
+	must.NoError(t, os.WriteFile(filepath.Join(dest, "sub"), []byte("file"), 0o644))
+
+	env := &parameters{
+		Mode:        getter.ClientModeAny,
+		AllocDir:    allocDir,
+		Destination: dest,
+	}
+	must.NoError(t, placeArtifact(env, src))
+
+	for name, content := range map[string]string{
+		"a.txt":     "new",
+		"keep.txt":  "keep",
+		"sub/b.txt": "sub",
+		"link":      "new",
+	} {
+		b, err := os.ReadFile(filepath.Join(dest, name))
+		must.NoError(t, err)
+		must.Eq(t, content, string(b))
+	}
+
+	target, err := os.Readlink(filepath.Join(dest, "link"))
+	must.NoError(t, err)
+	must.Eq(t, "a.txt", target)
+
+	srcInfo, err := os.Stat(filepath.Join(src, "a.txt"))
+	must.NoError(t, err)
+	dstInfo, err := os.Stat(filepath.Join(dest, "a.txt"))
+	must.NoError(t, err)
+	must.False(t, os.SameFile(srcInfo, dstInfo))
+
+	// writing to the placed files leaves the cached files untouched
+	must.NoError(t, os.WriteFile(filepath.Join(dest, "a.txt"), []byte("poisoned"), 0o644))
+	b, err := os.ReadFile(filepath.Join(src, "a.txt"))
+	must.NoError(t, err)
+	must.Eq(t, "new", string(b))
+
+	t.Run("file mode", func(t *testing.T) {
+		allocDir, taskDir := SetupDir(t)
+		dest := filepath.Join(taskDir, "local", "bin", "app")
+
+		env := &parameters{
+			Mode:        getter.ClientModeFile,
+			AllocDir:    allocDir,
+			Destination: dest,
+		}
+		must.NoError(t, placeArtifact(env, filepath.Join(src, "a.txt")))
+
+		b, err := os.ReadFile(dest)
+		must.NoError(t, err)
+		must.Eq(t, "new", string(b))
+	})
+}
@@ -0,0 +1,16 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package getter
+
+import (
+	"errors"
+	"os"
+)
+
+// cloneFile is not implemented by default
+func cloneFile(*os.File, *os.File) error {
+	return errors.ErrUnsupported
+}
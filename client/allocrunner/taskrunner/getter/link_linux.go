@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package getter
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// cloneFile reflinks the contents of src into dst, which fails on filesystems
+// without copy-on-write support.
+func cloneFile(dst, src *os.File) error {
+	return unix.IoctlFileClone(int(dst.Fd()), int(src.Fd()))
+}
@@ -0,0 +1,709 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package getter
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-getter"
+	"github.com/klauspost/compress/zstd"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// ociScheme is the URL scheme of artifacts stored in OCI registries
+	ociScheme = "oci"
+
+	// ociUnpackAnnotation marks layers pushed by ORAS from a directory, which
+	// are tarballs to be unpacked rather than files
+	ociUnpackAnnotation = "io.deis.oras.content.unpack"
+
+	// ociMaxManifestBytes is the maximum size of a manifest
+	ociMaxManifestBytes = 4 << 20
+
+	// ociWhiteoutPrefix is the prefix of the files marking the removal of a
+	// file from a lower image layer
+	ociWhiteoutPrefix = ".wh."
+)
+
+// ociManifestTypes are the manifest media types accepted from registries.
+var ociManifestTypes = []string{
+	ocispec.MediaTypeImageManifest,
+	ocispec.MediaTypeImageIndex,
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// registryAuth is the credentials used to pull an OCI artifact.
+type registryAuth struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	IdentityToken string `json:"identity_token,omitempty"`
+	RegistryToken string `json:"registry_token,omitempty"`
+}
+
+// ociReference is a parsed OCI artifact source.
+type ociReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     digest.Digest
+}
+
+// parseOCIReference parses a source of the form
+// oci://registry/repository[:tag][@digest]. The tag defaults to latest.
+func parseOCIReference(u *url.URL) (*ociReference, error) {
+	if u.Host == "" {
+		return nil, errors.New("OCI artifact source must include a registry")
+	}
+
+	named, err := reference.ParseNamed(u.Host + u.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI artifact reference: %w", err)
+	}
+
+	ref := &ociReference{
+		Registry:   reference.Domain(named),
+		Repository: reference.Path(named),
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		ref.Tag = tagged.Tag()
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		ref.Digest = digested.Digest()
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+	return ref, nil
+}
+
+// manifestRef returns the digest of the reference if it is pinned, and its
+// tag otherwise.
+func (r *ociReference) manifestRef() string {
+	if r.Digest != "" {
+		return r.Digest.String()
+	}
+	return r.Tag
+}
+
+func (r *ociReference) String() string {
+	s := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest.String()
+	}
+	return s
+}
+
+// ociGetter is a go-getter Getter for artifacts stored in OCI registries,
+// such as those pushed with ORAS. Layers annotated with a title are written
+// to a file of that name, or unpacked if ORAS pushed a directory, and other
+// tar layers are extracted into the destination.
+type ociGetter struct {
+	client *getter.Client
+
+	// Auth is the credentials for the registry, if any
+	Auth registryAuth
+
+	// Timeout is the duration in which the artifact must be pulled
+	Timeout time.Duration
+
+	// MaxBytes is the maximum total size of the layers of the artifact
+	MaxBytes int64
+
+	// FileCountLimit and FileSizeLimit limit the number and total size of
+	// the files extracted from the layers
+	FileCountLimit int
+	FileSizeLimit  int64
+}
+
+func (g *ociGetter) SetClient(c *getter.Client) { g.client = c }
+
+// ClientMode always returns ClientModeDir, as an artifact may have many
+// layers.
+func (g *ociGetter) ClientMode(*url.URL) (getter.ClientMode, error) {
+	return getter.ClientModeDir, nil
+}
+
+// Get pulls the artifact and extracts its layers into the dst directory.
+func (g *ociGetter) Get(dst string, u *url.URL) error {
+	ctx, cancel := g.context()
+	defer cancel()
+
+	reg, layers, err := g.resolve(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dst, 0o755); err != nil {
+		return err
+	}
+	root, err := os.OpenRoot(dst)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	x := &ociExtractor{
+		root:           root,
+		fileCountLimit: g.FileCountLimit,
+		fileSizeLimit:  g.FileSizeLimit,
+	}
+	for _, layer := range layers {
+		if err := reg.withBlob(ctx, layer, func(blob io.Reader) error {
+			return x.layer(layer, blob)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFile pulls an artifact made of a single file and writes it to dst.
+func (g *ociGetter) GetFile(dst string, u *url.URL) error {
+	ctx, cancel := g.context()
+	defer cancel()
+
+	reg, layers, err := g.resolve(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	if len(layers) != 1 || layers[0].Annotations[ocispec.AnnotationTitle] == "" ||
+		layers[0].Annotations[ociUnpackAnnotation] == "true" {
+		return errors.New("OCI artifact must contain a single file to be downloaded in file mode")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := reg.withBlob(ctx, layers[0], func(blob io.Reader) error {
+		_, err := io.Copy(f, blob)
+		return err
+	}); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func (g *ociGetter) context() (context.Context, context.CancelFunc) {
+	ctx := context.Background()
+	if g.client != nil && g.client.Ctx != nil {
+		ctx = g.client.Ctx
+	}
+	if g.Timeout > 0 {
+		return context.WithTimeout(ctx, g.Timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// resolve returns a registry client for the artifact at u, and the layers of
+// its manifest.
+func (g *ociGetter) resolve(ctx context.Context, u *url.URL) (*ociRegistry, []ocispec.Descriptor, error) {
+	ref, err := parseOCIReference(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transport := cleanhttp.DefaultPooledTransport()
+	if g.client != nil && g.client.Insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	reg := newOCIRegistry(ref, g.Auth, &http.Client{Transport: transport})
+	layers, err := reg.layers(ctx, ref.manifestRef(), ref.Digest, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve OCI artifact %s: %w", ref, err)
+	}
+
+	var total int64
+	for _, layer := range layers {
+		total += layer.Size
+	}
+	if g.MaxBytes > 0 && total > g.MaxBytes {
+		return nil, nil, fmt.Errorf("OCI artifact %s is %d bytes, larger than the maximum of %d", ref, total, g.MaxBytes)
+	}
+
+	return reg, layers, nil
+}
+
+// ociRegistry is a minimal client of the OCI distribution API, able to pull
+// the manifests and blobs of a single repository.
+type ociRegistry struct {
+	client     *http.Client
+	auth       registryAuth
+	base       string
+	repository string
+
+	// authenticated is set once the registry challenged for credentials,
+	// after which they are sent with every request
+	authenticated bool
+	basic         bool
+	token         string
+}
+
+func newOCIRegistry(ref *ociReference, auth registryAuth, client *http.Client) *ociRegistry {
+	host := ref.Registry
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+
+	return &ociRegistry{
+		client:     client,
+		auth:       auth,
+		base:       fmt.Sprintf("https://%s/v2/%s", host, ref.Repository),
+		repository: ref.Repository,
+		token:      auth.RegistryToken,
+	}
+}
+
+// layers returns the layers of the manifest ref, which must match want if it
+// is set. If the manifest is an index, the manifest for the platform of the
+// client is used.
+func (r *ociRegistry) layers(ctx context.Context, ref string, want digest.Digest, followIndex bool) ([]ocispec.Descriptor, error) {
+	resp, err := r.get(ctx, "/manifests/"+ref, ociManifestTypes...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, ociMaxManifestBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > ociMaxManifestBytes {
+		return nil, errors.New("manifest is too large")
+	}
+	if want != "" {
+		verifier := want.Verifier()
+		verifier.Write(body)
+		if !verifier.Verified() {
+			return nil, fmt.Errorf("manifest does not match digest %s", want)
+		}
+	}
+
+	var manifest struct {
+		MediaType string               `json:"mediaType"`
+		Manifests []ocispec.Descriptor `json:"manifests"`
+		Layers    []ocispec.Descriptor `json:"layers"`
+	}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	mediaType := manifest.MediaType
+	if mediaType == "" {
+		mediaType, _, _ = strings.Cut(resp.Header.Get("Content-Type"), ";")
+	}
+
+	switch mediaType {
+	case ocispec.MediaTypeImageIndex, "application/vnd.docker.distribution.manifest.list.v2+json":
+		if !followIndex {
+			return nil, errors.New("nested manifest indexes are not supported")
+		}
+		desc, err := platformManifest(manifest.Manifests)
+		if err != nil {
+			return nil, err
+		}
+		if err := desc.Digest.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid manifest digest: %w", err)
+		}
+		return r.layers(ctx, desc.Digest.String(), desc.Digest, false)
+	case ocispec.MediaTypeImageManifest, "application/vnd.docker.distribution.manifest.v2+json":
+		return manifest.Layers, nil
+	default:
+		return nil, fmt.Errorf("unsupported manifest media type %q", mediaType)
+	}
+}
+
+// platformManifest returns the manifest of an index for the platform of the
+// client, or its only manifest.
+func platformManifest(manifests []ocispec.Descriptor) (ocispec.Descriptor, error) {
+	for _, desc := range manifests {
+		if p := desc.Platform; p != nil && p.OS == runtime.GOOS && p.Architecture == runtime.GOARCH {
+			return desc, nil
+		}
+	}
+	if len(manifests) == 1 {
+		return manifests[0], nil
+	}
+	return ocispec.Descriptor{}, fmt.Errorf("manifest index has no manifest for %s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+// withBlob downloads the blob described by desc to a temporary file, verifies
+// it, and then calls fn with its contents.
+func (r *ociRegistry) withBlob(ctx context.Context, desc ocispec.Descriptor, fn func(io.Reader) error) error {
+	if err := desc.Digest.Validate(); err != nil {
+		return fmt.Errorf("invalid layer digest: %w", err)
+	}
+
+	resp, err := r.get(ctx, "/blobs/"+desc.Digest.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	f, err := os.CreateTemp("", "oci-blob-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	verifier := desc.Digest.Verifier()
+	n, err := io.Copy(io.MultiWriter(f, verifier), io.LimitReader(resp.Body, desc.Size+1))
+	if err != nil {
+		return fmt.Errorf("failed to download layer %s: %w", desc.Digest, err)
+	}
+	if n != desc.Size {
+		return fmt.Errorf("layer %s is %d bytes, expected %d", desc.Digest, n, desc.Size)
+	}
+	if !verifier.Verified() {
+		return fmt.Errorf("layer does not match digest %s", desc.Digest)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return fn(f)
+}
+
+// get requests the path of the repository, authenticating as challenged by
+// the registry.
+func (r *ociRegistry) get(ctx context.Context, path string, accept ...string) (*http.Response, error) {
+	resp, err := r.do(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && !r.authenticated {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		if err := r.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = r.do(ctx, path, accept); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected response from registry: %s", resp.Status)
+	}
+	return resp, nil
+}
+
+func (r *ociRegistry) do(ctx context.Context, path string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.base+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+
+	switch {
+	case r.token != "":
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	case r.basic:
+		req.SetBasicAuth(r.auth.Username, r.auth.Password)
+	}
+
+	return r.client.Do(req)
+}
+
+// authenticate handles the WWW-Authenticate challenge of the registry.
+func (r *ociRegistry) authenticate(ctx context.Context, challenge string) error {
+	r.authenticated = true
+
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if r.auth.Username == "" {
+			return errors.New("registry requires credentials")
+		}
+		r.basic = true
+		return nil
+	case "bearer":
+		token, err := r.fetchToken(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to authenticate with registry: %w", err)
+		}
+		r.token = token
+		return nil
+	default:
+		return fmt.Errorf("unsupported registry authentication scheme %q", scheme)
+	}
+}
+
+// fetchToken gets a bearer token from the token server of the registry, with
+// a refresh token if there is an identity token, or basic authentication if
+// there are credentials.
+func (r *ociRegistry) fetchToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || (realm.Scheme != "https" && realm.Scheme != "http") {
+		return "", fmt.Errorf("invalid token realm %q", params["realm"])
+	}
+
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", r.repository)
+	}
+
+	var req *http.Request
+	if r.auth.IdentityToken != "" {
+		form := url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {r.auth.IdentityToken},
+			"service":       {params["service"]},
+			"scope":         {scope},
+			"client_id":     {"nomad"},
+		}
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, realm.String(), strings.NewReader(form.Encode()))
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		q := realm.Query()
+		if service := params["service"]; service != "" {
+			q.Set("service", service)
+		}
+		q.Set("scope", scope)
+		realm.RawQuery = q.Encode()
+
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		if r.auth.Username != "" {
+			req.SetBasicAuth(r.auth.Username, r.auth.Password)
+		}
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response from token server: %s", resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, ociMaxManifestBytes)).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode token: %w", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", errors.New("token server returned no token")
+}
+
+// parseChallenge parses a WWW-Authenticate header into its scheme and
+// parameters, e.g. Bearer realm="https://auth.example.com/token",service="x"
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if strings.HasPrefix(value, `"`) {
+			// quoted values may contain commas and escaped quotes
+			var b strings.Builder
+			i := 1
+			for ; i < len(value) && value[i] != '"'; i++ {
+				if value[i] == '\\' && i+1 < len(value) {
+					i++
+				}
+				b.WriteByte(value[i])
+			}
+			params[key] = b.String()
+			rest = value[min(i+1, len(value)):]
+		} else {
+			v, _, _ := strings.Cut(value, ",")
+			params[key] = strings.TrimSpace(v)
+			rest = value[len(v):]
+		}
+		rest = strings.TrimLeft(rest, ", ")
+	}
+	return scheme, params
+}
+
+// ociExtractor writes the layers of an artifact into a directory.
+type ociExtractor struct {
+	root *os.Root
+
+	files          int
+	size           int64
+	fileCountLimit int
+	fileSizeLimit  int64
+}
+
+// layer writes the layer described by desc with the contents of blob.
+func (x *ociExtractor) layer(desc ocispec.Descriptor, blob io.Reader) error {
+	title := desc.Annotations[ocispec.AnnotationTitle]
+	switch {
+	case title != "" && desc.Annotations[ociUnpackAnnotation] != "true":
+		return x.writeFile(filepath.FromSlash(title), blob, 0o644)
+	case title != "" || strings.Contains(desc.MediaType, ".tar"):
+		r, err := decompressLayer(blob)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		return x.untar(r)
+	default:
+		return fmt.Errorf("unsupported OCI layer %s of media type %q", desc.Digest, desc.MediaType)
+	}
+}
+
+// untar extracts the tarball into the directory. Links are not allowed, and
+// files whited out by image layers are removed.
+func (x *ociExtractor) untar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read layer: %w", err)
+		}
+
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if name == "." {
+			continue
+		}
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("layer contains path %q outside of the destination", hdr.Name)
+		}
+
+		if base := filepath.Base(name); strings.HasPrefix(base, ociWhiteoutPrefix) {
+			// opaque whiteouts only apply to lower layers, which are
+			// not tracked, so only whiteouts of single files are handled
+			if whiteout := strings.TrimPrefix(base, ociWhiteoutPrefix); !strings.HasPrefix(whiteout, ociWhiteoutPrefix) {
+				if err := x.root.RemoveAll(filepath.Join(filepath.Dir(name), whiteout)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := x.root.MkdirAll(name, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := x.writeFile(name, tr, hdr.FileInfo().Mode()); err != nil {
+				return err
+			}
+		case tar.TypeSymlink, tar.TypeLink:
+			return fmt.Errorf("layer contains link %q, which is not allowed", hdr.Name)
+		default:
+			// devices, fifos, etc. are not extracted
+		}
+	}
+}
+
+// writeFile writes the file name within the directory, enforcing the limits
+// on extracted files.
+func (x *ociExtractor) writeFile(name string, r io.Reader, mode fs.FileMode) error {
+	if !filepath.IsLocal(name) {
+		return fmt.Errorf("layer title %q is outside of the destination", name)
+	}
+
+	x.files++
+	if x.fileCountLimit > 0 && x.files > x.fileCountLimit {
+		return fmt.Errorf("OCI artifact contains more than %d files", x.fileCountLimit)
+	}
+
+	if err := x.root.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	f, err := x.root.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if x.fileSizeLimit > 0 {
+		r = io.LimitReader(r, x.fileSizeLimit-x.size+1)
+	}
+	n, err := io.Copy(f, r)
+	x.size += n
+	if err != nil {
+		return err
+	}
+	if x.fileSizeLimit > 0 && x.size > x.fileSizeLimit {
+		return fmt.Errorf("OCI artifact contains more than %d bytes", x.fileSizeLimit)
+	}
+	return f.Close()
+}
+
+// decompressLayer returns a reader of the layer, decompressing it if it is
+// compressed with gzip or zstd. The compression is detected from the
+// contents, as ORAS layers may use any media type.
+func decompressLayer(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(4)
+
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		dec, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return io.NopCloser(br), nil
+	}
+}
@@ -0,0 +1,523 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package getter
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-getter"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/taskenv"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/shoenig/test/must"
+)
+
+// testRegistry is a stand-in for an OCI registry, serving the artifacts
+// pushed to it. If it has credentials, it requires a bearer token issued for
+// them by its token server.
+type testRegistry struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	username string
+	password string
+
+	manifests map[string][]byte
+	blobs     map[digest.Digest][]byte
+}
+
+const testRegistryToken = "t0k3n"
+
+func newTestRegistry(t *testing.T, username, password string) *testRegistry {
+	t.Helper()
+
+	r := &testRegistry{
+		t:         t,
+		username:  username,
+		password:  password,
+		manifests: make(map[string][]byte),
+		blobs:     make(map[digest.Digest][]byte),
+	}
+	r.srv = httptest.NewTLSServer(http.HandlerFunc(r.serve))
+	t.Cleanup(r.srv.Close)
+	return r
+}
+
+// host returns the registry host, as used in artifact sources.
+func (r *testRegistry) host() string {
+	u, _ := url.Parse(r.srv.URL)
+	return u.Host
+}
+
+func (r *testRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if user, pass, _ := req.BasicAuth(); user != r.username || pass != r.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"token": testRegistryToken})
+		return
+	}
+
+	if r.username != "" && req.Header.Get("Authorization") != "Bearer "+testRegistryToken {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+			`Bearer realm="%s/token",service="test",scope="repository:app:pull"`, r.srv.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	if _, ref, ok := strings.Cut(path, "/manifests/"); ok {
+		body, ok := r.manifests[ref]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+		w.Write(body)
+		return
+	}
+	if _, ref, ok := strings.Cut(path, "/blobs/"); ok {
+		body, ok := r.blobs[digest.Digest(ref)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+// testLayer is a layer pushed to the test registry.
+type testLayer struct {
+	mediaType   string
+	annotations map[string]string
+	content     []byte
+}
+
+// fileLayer returns a layer like those ORAS pushes for a file.
+func fileLayer(title, content string) testLayer {
+	return testLayer{
+		mediaType:   "application/vnd.oci.image.layer.v1.tar",
+		annotations: map[string]string{ocispec.AnnotationTitle: title},
+		content:     []byte(content),
+	}
+}
+
+// dirLayer returns a layer like those ORAS pushes for a directory.
+func dirLayer(t *testing.T, title string, files map[string]string) testLayer {
+	return testLayer{
+		mediaType: ocispec.MediaTypeImageLayerGzip,
+		annotations: map[string]string{
+			ocispec.AnnotationTitle: title,
+			ociUnpackAnnotation:     "true",
+		},
+		content: testTarball(t, true, files),
+	}
+}
+
+// testTarball returns a tarball of the files, optionally gzipped.
+func testTarball(t *testing.T, compress bool, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		must.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Typeflag: tar.TypeReg,
+			Mode:     0o755,
+			Size:     int64(len(content)),
+		}))
+		_, err := tw.Write([]byte(content))
+		must.NoError(t, err)
+	}
+	must.NoError(t, tw.Close())
+
+	if !compress {
+		return buf.Bytes()
+	}
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, err := zw.Write(buf.Bytes())
+	must.NoError(t, err)
+	must.NoError(t, zw.Close())
+	return gz.Bytes()
+}
+
+// push stores an artifact with the layers under the tag, and returns the
+// digest of its manifest.
+func (r *testRegistry) push(tag string, layers ...testLayer) digest.Digest {
+	manifest := ocispec.Manifest{
+		MediaType:    ocispec.MediaTypeImageManifest,
+		ArtifactType: "application/vnd.nomad.test",
+		Config:       ocispec.DescriptorEmptyJSON,
+	}
+	manifest.SchemaVersion = 2
+
+	for _, layer := range layers {
+		d := digest.FromBytes(layer.content)
+		r.blobs[d] = layer.content
+		manifest.Layers = append(manifest.Layers, ocispec.Descriptor{
+			MediaType:   layer.mediaType,
+			Digest:      d,
+			Size:        int64(len(layer.content)),
+			Annotations: layer.annotations,
+		})
+	}
+
+	body, err := json.Marshal(manifest)
+	must.NoError(r.t, err)
+
+	d := digest.FromBytes(body)
+	r.manifests[tag] = body
+	r.manifests[d.String()] = body
+	return d
+}
+
+func testOCIGetter(auth registryAuth) *ociGetter {
+	g := &ociGetter{Auth: auth}
+	g.SetClient(&getter.Client{Insecure: true})
+	return g
+}
+
+func TestParseOCIReference(t *testing.T) {
+	ci.Parallel(t)
+
+	const d = "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	testCases := []struct {
+		source string
+		exp    *ociReference
+		expErr string
+	}{
+		{
+			source: "oci://ghcr.io/org/app:1.2.3",
+			exp:    &ociReference{Registry: "ghcr.io", Repository: "org/app", Tag: "1.2.3"},
+		},
+		{
+			source: "oci://localhost:5000/app",
+			exp:    &ociReference{Registry: "localhost:5000", Repository: "app", Tag: "latest"},
+		},
+		{
+			source: "oci://ghcr.io/org/app@" + d,
+			exp:    &ociReference{Registry: "ghcr.io", Repository: "org/app", Digest: d},
+		},
+		{
+			source: "oci://ghcr.io/org/app:1.2.3@" + d,
+			exp:    &ociReference{Registry: "ghcr.io", Repository: "org/app", Tag: "1.2.3", Digest: d},
+		},
+		{
+			source: "oci:///org/app",
+			expErr: "must include a registry",
+		},
+		{
+			source: "oci://ghcr.io/Org/App",
+			expErr: "invalid OCI artifact reference",
+		},
+		{
+			source: "oci://ghcr.io/org/app@sha256:abc",
+			expErr: "invalid OCI artifact reference",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.source, func(t *testing.T) {
+			u, err := url.Parse(tc.source)
+			must.NoError(t, err)
+
+			ref, err := parseOCIReference(u)
+			if tc.expErr != "" {
+				must.ErrorContains(t, err, tc.expErr)
+			} else {
+				must.NoError(t, err)
+				must.Eq(t, tc.exp, ref)
+			}
+		})
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	ci.Parallel(t)
+
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:org/app:pull,push"`)
+	must.Eq(t, "Bearer", scheme)
+	must.Eq(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:org/app:pull,push",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	must.Eq(t, "Basic", scheme)
+	must.Eq(t, map[string]string{"realm": "registry"}, params)
+}
+
+func TestOCIGetter_Get(t *testing.T) {
+	ci.Parallel(t)
+
+	reg := newTestRegistry(t, "user", "hunter2")
+	d := reg.push("1.0",
+		fileLayer("config.json", `{"port":8080}`),
+		dirLayer(t, "static", map[string]string{
+			"static/index.html":   "<html/>",
+			"static/js/bundle.js": "alert(1)",
+		}),
+	)
+	auth := registryAuth{Username: "user", Password: "hunter2"}
+
+	get := func(t *testing.T, g *ociGetter, source string) (string, error) {
+		t.Helper()
+
+		u, err := url.Parse(source)
+		must.NoError(t, err)
+
+		dst := filepath.Join(t.TempDir(), "out")
+		return dst, g.Get(dst, u)
+	}
+
+	for _, ref := range []string{":1.0", "@" + d.String(), ":1.0@" + d.String()} {
+		t.Run(ref, func(t *testing.T) {
+			dst, err := get(t, testOCIGetter(auth), "oci://"+reg.host()+"/app"+ref)
+			must.NoError(t, err)
+
+			for name, content := range map[string]string{
+				"config.json":         `{"port":8080}`,
+				"static/index.html":   "<html/>",
+				"static/js/bundle.js": "alert(1)",
+			} {
+				b, err := os.ReadFile(filepath.Join(dst, name))
+				must.NoError(t, err)
+				must.Eq(t, content, string(b))
+			}
+		})
+	}
+
+	t.Run("wrong digest", func(t *testing.T) {
+		other := digest.FromString("other")
+		reg.manifests[other.String()] = reg.manifests["1.0"]
+
+		_, err := get(t, testOCIGetter(auth), "oci://"+reg.host()+"/app@"+other.String())
+		must.ErrorContains(t, err, "manifest does not match digest")
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		_, err := get(t, testOCIGetter(registryAuth{}), "oci://"+reg.host()+"/app:1.0")
+		must.ErrorContains(t, err, "failed to authenticate with registry")
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := get(t, testOCIGetter(auth), "oci://"+reg.host()+"/app:2.0")
+		must.ErrorContains(t, err, "404 Not Found")
+	})
+
+	t.Run("max bytes", func(t *testing.T) {
+		g := testOCIGetter(auth)
+		g.MaxBytes = 10
+		_, err := get(t, g, "oci://"+reg.host()+"/app:1.0")
+		must.ErrorContains(t, err, "larger than the maximum of 10")
+	})
+
+	t.Run("corrupt layer", func(t *testing.T) {
+		reg := newTestRegistry(t, "", "")
+		layer := fileLayer("app", "good")
+		reg.push("1.0", layer)
+		reg.blobs[digest.FromBytes(layer.content)] = []byte("evil")
+
+		_, err := get(t, testOCIGetter(registryAuth{}), "oci://"+reg.host()+"/app:1.0")
+		must.ErrorContains(t, err, "layer does not match digest")
+	})
+}
+
+func TestOCIGetter_GetFile(t *testing.T) {
+	ci.Parallel(t)
+
+	reg := newTestRegistry(t, "", "")
+	reg.push("file", fileLayer("app.bin", "binary"))
+	reg.push("dir", dirLayer(t, "static", map[string]string{"static/a": "a"}))
+
+	g := testOCIGetter(registryAuth{})
+	dst := filepath.Join(t.TempDir(), "bin", "app")
+
+	u, err := url.Parse("oci://" + reg.host() + "/app:file")
+	must.NoError(t, err)
+	must.NoError(t, g.GetFile(dst, u))
+
+	b, err := os.ReadFile(dst)
+	must.NoError(t, err)
+	must.Eq(t, "binary", string(b))
+
+	u, err = url.Parse("oci://" + reg.host() + "/app:dir")
+	must.NoError(t, err)
+	must.ErrorContains(t, g.GetFile(dst, u), "must contain a single file")
+}
+
+func TestOCIExtractor_untar(t *testing.T) {
+	ci.Parallel(t)
+
+	extract := func(t *testing.T, x *ociExtractor, headers ...*tar.Header) error {
+		t.Helper()
+
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, hdr := range headers {
+			must.NoError(t, tw.WriteHeader(hdr))
+			if hdr.Size > 0 {
+				_, err := tw.Write(bytes.Repeat([]byte("x"), int(hdr.Size)))
+				must.NoError(t, err)
+			}
+		}
+		must.NoError(t, tw.Close())
+
+		return x.untar(&buf)
+	}
+
+	newExtractor := func(t *testing.T) (string, *ociExtractor) {
+		dir := t.TempDir()
+		root, err := os.OpenRoot(dir)
+		must.NoError(t, err)
+		t.Cleanup(func() { root.Close() })
+		return dir, &ociExtractor{root: root}
+	}
+
+	t.Run("files and whiteouts", func(t *testing.T) {
+		dir, x := newExtractor(t)
+		must.NoError(t, os.WriteFile(filepath.Join(dir, "old"), nil, 0o644))
+
+		must.NoError(t, extract(t, x,
+			&tar.Header{Name: "./bin/", Typeflag: tar.TypeDir, Mode: 0o755},
+			&tar.Header{Name: "./bin/app", Typeflag: tar.TypeReg, Mode: 0o4755, Size: 3},
+			&tar.Header{Name: ".wh.old", Typeflag: tar.TypeReg},
+		))
+
+		info, err := os.Stat(filepath.Join(dir, "bin", "app"))
+		must.NoError(t, err)
+		must.Eq(t, 3, info.Size())
+		must.Eq(t, os.FileMode(0o755), info.Mode())
+		must.FileNotExists(t, filepath.Join(dir, "old"))
+	})
+
+	t.Run("escape", func(t *testing.T) {
+		_, x := newExtractor(t)
+		err := extract(t, x, &tar.Header{Name: "../escape", Typeflag: tar.TypeReg, Size: 1})
+		must.ErrorContains(t, err, "outside of the destination")
+	})
+
+	t.Run("symlink", func(t *testing.T) {
+		_, x := newExtractor(t)
+		err := extract(t, x, &tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"})
+		must.ErrorContains(t, err, "not allowed")
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		_, x := newExtractor(t)
+		x.fileCountLimit = 1
+		err := extract(t, x,
+			&tar.Header{Name: "a", Typeflag: tar.TypeReg, Size: 1},
+			&tar.Header{Name: "b", Typeflag: tar.TypeReg, Size: 1},
+		)
+		must.ErrorContains(t, err, "more than 1 files")
+
+		_, x = newExtractor(t)
+		x.fileSizeLimit = 4
+		err = extract(t, x,
+			&tar.Header{Name: "a", Typeflag: tar.TypeReg, Size: 3},
+			&tar.Header{Name: "b", Typeflag: tar.TypeReg, Size: 3},
+		)
+		must.ErrorContains(t, err, "more than 4 bytes")
+	})
+}
+
+func TestGetRegistryAuth(t *testing.T) {
+	ci.Parallel(t)
+
+	authConfig := filepath.Join(t.TempDir(), "config.json")
+	must.NoError(t, os.WriteFile(authConfig, []byte(fmt.Sprintf(`{"auths":{"ghcr.io":{"auth":%q}}}`,
+		base64.StdEncoding.EncodeToString([]byte("docker:s3cr3t")))), 0o600))
+
+	t.Run("not oci", func(t *testing.T) {
+		source, auth, err := getRegistryAuth("https://example.com/app?username=a", authConfig)
+		must.NoError(t, err)
+		must.Eq(t, "https://example.com/app?username=a", source)
+		must.Eq(t, registryAuth{}, auth)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		source, auth, err := getRegistryAuth("oci://ghcr.io/org/app:1.0?password=p%40ss&username=user", authConfig)
+		must.NoError(t, err)
+		must.Eq(t, "oci://ghcr.io/org/app:1.0", source)
+		must.Eq(t, registryAuth{Username: "user", Password: "p@ss"}, auth)
+	})
+
+	t.Run("docker config", func(t *testing.T) {
+		source, auth, err := getRegistryAuth("oci://ghcr.io/org/app:1.0", authConfig)
+		must.NoError(t, err)
+		must.Eq(t, "oci://ghcr.io/org/app:1.0", source)
+		must.Eq(t, registryAuth{Username: "docker", Password: "s3cr3t"}, auth)
+	})
+
+	t.Run("other registry", func(t *testing.T) {
+		_, auth, err := getRegistryAuth("oci://quay.io/org/app:1.0", authConfig)
+		must.NoError(t, err)
+		must.Eq(t, registryAuth{}, auth)
+	})
+
+	t.Run("missing config", func(t *testing.T) {
+		_, _, err := getRegistryAuth("oci://ghcr.io/org/app:1.0", "/does/not/exist.json")
+		must.ErrorContains(t, err, "failed to open OCI auth config")
+	})
+}
+
+func TestGetRegistryAuth_variables(t *testing.T) {
+	ci.Parallel(t)
+
+	// credentials read from Nomad variables by a secret block of the task
+	// are interpolated into the artifact options
+	env := taskenv.NewTaskEnv(nil, nil, nil, nil, map[string]string{
+		"secret.registry.username": "user",
+		"secret.registry.password": "hunter2",
+	}, "", "")
+	source, err := getURL(env, &structs.TaskArtifact{
+		GetterSource: "oci://ghcr.io/org/app:1.0",
+		GetterOptions: map[string]string{
+			"username": "${secret.registry.username}",
+			"password": "${secret.registry.password}",
+		},
+	})
+	must.NoError(t, err)
+
+	source, auth, err := getRegistryAuth(source, "")
+	must.NoError(t, err)
+	must.Eq(t, "oci://ghcr.io/org/app:1.0", source)
+	must.Eq(t, registryAuth{Username: "user", Password: "hunter2"}, auth)
+}
+
+func TestGetRegistryAuth_noConfig(t *testing.T) {
+	// the docker config of the client user is never used implicitly
+	dockerConfig := t.TempDir()
+	t.Setenv("DOCKER_CONFIG", dockerConfig)
+	must.NoError(t, os.WriteFile(filepath.Join(dockerConfig, "config.json"),
+		[]byte(fmt.Sprintf(`{"auths":{"ghcr.io":{"auth":%q}}}`,
+			base64.StdEncoding.EncodeToString([]byte("docker:s3cr3t")))), 0o600))
+
+	_, auth, err := getRegistryAuth("oci://ghcr.io/org/app:1.0", "")
+	must.NoError(t, err)
+	must.Eq(t, registryAuth{}, auth)
+}
@@ -43,6 +43,9 @@ type parameters struct {
 	Destination string              `json:"artifact_destination"`
 	Headers     map[string][]string `json:"artifact_headers"`
 
+	// RegistryAuth is the registry credentials of OCI artifacts
+	RegistryAuth registryAuth `json:"registry_auth,omitzero"`
+
 	// Task Filesystem
 	AllocDir string `json:"alloc_dir"`
 	TaskDir  string `json:"task_dir"`
@@ -121,6 +124,8 @@ func (p *parameters) Equal(o *parameters) bool {
 		return false
 	case !maps.EqualFunc(p.Headers, o.Headers, headersCompareFn):
 		return false
+	case p.RegistryAuth != o.RegistryAuth:
+		return false
 	}
 
 	return true
@@ -161,6 +166,14 @@ func (p *parameters) client(ctx context.Context) *getter.Client {
 		MaxBytes: p.HTTPMaxBytes,
 	}
 
+	ociGetter := &ociGetter{
+		Auth:           p.RegistryAuth,
+		Timeout:        p.HTTPReadTimeout,
+		MaxBytes:       p.HTTPMaxBytes,
+		FileCountLimit: p.DecompressionLimitFileCount,
+		FileSizeLimit:  p.DecompressionLimitSize,
+	}
+
 	// setup custom decompressors with file count and total size limits
 	decompressors := getter.LimitedDecompressors(
 		p.DecompressionLimitFileCount,
@@ -189,8 +202,9 @@ func (p *parameters) client(ctx context.Context) *getter.Client {
 			"s3": &getter.S3Getter{
 				Timeout: p.S3Timeout,
 			},
-			"http":  httpGetter,
-			"https": httpGetter,
+			"http":    httpGetter,
+			"https":   httpGetter,
+			ociScheme: ociGetter,
 		},
 	}
 }
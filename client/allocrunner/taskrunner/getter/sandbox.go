@@ -12,16 +12,31 @@ import (
 
 // New creates a Sandbox with the given ArtifactConfig.
 func New(ac *config.ArtifactConfig, logger hclog.Logger) *Sandbox {
-	return &Sandbox{
+	s := &Sandbox{
 		logger: logger.Named("artifact"),
 		ac:     ac,
 	}
+
+	if ac != nil && ac.CacheEnabled {
+		if ac.CacheDir == "" {
+			s.logger.Warn("artifact cache disabled because no cache dir is set")
+		} else if cache, err := NewCache(ac.CacheDir, ac.CacheSizeLimit, s.logger); err != nil {
+			s.logger.Error("artifact cache disabled", "error", err)
+		} else {
+			s.cache = cache
+		}
+	}
+
+	return s
 }
 
 // A Sandbox is used to download artifacts.
 type Sandbox struct {
 	logger hclog.Logger
 	ac     *config.ArtifactConfig
+
+	// cache is the node artifact cache, if enabled
+	cache *Cache
 }
 
 func (s *Sandbox) Get(env interfaces.EnvReplacer, artifact *structs.TaskArtifact, user string) error {
@@ -32,6 +47,15 @@ func (s *Sandbox) Get(env interfaces.EnvReplacer, artifact *structs.TaskArtifact
 		return err
 	}
 
+	source, registryAuth, err := getRegistryAuth(source, s.ac.OCIAuthConfig)
+	if err != nil {
+		return &Error{
+			URL:         artifact.GetterSource,
+			Err:         err,
+			Recoverable: true,
+		}
+	}
+
 	destination, err := getDestination(env, artifact)
 	if err != nil {
 		return err
@@ -64,6 +88,9 @@ func (s *Sandbox) Get(env interfaces.EnvReplacer, artifact *structs.TaskArtifact
 		Destination: destination,
 		Headers:     headers,
 
+		// registry credentials
+		RegistryAuth: registryAuth,
+
 		// task filesystem
 		AllocDir: allocDir,
 		TaskDir:  taskDir,
@@ -71,6 +98,12 @@ func (s *Sandbox) Get(env interfaces.EnvReplacer, artifact *structs.TaskArtifact
 		Chown:    artifact.Chown,
 	}
 
+	if s.cache != nil {
+		if key, ok := s.cache.key(getNamespace(env), params); ok {
+			return s.getCached(params, key)
+		}
+	}
+
 	if err = s.runCmd(params); err != nil {
 		return err
 	}
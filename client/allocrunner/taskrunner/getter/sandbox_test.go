@@ -5,6 +5,7 @@ package getter
 
 import (
 	"archive/tar"
+	"crypto/sha256"
 	"fmt"
 	"net/http"
 	"net/http/cgi"
@@ -12,6 +13,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -958,3 +960,56 @@ func makeAndServeGitRepo(t *testing.T, repoPath string) *httptest.Server {
 
 	return srv
 }
+
+func TestSandbox_Get_cache(t *testing.T) {
+	testutil.RequireRoot(t)
+
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gets.Add(1)
+		w.Write([]byte(testFileContent))
+	}))
+	t.Cleanup(srv.Close)
+
+	ac := artifactConfig(10 * time.Second)
+	ac.CacheEnabled = true
+	ac.CacheDir = t.TempDir()
+	ac.CacheSizeLimit = 1024
+	sbox := New(ac, testlog.HCLogger(t))
+	must.NotNil(t, sbox.cache)
+
+	get := func(artifact *structs.TaskArtifact) string {
+		t.Helper()
+
+		_, taskDir := SetupDir(t)
+		must.NoError(t, sbox.Get(noopTaskEnv(taskDir), artifact, "nobody"))
+
+		b, err := os.ReadFile(filepath.Join(taskDir, "local", "downloads", "app.txt"))
+		must.NoError(t, err)
+		return string(b)
+	}
+
+	artifact := &structs.TaskArtifact{
+		GetterSource: srv.URL + "/app.txt",
+		RelativeDest: "local/downloads",
+	}
+
+	// artifacts that are not pinned are downloaded every time
+	must.Eq(t, testFileContent, get(artifact))
+	must.Eq(t, testFileContent, get(artifact))
+	must.Eq(t, 2, gets.Load())
+
+	// artifacts pinned by a checksum are cached
+	artifact.GetterOptions = map[string]string{
+		"checksum": fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(testFileContent))),
+	}
+	must.Eq(t, testFileContent, get(artifact))
+	must.Eq(t, testFileContent, get(artifact))
+	must.Eq(t, 3, gets.Load())
+}
+
+func TestSandbox_New_noConfig(t *testing.T) {
+	// clients created without an artifact config don't cache artifacts
+	sbox := New(nil, testlog.HCLogger(t))
+	must.Nil(t, sbox.cache)
+}
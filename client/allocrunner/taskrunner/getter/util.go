@@ -18,8 +18,10 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/docker/cli/cli/config/configfile"
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/nomad/client/interfaces"
+	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/helper/subproc"
 	"github.com/hashicorp/nomad/helper/users"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -132,6 +134,80 @@ func getHeaders(env interfaces.EnvReplacer, artifact *structs.TaskArtifact) map[
 	return headers
 }
 
+// getRegistryAuth returns the source of an OCI artifact without its username
+// and password options, so they are never logged or passed to go-getter, and
+// the credentials to pull it with. Credentials set on the artifact take
+// precedence over those in the docker auth config of the client, which is
+// only used if it is set explicitly. Credentials stored in Nomad variables are
+// set on the artifact by interpolating the secrets of the task, for instance
+// ${secret.registry.password}.
+func getRegistryAuth(source, authConfig string) (string, registryAuth, error) {
+	u, err := url.Parse(source)
+	if err != nil || u.Scheme != ociScheme {
+		return source, registryAuth{}, nil
+	}
+
+	q := u.Query()
+	auth := registryAuth{
+		Username: q.Get("username"),
+		Password: q.Get("password"),
+	}
+	q.Del("username")
+	q.Del("password")
+	u.RawQuery = q.Encode()
+	source = u.String()
+
+	if auth.Username != "" || auth.Password != "" {
+		return source, auth, nil
+	}
+
+	auth, err = dockerRegistryAuth(authConfig, u.Host)
+	return source, auth, err
+}
+
+// dockerRegistryAuth returns the credentials for the registry from the docker
+// config file at path. The docker config of the user running the client is
+// never used implicitly, as it would be shared with every job on the node, so
+// no credentials are returned if path is empty.
+func dockerRegistryAuth(path, registry string) (registryAuth, error) {
+	if path == "" {
+		return registryAuth{}, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return registryAuth{}, fmt.Errorf("failed to open OCI auth config: %w", err)
+	}
+	defer f.Close()
+
+	cf := configfile.New(path)
+	if err := cf.LoadFromReader(f); err != nil {
+		return registryAuth{}, fmt.Errorf("failed to parse OCI auth config: %w", err)
+	}
+
+	// docker stores the credentials of docker hub under its index
+	if registry == "docker.io" || registry == "registry-1.docker.io" {
+		registry = "https://index.docker.io/v1/"
+	}
+
+	ac, err := cf.GetAuthConfig(registry)
+	if err != nil {
+		return registryAuth{}, fmt.Errorf("failed to get credentials for registry %q: %w", registry, err)
+	}
+
+	return registryAuth{
+		Username:      ac.Username,
+		Password:      ac.Password,
+		IdentityToken: ac.IdentityToken,
+		RegistryToken: ac.RegistryToken,
+	}, nil
+}
+
+// getNamespace returns the namespace of the task.
+func getNamespace(env interfaces.EnvReplacer) string {
+	return env.ReplaceEnv("${" + taskenv.Namespace + "}")
+}
+
 // getWritableDirs returns host paths to the task's allocation and task specific
 // directories - the locations into which a Task is allowed to download an artifact.
 func getWritableDirs(env interfaces.EnvReplacer) (string, string) {
@@ -161,6 +237,12 @@ func environment(taskDir string, inherit string) []string {
 	return result
 }
 
+// needsInspection returns whether the artifact contents must be inspected for
+// sandbox escapes, which is only necessary without filesystem isolation.
+func needsInspection(env *parameters) bool {
+	return !env.DisableArtifactInspection && (env.DisableFilesystemIsolation || !lockdownAvailable())
+}
+
 func (s *Sandbox) runCmd(env *parameters) error {
 	// find the nomad process
 	bin := subproc.Self()
@@ -173,7 +255,7 @@ func (s *Sandbox) runCmd(env *parameters) error {
 	var atTestRecreateDir string // test path to inform if existing destination directory should be removed
 	var atFinalDest string       // final destination path within rooted alloc directory
 	var atTemporaryDest string   // temporary destination within rooted alloc directory
-	if !env.DisableArtifactInspection && (env.DisableFilesystemIsolation || !lockdownAvailable()) {
+	if needsInspection(env) {
 		var err error
 
 		finalDest = env.Destination // store path so it can be reset
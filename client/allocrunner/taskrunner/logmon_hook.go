@@ -10,12 +10,16 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"sync"
 	"time"
 
 	hclog "github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	"github.com/hashicorp/nomad/client/logmon"
+	"github.com/hashicorp/nomad/client/logmon/sink"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
 	bstructs "github.com/hashicorp/nomad/plugins/base/structs"
@@ -40,6 +44,10 @@ type logmonHook struct {
 
 	config *logmonHookConfig
 
+	// statsCancel stops the collection of the stats of the log sinks
+	statsCancel context.CancelFunc
+	statsLock   sync.Mutex
+
 	logger hclog.Logger
 }
 
@@ -146,6 +154,8 @@ func (h *logmonHook) Prestart(ctx context.Context,
 			return err
 		}
 		resp.State = map[string]string{logmonReattachKey: string(jsonCfg)}
+
+		h.startSinkStats(req.Task)
 		return nil
 	}
 }
@@ -192,7 +202,7 @@ func (h *logmonHook) prestartOneLoop(ctx context.Context, req *interfaces.TaskPr
 		}
 	}
 
-	err := h.logmon.Start(&logmon.LogConfig{
+	cfg := &logmon.LogConfig{
 		LogDir:        h.config.logDir,
 		StdoutLogFile: fmt.Sprintf("%s.stdout", req.Task.Name),
 		StderrLogFile: fmt.Sprintf("%s.stderr", req.Task.Name),
@@ -200,7 +210,26 @@ func (h *logmonHook) prestartOneLoop(ctx context.Context, req *interfaces.TaskPr
 		StderrFifo:    h.config.stderrFifo,
 		MaxFiles:      req.Task.LogConfig.MaxFiles,
 		MaxFileSizeMB: req.Task.LogConfig.MaxFileSizeMB,
-	})
+		MaxAge:        req.Task.LogConfig.MaxAge,
+		Compress:      req.Task.LogConfig.Compress,
+		TaskName:      req.Task.Name,
+	}
+	if req.Alloc != nil {
+		cfg.AllocID = req.Alloc.ID
+		cfg.JobID = req.Alloc.JobID
+		cfg.Namespace = req.Alloc.Namespace
+	}
+	for _, s := range req.Task.LogConfig.Sinks {
+		cfg.Sinks = append(cfg.Sinks, &sink.Config{
+			Name:       s.Name,
+			Type:       s.Type,
+			Address:    s.Address,
+			Headers:    s.Headers,
+			BufferSize: s.BufferSize,
+		})
+	}
+
+	err := h.logmon.Start(cfg)
 	if err != nil {
 		h.logger.Error("failed to start logmon", "error", err)
 		return err
@@ -214,6 +243,8 @@ func (h *logmonHook) Stop(_ context.Context, req *interfaces.TaskStopRequest, _
 		return nil
 	}
 
+	h.stopSinkStats()
+
 	// It's possible that Stop was called without calling Prestart on agent
 	// restarts. Attempt to reattach to an existing logmon.
 	if h.logmon == nil || h.logmonPluginClient == nil {
@@ -246,3 +277,98 @@ func (h *logmonHook) reattach(req *interfaces.TaskStopRequest) error {
 
 	return h.launchLogMon(reattachConfig)
 }
+
+// Shutdown stops collecting the stats of the log sinks when the client shuts
+// down, leaving logmon running.
+func (h *logmonHook) Shutdown() {
+	h.stopSinkStats()
+}
+
+// startSinkStats starts emitting the stats of the log sinks of the task as
+// metrics, if the client publishes allocation metrics.
+func (h *logmonHook) startSinkStats(task *structs.Task) {
+	clientConfig := h.runner.clientConfig
+	if len(task.LogConfig.Sinks) == 0 || clientConfig == nil || !clientConfig.PublishAllocationMetrics {
+		return
+	}
+
+	h.statsLock.Lock()
+	defer h.statsLock.Unlock()
+
+	// prestart runs again when the task restarts
+	if h.statsCancel != nil {
+		h.statsCancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h.statsCancel = cancel
+	go h.collectSinkStats(ctx, h.logmon, clientConfig.StatsCollectionInterval)
+}
+
+func (h *logmonHook) stopSinkStats() {
+	h.statsLock.Lock()
+	defer h.statsLock.Unlock()
+
+	if h.statsCancel != nil {
+		h.statsCancel()
+		h.statsCancel = nil
+	}
+}
+
+// collectSinkStats polls the stats of the log sinks from logmon until ctx is
+// cancelled. The counters are emitted as the increments since the last poll.
+func (h *logmonHook) collectSinkStats(ctx context.Context, lm logmon.LogMon, interval time.Duration) {
+	last := make(map[string]*sink.Stats)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stats, err := lm.Stats()
+		if err != nil {
+			if err == bstructs.ErrPluginShutdown {
+				return
+			}
+			h.logger.Debug("failed to get log sink stats", "error", err)
+			continue
+		}
+
+		for _, s := range stats {
+			h.emitSinkStats(s, last[s.Name])
+			last[s.Name] = s
+		}
+	}
+}
+
+func (h *logmonHook) emitSinkStats(s, prev *sink.Stats) {
+	labels := append(slices.Clone(h.runner.baseLabels),
+		metrics.Label{Name: "sink", Value: s.Name},
+		metrics.Label{Name: "sink_type", Value: s.Type},
+	)
+
+	// the counters are reset when logmon restarts the sinks
+	delta := func(cur, prev uint64) float32 {
+		if cur < prev {
+			return float32(cur)
+		}
+		return float32(cur - prev)
+	}
+	if prev == nil {
+		prev = &sink.Stats{}
+	}
+
+	metrics.IncrCounterWithLabels([]string{"client", "allocs", "logs", "sink", "sent"},
+		delta(s.Sent, prev.Sent), labels)
+	metrics.IncrCounterWithLabels([]string{"client", "allocs", "logs", "sink", "dropped"},
+		delta(s.Dropped, prev.Dropped), labels)
+	metrics.IncrCounterWithLabels([]string{"client", "allocs", "logs", "sink", "errors"},
+		delta(s.Errors, prev.Errors), labels)
+	metrics.SetGaugeWithLabels([]string{"client", "allocs", "logs", "sink", "buffered"},
+		float32(s.Buffered), labels)
+}
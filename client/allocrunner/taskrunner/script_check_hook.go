@@ -119,6 +119,10 @@ func (h *scriptCheckHook) Poststart(ctx context.Context, req *interfaces.TaskPos
 	h.driverExec = req.DriverExec
 	h.taskEnv = req.TaskEnv
 
+	// script checks of Nomad services are run by the alloc runner checks
+	// hook, which needs the driver of the task
+	h.arHookResources.SetScriptExecutor(h.task.Name, req.DriverExec)
+
 	return h.upsertChecks()
 }
 
@@ -173,6 +177,7 @@ func (h *scriptCheckHook) upsertChecks() error {
 func (h *scriptCheckHook) Stop(ctx context.Context, req *interfaces.TaskStopRequest, resp *interfaces.TaskStopResponse) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
+	h.arHookResources.SetScriptExecutor(h.task.Name, nil)
 	close(h.shutdownCh)
 	deadline := time.After(h.shutdownWait)
 	err := fmt.Errorf("timed out waiting for script checks to exit")
@@ -195,6 +200,10 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 	scriptChecks := make(map[string]*scriptCheck)
 	interpolatedTaskServices := taskenv.InterpolateServices(h.taskEnv, h.task.Services)
 	for _, service := range interpolatedTaskServices {
+		// script checks of Nomad services are run by the checks hook
+		if service.Provider == structs.ServiceProviderNomad {
+			continue
+		}
 		for _, check := range service.Checks {
 			if check.Type != structs.ServiceCheckScript {
 				continue
@@ -232,6 +241,9 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 	checkIDs := h.arHookResources.GetConsulCheckIDs()
 	interpolatedGroupServices := taskenv.InterpolateServices(h.taskEnv, tg.Services)
 	for i, service := range interpolatedGroupServices {
+		if service.Provider == structs.ServiceProviderNomad {
+			continue
+		}
 		for j, check := range service.Checks {
 			if check.Type != structs.ServiceCheckScript {
 				continue
@@ -966,8 +966,13 @@ func (tr *TaskRunner) runDriver() error {
 		return nil
 	}
 
-	// Start the job if there's no existing handle (or if RecoverTask failed)
-	handle, net, err := tr.driver.StartTask(taskConfig)
+	// Restore the task if its checkpoint was migrated from the previous
+	// allocation, otherwise start the job if there's no existing handle (or if
+	// RecoverTask failed)
+	handle, net := tr.restoreTask(taskConfig)
+	if handle == nil {
+		handle, net, err = tr.driver.StartTask(taskConfig)
+	}
 	if err != nil {
 		// The plugin has died, try relaunching it
 		if err == bstructs.ErrPluginShutdown {
@@ -1086,6 +1091,9 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 		return nil
 	}
 
+	// Checkpoint the task before killing it if its allocation is migrating
+	tr.checkpointTask(handle)
+
 	// Kill the task using an exponential backoff in-case of failures.
 	result, killErr := tr.killTask(handle, resultCh)
 	if killErr != nil {
@@ -1191,6 +1191,90 @@ func TestTaskRunner_NoShutdownDelay(t *testing.T) {
 	must.True(t, hasEvent)
 }
 
+// TestTaskRunner_Checkpoint asserts that a task is checkpointed before it is
+// killed when its allocation is migrating.
+func TestTaskRunner_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	alloc.Job.TaskGroups[0].EphemeralDisk.Migrate = true
+	alloc.Job.TaskGroups[0].EphemeralDisk.Checkpoint = true
+	alloc.DesiredTransition = structs.DesiredTransition{Migrate: new(true)}
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "1000s",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	must.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent("test")))
+	testWaitForTaskToDie(t, tr)
+
+	must.FileExists(t, filepath.Join(tr.taskDir.CheckpointDir, "checkpoint"))
+	must.SliceContainsFunc(t, tr.TaskState().Events, structs.TaskCheckpointed,
+		func(ev *structs.TaskEvent, typ string) bool { return ev.Type == typ })
+}
+
+// TestTaskRunner_Checkpoint_NotMigrating asserts that a task is not
+// checkpointed when its allocation is stopped without migrating.
+func TestTaskRunner_Checkpoint_NotMigrating(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	alloc.Job.TaskGroups[0].EphemeralDisk.Migrate = true
+	alloc.Job.TaskGroups[0].EphemeralDisk.Checkpoint = true
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "1000s",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	must.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent("test")))
+	testWaitForTaskToDie(t, tr)
+
+	must.DirNotExists(t, tr.taskDir.CheckpointDir)
+}
+
+// TestTaskRunner_RestoreCheckpoint asserts that a task is restored from the
+// checkpoint migrated from the previous allocation, and that the checkpoint is
+// removed afterwards.
+func TestTaskRunner_RestoreCheckpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	alloc.Job.TaskGroups[0].EphemeralDisk.Migrate = true
+	alloc.Job.TaskGroups[0].EphemeralDisk.Checkpoint = true
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "1000s",
+	}
+
+	conf, cleanup := testTaskRunnerConfig(t, alloc, task.Name, nil)
+	defer cleanup()
+
+	// Write the checkpoint as migrated by the alloc dir
+	must.NoError(t, os.MkdirAll(conf.TaskDir.CheckpointDir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(conf.TaskDir.CheckpointDir, "checkpoint"), nil, 0o600))
+
+	tr, err := NewTaskRunner(conf)
+	must.NoError(t, err)
+	go tr.Run()
+	defer tr.Kill(context.Background(), structs.NewTaskEvent("cleanup"))
+
+	testWaitForTaskToStart(t, tr)
+	must.SliceContainsFunc(t, tr.TaskState().Events, structs.TaskRestoredFromCheckpoint,
+		func(ev *structs.TaskEvent, typ string) bool { return ev.Type == typ })
+	must.DirNotExists(t, conf.TaskDir.CheckpointDir)
+}
+
 // TestTaskRunner_Dispatch_Payload asserts that a dispatch job runs and the
 // payload was written to disk.
 func TestTaskRunner_Dispatch_Payload(t *testing.T) {
@@ -43,6 +43,7 @@ import (
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	"github.com/hashicorp/nomad/client/servers"
+	"github.com/hashicorp/nomad/client/servicedns"
 	"github.com/hashicorp/nomad/client/serviceregistration"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/serviceregistration/nsd"
@@ -161,6 +162,9 @@ type Client struct {
 	// at runtime it may be accessed outside of locks.
 	metaStatic map[string]string
 
+	// imagePrePullLock serializes passing the images to pre-pull to drivers
+	imagePrePullLock sync.Mutex
+
 	logger    hclog.InterceptLogger
 	rpcLogger hclog.Logger
 
@@ -222,6 +226,10 @@ type Client struct {
 	// registrations.
 	nomadService serviceregistration.Handler
 
+	// dnsServer answers DNS queries for Nomad service registrations. It is
+	// nil if the DNS interface is not enabled.
+	dnsServer *servicedns.Server
+
 	// checkStore is used to store group and task checks and their current pass/fail
 	// status.
 	checkStore checkstore.Shim
@@ -560,6 +568,19 @@ func NewClient(cfg *config.Config, consulCatalog consul.CatalogAPI, consulProxie
 	c.setupNomadServiceRegistrationHandler()
 	c.serviceRegWrapper = wrapper.NewHandlerWrapper(c.logger, c.consulServices, c.nomadService)
 
+	// Start the DNS interface for Nomad service registrations, if enabled.
+	if dnsConfig := c.GetConfig().DNS; dnsConfig != nil {
+		c.dnsServer, err = servicedns.NewServer(c.logger, &servicedns.Config{
+			DNS:       dnsConfig,
+			Region:    c.Region(),
+			RPCFn:     c.RPC,
+			AuthToken: c.namespaceIdentityToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to start DNS interface: %w", err)
+		}
+	}
+
 	// Batching of initial fingerprints is done to reduce the number of node
 	// updates sent to the server on startup.
 	go c.batchFirstFingerprints()
@@ -1024,6 +1045,10 @@ func (c *Client) Shutdown() error {
 		h.Shutdown()
 	}
 
+	if c.dnsServer != nil {
+		c.dnsServer.Shutdown()
+	}
+
 	// Shutdown the plugin managers
 	c.pluginManagers.Shutdown()
 
@@ -1520,6 +1545,23 @@ func (c *Client) getAllocRunners() map[string]interfaces.AllocRunner {
 	return runners
 }
 
+// namespaceIdentityToken returns the default workload identity of a running
+// alloc in the namespace, or an empty string if there is none. The DNS
+// interface reads the services of a namespace with it, so it only answers for
+// namespaces with allocs on this node.
+func (c *Client) namespaceIdentityToken(namespace string) string {
+	for _, ar := range c.getAllocRunners() {
+		alloc := ar.Alloc()
+		if alloc.Namespace != namespace || alloc.ClientTerminalStatus() {
+			continue
+		}
+		if token := alloc.DefaultIdentityToken(); token != "" {
+			return token
+		}
+	}
+	return ""
+}
+
 // NumAllocs returns the number of un-GC'd allocs this client has. Used to
 // fulfill the AllocCounter interface for the GC.
 func (c *Client) NumAllocs() int {
@@ -3049,6 +3091,7 @@ func (c *Client) setupNomadServiceRegistrationHandler() {
 		CheckWatcher: serviceregistration.NewCheckWatcher(
 			c.logger, nsd.NewStatusGetter(c.checkStore),
 		),
+		CheckStatusGetter: nsd.NewStatusGetter(c.checkStore),
 	}
 	c.nomadService = nsd.NewServiceRegistrationHandler(c.logger, &cfg)
 }
@@ -30,6 +30,12 @@ type ArtifactConfig struct {
 	DisableFilesystemIsolation    bool
 	FilesystemIsolationExtraPaths []string
 	SetEnvironmentVariables       string
+
+	CacheEnabled   bool
+	CacheDir       string
+	CacheSizeLimit int64
+
+	OCIAuthConfig string
 }
 
 // ArtifactConfigFromAgent creates a new internal readonly copy of the client
@@ -70,6 +76,11 @@ func ArtifactConfigFromAgent(c *config.ArtifactConfig) (*ArtifactConfig, error)
 		return nil, fmt.Errorf("error parsing DecompressionLimitSize: %w", err)
 	}
 
+	cacheSizeLimit, err := humanize.ParseBytes(*c.CacheSizeLimit)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CacheSizeLimit: %w", err)
+	}
+
 	return &ArtifactConfig{
 		HTTPReadTimeout:               httpReadTimeout,
 		HTTPMaxBytes:                  int64(httpMaxSize),
@@ -83,6 +94,10 @@ func ArtifactConfigFromAgent(c *config.ArtifactConfig) (*ArtifactConfig, error)
 		DisableFilesystemIsolation:    *c.DisableFilesystemIsolation,
 		FilesystemIsolationExtraPaths: slices.Clone(c.FilesystemIsolationExtraPaths),
 		SetEnvironmentVariables:       *c.SetEnvironmentVariables,
+		CacheEnabled:                  *c.CacheEnabled,
+		CacheDir:                      *c.CacheDir,
+		CacheSizeLimit:                int64(cacheSizeLimit),
+		OCIAuthConfig:                 *c.OCIAuthConfig,
 	}, nil
 
 }
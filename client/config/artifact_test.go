@@ -33,6 +33,7 @@ func TestArtifactConfigFromAgent(t *testing.T) {
 				S3Timeout:                   30 * time.Minute,
 				DecompressionLimitFileCount: 4096,
 				DecompressionLimitSize:      100_000_000_000,
+				CacheSizeLimit:              10_000_000_000,
 			},
 		},
 		{
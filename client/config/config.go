@@ -77,6 +77,14 @@ var (
 	DefaultTemplateFunctionDenylist = []string{"executeTemplate", "plugin", "writeToFile"}
 )
 
+const (
+	// FirewallBackendIPTables manages firewall rules with iptables
+	FirewallBackendIPTables = "iptables"
+
+	// FirewallBackendNftables manages firewall rules with nftables
+	FirewallBackendNftables = "nftables"
+)
+
 // RPCHandler can be provided to the Client if there is a local server
 // to avoid going over the network. If not provided, the Client will
 // maintain a connection pool to the servers
@@ -332,6 +340,12 @@ type Config struct {
 	// notation and must be an IPv6 address.
 	BridgeNetworkAllocSubnetIPv6 string
 
+	// FirewallBackend is the backend managing the firewall rules of
+	// allocations in bridge networking mode and of egress policies, either
+	// FirewallBackendIPTables or FirewallBackendNftables. It is detected when
+	// the allocation network is set up if empty.
+	FirewallBackend string
+
 	// HostVolumes is a map of the configured host volumes by name.
 	HostVolumes map[string]*structs.ClientHostVolumeConfig
 
@@ -391,6 +405,10 @@ type Config struct {
 	// Drain configuration from the agent's config file.
 	Drain *DrainConfig
 
+	// DNS configures the DNS interface for Nomad service registrations. It is
+	// nil if the DNS interface is not enabled.
+	DNS *DNSConfig
+
 	// Uesrs configuration from the agent's config file.
 	Users *UsersConfig
 
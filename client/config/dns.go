@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs/config"
+)
+
+const (
+	// DefaultDNSAddress is the default address the DNS interface listens on.
+	DefaultDNSAddress = "127.0.0.1"
+
+	// DefaultDNSPort is the default port the DNS interface listens on.
+	DefaultDNSPort = 8600
+
+	// DefaultDNSDomain is the default domain of the DNS interface.
+	DefaultDNSDomain = "nomad"
+)
+
+// DNSConfig configures the DNS interface of the client, which answers queries
+// for Nomad service registrations.
+type DNSConfig struct {
+	// Address is the IP address the DNS interface listens on.
+	Address string
+
+	// Port is the port the DNS interface listens on, over both UDP and TCP.
+	Port int
+
+	// Domain is the domain of the service names the DNS interface answers
+	// queries for, without leading or trailing dots.
+	Domain string
+
+	// TTL is the time to live of the records of the answers.
+	TTL time.Duration
+}
+
+// DNSConfigFromAgent creates the internal read-only copy of the client
+// agent's DNSConfig. It returns nil if the DNS interface is not enabled.
+func DNSConfigFromAgent(c *config.DNSConfig) (*DNSConfig, error) {
+	if c == nil || c.Enabled == nil || !*c.Enabled {
+		return nil, nil
+	}
+
+	dns := &DNSConfig{
+		Address: DefaultDNSAddress,
+		Port:    DefaultDNSPort,
+		Domain:  DefaultDNSDomain,
+	}
+
+	if c.Address != nil {
+		if net.ParseIP(*c.Address) == nil {
+			return nil, fmt.Errorf("invalid address %q", *c.Address)
+		}
+		dns.Address = *c.Address
+	}
+	if c.Port != nil {
+		if *c.Port < 1 || *c.Port > 65535 {
+			return nil, fmt.Errorf("invalid port %d", *c.Port)
+		}
+		dns.Port = *c.Port
+	}
+	if c.Domain != nil {
+		dns.Domain = strings.Trim(*c.Domain, ".")
+		if dns.Domain == "" {
+			return nil, fmt.Errorf("domain must not be empty")
+		}
+	}
+	if c.TTL != nil {
+		ttl, err := time.ParseDuration(*c.TTL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing TTL: %w", err)
+		}
+		if ttl < 0 {
+			return nil, fmt.Errorf("TTL must not be negative")
+		}
+		dns.TTL = ttl
+	}
+
+	return dns, nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/shoenig/test/must"
+)
+
+func TestDNSConfigFromAgent(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name     string
+		config   *config.DNSConfig
+		expected *DNSConfig
+		expErr   string
+	}{
+		{
+			name:     "nil",
+			expected: nil,
+		},
+		{
+			name:     "disabled",
+			config:   &config.DNSConfig{Enabled: new(false), Port: new(53)},
+			expected: nil,
+		},
+		{
+			name:   "defaults",
+			config: &config.DNSConfig{Enabled: new(true)},
+			expected: &DNSConfig{
+				Address: DefaultDNSAddress,
+				Port:    DefaultDNSPort,
+				Domain:  DefaultDNSDomain,
+			},
+		},
+		{
+			name: "full",
+			config: &config.DNSConfig{
+				Enabled: new(true),
+				Address: new("0.0.0.0"),
+				Port:    new(53),
+				Domain:  new("cluster.local."),
+				TTL:     new("10s"),
+			},
+			expected: &DNSConfig{
+				Address: "0.0.0.0",
+				Port:    53,
+				Domain:  "cluster.local",
+				TTL:     10 * time.Second,
+			},
+		},
+		{
+			name:   "invalid address",
+			config: &config.DNSConfig{Enabled: new(true), Address: new("localhost")},
+			expErr: `invalid address "localhost"`,
+		},
+		{
+			name:   "invalid port",
+			config: &config.DNSConfig{Enabled: new(true), Port: new(70000)},
+			expErr: "invalid port 70000",
+		},
+		{
+			name:   "empty domain",
+			config: &config.DNSConfig{Enabled: new(true), Domain: new(".")},
+			expErr: "domain must not be empty",
+		},
+		{
+			name:   "invalid ttl",
+			config: &config.DNSConfig{Enabled: new(true), TTL: new("soon")},
+			expErr: "error parsing TTL",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := DNSConfigFromAgent(tc.config)
+			if tc.expErr != "" {
+				must.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, result)
+		})
+	}
+}
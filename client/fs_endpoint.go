@@ -4,6 +4,7 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -12,8 +13,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
-	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/client/allocdir"
 	sframer "github.com/hashicorp/nomad/client/lib/streamframer"
+	"github.com/hashicorp/nomad/client/logmon/logging"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -35,6 +37,7 @@ var (
 	taskNotPresentErr    = fmt.Errorf("must provide task name")
 	logTypeNotPresentErr = fmt.Errorf("must provide log type (stdout/stderr)")
 	invalidOrigin        = fmt.Errorf("origin must be start or end")
+	patternNotPresentErr = fmt.Errorf("must provide a search pattern")
 )
 
 const (
@@ -69,6 +72,19 @@ const (
 	// and end of a file.
 	OriginStart = "start"
 	OriginEnd   = "end"
+
+	// defaultLogsSearchLimit and maxLogsSearchLimit are the default and
+	// maximum number of matches returned when searching logs.
+	defaultLogsSearchLimit = 100
+	maxLogsSearchLimit     = 1000
+
+	// maxLogsSearchContext is the maximum number of context lines returned
+	// before and after each match when searching logs.
+	maxLogsSearchContext = 100
+
+	// maxLogsSearchLineSize is the size after which log lines are truncated
+	// when searching logs.
+	maxLogsSearchLineSize = 16 * 1024
 )
 
 // FileSystem endpoint is used for accessing the logs and filesystem of
@@ -156,6 +172,41 @@ func (f *FileSystem) Stat(args *cstructs.FsStatRequest, reply *cstructs.FsStatRe
 	return nil
 }
 
+// SearchLogs is used to search the logs of a task for lines matching a
+// pattern, across its rotated log files.
+func (f *FileSystem) SearchLogs(args *cstructs.FsLogsSearchRequest, reply *cstructs.FsLogsSearchResponse) error {
+	defer metrics.MeasureSince([]string{"client", "file_system", "search_logs"}, time.Now())
+
+	alloc, err := f.c.GetAlloc(args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	// Check namespace read-logs *or* read-fs permissions.
+	aclObj, err := f.c.ResolveToken(args.QueryOptions.AuthToken)
+	if err != nil {
+		return err
+	}
+	readfs := aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadFS)
+	logs := aclObj.AllowNsOp(alloc.Namespace, acl.NamespaceCapabilityReadLogs)
+	if !readfs && !logs {
+		return structs.ErrPermissionDenied
+	}
+
+	fs, err := f.c.GetAllocFS(args.AllocID)
+	if err != nil {
+		return err
+	}
+
+	result, err := searchLogs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	reply.Result = result
+	return nil
+}
+
 // stream is is used to stream the contents of file in an allocation's
 // directory.
 func (f *FileSystem) stream(conn io.ReadWriteCloser) {
@@ -577,6 +628,15 @@ func (f *FileSystem) logsImpl(ctx context.Context, follow, plain bool, offset in
 			return fmt.Errorf("failed to list entries: %v", err)
 		}
 
+		// Offsets are in terms of the decompressed logs, so the size of any
+		// compressed log file needs to be resolved to find the right file.
+		if offset != 0 {
+			entries, err = decompressedSizes(fs, logPath, entries, task, logType)
+			if err != nil {
+				return err
+			}
+		}
+
 		// If we are not following logs, determine the max index for the logs we are
 		// interested in so we can stop there.
 		maxIndex := int64(math.MaxInt64)
@@ -596,6 +656,7 @@ func (f *FileSystem) logsImpl(ctx context.Context, follow, plain bool, offset in
 		var eofCancelCh chan error
 		cancelAfterFirstEof := false
 		exitAfter := false
+		compression := logging.CompressionOf(logEntry.Name)
 		if !follow && idx > maxIndex {
 			// Exceeded what was there initially so return
 			return nil
@@ -603,12 +664,18 @@ func (f *FileSystem) logsImpl(ctx context.Context, follow, plain bool, offset in
 			// At the end
 			cancelAfterFirstEof = true
 			exitAfter = true
-		} else {
+		} else if compression == "" {
 			eofCancelCh = blockUntilNextLog(ctx, fs, logPath, task, logType, idx+1)
 		}
 
 		p := filepath.Join(logPath, logEntry.Name)
-		err = f.streamFile(ctx, openOffset, p, 0, fs, framer, eofCancelCh, cancelAfterFirstEof)
+		if compression != "" {
+			// Compressed log files have been rotated, so they are read until
+			// EOF without waiting for changes
+			err = f.streamCompressedFile(openOffset, p, compression, fs, framer)
+		} else {
+			err = f.streamFile(ctx, openOffset, p, 0, fs, framer, eofCancelCh, cancelAfterFirstEof)
+		}
 
 		// Check if the context is cancelled
 		select {
@@ -798,6 +865,314 @@ OUTER:
 	}
 }
 
+// searchLogs searches the log files of a task, in the order they were
+// rotated, and returns the lines matching the pattern of the request.
+func searchLogs(fs allocdir.AllocDirFS, args *cstructs.FsLogsSearchRequest) (*cstructs.LogsSearchResult, error) {
+	// Validate the arguments
+	if args.Task == "" {
+		return nil, taskNotPresentErr
+	}
+	switch args.LogType {
+	case "stdout", "stderr":
+	default:
+		return nil, logTypeNotPresentErr
+	}
+	if args.Pattern == "" {
+		return nil, patternNotPresentErr
+	}
+	pattern, err := regexp.Compile(args.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search pattern: %v", err)
+	}
+	if args.Context < 0 || args.Context > maxLogsSearchContext {
+		return nil, fmt.Errorf("context must be between 0 and %d", maxLogsSearchContext)
+	}
+	limit := args.Limit
+	if limit == 0 {
+		limit = defaultLogsSearchLimit
+	} else if limit < 0 || limit > maxLogsSearchLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", maxLogsSearchLimit)
+	}
+
+	logPath := filepath.Join(allocdir.SharedAllocName, allocdir.LogDirName)
+	entries, err := fs.List(logPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entries: %v", err)
+	}
+	indexes, err := logIndexes(entries, args.Task, args.LogType)
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) == 0 {
+		return nil, notFoundErr{taskName: args.Task, logType: args.LogType}
+	}
+	sort.Sort(indexes)
+
+	s := &logsSearcher{
+		pattern: pattern,
+		context: args.Context,
+		limit:   limit,
+		result:  &cstructs.LogsSearchResult{Matches: []*cstructs.LogMatch{}},
+	}
+	for _, index := range indexes {
+		if args.Since > 0 && time.Since(index.entry.ModTime) > args.Since {
+			continue
+		}
+
+		name := index.entry.Name
+		err := s.searchFile(fs, filepath.Join(logPath, name))
+		if os.IsNotExist(err) {
+			// The file may have been compressed while searching, so it is
+			// looked up again by its index. Otherwise it was rotated out.
+			entry, lerr := findLogIndex(fs, logPath, args.Task, args.LogType, index.idx)
+			if lerr != nil {
+				return nil, lerr
+			}
+			if entry == nil || entry.Name == name {
+				continue
+			}
+			name = entry.Name
+			if err = s.searchFile(fs, filepath.Join(logPath, name)); os.IsNotExist(err) {
+				continue
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to search %q: %v", name, err)
+		}
+		if s.done {
+			break
+		}
+	}
+
+	return s.result, nil
+}
+
+// findLogIndex lists the log files of the task again and returns the one with
+// the index, or nil if it was rotated out.
+func findLogIndex(fs allocdir.AllocDirFS, logPath, task, logType string, idx int64) (*cstructs.AllocFileInfo, error) {
+	entries, err := fs.List(logPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entries: %v", err)
+	}
+	indexes, err := logIndexes(entries, task, logType)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		if index.idx == idx {
+			return index.entry, nil
+		}
+	}
+	return nil, nil
+}
+
+// logsSearcher matches the lines of log files, keeping track of the lines
+// before and after each match. The log files are searched as a single
+// stream, so the context of a match can span log files.
+type logsSearcher struct {
+	pattern *regexp.Regexp
+	context int
+	limit   int
+
+	result *cstructs.LogsSearchResult
+	done   bool
+
+	// before holds the last lines that are not part of a match yet
+	before []string
+
+	// last is the last match, and afterLeft the number of lines to add to
+	// its context
+	last      *cstructs.LogMatch
+	afterLeft int
+}
+
+// searchFile searches the lines of a single log file, decompressing it if
+// needed.
+func (s *logsSearcher) searchFile(fs allocdir.AllocDirFS, path string) error {
+	file, err := fs.ReadAt(path, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	r, err := logging.NewReader(file, logging.CompressionOf(path))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	name := filepath.Base(path)
+	br := bufio.NewReaderSize(r, maxLogsSearchLineSize)
+	for lineNum := 1; !s.done; lineNum++ {
+		line, err := readLogLine(br)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		s.add(name, lineNum, line)
+	}
+	return nil
+}
+
+// add matches a single log line.
+func (s *logsSearcher) add(file string, lineNum int, line string) {
+	if s.pattern.MatchString(line) {
+		if len(s.result.Matches) == s.limit {
+			// the search is only truncated once a match past the limit is
+			// found, and the context of the last match ends there
+			s.result.Truncated = true
+			s.done = true
+			return
+		}
+
+		s.last = &cstructs.LogMatch{
+			File:   file,
+			Line:   lineNum,
+			Text:   line,
+			Before: s.before,
+		}
+		s.result.Matches = append(s.result.Matches, s.last)
+		s.before = nil
+		s.afterLeft = s.context
+	} else if s.afterLeft > 0 {
+		s.last.After = append(s.last.After, line)
+		s.afterLeft--
+	} else if s.context > 0 && len(s.result.Matches) < s.limit {
+		if len(s.before) == s.context {
+			s.before = s.before[1:]
+		}
+		s.before = append(s.before, line)
+	}
+}
+
+// readLogLine reads a line without its line ending. Lines longer than
+// maxLogsSearchLineSize are truncated.
+func readLogLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		truncated := string(line)
+		for err == bufio.ErrBufferFull {
+			_, err = r.ReadSlice('\n')
+		}
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+		return truncated, nil
+	}
+	if err == io.EOF && len(line) > 0 {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	return string(line), nil
+}
+
+// streamCompressedFile streams the decompressed content of a rotated log file,
+// starting at the given offset into the decompressed content. If the
+// connection is broken an EPIPE error is returned.
+func (f *FileSystem) streamCompressedFile(offset int64, path, compression string,
+	fs allocdir.AllocDirFS, framer *sframer.StreamFramer) error {
+
+	file, err := fs.ReadAt(path, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	r, err := logging.NewReader(file, compression)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if _, err := io.CopyN(io.Discard, r, offset); err != nil && err != io.EOF {
+		return err
+	}
+
+	data := make([]byte, streamFrameSize)
+	for {
+		n, readErr := r.Read(data)
+		offset += int64(n)
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+
+		if n != 0 {
+			if err := framer.Send(path, "", data[:n], offset); err != nil {
+				return parseFramerErr(err)
+			}
+		}
+
+		if readErr == io.EOF {
+			return nil
+		}
+	}
+}
+
+// decompressedSizes returns a copy of the entries in which the size of the
+// compressed log files of the task is the size of their decompressed content.
+func decompressedSizes(fs allocdir.AllocDirFS, logPath string,
+	entries []*cstructs.AllocFileInfo, task, logType string) ([]*cstructs.AllocFileInfo, error) {
+
+	prefix := fmt.Sprintf("%s.%s.", task, logType)
+	out := make([]*cstructs.AllocFileInfo, len(entries))
+	for i, entry := range entries {
+		out[i] = entry
+		compression := logging.CompressionOf(entry.Name)
+		if entry.IsDir || compression == "" || !strings.HasPrefix(entry.Name, prefix) {
+			continue
+		}
+
+		size, err := decompressedSize(fs, filepath.Join(logPath, entry.Name), compression)
+		if os.IsNotExist(err) {
+			// the file was rotated out and is skipped when opening it
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read %q: %v", entry.Name, err)
+		}
+
+		resolved := *entry
+		resolved.Size = size
+		out[i] = &resolved
+	}
+	return out, nil
+}
+
+// decompressedSize returns the size of the decompressed content of a file. It
+// is read from the header of the file, and the file is only decompressed if it
+// was compressed without recording its size.
+func decompressedSize(fs allocdir.AllocDirFS, path, compression string) (int64, error) {
+	header, err := fs.ReadAt(path, 0)
+	if err != nil {
+		return 0, err
+	}
+	size, ok, err := logging.DecompressedSize(header, compression)
+	header.Close()
+	if err != nil {
+		return 0, err
+	} else if ok {
+		return size, nil
+	}
+
+	file, err := fs.ReadAt(path, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	r, err := logging.NewReader(file, compression)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	return io.Copy(io.Discard, r)
+}
+
 // blockUntilNextLog returns a channel that will have data sent when the next
 // log index or anything greater is created.
 func blockUntilNextLog(ctx context.Context, fs allocdir.AllocDirFS, logPath, task, logType string, nextIndex int64) chan error {
@@ -871,28 +1246,36 @@ func (a indexTupleArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 
 // logIndexes takes a set of entries and returns a indexTupleArray of
 // the desired log file entries. If the indexes could not be determined, an
-// error is returned.
+// error is returned. Rotated log files may be compressed; if a log file is
+// listed both before and after being compressed, the uncompressed one is used.
 func logIndexes(entries []*cstructs.AllocFileInfo, task, logType string) (indexTupleArray, error) {
 	var indexes []indexTuple
-	prefix := fmt.Sprintf("%s.%s.", task, logType)
+	positions := make(map[int64]int)
+	baseName := fmt.Sprintf("%s.%s", task, logType)
 	for _, entry := range entries {
 		if entry.IsDir {
 			continue
 		}
 
-		// If nothing was trimmed, then it is not a match
-		idxStr := strings.TrimPrefix(entry.Name, prefix)
-		if idxStr == entry.Name {
+		// If the prefix doesn't match, then it is not a match
+		if !strings.HasPrefix(entry.Name, baseName+".") {
 			continue
 		}
 
-		// Convert to an int
-		idx, err := strconv.Atoi(idxStr)
+		idx, compression, err := logging.ParseFileName(entry.Name, baseName)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert %q to a log index: %v", idxStr, err)
+			return nil, err
 		}
 
-		indexes = append(indexes, indexTuple{idx: int64(idx), entry: entry})
+		tuple := indexTuple{idx: int64(idx), entry: entry}
+		if i, ok := positions[tuple.idx]; ok {
+			if compression == "" {
+				indexes[i] = tuple
+			}
+			continue
+		}
+		positions[tuple.idx] = len(indexes)
+		indexes = append(indexes, tuple)
 	}
 
 	return indexTupleArray(indexes), nil
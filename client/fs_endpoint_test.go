@@ -4,7 +4,10 @@
 package client
 
 import (
+	"bufio"
+	"compress/gzip"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"math"
@@ -2085,6 +2088,278 @@ func TestFS_logsImpl_NoFollow(t *testing.T) {
 	}
 }
 
+func TestFS_logIndexes_Compressed(t *testing.T) {
+	ci.Parallel(t)
+
+	entries := []*cstructs.AllocFileInfo{
+		{Name: "foo.stdout.0.gz"},
+		{Name: "foo.stdout.1.zst"},
+		{Name: "foo.stdout.2.gz"},
+		{Name: "foo.stdout.2"},
+		{Name: "foo.stdout.3"},
+		{Name: ".foo.stdout.3.gz.tmp"},
+		{Name: "foo.stderr.0.gz"},
+	}
+
+	indexes, err := logIndexes(entries, "foo", "stdout")
+	must.NoError(t, err)
+
+	var names []string
+	for _, tuple := range indexes {
+		names = append(names, tuple.entry.Name)
+	}
+	must.Eq(t, []string{"foo.stdout.0.gz", "foo.stdout.1.zst", "foo.stdout.2", "foo.stdout.3"}, names)
+
+	_, err = logIndexes([]*cstructs.AllocFileInfo{{Name: "foo.stdout.x.gz"}}, "foo", "stdout")
+	must.ErrorContains(t, err, "failed to convert")
+}
+
+func TestFS_logsImpl_Compressed(t *testing.T) {
+	ci.Parallel(t)
+
+	// streaming logs doesn't depend on the client
+	endpoint := &FileSystem{}
+
+	ad := tempAllocDir(t)
+	must.NoError(t, ad.Build())
+	defer ad.Destroy()
+
+	logDir := filepath.Join(ad.SharedDir, allocdir.LogDirName)
+	must.NoError(t, os.MkdirAll(logDir, 0777))
+
+	// the first two log files are compressed
+	task := "foo"
+	logType := "stdout"
+	for i, content := range []string{"first\n", "second\n"} {
+		f, err := os.Create(filepath.Join(logDir, fmt.Sprintf("%s.%s.%d.gz", task, logType, i)))
+		must.NoError(t, err)
+		w := gzip.NewWriter(f)
+		_, err = w.Write([]byte(content))
+		must.NoError(t, err)
+		must.NoError(t, w.Close())
+		must.NoError(t, f.Close())
+	}
+	must.NoError(t, os.WriteFile(filepath.Join(logDir, fmt.Sprintf("%s.%s.2", task, logType)), []byte("third\n"), 0777))
+
+	readLogs := func(origin string, offset int64) string {
+		frames := make(chan *sframer.StreamFrame, 32)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- endpoint.logsImpl(ctx, false, false, offset, origin, task, logType, ad, frames)
+		}()
+
+		// the frames are closed once the logs have been streamed
+		var received []byte
+		for frame := range frames {
+			received = append(received, frame.Data...)
+		}
+		must.NoError(t, <-errCh)
+		return string(received)
+	}
+
+	must.Eq(t, "first\nsecond\nthird\n", readLogs(OriginStart, 0))
+	must.Eq(t, "ond\nthird\n", readLogs(OriginStart, 9))
+	must.Eq(t, "nd\nthird\n", readLogs(OriginEnd, 9))
+}
+
+func TestFS_decompressedSizes(t *testing.T) {
+	ci.Parallel(t)
+
+	ad := tempAllocDir(t)
+	must.NoError(t, ad.Build())
+	defer ad.Destroy()
+
+	logDir := filepath.Join(ad.SharedDir, allocdir.LogDirName)
+	must.NoError(t, os.MkdirAll(logDir, 0777))
+
+	writeGzip := func(name string, extra []byte) {
+		f, err := os.Create(filepath.Join(logDir, name))
+		must.NoError(t, err)
+		w := gzip.NewWriter(f)
+		w.Header.Extra = extra
+		_, err = w.Write([]byte("hello\n"))
+		must.NoError(t, err)
+		must.NoError(t, w.Close())
+		must.NoError(t, f.Close())
+	}
+
+	// the size recorded by the rotator is trusted, and files compressed
+	// without it are decompressed
+	writeGzip("foo.stdout.0.gz", binary.LittleEndian.AppendUint64([]byte{'N', 'S', 8, 0}, 1<<33))
+	writeGzip("foo.stdout.1.gz", nil)
+
+	logPath := filepath.Join(allocdir.SharedAllocName, allocdir.LogDirName)
+	entries, err := ad.List(logPath)
+	must.NoError(t, err)
+	entries, err = decompressedSizes(ad, logPath, entries, "foo", "stdout")
+	must.NoError(t, err)
+
+	sizes := make(map[string]int64)
+	for _, e := range entries {
+		sizes[e.Name] = e.Size
+	}
+	must.Eq(t, map[string]int64{"foo.stdout.0.gz": 1 << 33, "foo.stdout.1.gz": 6}, sizes)
+}
+
+func TestFS_searchLogs(t *testing.T) {
+	ci.Parallel(t)
+
+	ad := tempAllocDir(t)
+	must.NoError(t, ad.Build())
+	defer ad.Destroy()
+
+	logDir := filepath.Join(ad.SharedDir, allocdir.LogDirName)
+	must.NoError(t, os.MkdirAll(logDir, 0777))
+
+	// the oldest log file is compressed and hasn't been written to in a while
+	f, err := os.Create(filepath.Join(logDir, "foo.stdout.0.gz"))
+	must.NoError(t, err)
+	w := gzip.NewWriter(f)
+	_, err = w.Write([]byte("starting\nerror: one\nretrying\n"))
+	must.NoError(t, err)
+	must.NoError(t, w.Close())
+	must.NoError(t, f.Close())
+	old := time.Now().Add(-2 * time.Hour)
+	must.NoError(t, os.Chtimes(f.Name(), old, old))
+
+	must.NoError(t, os.WriteFile(filepath.Join(logDir, "foo.stdout.1"),
+		[]byte("a\nb\nerror: two\r\nc\nerror: three\nd\ne\nf\nerror: four"), 0777))
+
+	search := func(args *cstructs.FsLogsSearchRequest) *cstructs.LogsSearchResult {
+		args.Task = "foo"
+		args.LogType = "stdout"
+		if args.Pattern == "" {
+			args.Pattern = "^error:"
+		}
+		result, err := searchLogs(ad, args)
+		must.NoError(t, err)
+		return result
+	}
+
+	// matches are found across files, with context spanning files and
+	// without repeating lines already returned
+	must.Eq(t, &cstructs.LogsSearchResult{
+		Matches: []*cstructs.LogMatch{
+			{File: "foo.stdout.0.gz", Line: 2, Text: "error: one", Before: []string{"starting"},
+				After: []string{"retrying", "a"}},
+			{File: "foo.stdout.1", Line: 3, Text: "error: two", Before: []string{"b"}, After: []string{"c"}},
+			{File: "foo.stdout.1", Line: 5, Text: "error: three", After: []string{"d", "e"}},
+			{File: "foo.stdout.1", Line: 9, Text: "error: four", Before: []string{"f"}},
+		},
+	}, search(&cstructs.FsLogsSearchRequest{Context: 2}))
+
+	// the context of the last match is returned when reaching the limit
+	must.Eq(t, &cstructs.LogsSearchResult{
+		Matches: []*cstructs.LogMatch{
+			{File: "foo.stdout.0.gz", Line: 2, Text: "error: one", Before: []string{"starting"},
+				After: []string{"retrying"}},
+			{File: "foo.stdout.1", Line: 3, Text: "error: two", Before: []string{"b"}, After: []string{"c"}},
+		},
+		Truncated: true,
+	}, search(&cstructs.FsLogsSearchRequest{Context: 1, Limit: 2}))
+
+	// reaching the limit with the last match doesn't truncate the search
+	must.Eq(t, &cstructs.LogsSearchResult{
+		Matches: []*cstructs.LogMatch{
+			{File: "foo.stdout.1", Line: 5, Text: "error: three", Before: []string{"error: two", "c"},
+				After: []string{"d", "e"}},
+			{File: "foo.stdout.1", Line: 9, Text: "error: four", Before: []string{"f"}},
+		},
+	}, search(&cstructs.FsLogsSearchRequest{Context: 2, Limit: 2, Since: time.Hour, Pattern: "^error: (three|four)"}))
+
+	// old log files are skipped
+	result := search(&cstructs.FsLogsSearchRequest{Since: time.Hour})
+	must.Len(t, 3, result.Matches)
+	must.Eq(t, "error: two", result.Matches[0].Text)
+
+	_, err = searchLogs(ad, &cstructs.FsLogsSearchRequest{Task: "foo", LogType: "stdout", Pattern: "("})
+	must.ErrorContains(t, err, "invalid search pattern")
+
+	_, err = searchLogs(ad, &cstructs.FsLogsSearchRequest{Task: "foo", LogType: "stdout", Pattern: "a", Limit: 1001})
+	must.ErrorContains(t, err, "limit must be between 1 and 1000")
+
+	_, err = searchLogs(ad, &cstructs.FsLogsSearchRequest{Task: "bar", LogType: "stdout", Pattern: "a"})
+	must.ErrorIs(t, err, notFoundErr{taskName: "bar", logType: "stdout"})
+}
+
+// compressingFS compresses a log file when it is first opened, as if it was
+// compressed between listing the log files and opening it.
+type compressingFS struct {
+	allocdir.AllocDirFS
+	t    *testing.T
+	path string
+	once sync.Once
+}
+
+func (fs *compressingFS) ReadAt(path string, offset int64) (io.ReadCloser, error) {
+	if filepath.Base(path) == filepath.Base(fs.path) {
+		fs.once.Do(func() {
+			data, err := os.ReadFile(fs.path)
+			must.NoError(fs.t, err)
+			f, err := os.Create(fs.path + ".gz")
+			must.NoError(fs.t, err)
+			w := gzip.NewWriter(f)
+			_, err = w.Write(data)
+			must.NoError(fs.t, err)
+			must.NoError(fs.t, w.Close())
+			must.NoError(fs.t, f.Close())
+			must.NoError(fs.t, os.Remove(fs.path))
+		})
+	}
+	return fs.AllocDirFS.ReadAt(path, offset)
+}
+
+func TestFS_searchLogs_compressed(t *testing.T) {
+	ci.Parallel(t)
+
+	ad := tempAllocDir(t)
+	must.NoError(t, ad.Build())
+	defer ad.Destroy()
+
+	logDir := filepath.Join(ad.SharedDir, allocdir.LogDirName)
+	must.NoError(t, os.MkdirAll(logDir, 0777))
+	must.NoError(t, os.WriteFile(filepath.Join(logDir, "foo.stdout.0"),
+		[]byte("starting\nerror: one\n"), 0777))
+	must.NoError(t, os.WriteFile(filepath.Join(logDir, "foo.stdout.1"),
+		[]byte("error: two\n"), 0777))
+
+	// the rotated log file is compressed after being listed
+	fs := &compressingFS{AllocDirFS: ad, t: t, path: filepath.Join(logDir, "foo.stdout.0")}
+	result, err := searchLogs(fs, &cstructs.FsLogsSearchRequest{
+		Task:    "foo",
+		LogType: "stdout",
+		Pattern: "^error:",
+	})
+	must.NoError(t, err)
+	must.Eq(t, &cstructs.LogsSearchResult{
+		Matches: []*cstructs.LogMatch{
+			{File: "foo.stdout.0.gz", Line: 2, Text: "error: one"},
+			{File: "foo.stdout.1", Line: 1, Text: "error: two"},
+		},
+	}, result)
+}
+
+func TestFS_readLogLine(t *testing.T) {
+	ci.Parallel(t)
+
+	long := strings.Repeat("x", maxLogsSearchLineSize+10)
+	r := bufio.NewReaderSize(strings.NewReader("one\r\n"+long+"\ntwo"), maxLogsSearchLineSize)
+
+	var lines []string
+	for {
+		line, err := readLogLine(r)
+		if err == io.EOF {
+			break
+		}
+		must.NoError(t, err)
+		lines = append(lines, line)
+	}
+	must.Eq(t, []string{"one", long[:maxLogsSearchLineSize], "two"}, lines)
+}
+
 func TestFS_logsImpl_Follow(t *testing.T) {
 	ci.Parallel(t)
 
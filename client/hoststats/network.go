@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package hoststats
+
+// NetworkStats represents the traffic counters of the interfaces of a
+// network namespace
+type NetworkStats struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	RxDropped uint64
+	TxDropped uint64
+}
+
+// Add adds the counters of other to the stats.
+func (n *NetworkStats) Add(other *NetworkStats) {
+	if other == nil {
+		return
+	}
+	n.RxBytes += other.RxBytes
+	n.TxBytes += other.TxBytes
+	n.RxPackets += other.RxPackets
+	n.TxPackets += other.TxPackets
+	n.RxDropped += other.RxDropped
+	n.TxDropped += other.TxDropped
+}
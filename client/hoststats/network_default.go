@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package hoststats
+
+import "errors"
+
+// CollectNetworkStats is only supported on Linux.
+func CollectNetworkStats(string) (*NetworkStats, error) {
+	return nil, errors.New("network stats are only supported on linux")
+}
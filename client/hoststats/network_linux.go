@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package hoststats
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/nomad/client/lib/nsutil"
+	"github.com/vishvananda/netlink"
+)
+
+// CollectNetworkStats returns the sum of the traffic counters of the
+// interfaces of the network namespace at netnsPath, excluding the loopback
+// interface.
+func CollectNetworkStats(netnsPath string) (*NetworkStats, error) {
+	stats := &NetworkStats{}
+	err := nsutil.WithNetNSPath(netnsPath, func(nsutil.NetNS) error {
+		links, err := netlink.LinkList()
+		if err != nil {
+			return fmt.Errorf("failed to list interfaces: %w", err)
+		}
+
+		for _, link := range links {
+			attrs := link.Attrs()
+			if attrs.EncapType == "loopback" || attrs.Statistics == nil {
+				continue
+			}
+			stats.Add(&NetworkStats{
+				RxBytes:   attrs.Statistics.RxBytes,
+				TxBytes:   attrs.Statistics.TxBytes,
+				RxPackets: attrs.Statistics.RxPackets,
+				TxPackets: attrs.Statistics.TxPackets,
+				RxDropped: attrs.Statistics.RxDropped,
+				TxDropped: attrs.Statistics.TxDropped,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
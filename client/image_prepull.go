@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package client
+
+import (
+	"strings"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+// imagePrePullMetaSuffix is the suffix of the node metadata keys listing the
+// images a driver pulls ahead of placement, such as "docker.image_prepull".
+const imagePrePullMetaSuffix = ".image_prepull"
+
+// imagePrePullMetaKey returns the node metadata key listing the images the
+// driver pulls ahead of placement.
+func imagePrePullMetaKey(driver string) string {
+	return driver + imagePrePullMetaSuffix
+}
+
+// parseImagePrePullMeta returns the images of the comma separated node
+// metadata value.
+func parseImagePrePullMeta(value string) []string {
+	var images []string
+	for image := range strings.SplitSeq(value, ",") {
+		if image = strings.TrimSpace(image); image != "" {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+// prePullImages passes the images listed in the node metadata of the driver
+// to the driver, if it supports pulling images ahead of placement. The
+// current node metadata is read, so concurrent calls converge to the latest
+// images.
+func (c *Client) prePullImages(name string) {
+	c.imagePrePullLock.Lock()
+	defer c.imagePrePullLock.Unlock()
+
+	c.configLock.Lock()
+	value := c.config.Node.Meta[imagePrePullMetaKey(name)]
+	c.configLock.Unlock()
+
+	driver, err := c.drivermanager.Dispense(name)
+	if err != nil {
+		c.logger.Warn("failed to dispense driver to pre-pull images", "driver", name, "error", err)
+		return
+	}
+
+	puller, ok := driver.(drivers.DriverImagePrePuller)
+	if !ok {
+		c.logger.Warn("driver does not support pre-pulling images", "driver", name)
+		return
+	}
+	puller.PrePullImages(parseImagePrePullMeta(value))
+}
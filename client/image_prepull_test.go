@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package client
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestClient_parseImagePrePullMeta(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Eq(t, "docker.image_prepull", imagePrePullMetaKey("docker"))
+	must.Nil(t, parseImagePrePullMeta(""))
+	must.Eq(t, []string{"redis:7", "nginx:1.27"}, parseImagePrePullMeta(" redis:7, ,nginx:1.27,"))
+}
@@ -14,3 +14,8 @@ func LinuxResourcesPath(string, string, bool) string {
 func MaybeDisableMemorySwappiness() *uint64 {
 	return nil
 }
+
+// GetDefaultRoot returns an empty root on non-Linux systems
+func GetDefaultRoot() string {
+	return ""
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/nomad/client/logmon/proto"
+	"github.com/hashicorp/nomad/client/logmon/sink"
 	"github.com/hashicorp/nomad/helper/pluginutils/grpcutils"
 )
 
@@ -27,8 +28,23 @@ func (c *logmonClient) Start(cfg *LogConfig) error {
 		StderrFileName: cfg.StderrLogFile,
 		MaxFiles:       uint32(cfg.MaxFiles),
 		MaxFileSizeMb:  uint32(cfg.MaxFileSizeMB),
+		MaxAge:         int64(cfg.MaxAge),
+		Compress:       cfg.Compress,
 		StdoutFifo:     cfg.StdoutFifo,
 		StderrFifo:     cfg.StderrFifo,
+		AllocId:        cfg.AllocID,
+		JobId:          cfg.JobID,
+		Namespace:      cfg.Namespace,
+		TaskName:       cfg.TaskName,
+	}
+	for _, s := range cfg.Sinks {
+		req.Sinks = append(req.Sinks, &proto.LogSink{
+			Name:       s.Name,
+			Type:       s.Type,
+			Address:    s.Address,
+			Headers:    s.Headers,
+			BufferSize: uint32(s.BufferSize),
+		})
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), logmonRPCTimeout)
 	defer cancel()
@@ -45,3 +61,27 @@ func (c *logmonClient) Stop() error {
 	_, err := c.client.Stop(ctx, req)
 	return grpcutils.HandleGrpcErr(err, c.doneCtx)
 }
+
+func (c *logmonClient) Stats() ([]*sink.Stats, error) {
+	req := &proto.StatsRequest{}
+	ctx, cancel := context.WithTimeout(context.Background(), logmonRPCTimeout)
+	defer cancel()
+
+	resp, err := c.client.Stats(ctx, req)
+	if err != nil {
+		return nil, grpcutils.HandleGrpcErr(err, c.doneCtx)
+	}
+
+	stats := make([]*sink.Stats, 0, len(resp.Sinks))
+	for _, s := range resp.Sinks {
+		stats = append(stats, &sink.Stats{
+			Name:     s.Name,
+			Type:     s.Type,
+			Sent:     s.Sent,
+			Dropped:  s.Dropped,
+			Errors:   s.Errors,
+			Buffered: int(s.Buffered),
+		})
+	}
+	return stats, nil
+}
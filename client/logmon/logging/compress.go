@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package logging
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionGzip compresses rotated log files with gzip
+	CompressionGzip = "gzip"
+
+	// CompressionZstd compresses rotated log files with zstd
+	CompressionZstd = "zstd"
+)
+
+// gzipSizeID is the subfield ID of the gzip extra field recording the size of
+// the uncompressed log file (RFC 1952 2.3.1.1), as ISIZE is truncated to 32
+// bits.
+var gzipSizeID = [2]byte{'N', 'S'}
+
+// errCompressionCancelled is returned when compressing a file is cancelled.
+var errCompressionCancelled = errors.New("compression cancelled")
+
+// compressionExts maps the compressions to the extensions of the compressed
+// log files
+var compressionExts = map[string]string{
+	CompressionGzip: ".gz",
+	CompressionZstd: ".zst",
+}
+
+// ParseFileName returns the index of the log file with the given base name,
+// and its compression if it is compressed. An error is returned if the file
+// name has the prefix of the base name but is not a log file.
+func ParseFileName(name, baseFileName string) (int, string, error) {
+	suffix, ok := strings.CutPrefix(name, baseFileName+".")
+	if !ok {
+		return 0, "", fmt.Errorf("%q is not a log file of %q", name, baseFileName)
+	}
+
+	var compression string
+	for c, ext := range compressionExts {
+		if trimmed, ok := strings.CutSuffix(suffix, ext); ok {
+			suffix, compression = trimmed, c
+			break
+		}
+	}
+
+	idx, err := strconv.Atoi(suffix)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to convert %q to a log index: %v", suffix, err)
+	}
+	return idx, compression, nil
+}
+
+// CompressionOf returns the compression of the log file, based on its
+// extension, or an empty string if it is not compressed.
+func CompressionOf(name string) string {
+	for c, ext := range compressionExts {
+		if strings.HasSuffix(name, ext) {
+			return c
+		}
+	}
+	return ""
+}
+
+// NewReader returns a reader decompressing r.
+func NewReader(r io.Reader, compression string) (io.ReadCloser, error) {
+	switch compression {
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	case CompressionZstd:
+		dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	case "":
+		return io.NopCloser(r), nil
+	default:
+		return nil, fmt.Errorf("unknown log compression %q", compression)
+	}
+}
+
+// DecompressedSize returns the size of the decompressed content of r, read
+// from the header of the compressed log file. It returns false if the header
+// doesn't record the size, as for files compressed by older versions.
+func DecompressedSize(r io.Reader, compression string) (int64, bool, error) {
+	switch compression {
+	case CompressionGzip:
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return 0, false, err
+		}
+		extra := gr.Header.Extra
+		for len(extra) >= 4 {
+			n := int(binary.LittleEndian.Uint16(extra[2:4]))
+			if len(extra) < 4+n {
+				break
+			}
+			if [2]byte(extra[:2]) == gzipSizeID && n == 8 {
+				return int64(binary.LittleEndian.Uint64(extra[4:12])), true, nil
+			}
+			extra = extra[4+n:]
+		}
+		return 0, false, nil
+	case CompressionZstd:
+		buf := make([]byte, zstd.HeaderMaxSize)
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return 0, false, err
+		}
+		var h zstd.Header
+		if err := h.Decode(buf[:n]); err != nil {
+			return 0, false, err
+		}
+		return int64(h.FrameContentSize), h.HasFCS, nil
+	default:
+		return 0, false, fmt.Errorf("unknown log compression %q", compression)
+	}
+}
+
+// cancelReader fails the reads once doneCh is closed, so that compressing a
+// large file doesn't delay closing the rotator.
+type cancelReader struct {
+	r      io.Reader
+	doneCh <-chan struct{}
+}
+
+func (c *cancelReader) Read(p []byte) (int, error) {
+	select {
+	case <-c.doneCh:
+		return 0, errCompressionCancelled
+	default:
+	}
+	return c.r.Read(p)
+}
+
+// compressFile compresses the log file at path and removes it. The compressed
+// file is written to a hidden temporary file first, so readers never see a
+// partially compressed log file. The size of the log file is recorded in the
+// header of the compressed file, and compressing is aborted with
+// errCompressionCancelled once doneCh is closed.
+func compressFile(path, compression string, doneCh <-chan struct{}) error {
+	ext, ok := compressionExts[compression]
+	if !ok {
+		return fmt.Errorf("unknown log compression %q", compression)
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	fi, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	dir, name := filepath.Split(path)
+	tmpPath := filepath.Join(dir, "."+name+ext+".tmp")
+	dst, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+	defer dst.Close()
+
+	var w io.WriteCloser
+	switch compression {
+	case CompressionGzip:
+		gw := gzip.NewWriter(dst)
+		gw.Header.Extra = append(gzipSizeID[:], 8, 0)
+		gw.Header.Extra = binary.LittleEndian.AppendUint64(gw.Header.Extra, uint64(fi.Size()))
+		w = gw
+	case CompressionZstd:
+		zw, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return err
+		}
+		zw.ResetContentSize(dst, fi.Size())
+		w = zw
+	}
+
+	if _, err := io.Copy(w, &cancelReader{r: src, doneCh: doneCh}); err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+
+	// keep the modification time, used to expire the log file
+	os.Chtimes(tmpPath, fi.ModTime(), fi.ModTime())
+
+	if err := os.Rename(tmpPath, path+ext); err != nil {
+		return err
+	}
+	src.Close()
+	return os.Remove(path)
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package logging
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/klauspost/compress/zstd"
+	"github.com/shoenig/test/must"
+)
+
+func TestParseFileName(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name        string
+		idx         int
+		compression string
+		expErr      string
+	}{
+		{name: "web.stdout.0"},
+		{name: "web.stdout.12", idx: 12},
+		{name: "web.stdout.3.gz", idx: 3, compression: CompressionGzip},
+		{name: "web.stdout.4.zst", idx: 4, compression: CompressionZstd},
+		{name: "web.stdout.x.gz", expErr: "failed to convert"},
+		{name: "web.stdout.fifo", expErr: "failed to convert"},
+		{name: "web.stderr.0", expErr: "is not a log file"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			idx, compression, err := ParseFileName(tc.name, "web.stdout")
+			if tc.expErr != "" {
+				must.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.idx, idx)
+			must.Eq(t, tc.compression, compression)
+		})
+	}
+}
+
+func TestCompressFile(t *testing.T) {
+	ci.Parallel(t)
+
+	content := strings.Repeat("some log line\n", 1000)
+
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "web.stdout.0")
+			must.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+			must.NoError(t, compressFile(path, compression, nil))
+			_, err := os.Stat(path)
+			must.True(t, os.IsNotExist(err))
+
+			b, err := os.ReadFile(path + compressionExts[compression])
+			must.NoError(t, err)
+
+			// the size is read from the header
+			size, ok, err := DecompressedSize(bytes.NewReader(b), compression)
+			must.NoError(t, err)
+			must.True(t, ok)
+			must.Eq(t, int64(len(content)), size)
+
+			r, err := NewReader(bytes.NewReader(b), compression)
+			must.NoError(t, err)
+			defer r.Close()
+			decompressed, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, content, string(decompressed))
+		})
+	}
+}
+
+func TestCompressFile_Cancel(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "web.stdout.0")
+	must.NoError(t, os.WriteFile(path, []byte("hello"), 0644))
+
+	doneCh := make(chan struct{})
+	close(doneCh)
+	must.ErrorIs(t, compressFile(path, CompressionGzip, doneCh), errCompressionCancelled)
+
+	// the log file is left untouched
+	entries, err := os.ReadDir(dir)
+	must.NoError(t, err)
+	must.Len(t, 1, entries)
+	must.Eq(t, "web.stdout.0", entries[0].Name())
+}
+
+func TestDecompressedSize_Unknown(t *testing.T) {
+	ci.Parallel(t)
+
+	// files compressed without recording the size
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	gw.Write([]byte("hello"))
+	must.NoError(t, gw.Close())
+	_, ok, err := DecompressedSize(bytes.NewReader(buf.Bytes()), CompressionGzip)
+	must.NoError(t, err)
+	must.False(t, ok)
+
+	buf.Reset()
+	zw, err := zstd.NewWriter(&buf)
+	must.NoError(t, err)
+	zw.Write([]byte("hello"))
+	must.NoError(t, zw.Close())
+	_, ok, err = DecompressedSize(bytes.NewReader(buf.Bytes()), CompressionZstd)
+	must.NoError(t, err)
+	must.False(t, ok)
+}
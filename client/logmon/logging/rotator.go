@@ -6,11 +6,11 @@ package logging
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -33,20 +33,25 @@ const (
 
 	// newLineDelimiter is the delimiter used for new lines.
 	newLineDelimiter = '\n'
+
+	// maxAgeCheckInterval is the interval at which rotated files are checked
+	// for expiry, as a task that stopped logging doesn't rotate files.
+	maxAgeCheckInterval = time.Minute
 )
 
 // FileRotator writes bytes to a rotated set of files
 type FileRotator struct {
-	MaxFiles int   // MaxFiles is the maximum number of rotated files allowed in a path
-	FileSize int64 // FileSize is the size a rotated file is allowed to grow
+	MaxFiles    int           // MaxFiles is the maximum number of rotated files allowed in a path
+	FileSize    int64         // FileSize is the size a rotated file is allowed to grow
+	MaxAge      time.Duration // MaxAge is the age after which rotated files are removed, if set
+	Compression string        // Compression is the compression of the rotated files, if set
 
 	path         string // path is the path on the file system where the rotated set of files are opened
 	baseFileName string // baseFileName is the base file name of the rotated files
 	logFileIdx   int    // logFileIdx is the current index of the rotated files
 
-	oldestLogFileIdx int // oldestLogFileIdx is the index of the oldest log file in a path
-	closed           bool
-	fileLock         sync.Mutex
+	closed   bool
+	fileLock sync.Mutex
 
 	currentFile *os.File // currentFile is the file that is currently getting written
 	currentWr   int64    // currentWr is the number of bytes written to the current file
@@ -56,16 +61,21 @@ type FileRotator struct {
 	flushTicker *time.Ticker
 	logger      hclog.Logger
 	purgeCh     chan struct{}
+	purgeDoneCh chan struct{}
 	doneCh      chan struct{}
 }
 
-// NewFileRotator returns a new file rotator
+// NewFileRotator returns a new file rotator. Rotated files older than maxAge
+// are removed if it is set, and rotated files are compressed with the given
+// compression if it is set.
 func NewFileRotator(path string, baseFile string, maxFiles int,
-	fileSize int64, logger hclog.Logger) (*FileRotator, error) {
+	fileSize int64, maxAge time.Duration, compression string, logger hclog.Logger) (*FileRotator, error) {
 	logger = logger.Named("rotator")
 	rotator := &FileRotator{
-		MaxFiles: maxFiles,
-		FileSize: fileSize,
+		MaxFiles:    maxFiles,
+		FileSize:    fileSize,
+		MaxAge:      maxAge,
+		Compression: compression,
 
 		path:         path,
 		baseFileName: baseFile,
@@ -73,12 +83,24 @@ func NewFileRotator(path string, baseFile string, maxFiles int,
 		flushTicker: time.NewTicker(bufferFlushDuration),
 		logger:      logger,
 		purgeCh:     make(chan struct{}, 1),
+		purgeDoneCh: make(chan struct{}),
 		doneCh:      make(chan struct{}),
 	}
 
+	if compression != "" {
+		if _, ok := compressionExts[compression]; !ok {
+			return nil, fmt.Errorf("unknown log compression %q", compression)
+		}
+	}
+
 	if err := rotator.lastFile(); err != nil {
 		return nil, err
 	}
+
+	// files rotated before a restart may still have to be purged or
+	// compressed
+	rotator.purgeCh <- struct{}{}
+
 	go rotator.purgeOldFiles()
 	go rotator.flushPeriodically()
 	return rotator, nil
@@ -171,16 +193,18 @@ func (f *FileRotator) nextFile() error {
 				continue
 			}
 		}
+		f.fileLock.Lock()
 		f.logFileIdx = nextFileIdx
+		f.fileLock.Unlock()
 		if err := f.createFile(); err != nil {
 			return err
 		}
 		break
 	}
-	// Purge old files if we have more files than MaxFiles
+	// Purge and compress the rotated files
 	f.fileLock.Lock()
 	defer f.fileLock.Unlock()
-	if f.logFileIdx-f.oldestLogFileIdx >= f.MaxFiles && !f.closed {
+	if !f.closed {
 		select {
 		case f.purgeCh <- struct{}{}:
 		default:
@@ -196,20 +220,16 @@ func (f *FileRotator) lastFile() error {
 		return err
 	}
 
-	prefix := fmt.Sprintf("%s.", f.baseFileName)
 	for _, fi := range finfos {
 		if fi.IsDir() {
 			continue
 		}
-		if after, ok := strings.CutPrefix(fi.Name(), prefix); ok {
-			fileIdx := after
-			n, err := strconv.Atoi(fileIdx)
-			if err != nil {
-				continue
-			}
-			if n > f.logFileIdx {
-				f.logFileIdx = n
-			}
+		n, _, err := ParseFileName(fi.Name(), f.baseFileName)
+		if err != nil {
+			continue
+		}
+		if n > f.logFileIdx {
+			f.logFileIdx = n
 		}
 	}
 	if err := f.createFile(); err != nil {
@@ -253,7 +273,6 @@ func (f *FileRotator) flushPeriodically() {
 // Close flushes and closes the rotator. It never returns an error.
 func (f *FileRotator) Close() error {
 	f.fileLock.Lock()
-	defer f.fileLock.Unlock()
 
 	// Stop the ticker and flush for one last time
 	f.flushTicker.Stop()
@@ -266,60 +285,138 @@ func (f *FileRotator) Close() error {
 		f.closed = true
 		f.currentFile.Close()
 	}
+	f.fileLock.Unlock()
 
+	// Wait for a file being compressed
+	<-f.purgeDoneCh
 	return nil
 }
 
 // purgeOldFiles removes older files and keeps only the last N files rotated for
-// a file
+// a file, removes the files older than MaxAge, and compresses the others
 func (f *FileRotator) purgeOldFiles() {
+	defer close(f.purgeDoneCh)
+
+	var maxAgeCh <-chan time.Time
+	if f.MaxAge > 0 {
+		ticker := time.NewTicker(maxAgeCheckInterval)
+		defer ticker.Stop()
+		maxAgeCh = ticker.C
+	}
+
 	for {
 		select {
-		case <-f.purgeCh:
-			var fIndexes []int
-			files, err := os.ReadDir(f.path)
-			if err != nil {
-				f.logger.Error("error getting directory listing", "error", err)
+		case _, ok := <-f.purgeCh:
+			if !ok {
 				return
 			}
-			// Inserting all the rotated files in a slice
-			for _, fi := range files {
-				if strings.HasPrefix(fi.Name(), f.baseFileName) {
-					fileIdx := strings.TrimPrefix(fi.Name(), fmt.Sprintf("%s.", f.baseFileName))
-					n, err := strconv.Atoi(fileIdx)
-					if err != nil {
-						f.logger.Error("error extracting file index", "error", err)
-						continue
-					}
-					fIndexes = append(fIndexes, n)
-				}
-			}
+		case <-maxAgeCh:
+		case <-f.doneCh:
+			return
+		}
 
-			// Not continuing to delete files if the number of files is not more
-			// than MaxFiles
-			if len(fIndexes) <= f.MaxFiles {
-				continue
-			}
+		if err := f.purge(); err != nil {
+			f.logger.Error("error getting directory listing", "error", err)
+			return
+		}
+	}
+}
 
-			// Sorting the file indexes so that we can purge the older files and keep
-			// only the number of files as configured by the user
-			sort.Ints(fIndexes)
-			toDelete := fIndexes[0 : len(fIndexes)-f.MaxFiles]
-			for _, fIndex := range toDelete {
-				fname := filepath.Join(f.path, fmt.Sprintf("%s.%d", f.baseFileName, fIndex))
-				err := os.RemoveAll(fname)
-				if err != nil {
-					f.logger.Error("error removing file", "filename", fname, "error", err)
-				}
+// rotatedFile is a rotated log file, possibly compressed
+type rotatedFile struct {
+	names   []string
+	modTime time.Time
+	plain   string
+}
+
+// purge removes the rotated files exceeding MaxFiles or older than MaxAge,
+// and compresses the others except the last one, as it may still be read by
+// clients following the logs.
+func (f *FileRotator) purge() error {
+	files, err := os.ReadDir(f.path)
+	if err != nil {
+		return err
+	}
+
+	// Inserting all the rotated files in a map keyed by their index, as a
+	// file may be listed while compressed
+	rotated := make(map[int]*rotatedFile)
+	var fIndexes []int
+	for _, fi := range files {
+		if fi.IsDir() || !strings.HasPrefix(fi.Name(), f.baseFileName) {
+			continue
+		}
+		n, compression, err := ParseFileName(fi.Name(), f.baseFileName)
+		if err != nil {
+			f.logger.Error("error extracting file index", "error", err)
+			continue
+		}
+
+		r, ok := rotated[n]
+		if !ok {
+			r = &rotatedFile{}
+			rotated[n] = r
+			fIndexes = append(fIndexes, n)
+		}
+		r.names = append(r.names, fi.Name())
+		if compression == "" {
+			r.plain = fi.Name()
+		}
+		if info, err := fi.Info(); err == nil && info.ModTime().After(r.modTime) {
+			r.modTime = info.ModTime()
+		}
+	}
+
+	f.fileLock.Lock()
+	currentIdx := f.logFileIdx
+	f.fileLock.Unlock()
+
+	// Sorting the file indexes so that we can purge the older files and keep
+	// only the number of files as configured by the user
+	sort.Ints(fIndexes)
+	var toDelete []int
+	if len(fIndexes) > f.MaxFiles {
+		toDelete, fIndexes = fIndexes[:len(fIndexes)-f.MaxFiles], fIndexes[len(fIndexes)-f.MaxFiles:]
+	}
+
+	// Remove the expired files, but never the current one
+	var toCompress []int
+	for _, fIndex := range fIndexes {
+		if fIndex >= currentIdx {
+			continue
+		}
+		if f.MaxAge > 0 && time.Since(rotated[fIndex].modTime) > f.MaxAge {
+			toDelete = append(toDelete, fIndex)
+		} else if f.Compression != "" && rotated[fIndex].plain != "" && fIndex < currentIdx-1 {
+			toCompress = append(toCompress, fIndex)
+		}
+	}
+
+	for _, fIndex := range toDelete {
+		for _, name := range rotated[fIndex].names {
+			fname := filepath.Join(f.path, name)
+			if err := os.RemoveAll(fname); err != nil {
+				f.logger.Error("error removing file", "filename", fname, "error", err)
 			}
+		}
+	}
 
-			f.fileLock.Lock()
-			f.oldestLogFileIdx = fIndexes[0]
-			f.fileLock.Unlock()
+	for _, fIndex := range toCompress {
+		select {
 		case <-f.doneCh:
-			return
+			return nil
+		default:
+		}
+
+		fname := filepath.Join(f.path, rotated[fIndex].plain)
+		err := compressFile(fname, f.Compression, f.doneCh)
+		if errors.Is(err, errCompressionCancelled) {
+			return nil
+		} else if err != nil {
+			f.logger.Error("error compressing file", "filename", fname, "error", err)
 		}
 	}
+	return nil
 }
 
 // flushBuffer flushes the buffer
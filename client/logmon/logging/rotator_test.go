@@ -5,10 +5,13 @@ package logging
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/testutil"
@@ -23,7 +26,7 @@ var (
 func TestFileRotator_IncorrectPath(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
-	_, err := NewFileRotator("/foo", baseFileName, 10, 10, testlog.HCLogger(t))
+	_, err := NewFileRotator("/foo", baseFileName, 10, 10, 0, "", testlog.HCLogger(t))
 	must.Error(t, err)
 	must.ErrorContains(t, err, "no such file or directory")
 }
@@ -33,7 +36,7 @@ func TestFileRotator_CreateNewFile(t *testing.T) {
 
 	path := t.TempDir()
 
-	fr, err := NewFileRotator(path, baseFileName, 10, 10, testlog.HCLogger(t))
+	fr, err := NewFileRotator(path, baseFileName, 10, 10, 0, "", testlog.HCLogger(t))
 	must.NoError(t, err)
 	defer fr.Close()
 
@@ -57,7 +60,7 @@ func TestFileRotator_OpenLastFile(t *testing.T) {
 	must.NoError(t, err)
 	f2.Close()
 
-	fr, err := NewFileRotator(path, baseFileName, 10, 10, testlog.HCLogger(t))
+	fr, err := NewFileRotator(path, baseFileName, 10, 10, 0, "", testlog.HCLogger(t))
 	must.NoError(t, err)
 	defer fr.Close()
 
@@ -74,7 +77,7 @@ func TestFileRotator_WriteToCurrentFile(t *testing.T) {
 	must.NoError(t, err)
 	f1.Close()
 
-	fr, err := NewFileRotator(path, baseFileName, 10, 5, testlog.HCLogger(t))
+	fr, err := NewFileRotator(path, baseFileName, 10, 5, 0, "", testlog.HCLogger(t))
 	must.NoError(t, err)
 	defer fr.Close()
 
@@ -101,7 +104,7 @@ func TestFileRotator_RotateFiles(t *testing.T) {
 
 	path := t.TempDir()
 
-	fr, err := NewFileRotator(path, baseFileName, 10, 5, testlog.HCLogger(t))
+	fr, err := NewFileRotator(path, baseFileName, 10, 5, 0, "", testlog.HCLogger(t))
 	must.NoError(t, err)
 	defer fr.Close()
 
@@ -144,7 +147,7 @@ func TestFileRotator_RotateFiles_Boundary(t *testing.T) {
 
 	path := t.TempDir()
 
-	fr, err := NewFileRotator(path, baseFileName, 10, 5, testlog.HCLogger(t))
+	fr, err := NewFileRotator(path, baseFileName, 10, 5, 0, "", testlog.HCLogger(t))
 	must.NoError(t, err)
 	defer fr.Close()
 
@@ -194,7 +197,7 @@ func TestFileRotator_WriteRemaining(t *testing.T) {
 	err := os.WriteFile(fname1, []byte("abcd"), 0600)
 	must.NoError(t, err)
 
-	fr, err := NewFileRotator(path, baseFileName, 10, 5, testlog.HCLogger(t))
+	fr, err := NewFileRotator(path, baseFileName, 10, 5, 0, "", testlog.HCLogger(t))
 	must.NoError(t, err)
 	defer fr.Close()
 
@@ -250,7 +253,7 @@ func TestFileRotator_PurgeOldFiles(t *testing.T) {
 
 	path := t.TempDir()
 
-	fr, err := NewFileRotator(path, baseFileName, 2, 2, testlog.HCLogger(t))
+	fr, err := NewFileRotator(path, baseFileName, 2, 2, 0, "", testlog.HCLogger(t))
 	must.NoError(t, err)
 	defer fr.Close()
 
@@ -275,6 +278,103 @@ func TestFileRotator_PurgeOldFiles(t *testing.T) {
 	})
 }
 
+func TestFileRotator_Compress(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			path := t.TempDir()
+
+			fr, err := NewFileRotator(path, baseFileName, 10, 2, 0, compression, testlog.HCLogger(t))
+			must.NoError(t, err)
+			defer fr.Close()
+
+			str := "aabbccdde"
+			nw, err := fr.Write([]byte(str))
+			must.NoError(t, err)
+			must.Eq(t, len(str), nw)
+
+			// all but the last rotated file and the current one are compressed
+			ext := compressionExts[compression]
+			expected := []string{
+				"redis.stdout.0" + ext,
+				"redis.stdout.1" + ext,
+				"redis.stdout.2" + ext,
+				"redis.stdout.3",
+				"redis.stdout.4",
+			}
+			testutil.WaitForResult(func() (bool, error) {
+				entries, err := os.ReadDir(path)
+				if err != nil {
+					return false, err
+				}
+				var names []string
+				for _, e := range entries {
+					names = append(names, e.Name())
+				}
+				if !slices.Equal(expected, names) {
+					return false, fmt.Errorf("expected files %v, got %v", expected, names)
+				}
+				return true, nil
+			}, func(err error) {
+				must.NoError(t, err)
+			})
+
+			f, err := os.Open(filepath.Join(path, expected[1]))
+			must.NoError(t, err)
+			defer f.Close()
+			r, err := NewReader(f, compression)
+			must.NoError(t, err)
+			defer r.Close()
+			b, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, "bb", string(b))
+		})
+	}
+}
+
+func TestFileRotator_MaxAge(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	path := t.TempDir()
+
+	// files rotated before a restart are expired by age, but the most recent
+	// file is kept as it is written to
+	old := time.Now().Add(-2 * time.Hour)
+	for _, name := range []string{"redis.stdout.0.gz", "redis.stdout.1", "redis.stdout.2"} {
+		fname := filepath.Join(path, name)
+		must.NoError(t, os.WriteFile(fname, []byte("a"), 0644))
+		must.NoError(t, os.Chtimes(fname, old, old))
+	}
+	recent := filepath.Join(path, "redis.stdout.3")
+	must.NoError(t, os.WriteFile(recent, []byte("a"), 0644))
+	must.NoError(t, os.Chtimes(recent, old, old))
+
+	fr, err := NewFileRotator(path, baseFileName, 10, 10, time.Hour, "", testlog.HCLogger(t))
+	must.NoError(t, err)
+	defer fr.Close()
+
+	testutil.WaitForResult(func() (bool, error) {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return false, err
+		}
+		if len(entries) != 1 || entries[0].Name() != "redis.stdout.3" {
+			return false, fmt.Errorf("expected only the current file, got %v", entries)
+		}
+		return true, nil
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+}
+
+func TestFileRotator_UnknownCompression(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	_, err := NewFileRotator(t.TempDir(), baseFileName, 10, 10, 0, "lz4", testlog.HCLogger(t))
+	must.ErrorContains(t, err, `unknown log compression "lz4"`)
+}
+
 func BenchmarkRotator(b *testing.B) {
 	kb := 1024
 	for _, inputSize := range []int{kb, 2 * kb, 4 * kb, 8 * kb, 16 * kb, 32 * kb, 64 * kb, 128 * kb, 256 * kb} {
@@ -287,7 +387,7 @@ func BenchmarkRotator(b *testing.B) {
 func benchmarkRotatorWithInputSize(size int, b *testing.B) {
 	path := b.TempDir()
 
-	fr, err := NewFileRotator(path, baseFileName, 5, 1024*1024, testlog.HCLogger(b))
+	fr, err := NewFileRotator(path, baseFileName, 5, 1024*1024, 0, "", testlog.HCLogger(b))
 	must.NoError(b, err)
 	defer fr.Close()
 
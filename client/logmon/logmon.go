@@ -14,6 +14,7 @@ import (
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/lib/fifo"
 	"github.com/hashicorp/nomad/client/logmon/logging"
+	"github.com/hashicorp/nomad/client/logmon/sink"
 )
 
 const (
@@ -44,11 +45,31 @@ type LogConfig struct {
 
 	// MaxFileSizeMB is the max log file size in MB allowed before rotation occures
 	MaxFileSizeMB int
+
+	// MaxAge is the age after which rotated files are removed, if set
+	MaxAge time.Duration
+
+	// Compress is the compression of the rotated files, if set
+	Compress string
+
+	// Sinks ship the logs to external destinations, in addition to the log
+	// files
+	Sinks []*sink.Config
+
+	// AllocID, JobID, Namespace and TaskName identify the task in the logs
+	// shipped to the sinks
+	AllocID   string
+	JobID     string
+	Namespace string
+	TaskName  string
 }
 
 type LogMon interface {
 	Start(*LogConfig) error
 	Stop() error
+
+	// Stats returns the counters of the sinks of the task
+	Stats() ([]*sink.Stats, error)
 }
 
 func NewLogMon(logger hclog.Logger) LogMon {
@@ -101,6 +122,15 @@ func (l *logmonImpl) Stop() error {
 	return nil
 }
 
+func (l *logmonImpl) Stats() ([]*sink.Stats, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.tl == nil {
+		return nil, nil
+	}
+	return l.tl.Stats(), nil
+}
+
 type TaskLogger struct {
 	config *LogConfig
 
@@ -109,6 +139,9 @@ type TaskLogger struct {
 
 	// rotator for stderr
 	lre *logRotatorWrapper
+
+	// sinks the logs are shipped to
+	sinks []*sink.Sink
 }
 
 // IsRunning will return true as long as one rotator wrapper is still running
@@ -132,19 +165,59 @@ func (tl *TaskLogger) Close() {
 		})
 	}
 	wg.Wait()
+
+	// the streams are closed, so flush the sinks
+	for _, s := range tl.sinks {
+		wg.Go(func() {
+			s.Close()
+		})
+	}
+	wg.Wait()
+}
+
+// Stats returns the counters of the sinks
+func (tl *TaskLogger) Stats() []*sink.Stats {
+	stats := make([]*sink.Stats, 0, len(tl.sinks))
+	for _, s := range tl.sinks {
+		stats = append(stats, s.Stats())
+	}
+	return stats
 }
 
 func NewTaskLogger(cfg *LogConfig, logger hclog.Logger) (*TaskLogger, error) {
 	tl := &TaskLogger{config: cfg}
 
+	meta := &sink.Metadata{
+		AllocID:   cfg.AllocID,
+		JobID:     cfg.JobID,
+		Namespace: cfg.Namespace,
+		TaskName:  cfg.TaskName,
+	}
+	var err error
+	defer func() {
+		if err != nil {
+			for _, s := range tl.sinks {
+				s.Close()
+			}
+		}
+	}()
+	for _, sinkCfg := range cfg.Sinks {
+		var s *sink.Sink
+		s, err = sink.New(sinkCfg, meta, logger)
+		if err != nil {
+			return nil, err
+		}
+		tl.sinks = append(tl.sinks, s)
+	}
+
 	logFileSize := int64(cfg.MaxFileSizeMB * 1024 * 1024)
 	lro, err := logging.NewFileRotator(cfg.LogDir, cfg.StdoutLogFile,
-		cfg.MaxFiles, logFileSize, logger)
+		cfg.MaxFiles, logFileSize, cfg.MaxAge, cfg.Compress, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stdout logfile for %q: %v", cfg.StdoutLogFile, err)
 	}
 
-	wrapperOut, err := newLogRotatorWrapper(cfg.StdoutFifo, logger, lro)
+	wrapperOut, err := newLogRotatorWrapper(cfg.StdoutFifo, logger, tl.withSinks(lro, sink.StreamStdout))
 	if err != nil {
 		return nil, err
 	}
@@ -152,12 +225,12 @@ func NewTaskLogger(cfg *LogConfig, logger hclog.Logger) (*TaskLogger, error) {
 	tl.lro = wrapperOut
 
 	lre, err := logging.NewFileRotator(cfg.LogDir, cfg.StderrLogFile,
-		cfg.MaxFiles, logFileSize, logger)
+		cfg.MaxFiles, logFileSize, cfg.MaxAge, cfg.Compress, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stderr logfile for %q: %v", cfg.StderrLogFile, err)
 	}
 
-	wrapperErr, err := newLogRotatorWrapper(cfg.StderrFifo, logger, lre)
+	wrapperErr, err := newLogRotatorWrapper(cfg.StderrFifo, logger, tl.withSinks(lre, sink.StreamStderr))
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +241,35 @@ func NewTaskLogger(cfg *LogConfig, logger hclog.Logger) (*TaskLogger, error) {
 
 }
 
+// withSinks returns a writer teeing the stream to the rotator and the sinks
+func (tl *TaskLogger) withSinks(rotator io.WriteCloser, stream string) io.WriteCloser {
+	if len(tl.sinks) == 0 {
+		return rotator
+	}
+	return &sinkWriter{
+		rotator: rotator,
+		lines:   sink.NewLineWriter(stream, tl.sinks),
+	}
+}
+
+// sinkWriter writes to the rotator and the sinks. Errors of the sinks are
+// never returned, as they must not interrupt log collection.
+type sinkWriter struct {
+	rotator io.WriteCloser
+	lines   *sink.LineWriter
+}
+
+func (w *sinkWriter) Write(p []byte) (int, error) {
+	n, err := w.rotator.Write(p)
+	w.lines.Write(p[:n])
+	return n, err
+}
+
+func (w *sinkWriter) Close() error {
+	w.lines.Close()
+	return w.rotator.Close()
+}
+
 // logRotatorWrapper wraps our log rotator and exposes a pipe that can feed the
 // log rotator data. The processOutWriter should be attached to the process and
 // data will be copied from the reader to the rotator.
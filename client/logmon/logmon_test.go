@@ -6,13 +6,16 @@ package logmon
 import (
 	"crypto/rand"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/client/lib/fifo"
+	"github.com/hashicorp/nomad/client/logmon/sink"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/testutil"
@@ -304,3 +307,72 @@ func TestLogmon_NewError(t *testing.T) {
 	must.Error(t, err)
 	must.Nil(t, w)
 }
+
+// asserts that the logs are shipped to the sinks in addition to the log files
+func TestLogmon_Start_sinks(t *testing.T) {
+	ci.Parallel(t)
+
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not supported on windows")
+	}
+
+	dir := t.TempDir()
+
+	// use a unix datagram socket as the syslog server
+	sockPath := filepath.Join(dir, "syslog.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: sockPath, Net: "unixgram"})
+	must.NoError(t, err)
+	defer conn.Close()
+
+	cfg := &LogConfig{
+		LogDir:        dir,
+		StdoutLogFile: "stdout",
+		StdoutFifo:    filepath.Join(dir, "stdout.fifo"),
+		StderrLogFile: "stderr",
+		StderrFifo:    filepath.Join(dir, "stderr.fifo"),
+		MaxFiles:      2,
+		MaxFileSizeMB: 1,
+		Sinks: []*sink.Config{{
+			Name:    "syslog",
+			Type:    sink.TypeSyslog,
+			Address: "unix://" + sockPath,
+		}},
+		AllocID:  uuid.Generate(),
+		TaskName: "web",
+	}
+
+	lm := NewLogMon(testlog.HCLogger(t))
+	must.NoError(t, lm.Start(cfg))
+
+	stdout, err := fifo.OpenWriter(cfg.StdoutFifo)
+	must.NoError(t, err)
+	stderr, err := fifo.OpenWriter(cfg.StderrFifo)
+	must.NoError(t, err)
+
+	_, err = stdout.Write([]byte("hello\n"))
+	must.NoError(t, err)
+
+	buf := make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	n, err := conn.Read(buf)
+	must.NoError(t, err)
+	must.StrContains(t, string(buf[:n]), " web "+cfg.AllocID+" stdout - hello")
+
+	testutil.WaitForResult(func() (bool, error) {
+		raw, err := os.ReadFile(filepath.Join(dir, "stdout.0"))
+		if err != nil {
+			return false, err
+		}
+		return "hello\n" == string(raw), fmt.Errorf("unexpected stdout %q", string(raw))
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+
+	stats, err := lm.Stats()
+	must.NoError(t, err)
+	must.Eq(t, []*sink.Stats{{Name: "syslog", Type: sink.TypeSyslog, Sent: 1}}, stats)
+
+	must.NoError(t, stdout.Close())
+	must.NoError(t, stderr.Close())
+	must.NoError(t, lm.Stop())
+}
@@ -25,16 +25,23 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type StartRequest struct {
-	LogDir               string   `protobuf:"bytes,1,opt,name=log_dir,json=logDir,proto3" json:"log_dir,omitempty"`
-	StdoutFileName       string   `protobuf:"bytes,2,opt,name=stdout_file_name,json=stdoutFileName,proto3" json:"stdout_file_name,omitempty"`
-	StderrFileName       string   `protobuf:"bytes,3,opt,name=stderr_file_name,json=stderrFileName,proto3" json:"stderr_file_name,omitempty"`
-	MaxFiles             uint32   `protobuf:"varint,4,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
-	MaxFileSizeMb        uint32   `protobuf:"varint,5,opt,name=max_file_size_mb,json=maxFileSizeMb,proto3" json:"max_file_size_mb,omitempty"`
-	StdoutFifo           string   `protobuf:"bytes,6,opt,name=stdout_fifo,json=stdoutFifo,proto3" json:"stdout_fifo,omitempty"`
-	StderrFifo           string   `protobuf:"bytes,7,opt,name=stderr_fifo,json=stderrFifo,proto3" json:"stderr_fifo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LogDir               string     `protobuf:"bytes,1,opt,name=log_dir,json=logDir,proto3" json:"log_dir,omitempty"`
+	StdoutFileName       string     `protobuf:"bytes,2,opt,name=stdout_file_name,json=stdoutFileName,proto3" json:"stdout_file_name,omitempty"`
+	StderrFileName       string     `protobuf:"bytes,3,opt,name=stderr_file_name,json=stderrFileName,proto3" json:"stderr_file_name,omitempty"`
+	MaxFiles             uint32     `protobuf:"varint,4,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	MaxFileSizeMb        uint32     `protobuf:"varint,5,opt,name=max_file_size_mb,json=maxFileSizeMb,proto3" json:"max_file_size_mb,omitempty"`
+	StdoutFifo           string     `protobuf:"bytes,6,opt,name=stdout_fifo,json=stdoutFifo,proto3" json:"stdout_fifo,omitempty"`
+	StderrFifo           string     `protobuf:"bytes,7,opt,name=stderr_fifo,json=stderrFifo,proto3" json:"stderr_fifo,omitempty"`
+	Sinks                []*LogSink `protobuf:"bytes,8,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	AllocId              string     `protobuf:"bytes,9,opt,name=alloc_id,json=allocId,proto3" json:"alloc_id,omitempty"`
+	JobId                string     `protobuf:"bytes,10,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Namespace            string     `protobuf:"bytes,11,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskName             string     `protobuf:"bytes,12,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	MaxAge               int64      `protobuf:"varint,13,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	Compress             string     `protobuf:"bytes,14,opt,name=compress,proto3" json:"compress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *StartRequest) Reset()         { *m = StartRequest{} }
@@ -111,6 +118,55 @@ func (m *StartRequest) GetStderrFifo() string {
 	return ""
 }
 
+func (m *StartRequest) GetSinks() []*LogSink {
+	if m != nil {
+		return m.Sinks
+	}
+	return nil
+}
+
+func (m *StartRequest) GetAllocId() string {
+	if m != nil {
+		return m.AllocId
+	}
+	return ""
+}
+
+func (m *StartRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StartRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *StartRequest) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
+
+func (m *StartRequest) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *StartRequest) GetCompress() string {
+	if m != nil {
+		return m.Compress
+	}
+	return ""
+}
+
 type StartResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -204,11 +260,236 @@ func (m *StopResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StopResponse proto.InternalMessageInfo
 
+type LogSink struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Address              string            `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Headers              map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" json:"headers,omitempty"`
+	BufferSize           uint32            `protobuf:"varint,5,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LogSink) Reset()         { *m = LogSink{} }
+func (m *LogSink) String() string { return proto.CompactTextString(m) }
+func (*LogSink) ProtoMessage()    {}
+func (*LogSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be72d5e24d2ecba6, []int{4}
+}
+
+func (m *LogSink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogSink.Unmarshal(m, b)
+}
+func (m *LogSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogSink.Marshal(b, m, deterministic)
+}
+func (m *LogSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSink.Merge(m, src)
+}
+func (m *LogSink) XXX_Size() int {
+	return xxx_messageInfo_LogSink.Size(m)
+}
+func (m *LogSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSink proto.InternalMessageInfo
+
+func (m *LogSink) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LogSink) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *LogSink) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LogSink) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *LogSink) GetBufferSize() uint32 {
+	if m != nil {
+		return m.BufferSize
+	}
+	return 0
+}
+
+type StatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
+func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
+func (*StatsRequest) ProtoMessage()    {}
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be72d5e24d2ecba6, []int{5}
+}
+
+func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
+}
+func (m *StatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsRequest.Marshal(b, m, deterministic)
+}
+func (m *StatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsRequest.Merge(m, src)
+}
+func (m *StatsRequest) XXX_Size() int {
+	return xxx_messageInfo_StatsRequest.Size(m)
+}
+func (m *StatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsRequest proto.InternalMessageInfo
+
+type StatsResponse struct {
+	Sinks                []*SinkStats `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
+func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
+func (*StatsResponse) ProtoMessage()    {}
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be72d5e24d2ecba6, []int{6}
+}
+
+func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsResponse.Unmarshal(m, b)
+}
+func (m *StatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsResponse.Marshal(b, m, deterministic)
+}
+func (m *StatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsResponse.Merge(m, src)
+}
+func (m *StatsResponse) XXX_Size() int {
+	return xxx_messageInfo_StatsResponse.Size(m)
+}
+func (m *StatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsResponse proto.InternalMessageInfo
+
+func (m *StatsResponse) GetSinks() []*SinkStats {
+	if m != nil {
+		return m.Sinks
+	}
+	return nil
+}
+
+type SinkStats struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Sent                 uint64   `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	Dropped              uint64   `protobuf:"varint,4,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Errors               uint64   `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	Buffered             uint32   `protobuf:"varint,6,opt,name=buffered,proto3" json:"buffered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SinkStats) Reset()         { *m = SinkStats{} }
+func (m *SinkStats) String() string { return proto.CompactTextString(m) }
+func (*SinkStats) ProtoMessage()    {}
+func (*SinkStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be72d5e24d2ecba6, []int{7}
+}
+
+func (m *SinkStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SinkStats.Unmarshal(m, b)
+}
+func (m *SinkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SinkStats.Marshal(b, m, deterministic)
+}
+func (m *SinkStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkStats.Merge(m, src)
+}
+func (m *SinkStats) XXX_Size() int {
+	return xxx_messageInfo_SinkStats.Size(m)
+}
+func (m *SinkStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkStats proto.InternalMessageInfo
+
+func (m *SinkStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SinkStats) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SinkStats) GetSent() uint64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *SinkStats) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+func (m *SinkStats) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *SinkStats) GetBuffered() uint32 {
+	if m != nil {
+		return m.Buffered
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StartRequest)(nil), "hashicorp.nomad.client.logmon.proto.StartRequest")
 	proto.RegisterType((*StartResponse)(nil), "hashicorp.nomad.client.logmon.proto.StartResponse")
 	proto.RegisterType((*StopRequest)(nil), "hashicorp.nomad.client.logmon.proto.StopRequest")
 	proto.RegisterType((*StopResponse)(nil), "hashicorp.nomad.client.logmon.proto.StopResponse")
+	proto.RegisterType((*LogSink)(nil), "hashicorp.nomad.client.logmon.proto.LogSink")
+	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.client.logmon.proto.LogSink.HeadersEntry")
+	proto.RegisterType((*StatsRequest)(nil), "hashicorp.nomad.client.logmon.proto.StatsRequest")
+	proto.RegisterType((*StatsResponse)(nil), "hashicorp.nomad.client.logmon.proto.StatsResponse")
+	proto.RegisterType((*SinkStats)(nil), "hashicorp.nomad.client.logmon.proto.SinkStats")
 }
 
 func init() {
@@ -216,27 +497,47 @@ func init() {
 }
 
 var fileDescriptor_be72d5e24d2ecba6 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x31, 0x6f, 0xc2, 0x30,
-	0x10, 0x85, 0x1b, 0x0a, 0xa1, 0x1c, 0x0d, 0x45, 0x5e, 0x1a, 0xd1, 0xa1, 0x28, 0x1d, 0xca, 0x14,
-	0x0a, 0xfd, 0x07, 0x55, 0xd5, 0xa9, 0x74, 0x80, 0xad, 0x4b, 0x64, 0xc0, 0x09, 0x96, 0x62, 0x5f,
-	0x6a, 0x1b, 0x09, 0xb1, 0xf6, 0xd7, 0xf6, 0x5f, 0x54, 0x71, 0x4c, 0x94, 0x11, 0x26, 0xeb, 0xee,
-	0x7d, 0x4f, 0xf7, 0xfc, 0x60, 0xbc, 0xc9, 0x39, 0x93, 0x66, 0x9a, 0x63, 0x26, 0x50, 0x4e, 0x0b,
-	0x85, 0x06, 0xdd, 0x10, 0xdb, 0x81, 0x3c, 0xed, 0xa8, 0xde, 0xf1, 0x0d, 0xaa, 0x22, 0x96, 0x28,
-	0xe8, 0x36, 0xae, 0x1c, 0x71, 0x13, 0x8a, 0x7e, 0x5b, 0x70, 0xbb, 0x32, 0x54, 0x99, 0x25, 0xfb,
-	0xd9, 0x33, 0x6d, 0xc8, 0x3d, 0x74, 0x73, 0xcc, 0x92, 0x2d, 0x57, 0xa1, 0x37, 0xf6, 0x26, 0xbd,
-	0xa5, 0x9f, 0x63, 0xf6, 0xce, 0x15, 0x99, 0xc0, 0x50, 0x9b, 0x2d, 0xee, 0x4d, 0x92, 0xf2, 0x9c,
-	0x25, 0x92, 0x0a, 0x16, 0xb6, 0x2c, 0x31, 0xa8, 0xf6, 0x1f, 0x3c, 0x67, 0x5f, 0x54, 0x30, 0x47,
-	0x32, 0xa5, 0x1a, 0xe4, 0x75, 0x4d, 0x32, 0xa5, 0x6a, 0xf2, 0x01, 0x7a, 0x82, 0x1e, 0x2c, 0xa6,
-	0xc3, 0xf6, 0xd8, 0x9b, 0x04, 0xcb, 0x1b, 0x41, 0x0f, 0xa5, 0xae, 0xc9, 0x33, 0x0c, 0x4f, 0x62,
-	0xa2, 0xf9, 0x91, 0x25, 0x62, 0x1d, 0x76, 0x2c, 0x13, 0x38, 0x66, 0xc5, 0x8f, 0x6c, 0xb1, 0x26,
-	0x8f, 0xd0, 0xaf, 0x93, 0xa5, 0x18, 0xfa, 0xf6, 0x14, 0x9c, 0x42, 0xa5, 0xe8, 0x80, 0x2a, 0x50,
-	0x8a, 0x61, 0xb7, 0x06, 0x6c, 0x96, 0x14, 0xa3, 0x3b, 0x08, 0x5c, 0x09, 0xba, 0x40, 0xa9, 0x59,
-	0x14, 0x40, 0x7f, 0x65, 0xb0, 0x70, 0xa5, 0x44, 0x83, 0xb2, 0xa4, 0x72, 0xac, 0xe4, 0xf9, 0x9f,
-	0x07, 0xfe, 0x27, 0x66, 0x0b, 0x94, 0xa4, 0x80, 0x8e, 0xb5, 0x92, 0x59, 0x7c, 0x46, 0xdf, 0x71,
-	0xb3, 0xeb, 0xd1, 0xfc, 0x12, 0x8b, 0x4b, 0x76, 0x45, 0x04, 0xb4, 0xcb, 0x30, 0xe4, 0xe5, 0x4c,
-	0x77, 0xfd, 0x8d, 0xd1, 0xec, 0x02, 0xc7, 0xe9, 0xdc, 0x5b, 0xf7, 0xbb, 0x63, 0xf7, 0x6b, 0xdf,
-	0x3e, 0xaf, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xb4, 0x87, 0xfc, 0x7a, 0x02, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x51, 0x6f, 0xd3, 0x3c,
+	0x14, 0x5d, 0xd6, 0x34, 0x69, 0x6f, 0x9b, 0x7d, 0x93, 0xf5, 0xc1, 0x42, 0x41, 0xa2, 0x2a, 0x0f,
+	0xf4, 0x01, 0x65, 0x6c, 0xbc, 0xc0, 0xde, 0x98, 0x06, 0x62, 0xd2, 0xc6, 0x43, 0x2a, 0x5e, 0x78,
+	0xa9, 0xdc, 0xc6, 0xc9, 0xb2, 0x26, 0x71, 0xb0, 0x5d, 0xb4, 0xed, 0x7f, 0xf0, 0xeb, 0xf8, 0x05,
+	0xfc, 0x08, 0x24, 0xe4, 0x6b, 0x27, 0xeb, 0x63, 0xfb, 0x54, 0x9f, 0x7b, 0xcf, 0x8d, 0x7d, 0x8e,
+	0x8f, 0x0b, 0xe3, 0x65, 0x91, 0xb3, 0x4a, 0x1d, 0x17, 0x3c, 0x2b, 0x79, 0x75, 0x5c, 0x0b, 0xae,
+	0xb8, 0x05, 0x11, 0x02, 0xf2, 0xea, 0x86, 0xca, 0x9b, 0x7c, 0xc9, 0x45, 0x1d, 0x55, 0xbc, 0xa4,
+	0x49, 0x64, 0x26, 0xa2, 0x4d, 0xd2, 0xe4, 0x4f, 0x07, 0x86, 0x33, 0x45, 0x85, 0x8a, 0xd9, 0x8f,
+	0x35, 0x93, 0x8a, 0x1c, 0x81, 0x5f, 0xf0, 0x6c, 0x9e, 0xe4, 0x22, 0x74, 0xc6, 0xce, 0xb4, 0x1f,
+	0x7b, 0x05, 0xcf, 0x2e, 0x72, 0x41, 0xa6, 0x70, 0x28, 0x55, 0xc2, 0xd7, 0x6a, 0x9e, 0xe6, 0x05,
+	0x9b, 0x57, 0xb4, 0x64, 0xe1, 0x3e, 0x32, 0x0e, 0x4c, 0xfd, 0x73, 0x5e, 0xb0, 0xaf, 0xb4, 0x64,
+	0x96, 0xc9, 0x84, 0xd8, 0x60, 0x76, 0x5a, 0x26, 0x13, 0xa2, 0x65, 0x3e, 0x87, 0x7e, 0x49, 0xef,
+	0x90, 0x26, 0x43, 0x77, 0xec, 0x4c, 0x83, 0xb8, 0x57, 0xd2, 0x3b, 0xdd, 0x97, 0xe4, 0x35, 0x1c,
+	0x36, 0xcd, 0xb9, 0xcc, 0x1f, 0xd8, 0xbc, 0x5c, 0x84, 0x5d, 0xe4, 0x04, 0x96, 0x33, 0xcb, 0x1f,
+	0xd8, 0xf5, 0x82, 0xbc, 0x84, 0x41, 0x7b, 0xb2, 0x94, 0x87, 0x1e, 0x6e, 0x05, 0xcd, 0xa1, 0x52,
+	0x6e, 0x09, 0xe6, 0x40, 0x29, 0x0f, 0xfd, 0x96, 0x80, 0x67, 0x49, 0x39, 0x39, 0x87, 0xae, 0xcc,
+	0xab, 0x95, 0x0c, 0x7b, 0xe3, 0xce, 0x74, 0x70, 0xfa, 0x26, 0xda, 0xc2, 0xba, 0xe8, 0x8a, 0x67,
+	0xb3, 0xbc, 0x5a, 0xc5, 0x66, 0x94, 0x3c, 0x83, 0x1e, 0x2d, 0x0a, 0xbe, 0x9c, 0xe7, 0x49, 0xd8,
+	0xc7, 0x1d, 0x7c, 0xc4, 0x97, 0x09, 0x79, 0x02, 0xde, 0x2d, 0x5f, 0xe8, 0x06, 0x60, 0xa3, 0x7b,
+	0xcb, 0x17, 0x97, 0x09, 0x79, 0x01, 0x7d, 0xed, 0x8d, 0xac, 0xe9, 0x92, 0x85, 0x03, 0xec, 0x3c,
+	0x16, 0xb4, 0x37, 0x8a, 0xca, 0x95, 0xb1, 0x6f, 0x88, 0xdd, 0x9e, 0x2e, 0xa0, 0x71, 0x47, 0xe0,
+	0x6b, 0x6f, 0x68, 0xc6, 0xc2, 0x60, 0xec, 0x4c, 0x3b, 0xb1, 0x57, 0xd2, 0xbb, 0x8f, 0x19, 0x23,
+	0x23, 0xe8, 0x2d, 0x79, 0x59, 0x0b, 0x26, 0x65, 0x78, 0x60, 0x86, 0x1a, 0x3c, 0xf9, 0x0f, 0x02,
+	0x7b, 0xd5, 0xb2, 0xe6, 0x95, 0x64, 0x93, 0x00, 0x06, 0x33, 0xc5, 0x6b, 0x7b, 0xf5, 0x93, 0x03,
+	0x18, 0x1a, 0x68, 0xdb, 0x7f, 0x1d, 0xf0, 0xad, 0x48, 0x42, 0xc0, 0xc5, 0x83, 0x98, 0x4c, 0xe0,
+	0x5a, 0xd7, 0xd4, 0x7d, 0xdd, 0xa4, 0x00, 0xd7, 0x24, 0x04, 0x9f, 0x26, 0x09, 0x6e, 0xdf, 0xb1,
+	0x26, 0x18, 0x48, 0x66, 0xe0, 0xdf, 0x30, 0x9a, 0x30, 0xa1, 0x6f, 0x5a, 0xbb, 0xfc, 0x61, 0x17,
+	0x97, 0xa3, 0x2f, 0x66, 0xf6, 0x53, 0xa5, 0xc4, 0x7d, 0xdc, 0x7c, 0x49, 0xdf, 0xec, 0x62, 0x9d,
+	0xa6, 0x4c, 0x60, 0x42, 0x6c, 0x3c, 0xc0, 0x94, 0x74, 0x3a, 0x46, 0x67, 0x30, 0xdc, 0x9c, 0x24,
+	0x87, 0xd0, 0x59, 0xb1, 0x7b, 0x2b, 0x43, 0x2f, 0xc9, 0xff, 0xd0, 0xfd, 0x49, 0x8b, 0x75, 0x23,
+	0xc3, 0x80, 0xb3, 0xfd, 0xf7, 0x8e, 0xf1, 0x83, 0x2a, 0xd9, 0xf8, 0xf3, 0x0d, 0x02, 0x8b, 0x8d,
+	0x41, 0xe4, 0xa2, 0x89, 0x8d, 0x83, 0x82, 0xa2, 0xad, 0x04, 0x69, 0x35, 0xe6, 0x33, 0x66, 0x78,
+	0xf2, 0xcb, 0x81, 0x7e, 0x5b, 0xdc, 0xda, 0x68, 0x02, 0xae, 0x64, 0x95, 0x42, 0x97, 0xdd, 0x18,
+	0xd7, 0xda, 0xfc, 0x44, 0xf0, 0xba, 0x66, 0x09, 0x3e, 0x26, 0x37, 0x6e, 0x20, 0x79, 0x0a, 0x1e,
+	0x13, 0x82, 0x0b, 0x89, 0x16, 0xb9, 0xb1, 0x45, 0x3a, 0x2e, 0xc6, 0x2c, 0x96, 0xe0, 0xbb, 0x09,
+	0xe2, 0x16, 0x9f, 0xfe, 0xde, 0x07, 0xef, 0x8a, 0x67, 0xd7, 0xbc, 0x22, 0x35, 0x74, 0x31, 0x39,
+	0xe4, 0x64, 0x3b, 0x89, 0x1b, 0x7f, 0x28, 0xa3, 0xd3, 0x5d, 0x46, 0x6c, 0xf2, 0xf6, 0x48, 0x09,
+	0xae, 0xce, 0x22, 0x79, 0xbb, 0xe5, 0x74, 0x9b, 0xe2, 0xd1, 0xc9, 0x0e, 0x13, 0xed, 0x76, 0x46,
+	0xa0, 0x92, 0xdb, 0x0b, 0x54, 0x72, 0x67, 0x81, 0x8f, 0xc9, 0x99, 0xec, 0x9d, 0xfb, 0xdf, 0xbb,
+	0xd8, 0x58, 0x78, 0xf8, 0xf3, 0xee, 0xdf, 0x00, 0xcc, 0xf1, 0xfa, 0x52, 0xd1, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -253,6 +554,7 @@ const _ = grpc.SupportPackageIsVersion6
 type LogMonClient interface {
 	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
 }
 
 type logMonClient struct {
@@ -281,10 +583,20 @@ func (c *logMonClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *logMonClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.client.logmon.proto.LogMon/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogMonServer is the server API for LogMon service.
 type LogMonServer interface {
 	Start(context.Context, *StartRequest) (*StartResponse, error)
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
 }
 
 // UnimplementedLogMonServer can be embedded to have forward compatible implementations.
@@ -297,6 +609,9 @@ func (*UnimplementedLogMonServer) Start(ctx context.Context, req *StartRequest)
 func (*UnimplementedLogMonServer) Stop(ctx context.Context, req *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
+func (*UnimplementedLogMonServer) Stats(ctx context.Context, req *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
 
 func RegisterLogMonServer(s *grpc.Server, srv LogMonServer) {
 	s.RegisterService(&_LogMon_serviceDesc, srv)
@@ -338,6 +653,24 @@ func _LogMon_Stop_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogMon_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogMonServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.client.logmon.proto.LogMon/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogMonServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LogMon_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.client.logmon.proto.LogMon",
 	HandlerType: (*LogMonServer)(nil),
@@ -350,6 +683,10 @@ var _LogMon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Stop",
 			Handler:    _LogMon_Stop_Handler,
 		},
+		{
+			MethodName: "Stats",
+			Handler:    _LogMon_Stats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/logmon/proto/logmon.proto",
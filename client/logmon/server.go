@@ -5,9 +5,11 @@ package logmon
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/nomad/client/logmon/proto"
+	"github.com/hashicorp/nomad/client/logmon/sink"
 )
 
 type logmonServer struct {
@@ -22,8 +24,23 @@ func (s *logmonServer) Start(ctx context.Context, req *proto.StartRequest) (*pro
 		StderrLogFile: req.StderrFileName,
 		MaxFiles:      int(req.MaxFiles),
 		MaxFileSizeMB: int(req.MaxFileSizeMb),
+		MaxAge:        time.Duration(req.MaxAge),
+		Compress:      req.Compress,
 		StdoutFifo:    req.StdoutFifo,
 		StderrFifo:    req.StderrFifo,
+		AllocID:       req.AllocId,
+		JobID:         req.JobId,
+		Namespace:     req.Namespace,
+		TaskName:      req.TaskName,
+	}
+	for _, s := range req.Sinks {
+		cfg.Sinks = append(cfg.Sinks, &sink.Config{
+			Name:       s.Name,
+			Type:       s.Type,
+			Address:    s.Address,
+			Headers:    s.Headers,
+			BufferSize: int(s.BufferSize),
+		})
 	}
 
 	err := s.impl.Start(cfg)
@@ -37,3 +54,23 @@ func (s *logmonServer) Start(ctx context.Context, req *proto.StartRequest) (*pro
 func (s *logmonServer) Stop(ctx context.Context, req *proto.StopRequest) (*proto.StopResponse, error) {
 	return &proto.StopResponse{}, s.impl.Stop()
 }
+
+func (s *logmonServer) Stats(ctx context.Context, req *proto.StatsRequest) (*proto.StatsResponse, error) {
+	stats, err := s.impl.Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.StatsResponse{}
+	for _, s := range stats {
+		resp.Sinks = append(resp.Sinks, &proto.SinkStats{
+			Name:     s.Name,
+			Type:     s.Type,
+			Sent:     s.Sent,
+			Dropped:  s.Dropped,
+			Errors:   s.Errors,
+			Buffered: uint32(s.Buffered),
+		})
+	}
+	return resp, nil
+}
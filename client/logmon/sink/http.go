@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// httpTimeout is the timeout of the requests of the http sink.
+const httpTimeout = 30 * time.Second
+
+// httpTransport POSTs batches of entries as newline delimited JSON.
+type httpTransport struct {
+	address string
+	headers map[string]string
+	meta    *Metadata
+	client  *http.Client
+}
+
+// httpEntry is the JSON serialization of an entry.
+type httpEntry struct {
+	Timestamp string `json:"timestamp"`
+	Stream    string `json:"stream"`
+	Message   string `json:"message"`
+	AllocID   string `json:"alloc_id"`
+	JobID     string `json:"job_id"`
+	Namespace string `json:"namespace"`
+	TaskName  string `json:"task_name"`
+}
+
+func newHTTPTransport(config *Config, meta *Metadata) (*httpTransport, error) {
+	client := cleanhttp.DefaultPooledClient()
+	client.Timeout = httpTimeout
+	return &httpTransport{
+		address: config.Address,
+		headers: config.Headers,
+		meta:    meta,
+		client:  client,
+	}, nil
+}
+
+func (t *httpTransport) send(ctx context.Context, entries []Entry) (int, error) {
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, e := range entries {
+		enc.Encode(httpEntry{
+			Timestamp: e.Time.UTC().Format(time.RFC3339Nano),
+			Stream:    e.Stream,
+			Message:   e.Line,
+			AllocID:   t.meta.AllocID,
+			JobID:     t.meta.JobID,
+			Namespace: t.meta.Namespace,
+			TaskName:  t.meta.TaskName,
+		})
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.address, &body)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return 0, fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+	return len(entries), nil
+}
+
+func (t *httpTransport) close() error {
+	t.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultJournaldSocket is the socket of journald used by sinks without an
+// address.
+const DefaultJournaldSocket = "/run/systemd/journal/socket"
+
+// journaldTransport sends entries to a socket speaking the native protocol of
+// journald, a datagram per entry.
+type journaldTransport struct {
+	address string
+	meta    *Metadata
+
+	conn net.Conn
+}
+
+func newJournaldTransport(config *Config, meta *Metadata) (*journaldTransport, error) {
+	address := strings.TrimPrefix(config.Address, "unix://")
+	if address == "" {
+		address = DefaultJournaldSocket
+	}
+	if address != DefaultJournaldSocket {
+		return nil, fmt.Errorf("journald socket %q must be %q", address, DefaultJournaldSocket)
+	}
+	return &journaldTransport{
+		address: address,
+		meta:    meta,
+	}, nil
+}
+
+func (t *journaldTransport) send(_ context.Context, entries []Entry) (int, error) {
+	if t.conn == nil {
+		conn, err := net.DialTimeout("unixgram", t.address, dialTimeout)
+		if err != nil {
+			return 0, err
+		}
+		t.conn = conn
+	}
+
+	for i, e := range entries {
+		t.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if _, err := t.conn.Write(formatJournald(e, t.meta)); err != nil {
+			t.conn.Close()
+			t.conn = nil
+			return i, err
+		}
+	}
+	return len(entries), nil
+}
+
+func (t *journaldTransport) close() error {
+	if t.conn == nil {
+		return nil
+	}
+	err := t.conn.Close()
+	t.conn = nil
+	return err
+}
+
+// formatJournald returns the entry serialized in the native protocol of
+// journald, with the metadata of the task as NOMAD_ fields.
+func formatJournald(e Entry, meta *Metadata) []byte {
+	priority := syslogSeverityInfo
+	if e.Stream == StreamStderr {
+		priority = syslogSeverityError
+	}
+
+	var buf bytes.Buffer
+	writeJournaldField(&buf, "MESSAGE", e.Line)
+	writeJournaldField(&buf, "PRIORITY", strconv.Itoa(priority))
+	writeJournaldField(&buf, "SYSLOG_IDENTIFIER", meta.TaskName)
+	writeJournaldField(&buf, "NOMAD_ALLOC_ID", meta.AllocID)
+	writeJournaldField(&buf, "NOMAD_JOB_ID", meta.JobID)
+	writeJournaldField(&buf, "NOMAD_NAMESPACE", meta.Namespace)
+	writeJournaldField(&buf, "NOMAD_TASK_NAME", meta.TaskName)
+	writeJournaldField(&buf, "NOMAD_STREAM", e.Stream)
+	return buf.Bytes()
+}
+
+// writeJournaldField writes the field, using the binary serialization for
+// values containing newlines.
+func writeJournaldField(buf *bytes.Buffer, key, value string) {
+	if value == "" {
+		return
+	}
+
+	buf.WriteString(key)
+	if !strings.Contains(value, "\n") {
+		buf.WriteByte('=')
+		buf.WriteString(value)
+		buf.WriteByte('\n')
+		return
+	}
+
+	buf.WriteByte('\n')
+	binary.Write(buf, binary.LittleEndian, uint64(len(value)))
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
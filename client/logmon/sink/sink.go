@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package sink ships the log lines of a task to external destinations. Sinks
+// run in the logmon process of the task and never block log collection: lines
+// are buffered in memory up to a bound and dropped once it is reached.
+package sink
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/helper"
+)
+
+const (
+	TypeSyslog   = "syslog"
+	TypeJournald = "journald"
+	TypeHTTP     = "http"
+
+	// StreamStdout and StreamStderr are the streams a log line is read from.
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+
+	// DefaultBufferSize is the number of lines buffered by a sink that has no
+	// buffer size configured.
+	DefaultBufferSize = 1024
+
+	// maxBatchSize is the maximum number of lines sent to the destination at
+	// once.
+	maxBatchSize = 128
+
+	// minRetryInterval and maxRetryInterval bound the backoff between the
+	// attempts to send lines to an unavailable destination.
+	minRetryInterval = 500 * time.Millisecond
+	maxRetryInterval = 30 * time.Second
+
+	// closeTimeout is how long a closing sink tries to flush its buffer.
+	closeTimeout = 5 * time.Second
+
+	// dialTimeout and writeTimeout bound the socket operations of the
+	// transports.
+	dialTimeout  = 5 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+// Config is the configuration of a sink.
+type Config struct {
+	Name       string
+	Type       string
+	Address    string
+	Headers    map[string]string
+	BufferSize int
+}
+
+// Metadata identifies the task whose logs are shipped.
+type Metadata struct {
+	AllocID   string
+	JobID     string
+	Namespace string
+	TaskName  string
+}
+
+// Entry is a log line of a task.
+type Entry struct {
+	Time   time.Time
+	Stream string
+	Line   string
+}
+
+// Stats are the counters of a sink since it was started.
+type Stats struct {
+	Name string
+	Type string
+
+	// Sent is the number of lines sent to the destination.
+	Sent uint64
+
+	// Dropped is the number of lines dropped because the buffer was full.
+	Dropped uint64
+
+	// Errors is the number of failed attempts to send lines.
+	Errors uint64
+
+	// Buffered is the number of lines waiting to be sent.
+	Buffered int
+}
+
+// transport sends log lines to a destination.
+type transport interface {
+	// send sends the entries in order, returning how many were sent before an
+	// error occurred.
+	send(context.Context, []Entry) (int, error)
+
+	close() error
+}
+
+// Sink buffers the log lines of a task and ships them to a destination in
+// the background.
+type Sink struct {
+	config    *Config
+	transport transport
+	logger    hclog.Logger
+
+	entries chan Entry
+
+	// closeCh is closed to stop accepting entries, and doneCh once the
+	// buffered entries are flushed or closeTimeout passed.
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	doneCh    chan struct{}
+
+	sent     atomic.Uint64
+	dropped  atomic.Uint64
+	errors   atomic.Uint64
+	inFlight atomic.Int64
+}
+
+// New returns a running sink for the given configuration.
+func New(config *Config, meta *Metadata, logger hclog.Logger) (*Sink, error) {
+	var t transport
+	var err error
+	switch config.Type {
+	case TypeSyslog:
+		t, err = newSyslogTransport(config, meta)
+	case TypeJournald:
+		t, err = newJournaldTransport(config, meta)
+	case TypeHTTP:
+		t, err = newHTTPTransport(config, meta)
+	default:
+		err = fmt.Errorf("unknown sink type %q", config.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log sink %q: %w", config.Name, err)
+	}
+
+	return newSink(config, t, logger), nil
+}
+
+func newSink(config *Config, t transport, logger hclog.Logger) *Sink {
+	size := config.BufferSize
+	if size <= 0 {
+		size = DefaultBufferSize
+	}
+
+	s := &Sink{
+		config:    config,
+		transport: t,
+		logger:    logger.Named("sink").With("sink", config.Name, "type", config.Type),
+		entries:   make(chan Entry, size),
+		closeCh:   make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write buffers the entry to be sent. It never blocks: the entry is dropped
+// if the buffer is full or the sink is closed.
+func (s *Sink) Write(e Entry) {
+	select {
+	case <-s.closeCh:
+		s.dropped.Add(1)
+		return
+	default:
+	}
+
+	select {
+	case s.entries <- e:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Stats returns the counters of the sink.
+func (s *Sink) Stats() *Stats {
+	return &Stats{
+		Name:     s.config.Name,
+		Type:     s.config.Type,
+		Sent:     s.sent.Load(),
+		Dropped:  s.dropped.Load(),
+		Errors:   s.errors.Load(),
+		Buffered: len(s.entries) + int(s.inFlight.Load()),
+	}
+}
+
+// Close stops accepting entries and waits for the buffered entries to be
+// flushed, for up to closeTimeout.
+func (s *Sink) Close() error {
+	s.closeOnce.Do(func() { close(s.closeCh) })
+	<-s.doneCh
+	return s.transport.close()
+}
+
+// run sends the buffered entries in batches, retrying failed batches with a
+// backoff until the sink is closed.
+func (s *Sink) run() {
+	defer close(s.doneCh)
+
+	// flushCtx bounds the flush once the sink is closed
+	flushCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.closeCh:
+		case <-flushCtx.Done():
+			return
+		}
+		timer, stop := helper.NewSafeTimer(closeTimeout)
+		defer stop()
+		select {
+		case <-timer.C:
+			cancel()
+		case <-flushCtx.Done():
+		}
+	}()
+
+	var batch []Entry
+	retry := minRetryInterval
+
+	for {
+		if len(batch) == 0 {
+			select {
+			case e := <-s.entries:
+				batch = append(batch, e)
+			case <-s.closeCh:
+				if len(s.entries) == 0 {
+					return
+				}
+				batch = append(batch, <-s.entries)
+			}
+		}
+		batch = s.fill(batch)
+		s.inFlight.Store(int64(len(batch)))
+
+		n, err := s.transport.send(flushCtx, batch)
+		s.sent.Add(uint64(n))
+		batch = batch[n:]
+		s.inFlight.Store(int64(len(batch)))
+		if err == nil {
+			retry = minRetryInterval
+			batch = nil
+			continue
+		}
+
+		s.errors.Add(1)
+		s.logger.Warn("failed to send logs", "error", err, "retry", retry)
+
+		timer, stop := helper.NewSafeTimer(retry)
+		select {
+		case <-timer.C:
+		case <-flushCtx.Done():
+		}
+		stop()
+		retry = min(retry*2, maxRetryInterval)
+
+		if flushCtx.Err() != nil {
+			s.drop(batch)
+			return
+		}
+	}
+}
+
+// fill appends the buffered entries to the batch, up to maxBatchSize.
+func (s *Sink) fill(batch []Entry) []Entry {
+	for len(batch) < maxBatchSize {
+		select {
+		case e := <-s.entries:
+			batch = append(batch, e)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// drop counts the entries that could not be flushed before closing.
+func (s *Sink) drop(batch []Entry) {
+	n := len(batch) + len(s.entries)
+	s.inFlight.Store(0)
+	for len(s.entries) > 0 {
+		<-s.entries
+	}
+	if n > 0 {
+		s.dropped.Add(uint64(n))
+		s.logger.Warn("dropped logs that could not be flushed", "lines", n)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package sink
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+// mockTransport records the lines it sends. Sends block while blocked is
+// set, and fail while err is set.
+type mockTransport struct {
+	lock    sync.Mutex
+	lines   []string
+	err     error
+	blocked chan struct{}
+}
+
+func (m *mockTransport) send(_ context.Context, entries []Entry) (int, error) {
+	if m.blocked != nil {
+		<-m.blocked
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.err != nil {
+		return 0, m.err
+	}
+	for _, e := range entries {
+		m.lines = append(m.lines, e.Line)
+	}
+	return len(entries), nil
+}
+
+func (m *mockTransport) close() error { return nil }
+
+func (m *mockTransport) sent() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([]string(nil), m.lines...)
+}
+
+func TestSink_send(t *testing.T) {
+	ci.Parallel(t)
+
+	transport := &mockTransport{}
+	s := newSink(&Config{Name: "test", Type: TypeHTTP}, transport, testlog.HCLogger(t))
+
+	for _, line := range []string{"a", "b", "c"} {
+		s.Write(Entry{Time: time.Now(), Stream: StreamStdout, Line: line})
+	}
+	must.NoError(t, s.Close())
+
+	must.Eq(t, []string{"a", "b", "c"}, transport.sent())
+	must.Eq(t, &Stats{Name: "test", Type: TypeHTTP, Sent: 3}, s.Stats())
+
+	// lines written after closing are dropped
+	s.Write(Entry{Line: "d"})
+	must.Eq(t, 1, s.Stats().Dropped)
+}
+
+func TestSink_drop(t *testing.T) {
+	ci.Parallel(t)
+
+	transport := &mockTransport{blocked: make(chan struct{})}
+	s := newSink(&Config{Name: "test", Type: TypeHTTP, BufferSize: 2}, transport, testlog.HCLogger(t))
+
+	// the first line is sent and blocks, the next two are buffered, and the
+	// last two are dropped
+	s.Write(Entry{Line: "0"})
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return s.Stats().Buffered == 1 && len(s.entries) == 0 }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	for i := 1; i < 5; i++ {
+		s.Write(Entry{Line: string(rune('0' + i))})
+	}
+
+	stats := s.Stats()
+	must.Eq(t, 2, stats.Dropped)
+	must.Eq(t, 3, stats.Buffered)
+
+	close(transport.blocked)
+	must.NoError(t, s.Close())
+	must.Eq(t, []string{"0", "1", "2"}, transport.sent())
+	must.Eq(t, 3, s.Stats().Sent)
+	must.Eq(t, 0, s.Stats().Buffered)
+}
+
+func TestSink_retry(t *testing.T) {
+	ci.Parallel(t)
+
+	transport := &mockTransport{err: errors.New("unavailable")}
+	s := newSink(&Config{Name: "test", Type: TypeHTTP}, transport, testlog.HCLogger(t))
+
+	s.Write(Entry{Line: "a"})
+	testutil.WaitForResult(func() (bool, error) {
+		return s.Stats().Errors > 0, nil
+	}, func(err error) {
+		t.Fatal("expected send to fail")
+	})
+
+	// the failed line is sent once the destination is available again
+	transport.lock.Lock()
+	transport.err = nil
+	transport.lock.Unlock()
+
+	testutil.WaitForResult(func() (bool, error) {
+		return s.Stats().Sent == 1, nil
+	}, func(err error) {
+		t.Fatalf("expected line to be sent: %#v", s.Stats())
+	})
+	must.NoError(t, s.Close())
+	must.Eq(t, []string{"a"}, transport.sent())
+}
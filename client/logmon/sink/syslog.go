@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// syslogFacilityUser is the facility of the messages, as task output is
+	// user-level.
+	syslogFacilityUser = 1
+
+	syslogSeverityError = 3
+	syslogSeverityInfo  = 6
+)
+
+// SyslogSockets are the sockets of the local syslog daemon on the supported
+// platforms, which are the only unix sockets syslog sinks may write to.
+var SyslogSockets = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// syslogTransport sends RFC5424 messages to a syslog server. Stream
+// connections use octet counting framing (RFC6587), and datagram connections
+// send a message per datagram.
+type syslogTransport struct {
+	network  string
+	address  string
+	hostname string
+	meta     *Metadata
+
+	conn   net.Conn
+	stream bool
+}
+
+func newSyslogTransport(config *Config, meta *Metadata) (*syslogTransport, error) {
+	u, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog address: %w", err)
+	}
+
+	t := &syslogTransport{network: u.Scheme, meta: meta}
+	switch u.Scheme {
+	case "tcp", "udp":
+		t.address = u.Host
+	case "unix":
+		if !slices.Contains(SyslogSockets, u.Path) {
+			return nil, fmt.Errorf("syslog socket %q must be one of %q", u.Path, SyslogSockets)
+		}
+		t.address = u.Path
+	default:
+		return nil, fmt.Errorf("unsupported syslog network %q", u.Scheme)
+	}
+
+	t.hostname, _ = os.Hostname()
+	return t, nil
+}
+
+func (t *syslogTransport) send(_ context.Context, entries []Entry) (int, error) {
+	if err := t.connect(); err != nil {
+		return 0, err
+	}
+
+	for i, e := range entries {
+		msg := formatRFC5424(e, t.hostname, t.meta)
+		if t.stream {
+			msg = strconv.Itoa(len(msg)) + " " + msg
+		}
+
+		t.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if _, err := t.conn.Write([]byte(msg)); err != nil {
+			// reconnect on the next attempt
+			t.conn.Close()
+			t.conn = nil
+			return i, err
+		}
+	}
+	return len(entries), nil
+}
+
+// connect dials the server if not connected. Unix sockets are usually
+// datagram sockets, but stream sockets are supported as well.
+func (t *syslogTransport) connect() error {
+	if t.conn != nil {
+		return nil
+	}
+
+	var conn net.Conn
+	var err error
+	switch t.network {
+	case "unix":
+		conn, err = net.DialTimeout("unixgram", t.address, dialTimeout)
+		t.stream = false
+		if err != nil {
+			conn, err = net.DialTimeout("unix", t.address, dialTimeout)
+			t.stream = true
+		}
+	default:
+		conn, err = net.DialTimeout(t.network, t.address, dialTimeout)
+		t.stream = t.network == "tcp"
+	}
+	if err != nil {
+		return err
+	}
+
+	t.conn = conn
+	return nil
+}
+
+func (t *syslogTransport) close() error {
+	if t.conn == nil {
+		return nil
+	}
+	err := t.conn.Close()
+	t.conn = nil
+	return err
+}
+
+// formatRFC5424 returns the entry as a RFC5424 message. The APP-NAME is the
+// task, the PROCID the allocation, and the MSGID the stream.
+func formatRFC5424(e Entry, hostname string, meta *Metadata) string {
+	severity := syslogSeverityInfo
+	if e.Stream == StreamStderr {
+		severity = syslogSeverityError
+	}
+
+	return fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		syslogFacilityUser*8+severity,
+		e.Time.UTC().Format(time.RFC3339Nano),
+		syslogHeaderField(hostname, 255),
+		syslogHeaderField(meta.TaskName, 48),
+		syslogHeaderField(meta.AllocID, 128),
+		syslogHeaderField(e.Stream, 32),
+		e.Line)
+}
+
+// syslogHeaderField returns the value as a header field, which is printable
+// ASCII of a maximum length, or "-" if empty.
+func syslogHeaderField(value string, maxLen int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+	if value == "" {
+		return "-"
+	}
+	if len(value) > maxLen {
+		value = value[:maxLen]
+	}
+	return value
+}
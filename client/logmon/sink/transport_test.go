@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package sink
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+var testMeta = &Metadata{
+	AllocID:   "a8198d79-cfdb-6593-a999-1e9adabcba2e",
+	JobID:     "example",
+	Namespace: "default",
+	TaskName:  "web",
+}
+
+var testTime = time.Date(2026, 1, 2, 3, 4, 5, 600000000, time.UTC)
+
+func TestSyslog_formatRFC5424(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Eq(t, "<14>1 2026-01-02T03:04:05.6Z host web a8198d79-cfdb-6593-a999-1e9adabcba2e stdout - hello world",
+		formatRFC5424(Entry{Time: testTime, Stream: StreamStdout, Line: "hello world"}, "host", testMeta))
+
+	// stderr is logged as errors and header fields are sanitized
+	must.Eq(t, "<11>1 2026-01-02T03:04:05.6Z - my_task - stderr - oops",
+		formatRFC5424(Entry{Time: testTime, Stream: StreamStderr, Line: "oops"}, "", &Metadata{TaskName: "my task"}))
+}
+
+func TestSyslog_send(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("udp", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		must.NoError(t, err)
+		defer conn.Close()
+
+		transport, err := newSyslogTransport(&Config{Address: "udp://" + conn.LocalAddr().String()}, testMeta)
+		must.NoError(t, err)
+		defer transport.close()
+
+		n, err := transport.send(context.Background(), []Entry{{Time: testTime, Stream: StreamStdout, Line: "one"}})
+		must.NoError(t, err)
+		must.Eq(t, 1, n)
+
+		buf := make([]byte, 1024)
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err = conn.ReadFrom(buf)
+		must.NoError(t, err)
+		must.StrHasSuffix(t, "stdout - one", string(buf[:n]))
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		must.NoError(t, err)
+		defer ln.Close()
+
+		transport, err := newSyslogTransport(&Config{Address: "tcp://" + ln.Addr().String()}, testMeta)
+		must.NoError(t, err)
+		defer transport.close()
+
+		n, err := transport.send(context.Background(), []Entry{
+			{Time: testTime, Stream: StreamStdout, Line: "one"},
+			{Time: testTime, Stream: StreamStdout, Line: "two"},
+		})
+		must.NoError(t, err)
+		must.Eq(t, 2, n)
+		transport.close()
+
+		conn, err := ln.Accept()
+		must.NoError(t, err)
+		defer conn.Close()
+		data, err := io.ReadAll(conn)
+		must.NoError(t, err)
+
+		// messages are framed by their length
+		one := formatRFC5424(Entry{Time: testTime, Stream: StreamStdout, Line: "one"}, transport.hostname, testMeta)
+		two := formatRFC5424(Entry{Time: testTime, Stream: StreamStdout, Line: "two"}, transport.hostname, testMeta)
+		must.Eq(t, fmt.Sprintf("%d %s%d %s", len(one), one, len(two), two), string(data))
+	})
+}
+
+func TestJournald_format(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Eq(t, `MESSAGE=hello
+PRIORITY=6
+SYSLOG_IDENTIFIER=web
+NOMAD_ALLOC_ID=a8198d79-cfdb-6593-a999-1e9adabcba2e
+NOMAD_JOB_ID=example
+NOMAD_NAMESPACE=default
+NOMAD_TASK_NAME=web
+NOMAD_STREAM=stdout
+`, string(formatJournald(Entry{Time: testTime, Stream: StreamStdout, Line: "hello"}, testMeta)))
+
+	// values with newlines are length prefixed
+	var buf bytes.Buffer
+	writeJournaldField(&buf, "MESSAGE", "a\nb")
+	expected := []byte("MESSAGE\n")
+	expected = binary.LittleEndian.AppendUint64(expected, 3)
+	expected = append(expected, "a\nb\n"...)
+	must.Eq(t, expected, buf.Bytes())
+}
+
+func TestHTTP_send(t *testing.T) {
+	ci.Parallel(t)
+
+	var entries []httpEntry
+	var header http.Header
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var e httpEntry
+			must.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+			entries = append(entries, e)
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	transport, err := newHTTPTransport(&Config{
+		Address: srv.URL,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+	}, testMeta)
+	must.NoError(t, err)
+	defer transport.close()
+
+	n, err := transport.send(context.Background(), []Entry{
+		{Time: testTime, Stream: StreamStdout, Line: "one"},
+		{Time: testTime, Stream: StreamStderr, Line: "two"},
+	})
+	must.NoError(t, err)
+	must.Eq(t, 2, n)
+	must.Eq(t, "application/x-ndjson", header.Get("Content-Type"))
+	must.Eq(t, "Bearer secret", header.Get("Authorization"))
+	must.Eq(t, []httpEntry{
+		{Timestamp: "2026-01-02T03:04:05.6Z", Stream: "stdout", Message: "one",
+			AllocID: testMeta.AllocID, JobID: "example", Namespace: "default", TaskName: "web"},
+		{Timestamp: "2026-01-02T03:04:05.6Z", Stream: "stderr", Message: "two",
+			AllocID: testMeta.AllocID, JobID: "example", Namespace: "default", TaskName: "web"},
+	}, entries)
+
+	status = http.StatusServiceUnavailable
+	n, err = transport.send(context.Background(), []Entry{{Time: testTime, Line: "three"}})
+	must.ErrorContains(t, err, "503")
+	must.Eq(t, 0, n)
+}
+
+func TestHTTP_send_cancel(t *testing.T) {
+	ci.Parallel(t)
+
+	blockCh := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-blockCh
+	}))
+	defer srv.Close()
+	defer close(blockCh)
+
+	transport, err := newHTTPTransport(&Config{Address: srv.URL}, testMeta)
+	must.NoError(t, err)
+	defer transport.close()
+
+	// the request is bounded by the context rather than the http timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = transport.send(ctx, []Entry{{Time: testTime, Line: "one"}})
+	must.ErrorIs(t, err, context.DeadlineExceeded)
+	must.Less(t, httpTimeout, time.Since(start))
+}
+
+func TestTransport_sockets(t *testing.T) {
+	ci.Parallel(t)
+
+	_, err := newSyslogTransport(&Config{Address: "unix:///dev/log"}, testMeta)
+	must.NoError(t, err)
+	_, err = newSyslogTransport(&Config{Address: "unix:///var/run/docker.sock"}, testMeta)
+	must.ErrorContains(t, err, `syslog socket "/var/run/docker.sock" must be one of`)
+
+	_, err = newJournaldTransport(&Config{}, testMeta)
+	must.NoError(t, err)
+	_, err = newJournaldTransport(&Config{Address: "unix://" + DefaultJournaldSocket}, testMeta)
+	must.NoError(t, err)
+	_, err = newJournaldTransport(&Config{Address: "/var/run/docker.sock"}, testMeta)
+	must.ErrorContains(t, err, `journald socket "/var/run/docker.sock" must be`)
+}
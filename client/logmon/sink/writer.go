@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package sink
+
+import (
+	"bytes"
+	"time"
+)
+
+// maxLineSize is the maximum size of a log line. Longer lines are split.
+const maxLineSize = 16 * 1024
+
+// LineWriter splits the output of a stream of the task into lines and writes
+// them to the sinks. It never fails, so it can be teed with the log files.
+type LineWriter struct {
+	stream string
+	sinks  []*Sink
+	buf    []byte
+}
+
+// NewLineWriter returns a writer of the given stream to the sinks.
+func NewLineWriter(stream string, sinks []*Sink) *LineWriter {
+	return &LineWriter{stream: stream, sinks: sinks}
+}
+
+func (w *LineWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			w.buf = append(w.buf, p...)
+			for len(w.buf) >= maxLineSize {
+				w.emit(w.buf[:maxLineSize])
+				w.buf = w.buf[maxLineSize:]
+			}
+			break
+		}
+
+		w.buf = append(w.buf, p[:i]...)
+		p = p[i+1:]
+		for len(w.buf) > maxLineSize {
+			w.emit(w.buf[:maxLineSize])
+			w.buf = w.buf[maxLineSize:]
+		}
+		w.emit(bytes.TrimSuffix(w.buf, []byte("\r")))
+		w.buf = w.buf[:0]
+	}
+	return n, nil
+}
+
+// Close writes the last line if it was not terminated.
+func (w *LineWriter) Close() error {
+	if len(w.buf) > 0 {
+		w.emit(w.buf)
+		w.buf = nil
+	}
+	return nil
+}
+
+func (w *LineWriter) emit(line []byte) {
+	e := Entry{Time: time.Now(), Stream: w.stream, Line: string(line)}
+	for _, s := range w.sinks {
+		s.Write(e)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package sink
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/shoenig/test/must"
+)
+
+func TestLineWriter(t *testing.T) {
+	ci.Parallel(t)
+
+	transport := &mockTransport{}
+	s := newSink(&Config{Name: "test", Type: TypeHTTP, BufferSize: 16}, transport, testlog.HCLogger(t))
+	w := NewLineWriter(StreamStdout, []*Sink{s})
+
+	long := strings.Repeat("x", maxLineSize+1)
+	for _, p := range []string{"one\ntw", "o\r\n", "\n", long + "\n", "last"} {
+		n, err := w.Write([]byte(p))
+		must.NoError(t, err)
+		must.Eq(t, len(p), n)
+	}
+	must.NoError(t, w.Close())
+	must.NoError(t, s.Close())
+
+	must.Eq(t, []string{"one", "two", "", long[:maxLineSize], "x", "last"}, transport.sent())
+}
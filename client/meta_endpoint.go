@@ -6,6 +6,7 @@ package client
 import (
 	"maps"
 	"net/http"
+	"strings"
 	"time"
 
 	metrics "github.com/hashicorp/go-metrics/compat"
@@ -81,6 +82,13 @@ func (n *NodeMeta) Apply(args *structs.NodeMetaApplyRequest, reply *structs.Node
 	// Trigger an async node update
 	n.c.updateNode()
 
+	// Pass the images to pre-pull to the drivers whose list changed
+	for k := range args.Meta {
+		if driver, ok := strings.CutSuffix(k, imagePrePullMetaSuffix); ok && driver != "" {
+			go n.c.prePullImages(driver)
+		}
+	}
+
 	reply.Meta = newNode.Meta
 	reply.Dynamic = dyn
 	reply.Static = n.c.metaStatic
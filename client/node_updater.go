@@ -245,6 +245,9 @@ func (c *Client) updateNodeFromDriver(name string, info *structs.DriverInfo) {
 
 	newConfig := c.config.Copy()
 
+	oldInfo := newConfig.Node.Drivers[name]
+	detected := info.Detected && (oldInfo == nil || !oldInfo.Detected)
+
 	if c.applyNodeUpdatesFromDriver(name, info, newConfig.Node) {
 		newConfig.Node.Drivers[name] = info
 		if newConfig.Node.Drivers[name].UpdateTime.IsZero() {
@@ -254,6 +257,11 @@ func (c *Client) updateNodeFromDriver(name string, info *structs.DriverInfo) {
 		c.config = newConfig
 		c.updateNode()
 	}
+
+	// Pass the images to pre-pull once the driver is detected
+	if _, ok := newConfig.Node.Meta[imagePrePullMetaKey(name)]; ok && detected {
+		go c.prePullImages(name)
+	}
 }
 
 // applyNodeUpdatesFromDriver applies changes to the passed in node. true is
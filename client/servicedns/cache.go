@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package servicedns
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// cacheExpiry is how long a service is kept up to date in the cache
+	// after it was last looked up.
+	cacheExpiry = 10 * time.Minute
+
+	// cacheQueryTime is the maximum time of the blocking queries used to keep
+	// the cache up to date.
+	cacheQueryTime = 5 * time.Minute
+
+	// cacheRetryInterval is how long to wait before retrying a failed query.
+	cacheRetryInterval = 5 * time.Second
+
+	// cacheFetchTimeout is how long a lookup waits for a service not yet in
+	// the cache to be read from the servers.
+	cacheFetchTimeout = 2 * time.Second
+
+	// cacheNegativeTTL is how long a service which had no registrations, or
+	// could not be read, is cached before it is read again. These services
+	// are not kept up to date with blocking queries.
+	cacheNegativeTTL = 5 * time.Second
+
+	// cacheMaxEntries is the maximum number of services in the cache. The
+	// least recently looked up service is evicted to make room for new ones.
+	cacheMaxEntries = 1024
+)
+
+// serviceKey identifies a service in the cache.
+type serviceKey struct {
+	namespace string
+	name      string
+}
+
+// cacheEntry holds the registrations of a single service.
+type cacheEntry struct {
+	services []*structs.ServiceRegistration
+	err      error
+	lastRead time.Time
+
+	// negativeUntil is set if the service had no registrations or could not
+	// be read the first time. The entry is not watched, and is read again
+	// once it has passed.
+	negativeUntil time.Time
+
+	// ready is closed once the service was read from the servers for the
+	// first time.
+	ready chan struct{}
+
+	// stopCh is closed when the entry is evicted to stop watching it.
+	stopCh chan struct{}
+}
+
+// serviceCache is the client's view of the service registrations looked up
+// through the DNS interface. Each service is read from the servers on its
+// first lookup and then kept up to date with blocking queries, until it has
+// not been looked up for cacheExpiry or is evicted to keep the cache within
+// maxEntries. Services without registrations are cached for negativeTTL
+// instead of being watched.
+type serviceCache struct {
+	logger    hclog.Logger
+	region    string
+	rpcFn     func(method string, args, resp any) error
+	authToken func(namespace string) string
+
+	expiry      time.Duration
+	queryTime   time.Duration
+	negativeTTL time.Duration
+	maxEntries  int
+	shutdownCh  <-chan struct{}
+
+	lock    sync.Mutex
+	entries map[serviceKey]*cacheEntry
+}
+
+func newServiceCache(logger hclog.Logger, cfg *Config, shutdownCh <-chan struct{}) *serviceCache {
+	return &serviceCache{
+		logger:      logger,
+		region:      cfg.Region,
+		rpcFn:       cfg.RPCFn,
+		authToken:   cfg.AuthToken,
+		expiry:      cacheExpiry,
+		queryTime:   cacheQueryTime,
+		negativeTTL: cacheNegativeTTL,
+		maxEntries:  cacheMaxEntries,
+		shutdownCh:  shutdownCh,
+		entries:     make(map[serviceKey]*cacheEntry),
+	}
+}
+
+// get returns the registrations of the service, reading them from the servers
+// if the service is not yet in the cache. Services are only returned for
+// namespaces with allocations on the node.
+func (c *serviceCache) get(namespace, name string) ([]*structs.ServiceRegistration, error) {
+	if c.authToken(namespace) == "" {
+		return nil, errNoAllocs
+	}
+	key := serviceKey{namespace: namespace, name: name}
+
+	c.lock.Lock()
+	now := time.Now()
+	entry, ok := c.entries[key]
+	if ok && !entry.negativeUntil.IsZero() && now.After(entry.negativeUntil) {
+		delete(c.entries, key)
+		ok = false
+	}
+	if !ok {
+		c.evictLocked()
+		entry = &cacheEntry{ready: make(chan struct{}), stopCh: make(chan struct{})}
+		c.entries[key] = entry
+		go c.watch(key, entry)
+	}
+	entry.lastRead = now
+	c.lock.Unlock()
+
+	timer, stop := helper.NewSafeTimer(cacheFetchTimeout)
+	defer stop()
+
+	select {
+	case <-entry.ready:
+	case <-timer.C:
+		return nil, errFetchTimeout
+	case <-c.shutdownCh:
+		return nil, errShutdown
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return entry.services, entry.err
+}
+
+// evictLocked removes the least recently looked up services until there is
+// room for a new one. The cache lock must be held.
+func (c *serviceCache) evictLocked() {
+	for len(c.entries) >= c.maxEntries {
+		var oldestKey serviceKey
+		var oldest *cacheEntry
+		for key, entry := range c.entries {
+			if oldest == nil || entry.lastRead.Before(oldest.lastRead) {
+				oldestKey, oldest = key, entry
+			}
+		}
+		delete(c.entries, oldestKey)
+		close(oldest.stopCh)
+	}
+}
+
+// watch reads the service and keeps its entry up to date until it expires, is
+// evicted, the namespace no longer has allocations on the node, or the cache
+// is shut down. Services which have no registrations or can't be read the
+// first time are cached for negativeTTL and not watched.
+func (c *serviceCache) watch(key serviceKey, entry *cacheEntry) {
+	logger := c.logger.With("namespace", key.namespace, "service", key.name)
+
+	var index uint64
+	for {
+		token := c.authToken(key.namespace)
+		if token == "" && index != 0 {
+			c.lock.Lock()
+			if c.entries[key] == entry {
+				delete(c.entries, key)
+			}
+			c.lock.Unlock()
+			logger.Trace("namespace has no allocations left, removing service from cache")
+			return
+		}
+
+		args := structs.ServiceRegistrationByNameRequest{
+			ServiceName: key.name,
+			QueryOptions: structs.QueryOptions{
+				Region:        c.region,
+				Namespace:     key.namespace,
+				AuthToken:     token,
+				AllowStale:    true,
+				MinQueryIndex: index,
+				MaxQueryTime:  c.queryTime,
+			},
+		}
+		var resp structs.ServiceRegistrationByNameResponse
+		err := c.rpcFn(structs.ServiceRegistrationGetServiceRPCMethod, &args, &resp)
+
+		c.lock.Lock()
+		if index == 0 {
+			entry.services, entry.err = resp.Services, err
+			close(entry.ready)
+			if err != nil || len(resp.Services) == 0 {
+				entry.negativeUntil = time.Now().Add(c.negativeTTL)
+				c.lock.Unlock()
+				return
+			}
+		} else if err == nil {
+			// Keep serving the last known registrations if the servers
+			// become unavailable.
+			entry.services = resp.Services
+		}
+		if err == nil {
+			index = max(resp.Index, 1)
+		}
+
+		expired := time.Since(entry.lastRead) > c.expiry
+		if expired && c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.lock.Unlock()
+
+		if expired {
+			logger.Trace("service expired from cache")
+			return
+		}
+
+		if err != nil {
+			logger.Warn("failed to read service registrations", "error", err)
+			select {
+			case <-c.shutdownCh:
+				return
+			case <-entry.stopCh:
+				return
+			case <-time.After(cacheRetryInterval):
+			}
+			continue
+		}
+
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-entry.stopCh:
+			logger.Trace("service evicted from cache")
+			return
+		default:
+		}
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package servicedns implements the DNS interface of the client, which answers
+// A, AAAA and SRV queries for Nomad service registrations.
+//
+// Services are queried by name, optionally filtered by tag and namespace:
+//
+//	[<tag>.]<service>.service[.<namespace>].<domain>
+//
+// Only namespaces with allocations running on the node are answered for, and
+// their services are read with the workload identity of one of those
+// allocations. Instances whose checks are not passing are not included in the
+// answers.
+// The targets of SRV records are names of the form <hex-ip>.addr.<domain>,
+// which resolve to the address of the instance.
+package servicedns
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/miekg/dns"
+)
+
+var (
+	errFetchTimeout = errors.New("timed out reading service registrations")
+	errShutdown     = errors.New("dns interface is shut down")
+	errNoAllocs     = errors.New("namespace has no allocations on this node")
+)
+
+// Config is the configuration of the DNS interface.
+type Config struct {
+	// DNS is the DNS configuration of the client.
+	DNS *config.DNSConfig
+
+	// Region is the region of the client, used to read service registrations.
+	Region string
+
+	// RPCFn is the client RPC function used to read service registrations
+	// from the servers.
+	RPCFn func(method string, args, resp any) error
+
+	// AuthToken returns the token used to read the services of a namespace,
+	// which is the workload identity of an allocation of the namespace running
+	// on the node. It returns an empty string if there is none.
+	AuthToken func(namespace string) string
+}
+
+// Server is the DNS interface of the client. It listens on both UDP and TCP.
+type Server struct {
+	logger hclog.Logger
+
+	// domain is the fully qualified domain the server answers for.
+	domain string
+	ttl    uint32
+	cache  *serviceCache
+
+	servers      []*dns.Server
+	shutdownCh   chan struct{}
+	shutdownOnce sync.Once
+}
+
+// NewServer starts the DNS interface.
+func NewServer(logger hclog.Logger, cfg *Config) (*Server, error) {
+	logger = logger.Named("dns")
+	shutdownCh := make(chan struct{})
+
+	s := &Server{
+		logger:     logger,
+		domain:     dns.Fqdn(strings.ToLower(cfg.DNS.Domain)),
+		ttl:        uint32(cfg.DNS.TTL.Seconds()),
+		cache:      newServiceCache(logger, cfg, shutdownCh),
+		shutdownCh: shutdownCh,
+	}
+
+	addr := net.JoinHostPort(cfg.DNS.Address, fmt.Sprint(cfg.DNS.Port))
+
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s/udp: %w", addr, err)
+	}
+	// Listen for TCP on the port UDP was bound to, in case it is 0.
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		return nil, fmt.Errorf("failed to listen on %s/tcp: %w", addr, err)
+	}
+
+	s.servers = []*dns.Server{
+		{PacketConn: pc, Handler: s},
+		{Listener: l, Handler: s},
+	}
+	for _, srv := range s.servers {
+		go func() {
+			if err := srv.ActivateAndServe(); err != nil {
+				logger.Error("dns interface stopped", "error", err)
+			}
+		}()
+	}
+
+	logger.Info("started DNS interface", "address", pc.LocalAddr(), "domain", s.domain)
+	return s, nil
+}
+
+// Addr returns the UDP address the DNS interface listens on.
+func (s *Server) Addr() net.Addr {
+	return s.servers[0].PacketConn.LocalAddr()
+}
+
+// Shutdown stops the DNS interface.
+func (s *Server) Shutdown() {
+	s.shutdownOnce.Do(func() {
+		close(s.shutdownCh)
+		for _, srv := range s.servers {
+			_ = srv.Shutdown()
+		}
+	})
+}
+
+// ServeDNS answers a DNS query.
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	if len(req.Question) != 1 {
+		m.SetRcode(req, dns.RcodeFormatError)
+	} else {
+		s.answer(m, req.Question[0])
+	}
+
+	// Truncate answers which don't fit in a UDP message.
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+		m.Truncate(size)
+	}
+
+	if err := w.WriteMsg(m); err != nil {
+		s.logger.Debug("failed to write DNS response", "error", err)
+	}
+}
+
+// answer sets the answer of the question on m.
+func (s *Server) answer(m *dns.Msg, q dns.Question) {
+	name := strings.ToLower(q.Name)
+	if !dns.IsSubDomain(s.domain, name) {
+		m.Rcode = dns.RcodeRefused
+		return
+	}
+
+	labels := dns.SplitDomainName(strings.TrimSuffix(name, s.domain))
+	switch {
+	case len(labels) == 2 && labels[1] == "addr":
+		s.answerAddr(m, q, labels[0])
+		return
+
+	case len(labels) >= 2:
+		var namespace string
+		var rest []string
+		switch {
+		case labels[len(labels)-1] == "service":
+			namespace, rest = structs.DefaultNamespace, labels[:len(labels)-1]
+		case labels[len(labels)-2] == "service":
+			namespace, rest = labels[len(labels)-1], labels[:len(labels)-2]
+		}
+
+		switch len(rest) {
+		case 1:
+			s.answerService(m, q, namespace, rest[0], "")
+			return
+		case 2:
+			s.answerService(m, q, namespace, rest[1], rest[0])
+			return
+		}
+	}
+
+	s.nameError(m)
+}
+
+// answerService answers a query for the healthy instances of a service.
+func (s *Server) answerService(m *dns.Msg, q dns.Question, namespace, service, tag string) {
+	registrations, err := s.cache.get(namespace, service)
+	if errors.Is(err, errNoAllocs) {
+		s.logger.Debug("refused lookup of service", "namespace", namespace,
+			"service", service, "error", err)
+		m.Rcode = dns.RcodeRefused
+		return
+	} else if err != nil {
+		s.logger.Warn("failed to lookup service", "namespace", namespace,
+			"service", service, "error", err)
+		m.Rcode = dns.RcodeServerFailure
+		return
+	}
+	if len(registrations) == 0 {
+		s.nameError(m)
+		return
+	}
+
+	registrations = slices.DeleteFunc(slices.Clone(registrations), func(r *structs.ServiceRegistration) bool {
+		return !r.Healthy() || (tag != "" && !slices.Contains(r.Tags, tag))
+	})
+	rand.Shuffle(len(registrations), func(i, j int) {
+		registrations[i], registrations[j] = registrations[j], registrations[i]
+	})
+
+	seen := make(map[string]struct{})
+	for _, r := range registrations {
+		if q.Qtype != dns.TypeSRV {
+			// Dual-stack registrations answer both A and AAAA queries.
+			for _, addr := range []string{r.Address, r.DualStackAddress} {
+				ip := net.ParseIP(addr)
+				if ip == nil {
+					continue
+				}
+				if _, ok := seen[ip.String()]; ok {
+					continue
+				}
+				seen[ip.String()] = struct{}{}
+				m.Answer = append(m.Answer, s.addrRecords(q.Name, q.Qtype, ip)...)
+			}
+			continue
+		}
+
+		ip := net.ParseIP(r.Address)
+		target := dns.Fqdn(r.Address)
+		if ip != nil {
+			target = fmt.Sprintf("%s.addr.%s", hex.EncodeToString(ipBytes(ip)), s.domain)
+		}
+		m.Answer = append(m.Answer, &dns.SRV{
+			Hdr:      s.header(q.Name, dns.TypeSRV),
+			Priority: 1,
+			Weight:   1,
+			Port:     uint16(r.Port),
+			Target:   target,
+		})
+		if _, ok := seen[target]; !ok && ip != nil {
+			seen[target] = struct{}{}
+			m.Extra = append(m.Extra, s.addrRecords(target, dns.TypeANY, ip)...)
+		}
+	}
+
+	if len(m.Answer) == 0 {
+		m.Ns = append(m.Ns, s.soa())
+	}
+}
+
+// answerAddr answers a query for a name of the form <hex-ip>.addr.<domain>.
+func (s *Server) answerAddr(m *dns.Msg, q dns.Question, label string) {
+	b, err := hex.DecodeString(label)
+	if err != nil || (len(b) != net.IPv4len && len(b) != net.IPv6len) {
+		s.nameError(m)
+		return
+	}
+
+	m.Answer = s.addrRecords(q.Name, q.Qtype, net.IP(b))
+	if len(m.Answer) == 0 {
+		m.Ns = append(m.Ns, s.soa())
+	}
+}
+
+// addrRecords returns the A or AAAA record of ip, if it matches the query
+// type.
+func (s *Server) addrRecords(name string, qtype uint16, ip net.IP) []dns.RR {
+	if ip4 := ip.To4(); ip4 != nil {
+		if qtype == dns.TypeA || qtype == dns.TypeANY {
+			return []dns.RR{&dns.A{Hdr: s.header(name, dns.TypeA), A: ip4}}
+		}
+		return nil
+	}
+	if qtype == dns.TypeAAAA || qtype == dns.TypeANY {
+		return []dns.RR{&dns.AAAA{Hdr: s.header(name, dns.TypeAAAA), AAAA: ip}}
+	}
+	return nil
+}
+
+func (s *Server) header(name string, rrtype uint16) dns.RR_Header {
+	return dns.RR_Header{Name: name, Rrtype: rrtype, Class: dns.ClassINET, Ttl: s.ttl}
+}
+
+// nameError sets m to the answer for a name which does not exist.
+func (s *Server) nameError(m *dns.Msg) {
+	m.Rcode = dns.RcodeNameError
+	m.Ns = append(m.Ns, s.soa())
+}
+
+// soa returns the SOA record of the domain, used for negative caching.
+func (s *Server) soa() dns.RR {
+	return &dns.SOA{
+		Hdr:     s.header(s.domain, dns.TypeSOA),
+		Ns:      "ns." + s.domain,
+		Mbox:    "hostmaster." + s.domain,
+		Serial:  1,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  s.ttl,
+	}
+}
+
+// ipBytes returns the 4 byte form of IPv4 addresses and the 16 byte form of
+// IPv6 addresses.
+func ipBytes(ip net.IP) []byte {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip
+}
@@ -0,0 +1,483 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package servicedns
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/miekg/dns"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+// testAuthToken returns a workload identity for every namespace but
+// "noallocs", which has no allocations on the node.
+func testAuthToken(namespace string) string {
+	if namespace == "noallocs" {
+		return ""
+	}
+	return namespace + "-identity"
+}
+
+// mockRPC serves the service registrations of GetService RPCs authenticated
+// with the workload identity of their namespace.
+type mockRPC struct {
+	lock     sync.Mutex
+	services []*structs.ServiceRegistration
+	index    uint64
+	err      error
+	calls    int
+}
+
+func (m *mockRPC) RPC(method string, args, reply any) error {
+	if method != structs.ServiceRegistrationGetServiceRPCMethod {
+		return fmt.Errorf("unexpected RPC method: %v", method)
+	}
+	req := args.(*structs.ServiceRegistrationByNameRequest)
+	resp := reply.(*structs.ServiceRegistrationByNameResponse)
+	if req.AuthToken != testAuthToken(req.Namespace) {
+		return structs.ErrPermissionDenied
+	}
+
+	m.lock.Lock()
+	m.calls++
+	for m.err == nil && req.MinQueryIndex > 0 && req.MinQueryIndex >= m.index {
+		// Emulate a blocking query without changes.
+		m.lock.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		m.lock.Lock()
+	}
+	defer m.lock.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	for _, s := range m.services {
+		if s.Namespace == req.Namespace && s.ServiceName == req.ServiceName {
+			resp.Services = append(resp.Services, s.Copy())
+		}
+	}
+	resp.Index = m.index
+	return nil
+}
+
+func (m *mockRPC) set(services ...*structs.ServiceRegistration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.services = services
+	m.index++
+}
+
+func registration(namespace, name, address string, port int, status structs.CheckStatus, tags ...string) *structs.ServiceRegistration {
+	return &structs.ServiceRegistration{
+		ID:          fmt.Sprintf("%s-%s-%s-%d", namespace, name, address, port),
+		ServiceName: name,
+		Namespace:   namespace,
+		Address:     address,
+		Port:        port,
+		Tags:        tags,
+		CheckStatus: status,
+	}
+}
+
+func dualStack(r *structs.ServiceRegistration, address string) *structs.ServiceRegistration {
+	r.DualStackAddress = address
+	return r
+}
+
+func testServer(t *testing.T, rpc *mockRPC) *Server {
+	s, err := NewServer(testlog.HCLogger(t), &Config{
+		DNS: &config.DNSConfig{
+			Address: "127.0.0.1",
+			Port:    0,
+			Domain:  "nomad",
+			TTL:     5 * time.Second,
+		},
+		Region:    "global",
+		RPCFn:     rpc.RPC,
+		AuthToken: testAuthToken,
+	})
+	must.NoError(t, err)
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func query(t *testing.T, s *Server, name string, qtype uint16) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	in, _, err := new(dns.Client).Exchange(m, s.Addr().String())
+	must.NoError(t, err)
+	return in
+}
+
+// answers returns the answers of m in their textual form, sorted.
+func answers(rrs []dns.RR) []string {
+	result := make([]string, 0, len(rrs))
+	for _, rr := range rrs {
+		result = append(result, rr.String())
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestServer_Service(t *testing.T) {
+	ci.Parallel(t)
+
+	rpc := new(mockRPC)
+	rpc.set(
+		registration("default", "web", "10.0.0.1", 8080, structs.CheckSuccess, "v1"),
+		registration("default", "web", "10.0.0.2", 8080, "", "v2"),
+		registration("default", "web", "10.0.0.3", 8080, structs.CheckFailure, "v1"),
+		registration("default", "web", "10.0.0.4", 8080, structs.CheckPending, "v1"),
+		registration("default", "web", "fd00::1", 9090, structs.CheckSuccess),
+		registration("default", "down", "10.0.0.5", 8080, structs.CheckFailure),
+		registration("platform", "web", "10.1.0.1", 80, structs.CheckSuccess),
+		dualStack(registration("default", "dual", "10.0.1.1", 80, structs.CheckSuccess), "fd00::2"),
+	)
+	s := testServer(t, rpc)
+
+	testCases := []struct {
+		name     string
+		qname    string
+		qtype    uint16
+		rcode    int
+		expected []string
+		extra    []string
+	}{
+		{
+			name:  "A excludes unhealthy",
+			qname: "web.service.nomad.",
+			qtype: dns.TypeA,
+			rcode: dns.RcodeSuccess,
+			expected: []string{
+				"web.service.nomad.\t5\tIN\tA\t10.0.0.1",
+				"web.service.nomad.\t5\tIN\tA\t10.0.0.2",
+			},
+		},
+		{
+			name:     "AAAA",
+			qname:    "web.service.nomad.",
+			qtype:    dns.TypeAAAA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"web.service.nomad.\t5\tIN\tAAAA\tfd00::1"},
+		},
+		{
+			name:     "A dual-stack",
+			qname:    "dual.service.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"dual.service.nomad.\t5\tIN\tA\t10.0.1.1"},
+		},
+		{
+			name:     "AAAA dual-stack",
+			qname:    "dual.service.nomad.",
+			qtype:    dns.TypeAAAA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"dual.service.nomad.\t5\tIN\tAAAA\tfd00::2"},
+		},
+		{
+			name:     "tag",
+			qname:    "v1.web.service.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"v1.web.service.nomad.\t5\tIN\tA\t10.0.0.1"},
+		},
+		{
+			name:     "namespace",
+			qname:    "web.service.platform.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"web.service.platform.nomad.\t5\tIN\tA\t10.1.0.1"},
+		},
+		{
+			name:     "explicit default namespace is case insensitive",
+			qname:    "WEB.service.default.Nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"WEB.service.default.Nomad.\t5\tIN\tA\t10.0.0.1", "WEB.service.default.Nomad.\t5\tIN\tA\t10.0.0.2"},
+		},
+		{
+			name:  "SRV",
+			qname: "web.service.platform.nomad.",
+			qtype: dns.TypeSRV,
+			rcode: dns.RcodeSuccess,
+			expected: []string{
+				"web.service.platform.nomad.\t5\tIN\tSRV\t1 1 80 0a010001.addr.nomad.",
+			},
+			extra: []string{"0a010001.addr.nomad.\t5\tIN\tA\t10.1.0.1"},
+		},
+		{
+			name:     "no healthy instances",
+			qname:    "down.service.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{},
+		},
+		{
+			name:     "unknown service",
+			qname:    "api.service.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeNameError,
+			expected: []string{},
+		},
+		{
+			name:     "invalid name",
+			qname:    "web.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeNameError,
+			expected: []string{},
+		},
+		{
+			name:     "other domain",
+			qname:    "example.com.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeRefused,
+			expected: []string{},
+		},
+		{
+			name:     "addr",
+			qname:    "0a000001.addr.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"0a000001.addr.nomad.\t5\tIN\tA\t10.0.0.1"},
+		},
+		{
+			name:     "addr ipv6",
+			qname:    "fd000000000000000000000000000001.addr.nomad.",
+			qtype:    dns.TypeAAAA,
+			rcode:    dns.RcodeSuccess,
+			expected: []string{"fd000000000000000000000000000001.addr.nomad.\t5\tIN\tAAAA\tfd00::1"},
+		},
+		{
+			name:     "invalid addr",
+			qname:    "0a00.addr.nomad.",
+			qtype:    dns.TypeA,
+			rcode:    dns.RcodeNameError,
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := query(t, s, tc.qname, tc.qtype)
+			must.Eq(t, tc.rcode, in.Rcode)
+			must.True(t, in.Authoritative)
+			must.Eq(t, tc.expected, answers(in.Answer))
+			if tc.extra != nil {
+				must.Eq(t, tc.extra, answers(in.Extra))
+			}
+			if len(in.Answer) == 0 && tc.rcode != dns.RcodeRefused {
+				must.Len(t, 1, in.Ns)
+				must.Eq(t, dns.TypeSOA, in.Ns[0].Header().Rrtype)
+			}
+		})
+	}
+}
+
+func TestServer_ServiceUpdates(t *testing.T) {
+	ci.Parallel(t)
+
+	healthy := registration("default", "web", "10.0.0.1", 8080, structs.CheckSuccess)
+	rpc := new(mockRPC)
+	rpc.set(healthy)
+	s := testServer(t, rpc)
+
+	in := query(t, s, "web.service.nomad.", dns.TypeA)
+	must.Len(t, 1, in.Answer)
+
+	// The cache is updated by the blocking query once the check fails.
+	failing := healthy.Copy()
+	failing.CheckStatus = structs.CheckFailure
+	rpc.set(failing)
+
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			in := query(t, s, "web.service.nomad.", dns.TypeA)
+			return in.Rcode == dns.RcodeSuccess && len(in.Answer) == 0
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(20*time.Millisecond),
+	))
+}
+
+func TestServer_ServiceError(t *testing.T) {
+	ci.Parallel(t)
+
+	rpc := &mockRPC{err: errors.New("no servers")}
+	s := testServer(t, rpc)
+
+	in := query(t, s, "web.service.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeServerFailure, in.Rcode)
+}
+
+func TestServer_ServiceNoAllocs(t *testing.T) {
+	ci.Parallel(t)
+
+	rpc := new(mockRPC)
+	rpc.set(registration("noallocs", "web", "10.0.0.1", 8080, ""))
+	s := testServer(t, rpc)
+
+	// namespaces without allocations on the node are not answered for
+	in := query(t, s, "web.service.noallocs.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeRefused, in.Rcode)
+	must.Eq(t, 0, rpc.calls)
+}
+
+func TestServiceCache_NamespaceStopped(t *testing.T) {
+	ci.Parallel(t)
+
+	rpc := new(mockRPC)
+	rpc.set(registration("default", "web", "10.0.0.1", 8080, ""))
+
+	var stopped atomic.Bool
+	shutdownCh := make(chan struct{})
+	t.Cleanup(func() { close(shutdownCh) })
+
+	c := newServiceCache(testlog.HCLogger(t), &Config{
+		RPCFn: rpc.RPC,
+		AuthToken: func(namespace string) string {
+			if stopped.Load() {
+				return ""
+			}
+			return testAuthToken(namespace)
+		},
+	}, shutdownCh)
+	c.queryTime = 10 * time.Millisecond
+
+	services, err := c.get("default", "web")
+	must.NoError(t, err)
+	must.Len(t, 1, services)
+
+	// once the last alloc of the namespace stops, its services are no longer
+	// served nor watched
+	stopped.Store(true)
+	_, err = c.get("default", "web")
+	must.ErrorIs(t, err, errNoAllocs)
+
+	rpc.set(registration("default", "web", "10.0.0.2", 8080, ""))
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			c.lock.Lock()
+			defer c.lock.Unlock()
+			return len(c.entries) == 0
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+}
+
+func TestServiceCache_Expiry(t *testing.T) {
+	ci.Parallel(t)
+
+	rpc := new(mockRPC)
+	rpc.set(registration("default", "web", "10.0.0.1", 8080, ""))
+
+	shutdownCh := make(chan struct{})
+	t.Cleanup(func() { close(shutdownCh) })
+
+	c := newServiceCache(testlog.HCLogger(t), &Config{
+		RPCFn:     rpc.RPC,
+		AuthToken: testAuthToken,
+	}, shutdownCh)
+	c.expiry = 0
+	c.queryTime = 10 * time.Millisecond
+
+	services, err := c.get("default", "web")
+	must.NoError(t, err)
+	must.Len(t, 1, services)
+
+	// Wake up the blocking query; the service was not looked up since, so
+	// it is removed from the cache.
+	rpc.set(registration("default", "web", "10.0.0.2", 8080, ""))
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			c.lock.Lock()
+			defer c.lock.Unlock()
+			return len(c.entries) == 0
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+}
+
+func testServiceCache(t *testing.T, rpc *mockRPC) *serviceCache {
+	shutdownCh := make(chan struct{})
+	t.Cleanup(func() { close(shutdownCh) })
+
+	return newServiceCache(testlog.HCLogger(t), &Config{
+		RPCFn:     rpc.RPC,
+		AuthToken: testAuthToken,
+	}, shutdownCh)
+}
+
+func TestServiceCache_Negative(t *testing.T) {
+	ci.Parallel(t)
+
+	rpc := new(mockRPC)
+	c := testServiceCache(t, rpc)
+	c.negativeTTL = 50 * time.Millisecond
+
+	// A missing service is read once and cached without being watched.
+	for range 3 {
+		services, err := c.get("default", "web")
+		must.NoError(t, err)
+		must.SliceEmpty(t, services)
+	}
+	rpc.lock.Lock()
+	must.Eq(t, 1, rpc.calls)
+	rpc.lock.Unlock()
+
+	// Once registered, the service is found after the negative TTL.
+	rpc.set(registration("default", "web", "10.0.0.1", 8080, ""))
+	time.Sleep(60 * time.Millisecond)
+	services, err := c.get("default", "web")
+	must.NoError(t, err)
+	must.Len(t, 1, services)
+}
+
+func TestServiceCache_MaxEntries(t *testing.T) {
+	ci.Parallel(t)
+
+	rpc := new(mockRPC)
+	rpc.set(
+		registration("default", "web", "10.0.0.1", 8080, ""),
+		registration("default", "api", "10.0.0.2", 8080, ""),
+		registration("default", "db", "10.0.0.3", 8080, ""),
+	)
+	c := testServiceCache(t, rpc)
+	c.maxEntries = 2
+
+	for _, name := range []string{"web", "api", "web", "db"} {
+		_, err := c.get("default", name)
+		must.NoError(t, err)
+	}
+
+	// api is the least recently looked up service, so it was evicted.
+	c.lock.Lock()
+	must.MapLen(t, 2, c.entries)
+	must.MapContainsKeys(t, c.entries, []serviceKey{
+		{namespace: "default", name: "web"},
+		{namespace: "default", name: "db"},
+	})
+	c.lock.Unlock()
+
+	for range 100 {
+		_, err := c.get("default", uuid.Generate())
+		must.NoError(t, err)
+	}
+	c.lock.Lock()
+	must.MapLen(t, 2, c.entries)
+	c.lock.Unlock()
+}
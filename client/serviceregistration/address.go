@@ -185,3 +185,43 @@ func getAddressPort(addressMode string, netStatus *structs.AllocNetworkStatus, p
 
 	return netStatus.Address, port, nil
 }
+
+// GetDualStackAddress returns the address of the other IP family than the
+// address returned by GetAddress for the same service or check registration,
+// if the allocation is dual-stack. Custom advertise addresses and driver
+// networks are never dual-stack, so an empty string is returned for them.
+func GetDualStackAddress(
+	address, // custom address, if set
+	addressMode,
+	portLabel string,
+	driverNet *drivers.DriverNetwork,
+	ports structs.AllocatedPorts,
+	netStatus *structs.AllocNetworkStatus,
+) string {
+	if address != "" {
+		return ""
+	}
+
+	switch addressMode {
+	case structs.AddressModeAuto:
+		if driverNet.Advertise() {
+			return ""
+		}
+		return GetDualStackAddress("", structs.AddressModeHost, portLabel, driverNet, ports, netStatus)
+	case structs.AddressModeHost:
+		if mapping, ok := ports.Get(portLabel); ok {
+			return mapping.DualStackHostIP
+		}
+	case structs.AddressModeAlloc, structs.AddressModeAllocIPv6:
+		// Address falls back to AddressIPv6 if the allocation has no IPv4
+		// address, in which case it isn't dual-stack
+		if netStatus == nil || netStatus.AddressIPv6 == "" || netStatus.Address == netStatus.AddressIPv6 {
+			return ""
+		}
+		if addressMode == structs.AddressModeAllocIPv6 {
+			return netStatus.Address
+		}
+		return netStatus.AddressIPv6
+	}
+	return ""
+}
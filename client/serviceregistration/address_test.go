@@ -452,3 +452,80 @@ func Test_GetAddress(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetDualStackAddress(t *testing.T) {
+	ports := structs.AllocatedPorts{
+		{Label: "http", Value: 8080, HostIP: "192.168.1.10", DualStackHostIP: "2001:db8::10"},
+		{Label: "db", Value: 5432, HostIP: "192.168.1.10"},
+	}
+	dualStack := &structs.AllocNetworkStatus{Address: "172.26.64.2", AddressIPv6: "fd00:a110:c8::2"}
+	ipv6Only := &structs.AllocNetworkStatus{Address: "fd00:a110:c8::2", AddressIPv6: "fd00:a110:c8::2"}
+
+	testCases := []struct {
+		name      string
+		advertise string
+		mode      string
+		portLabel string
+		driver    *drivers.DriverNetwork
+		status    *structs.AllocNetworkStatus
+		exp       string
+	}{
+		{
+			name:      "auto host",
+			mode:      structs.AddressModeAuto,
+			portLabel: "http",
+			exp:       "2001:db8::10",
+		},
+		{
+			name:      "auto driver",
+			mode:      structs.AddressModeAuto,
+			portLabel: "http",
+			driver:    &drivers.DriverNetwork{IP: "10.1.2.3", AutoAdvertise: true},
+		},
+		{
+			name:      "custom address",
+			advertise: "example.com",
+			mode:      structs.AddressModeAuto,
+			portLabel: "http",
+		},
+		{
+			name:      "host single stack",
+			mode:      structs.AddressModeHost,
+			portLabel: "db",
+		},
+		{
+			name:      "alloc",
+			mode:      structs.AddressModeAlloc,
+			portLabel: "http",
+			status:    dualStack,
+			exp:       "fd00:a110:c8::2",
+		},
+		{
+			name:   "alloc ipv6",
+			mode:   structs.AddressModeAllocIPv6,
+			status: dualStack,
+			exp:    "172.26.64.2",
+		},
+		{
+			name:   "alloc ipv6 only",
+			mode:   structs.AddressModeAlloc,
+			status: ipv6Only,
+		},
+		{
+			name:   "alloc ipv4 only",
+			mode:   structs.AddressModeAllocIPv6,
+			status: &structs.AllocNetworkStatus{Address: "172.26.64.2"},
+		},
+		{
+			name: "alloc no status",
+			mode: structs.AddressModeAlloc,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, GetDualStackAddress(
+				tc.advertise, tc.mode, tc.portLabel, tc.driver, ports, tc.status))
+		})
+	}
+}
@@ -21,6 +21,10 @@ import (
 	"github.com/hashicorp/nomad/client/serviceregistration"
 	"github.com/hashicorp/nomad/helper/useragent"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"oss.indeed.com/go/libtime"
 )
 
@@ -36,7 +40,8 @@ type Checker interface {
 	Do(context.Context, *QueryContext, *Query) *structs.CheckQueryResult
 }
 
-// New creates a new Checker capable of executing HTTP and TCP checks.
+// New creates a new Checker capable of executing HTTP, TCP, gRPC and script
+// checks.
 func New(log hclog.Logger) Checker {
 	httpClient := cleanhttp.DefaultPooledClient()
 	httpClient.Timeout = maxTimeoutHTTP
@@ -65,8 +70,12 @@ func (c *checker) Do(ctx context.Context, qc *QueryContext, q *Query) *structs.C
 	defer cancel()
 
 	switch q.Type {
-	case "http":
+	case structs.ServiceCheckHTTP:
 		qr = c.checkHTTP(timeout, qc, q)
+	case structs.ServiceCheckGRPC:
+		qr = c.checkGRPC(timeout, qc, q)
+	case structs.ServiceCheckScript:
+		qr = c.checkScript(timeout, qc, q)
 	default:
 		qr = c.checkTCP(timeout, qc, q)
 	}
@@ -234,9 +243,124 @@ func (c *checker) checkHTTP(ctx context.Context, qc *QueryContext, q *Query) *st
 	return qr
 }
 
+func (c *checker) checkGRPC(ctx context.Context, qc *QueryContext, q *Query) *structs.CheckQueryResult {
+	qr := &structs.CheckQueryResult{
+		Mode:      q.Mode,
+		Timestamp: c.now(),
+		Status:    structs.CheckPending,
+	}
+
+	addr, err := address(qc, q)
+	if err != nil {
+		qr.Output = err.Error()
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	creds := insecure.NewCredentials()
+	if q.GRPCUseTLS {
+		creds = credentials.NewTLS(&tls.Config{
+			ServerName:         q.TLSServerName,
+			InsecureSkipVerify: q.TLSSkipVerify,
+		})
+	}
+
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUserAgent(useragent.String()),
+	)
+	if err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// follow the grpc.health.v1 protocol; an empty service name queries the
+	// overall health of the server
+	result, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{
+		Service: q.GRPCService,
+	})
+	if err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	if status := result.GetStatus(); status != healthpb.HealthCheckResponse_SERVING {
+		qr.Output = fmt.Sprintf("nomad: grpc status %s", status)
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	qr.Output = "nomad: grpc ok"
+	qr.Status = structs.CheckSuccess
+	return qr
+}
+
+// scriptResult is the result of a script check execution.
+type scriptResult struct {
+	output []byte
+	code   int
+	err    error
+}
+
+func (c *checker) checkScript(ctx context.Context, qc *QueryContext, q *Query) *structs.CheckQueryResult {
+	qr := &structs.CheckQueryResult{
+		Mode:      q.Mode,
+		Timestamp: c.now(),
+		Status:    structs.CheckPending,
+	}
+
+	// the task must be running for the script to be executed in its context,
+	// so the check remains pending until then
+	var exec ScriptExecutor
+	if qc.ScriptExecutor != nil {
+		exec = qc.ScriptExecutor(q.TaskName)
+	}
+	if exec == nil {
+		qr.Output = fmt.Sprintf("nomad: waiting for task %q to run script", q.TaskName)
+		return qr
+	}
+
+	// the executor has its own timeout, but cannot be interrupted; run it in
+	// the background so stopping the check does not wait for the script
+	resultCh := make(chan scriptResult, 1)
+	go func() {
+		output, code, err := exec.Exec(q.Timeout, q.Command, q.Args)
+		resultCh <- scriptResult{output: output, code: code, err: err}
+	}()
+
+	var result scriptResult
+	select {
+	case <-ctx.Done():
+		qr.Output = fmt.Sprintf("nomad: %s", ctx.Err().Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	case result = <-resultCh:
+	}
+
+	if result.err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", result.err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	// nomad checks do not have warnings, so any non-zero exit code fails
+	qr.Output = limitRead(bytes.NewReader(result.output))
+	if result.code == 0 {
+		qr.Status = structs.CheckSuccess
+	} else {
+		qr.Status = structs.CheckFailure
+	}
+	return qr
+}
+
 const (
 	// outputSizeLimit is the maximum number of bytes to read and store of an http
-	// check output. Set to 3kb which fits in 1 page with room for other fields.
+	// or script check output. Set to 3kb which fits in 1 page with room for other fields.
 	outputSizeLimit = 3 * 1024
 )
 
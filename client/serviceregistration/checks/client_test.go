@@ -21,6 +21,9 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/shoenig/test/must"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"oss.indeed.com/go/libtime/libtimetest"
 )
 
@@ -616,3 +619,187 @@ func tcpServer(t *testing.T, ctx context.Context, port int) {
 		}
 	}()
 }
+
+func TestChecker_Do_GRPC(t *testing.T) {
+	ci.Parallel(t)
+
+	// create a grpc server implementing the grpc.health.v1 protocol
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	must.NoError(t, err)
+
+	hs := health.NewServer()
+	hs.SetServingStatus("ok", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("down", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	go func() {
+		_ = srv.Serve(l)
+	}()
+	t.Cleanup(srv.Stop)
+
+	addr, port, err := net.SplitHostPort(l.Addr().String())
+	must.NoError(t, err)
+
+	now := time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC)
+	clock := libtimetest.NewClockMock(t).NowMock.Return(now)
+
+	qc := &QueryContext{
+		ID:               "abc123",
+		CustomAddress:    addr,
+		ServicePortLabel: port,
+		NetworkStatus:    mock.NewNetworkStatus(addr),
+		Group:            "group",
+		Task:             "task",
+		Service:          "service",
+		Check:            "check",
+	}
+
+	makeQuery := func(service string, useTLS bool) *Query {
+		return &Query{
+			Mode:        structs.Healthiness,
+			Type:        "grpc",
+			Timeout:     time.Second,
+			AddressMode: "auto",
+			PortLabel:   port,
+			GRPCService: service,
+			GRPCUseTLS:  useTLS,
+		}
+	}
+
+	cases := []struct {
+		name      string
+		q         *Query
+		expStatus structs.CheckStatus
+		expOutput string
+	}{{
+		name:      "server ok",
+		q:         makeQuery("", false),
+		expStatus: structs.CheckSuccess,
+		expOutput: "nomad: grpc ok",
+	}, {
+		name:      "service ok",
+		q:         makeQuery("ok", false),
+		expStatus: structs.CheckSuccess,
+		expOutput: "nomad: grpc ok",
+	}, {
+		name:      "service not serving",
+		q:         makeQuery("down", false),
+		expStatus: structs.CheckFailure,
+		expOutput: "nomad: grpc status NOT_SERVING",
+	}, {
+		name:      "service unknown",
+		q:         makeQuery("unknown", false),
+		expStatus: structs.CheckFailure,
+		expOutput: "nomad: rpc error: code = NotFound desc = unknown service",
+	}, {
+		name:      "tls to plaintext server",
+		q:         makeQuery("ok", true),
+		expStatus: structs.CheckFailure,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := New(testlog.HCLogger(t))
+			c.(*checker).clock = clock
+
+			result := c.Do(t.Context(), qc, tc.q)
+			must.Eq(t, tc.expStatus, result.Status)
+			must.Eq(t, now.Unix(), result.Timestamp)
+			must.Eq(t, "service", result.Service)
+			if tc.expOutput != "" {
+				must.Eq(t, tc.expOutput, result.Output)
+			}
+		})
+	}
+}
+
+type mockScriptExecutor struct {
+	output string
+	code   int
+	err    error
+	delay  time.Duration
+
+	cmd  string
+	args []string
+}
+
+func (m *mockScriptExecutor) Exec(timeout time.Duration, cmd string, args []string) ([]byte, int, error) {
+	m.cmd, m.args = cmd, args
+	time.Sleep(m.delay)
+	return []byte(m.output), m.code, m.err
+}
+
+func TestChecker_Do_Script(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC)
+	clock := libtimetest.NewClockMock(t).NowMock.Return(now)
+
+	query := &Query{
+		Mode:     structs.Healthiness,
+		Type:     "script",
+		Timeout:  100 * time.Millisecond,
+		Command:  "/bin/check",
+		Args:     []string{"-v"},
+		TaskName: "web",
+	}
+
+	cases := []struct {
+		name      string
+		exec      *mockScriptExecutor
+		expStatus structs.CheckStatus
+		expOutput string
+	}{{
+		name:      "task not running",
+		expStatus: structs.CheckPending,
+		expOutput: `nomad: waiting for task "web" to run script`,
+	}, {
+		name:      "exit zero",
+		exec:      &mockScriptExecutor{output: "all good"},
+		expStatus: structs.CheckSuccess,
+		expOutput: "all good",
+	}, {
+		name:      "exit non-zero",
+		exec:      &mockScriptExecutor{output: "degraded", code: 1},
+		expStatus: structs.CheckFailure,
+		expOutput: "degraded",
+	}, {
+		name:      "exec error",
+		exec:      &mockScriptExecutor{err: fmt.Errorf("no such file")},
+		expStatus: structs.CheckFailure,
+		expOutput: "nomad: no such file",
+	}, {
+		name:      "timeout",
+		exec:      &mockScriptExecutor{delay: time.Second},
+		expStatus: structs.CheckFailure,
+		expOutput: "nomad: context deadline exceeded",
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := New(testlog.HCLogger(t))
+			c.(*checker).clock = clock
+
+			qc := &QueryContext{
+				ID:   "abc123",
+				Task: "web",
+				ScriptExecutor: func(task string) ScriptExecutor {
+					must.Eq(t, "web", task)
+					if tc.exec == nil {
+						return nil
+					}
+					return tc.exec
+				},
+			}
+
+			result := c.Do(t.Context(), qc, query)
+			must.Eq(t, tc.expStatus, result.Status)
+			must.Eq(t, tc.expOutput, result.Output)
+			if tc.exec != nil && tc.exec.delay == 0 {
+				must.Eq(t, "/bin/check", tc.exec.cmd)
+				must.Eq(t, []string{"-v"}, tc.exec.args)
+			}
+		})
+	}
+}
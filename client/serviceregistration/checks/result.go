@@ -6,6 +6,7 @@ package checks
 import (
 	"maps"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -29,6 +30,12 @@ func GetCheckQuery(c *structs.ServiceCheck) *Query {
 		Headers:       maps.Clone(c.Header),
 		Body:          c.Body,
 		TLSSkipVerify: c.TLSSkipVerify,
+		TLSServerName: c.TLSServerName,
+		GRPCService:   c.GRPCService,
+		GRPCUseTLS:    c.GRPCUseTLS,
+		Command:       c.Command,
+		Args:          slices.Clone(c.Args),
+		TaskName:      c.TaskName,
 	}
 }
 
@@ -36,7 +43,7 @@ func GetCheckQuery(c *structs.ServiceCheck) *Query {
 // amount of information needed to actually execute that check.
 type Query struct {
 	Mode structs.CheckMode // readiness or healthiness
-	Type string            // tcp or http
+	Type string            // tcp, http, grpc or script
 
 	Timeout time.Duration // connection / request timeout
 
@@ -48,7 +55,15 @@ type Query struct {
 	Method        string      // http checks only
 	Headers       http.Header // http checks only
 	Body          string      // http checks only
-	TLSSkipVerify bool        // http checks only, https protocol
+	TLSSkipVerify bool        // http and grpc checks only, https protocol or grpc tls
+	TLSServerName string      // grpc checks only, grpc tls
+
+	GRPCService string // grpc checks only
+	GRPCUseTLS  bool   // grpc checks only
+
+	Command  string   // script checks only
+	Args     []string // script checks only
+	TaskName string   // script checks only, the task to execute in
 }
 
 // A QueryContext contains allocation and service parameters necessary for
@@ -65,6 +80,16 @@ type QueryContext struct {
 	Task    string
 	Service string
 	Check   string
+
+	// ScriptExecutor returns the executor of the task, or nil if the task
+	// isn't running. Only used by script checks.
+	ScriptExecutor func(task string) ScriptExecutor
+}
+
+// ScriptExecutor executes the command of a script check in the context of a
+// task, through its task driver.
+type ScriptExecutor interface {
+	Exec(timeout time.Duration, cmd string, args []string) ([]byte, int, error)
 }
 
 // Stub creates a temporary QueryResult for the check of ID in the Pending state
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package nsd
+
+import (
+	"time"
+
+	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// trackedRegistration is a service registration along with the IDs of the
+// healthiness checks which make up its check status.
+type trackedRegistration struct {
+	registration *structs.ServiceRegistration
+	checkIDs     []string
+}
+
+// trackRegistrations sets the check status of each registration of the
+// workload and returns them for tracking.
+func (s *ServiceRegistrationHandler) trackRegistrations(
+	workload *serviceregistration.WorkloadServices,
+	registrations []*structs.ServiceRegistration) []*trackedRegistration {
+
+	if s.cfg.CheckStatusGetter == nil {
+		return nil
+	}
+
+	statuses, err := s.cfg.CheckStatusGetter.Get()
+	if err != nil {
+		s.log.Warn("failed to get check statuses", "error", err)
+	}
+
+	tracked := make([]*trackedRegistration, 0, len(registrations))
+	for i, service := range workload.Services {
+		t := &trackedRegistration{registration: registrations[i]}
+		for _, check := range service.Checks {
+			if structs.GetCheckMode(check) != structs.Healthiness {
+				continue
+			}
+			checkID := structs.NomadCheckID(workload.AllocInfo.AllocID, workload.AllocInfo.Group, check)
+			t.checkIDs = append(t.checkIDs, string(checkID))
+		}
+		t.registration.CheckStatus = aggregateCheckStatus(t.checkIDs, statuses)
+		tracked = append(tracked, t)
+	}
+	return tracked
+}
+
+// syncCheckStatus periodically compares the status of the checks of each
+// tracked registration with its registered check status, and upserts the
+// registrations which have changed.
+func (s *ServiceRegistrationHandler) syncCheckStatus(interval time.Duration) {
+	timer, stop := helper.NewSafeTimer(interval)
+	defer stop()
+
+	for {
+		select {
+		case <-s.shutDownCh:
+			return
+		case <-timer.C:
+		}
+
+		if err := s.updateCheckStatus(); err != nil {
+			s.log.Warn("failed to update service registration check status", "error", err)
+		}
+		timer.Reset(interval)
+	}
+}
+
+// updateCheckStatus upserts the tracked registrations whose check status has
+// changed.
+func (s *ServiceRegistrationHandler) updateCheckStatus() error {
+	statuses, err := s.cfg.CheckStatusGetter.Get()
+	if err != nil {
+		return err
+	}
+
+	// Hold the lock across the RPC, so a concurrent removal can't be undone
+	// by upserting the registration again.
+	s.registrationsLock.Lock()
+	defer s.registrationsLock.Unlock()
+
+	var updated []*structs.ServiceRegistration
+	for _, t := range s.registrations {
+		status := aggregateCheckStatus(t.checkIDs, statuses)
+		if status == t.registration.CheckStatus {
+			continue
+		}
+		registration := t.registration.Copy()
+		registration.CheckStatus = status
+		updated = append(updated, registration)
+	}
+	if len(updated) == 0 {
+		return nil
+	}
+
+	args := structs.ServiceRegistrationUpsertRequest{
+		Services: updated,
+		WriteRequest: structs.WriteRequest{
+			Region:    s.cfg.Region,
+			AuthToken: s.authToken(),
+		},
+	}
+	var resp structs.ServiceRegistrationUpsertResponse
+	if err := s.cfg.RPCFn(structs.ServiceRegistrationUpsertRPCMethod, &args, &resp); err != nil {
+		return err
+	}
+
+	for _, registration := range updated {
+		s.registrations[registration.ID].registration = registration
+	}
+	return nil
+}
+
+// aggregateCheckStatus returns the status of a service given the status of
+// its checks: failure if any check is failing, pending if any check has not
+// completed, and success otherwise. Services without checks have no status.
+func aggregateCheckStatus(checkIDs []string, statuses map[string]string) structs.CheckStatus {
+	if len(checkIDs) == 0 {
+		return ""
+	}
+
+	result := structs.CheckSuccess
+	for _, id := range checkIDs {
+		switch structs.CheckStatus(statuses[id]) {
+		case structs.CheckSuccess:
+		case structs.CheckFailure:
+			return structs.CheckFailure
+		default:
+			result = structs.CheckPending
+		}
+	}
+	return result
+}
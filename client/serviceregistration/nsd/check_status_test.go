@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package nsd
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+type mockStatusGetter struct {
+	lock     sync.Mutex
+	statuses map[string]string
+}
+
+func (g *mockStatusGetter) set(checkID string, status structs.CheckStatus) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.statuses[checkID] = string(status)
+}
+
+func (g *mockStatusGetter) Get() (map[string]string, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	result := make(map[string]string, len(g.statuses))
+	for k, v := range g.statuses {
+		result[k] = v
+	}
+	return result, nil
+}
+
+// upsertRecorder records the registrations of the upsert RPCs.
+type upsertRecorder struct {
+	lock     sync.Mutex
+	upserted []*structs.ServiceRegistration
+}
+
+func (r *upsertRecorder) RPC(method string, args, _ any) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if method == structs.ServiceRegistrationUpsertRPCMethod {
+		r.upserted = append(r.upserted, args.(*structs.ServiceRegistrationUpsertRequest).Services...)
+	}
+	return nil
+}
+
+func (r *upsertRecorder) reset() []*structs.ServiceRegistration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	upserted := r.upserted
+	r.upserted = nil
+	return upserted
+}
+
+func TestServiceRegistrationHandler_CheckStatus(t *testing.T) {
+	ci.Parallel(t)
+
+	workload := mockWorkload()
+	checkID := string(structs.NomadCheckID(workload.AllocInfo.AllocID,
+		workload.AllocInfo.Group, workload.Services[1].Checks[0]))
+
+	getter := &mockStatusGetter{statuses: map[string]string{}}
+	rpc := new(upsertRecorder)
+
+	h := NewServiceRegistrationHandler(testlog.HCLogger(t), &ServiceRegistrationHandlerCfg{
+		Enabled:             true,
+		CheckWatcher:        new(mockCheckWatcher),
+		CheckStatusGetter:   getter,
+		CheckStatusInterval: time.Hour,
+		RPCFn:               rpc.RPC,
+	}).(*ServiceRegistrationHandler)
+	t.Cleanup(h.Shutdown)
+
+	// The service without checks has no status, and the service whose check
+	// has not run yet is pending.
+	must.NoError(t, h.RegisterWorkload(workload))
+	upserted := rpc.reset()
+	must.Len(t, 2, upserted)
+	must.Eq(t, "", upserted[0].CheckStatus)
+	must.True(t, upserted[0].Healthy())
+	must.Eq(t, structs.CheckPending, upserted[1].CheckStatus)
+	must.False(t, upserted[1].Healthy())
+
+	// No change results in no RPC.
+	must.NoError(t, h.updateCheckStatus())
+	must.Len(t, 0, rpc.reset())
+
+	// Only the registration whose check status changed is upserted.
+	getter.set(checkID, structs.CheckSuccess)
+	must.NoError(t, h.updateCheckStatus())
+	upserted = rpc.reset()
+	must.Len(t, 1, upserted)
+	must.Eq(t, "redis-http", upserted[0].ServiceName)
+	must.Eq(t, structs.CheckSuccess, upserted[0].CheckStatus)
+
+	getter.set(checkID, structs.CheckFailure)
+	must.NoError(t, h.updateCheckStatus())
+	upserted = rpc.reset()
+	must.Len(t, 1, upserted)
+	must.Eq(t, structs.CheckFailure, upserted[0].CheckStatus)
+
+	// Removed registrations are no longer updated.
+	h.RemoveWorkload(workload)
+	getter.set(checkID, structs.CheckSuccess)
+	must.NoError(t, h.updateCheckStatus())
+	must.Len(t, 0, rpc.reset())
+}
+
+func Test_aggregateCheckStatus(t *testing.T) {
+	ci.Parallel(t)
+
+	statuses := map[string]string{
+		"ok":      string(structs.CheckSuccess),
+		"pending": string(structs.CheckPending),
+		"failing": string(structs.CheckFailure),
+	}
+
+	must.Eq(t, "", aggregateCheckStatus(nil, statuses))
+	must.Eq(t, structs.CheckSuccess, aggregateCheckStatus([]string{"ok"}, statuses))
+	must.Eq(t, structs.CheckPending, aggregateCheckStatus([]string{"ok", "pending"}, statuses))
+	must.Eq(t, structs.CheckPending, aggregateCheckStatus([]string{"ok", "unknown"}, statuses))
+	must.Eq(t, structs.CheckFailure, aggregateCheckStatus([]string{"pending", "failing", "ok"}, statuses))
+}
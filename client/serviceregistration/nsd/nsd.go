@@ -43,6 +43,11 @@ type ServiceRegistrationHandler struct {
 	// processes, such as the RPC retry.
 	shutDownCh chan struct{}
 
+	// registrations tracks the services registered by this handler, so the
+	// status of their checks can be kept up to date, indexed by service ID.
+	registrations     map[string]*trackedRegistration
+	registrationsLock sync.Mutex
+
 	backoffMax     time.Duration
 	backoffInitial time.Duration
 }
@@ -74,6 +79,15 @@ type ServiceRegistrationHandlerCfg struct {
 	// and restarts associated tasks in accordance with their check_restart block.
 	CheckWatcher serviceregistration.CheckWatcher
 
+	// CheckStatusGetter provides the status of the checks of services in the
+	// Nomad service provider. If set, the aggregate status of the checks of
+	// each service is kept up to date on its registration.
+	CheckStatusGetter serviceregistration.CheckStatusGetter
+
+	// CheckStatusInterval is how often the status of checks is compared with
+	// the registrations, defaults to 5s.
+	CheckStatusInterval time.Duration
+
 	// BackoffMax is the maximum amont of time failed RemoveWorkload RPCs will
 	// be retried, defaults to 1s
 	BackoffMax time.Duration
@@ -96,6 +110,7 @@ func NewServiceRegistrationHandler(log hclog.Logger, cfg *ServiceRegistrationHan
 		registrationEnabled: cfg.Enabled,
 		checkWatcher:        cfg.CheckWatcher,
 		shutDownCh:          make(chan struct{}),
+		registrations:       make(map[string]*trackedRegistration),
 		backoffMax:          cfg.BackoffMax,
 		backoffInitial:      cfg.BackoffInitial,
 	}
@@ -105,6 +120,13 @@ func NewServiceRegistrationHandler(log hclog.Logger, cfg *ServiceRegistrationHan
 	if s.backoffMax == 0 {
 		s.backoffMax = time.Second
 	}
+	if cfg.CheckStatusGetter != nil {
+		interval := cfg.CheckStatusInterval
+		if interval == 0 {
+			interval = 5 * time.Second
+		}
+		go s.syncCheckStatus(interval)
+	}
 	return s
 }
 
@@ -143,6 +165,9 @@ func (s *ServiceRegistrationHandler) RegisterWorkload(workload *serviceregistrat
 		return err
 	}
 
+	// Set the initial status of the checks of each registration.
+	tracked := s.trackRegistrations(workload, registrations)
+
 	// Service registrations look ok; startup check watchers as specified. The
 	// astute observer may notice the services are not actually registered yet -
 	// this is the same as the Consul flow so hopefully things just work out.
@@ -165,7 +190,18 @@ func (s *ServiceRegistrationHandler) RegisterWorkload(workload *serviceregistrat
 
 	var resp structs.ServiceRegistrationUpsertResponse
 
-	return s.cfg.RPCFn(structs.ServiceRegistrationUpsertRPCMethod, &args, &resp)
+	// Hold the lock across the RPC, so an update of the check status can't
+	// overwrite the registrations with an older version.
+	s.registrationsLock.Lock()
+	defer s.registrationsLock.Unlock()
+
+	if err := s.cfg.RPCFn(structs.ServiceRegistrationUpsertRPCMethod, &args, &resp); err != nil {
+		return err
+	}
+	for _, t := range tracked {
+		s.registrations[t.registration.ID] = t
+	}
+	return nil
 }
 
 // RemoveWorkload iterates the services and removes them from the service
@@ -207,6 +243,10 @@ func (s *ServiceRegistrationHandler) removeWorkload(
 	// Generate the consistent ID for this service, so we know what to remove.
 	id := serviceregistration.MakeAllocServiceID(workload.AllocInfo.AllocID, workload.Name(), serviceSpec)
 
+	s.registrationsLock.Lock()
+	delete(s.registrations, id)
+	s.registrationsLock.Unlock()
+
 	deleteArgs := structs.ServiceRegistrationDeleteByIDRequest{
 		ID: id,
 		WriteRequest: structs.WriteRequest{
@@ -375,6 +415,9 @@ func (s *ServiceRegistrationHandler) generateNomadServiceRegistration(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get address for service %q: %v", serviceSpec.Name, err)
 	}
+	dualStackIP := serviceregistration.GetDualStackAddress(
+		serviceSpec.Address, addrMode, serviceSpec.PortLabel,
+		workload.DriverNetwork, workload.Ports, workload.NetworkStatus)
 
 	// Build the tags to use for this registration which is a result of whether
 	// this is a canary, or not.
@@ -389,16 +432,17 @@ func (s *ServiceRegistrationHandler) generateNomadServiceRegistration(
 	}
 
 	return &structs.ServiceRegistration{
-		ID:          serviceregistration.MakeAllocServiceID(workload.AllocInfo.AllocID, workload.Name(), serviceSpec),
-		ServiceName: serviceSpec.Name,
-		NodeID:      s.cfg.NodeID,
-		JobID:       workload.AllocInfo.JobID,
-		AllocID:     workload.AllocInfo.AllocID,
-		Namespace:   workload.ProviderNamespace,
-		Datacenter:  s.cfg.Datacenter,
-		Tags:        tags,
-		Address:     ip,
-		Port:        port,
+		ID:               serviceregistration.MakeAllocServiceID(workload.AllocInfo.AllocID, workload.Name(), serviceSpec),
+		ServiceName:      serviceSpec.Name,
+		NodeID:           s.cfg.NodeID,
+		JobID:            workload.AllocInfo.JobID,
+		AllocID:          workload.AllocInfo.AllocID,
+		Namespace:        workload.ProviderNamespace,
+		Datacenter:       s.cfg.Datacenter,
+		Tags:             tags,
+		Address:          ip,
+		DualStackAddress: dualStackIP,
+		Port:             port,
 	}, nil
 }
 
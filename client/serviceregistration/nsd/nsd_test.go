@@ -607,6 +607,29 @@ func TestServiceRegistrationHandler_dedupUpdatedWorkload(t *testing.T) {
 	}
 }
 
+func TestServiceRegistrationHandler_generateNomadServiceRegistration_DualStack(t *testing.T) {
+	h := NewServiceRegistrationHandler(hclog.NewNullLogger(), &ServiceRegistrationHandlerCfg{
+		Enabled:      true,
+		CheckWatcher: new(mockCheckWatcher),
+		NodeID:       "6d7f412e-e7ff-2e66-d47b-867b0e9d8726",
+		Datacenter:   "dc1",
+	}).(*ServiceRegistrationHandler)
+
+	workload := mockWorkload()
+	workload.Ports[1].DualStackHostIP = "2001:db8::2"
+
+	reg, err := h.generateNomadServiceRegistration(workload.Services[0], workload)
+	must.NoError(t, err)
+	must.Eq(t, "10.10.13.2", reg.Address)
+	must.Eq(t, "", reg.DualStackAddress)
+
+	reg, err = h.generateNomadServiceRegistration(workload.Services[1], workload)
+	must.NoError(t, err)
+	must.Eq(t, "10.10.13.2", reg.Address)
+	must.Eq(t, "2001:db8::2", reg.DualStackAddress)
+	must.Eq(t, 24098, reg.Port)
+}
+
 func mockWorkload() *serviceregistration.WorkloadServices {
 	return &serviceregistration.WorkloadServices{
 		AllocInfo: structs.AllocInfo{
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	consulapi "github.com/hashicorp/consul/api"
+	tinterfaces "github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -23,14 +24,19 @@ type AllocHookResources struct {
 	consulCheckIDs [][]string
 	networkStatus  *structs.AllocNetworkStatus
 
+	// scriptExecutors is the executor of each running task, used by the
+	// script checks of Nomad services
+	scriptExecutors map[string]tinterfaces.ScriptExecutor
+
 	mu sync.RWMutex
 }
 
 func NewAllocHookResources() *AllocHookResources {
 	return &AllocHookResources{
-		csiMounts:      map[string]*csimanager.MountInfo{},
-		consulTokens:   map[string]map[string]*consulapi.ACLToken{},
-		consulCheckIDs: [][]string{},
+		csiMounts:       map[string]*csimanager.MountInfo{},
+		consulTokens:    map[string]map[string]*consulapi.ACLToken{},
+		consulCheckIDs:  [][]string{},
+		scriptExecutors: map[string]tinterfaces.ScriptExecutor{},
 	}
 }
 
@@ -119,3 +125,25 @@ func (a *AllocHookResources) SetAllocNetworkStatus(ans *structs.AllocNetworkStat
 
 	a.networkStatus = ans
 }
+
+// GetScriptExecutor returns the executor of the task previously written by
+// the script check task runner hook, or nil if the task isn't running.
+func (a *AllocHookResources) GetScriptExecutor(task string) tinterfaces.ScriptExecutor {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.scriptExecutors[task]
+}
+
+// SetScriptExecutor stores the executor of the task for use by the script
+// checks of Nomad services. A nil executor removes the executor of the task.
+func (a *AllocHookResources) SetScriptExecutor(task string, exec tinterfaces.ScriptExecutor) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if exec == nil {
+		delete(a.scriptExecutors, task)
+		return
+	}
+	a.scriptExecutors[task] = exec
+}
@@ -195,6 +195,70 @@ type FsLogsRequest struct {
 	structs.QueryOptions
 }
 
+// FsLogsSearchRequest is used to search the logs of a task on the node
+// running it.
+type FsLogsSearchRequest struct {
+	// AllocID is the allocation to search logs of
+	AllocID string
+
+	// Task is the task to search logs of
+	Task string
+
+	// LogType indicates whether "stderr" or "stdout" should be searched
+	LogType string
+
+	// Pattern is the regular expression matched against each log line
+	Pattern string
+
+	// Since skips the log files that were last written to before this long
+	// ago. Zero searches all the log files.
+	Since time.Duration
+
+	// Context is the number of lines returned before and after each match
+	Context int
+
+	// Limit is the maximum number of matches returned. Zero uses the default
+	// limit.
+	Limit int
+
+	structs.QueryOptions
+}
+
+// FsLogsSearchResponse is used to return the result of searching logs
+type FsLogsSearchResponse struct {
+	// Result is the result of the search
+	Result *LogsSearchResult
+
+	structs.QueryMeta
+}
+
+// LogsSearchResult holds the log lines matching a search, in the order they
+// were logged.
+type LogsSearchResult struct {
+	Matches []*LogMatch
+
+	// Truncated is set if the search stopped after reaching the limit, so
+	// later lines may match too
+	Truncated bool
+}
+
+// LogMatch is a log line matching a search, along with its context
+type LogMatch struct {
+	// File is the name of the log file with the line
+	File string
+
+	// Line is the line number in the log file, starting at 1
+	Line int
+
+	// Text is the matching line, without its line ending
+	Text string
+
+	// Before and After are the lines logged before and after the matching
+	// line. Lines that are already part of the previous match are omitted.
+	Before []string
+	After  []string
+}
+
 // StreamErrWrapper is used to serialize output of a stream of a file or logs.
 type StreamErrWrapper struct {
 	// Error stores any error that may have occurred.
@@ -348,10 +412,17 @@ type AllocResourceUsage struct {
 	// Tasks contains the resource usage of each task
 	Tasks map[string]*TaskResourceUsage
 
+	// NetworkStats contains the traffic counters of the alloc network
+	// namespace, if the alloc uses network isolation
+	NetworkStats *NetworkStats
+
 	// The max timestamp of all the Tasks
 	Timestamp int64
 }
 
+// NetworkStats holds the traffic counters of an alloc network namespace
+type NetworkStats = hoststats.NetworkStats
+
 // joinStringSet takes two slices of strings and joins them
 func joinStringSet(s1, s2 []string) []string {
 	lookup := make(map[string]struct{}, len(s1))
@@ -329,6 +329,15 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		}
 		conf.EventBufferSize = int64(*agentConfig.Server.EventBufferSize)
 	}
+	if agentConfig.Server.EnableEventJournal != nil {
+		conf.EnableEventJournal = *agentConfig.Server.EnableEventJournal
+	}
+	if agentConfig.Server.EventJournalRetention != 0 {
+		if agentConfig.Server.EventJournalRetention < 0 {
+			return nil, fmt.Errorf("Invalid Config, event_journal_retention must be positive")
+		}
+		conf.EventJournalRetention = agentConfig.Server.EventJournalRetention
+	}
 	if agentConfig.Autopilot != nil {
 		if agentConfig.Autopilot.CleanupDeadServers != nil {
 			conf.AutopilotConfig.CleanupDeadServers = *agentConfig.Autopilot.CleanupDeadServers
@@ -619,6 +628,15 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		return nil, fmt.Errorf("deploy_query_rate_limit must be greater than 0")
 	}
 
+	// Set the networks canary analysis may query
+	for _, cidr := range agentConfig.Server.CanaryAnalysisHTTPAllowlist {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("canary_analysis_http_allowlist: invalid CIDR block %q: %v", cidr, err)
+		}
+		conf.CanaryAnalysisHTTPAllowlist = append(conf.CanaryAnalysisHTTPAllowlist, ipNet)
+	}
+
 	// Set plan rejection tracker configuration.
 	if planRejectConf := agentConfig.Server.PlanRejectionTracker; planRejectConf != nil {
 		if planRejectConf.Enabled != nil {
@@ -1130,6 +1148,13 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 		conf.BridgeNetworkAllocSubnetIPv6 = ipv6Subnet
 	}
 	conf.BridgeNetworkHairpinMode = agentConfig.Client.BridgeNetworkHairpinMode
+	switch backend := agentConfig.Client.FirewallBackend; backend {
+	case "", "auto":
+	case clientconfig.FirewallBackendIPTables, clientconfig.FirewallBackendNftables:
+		conf.FirewallBackend = backend
+	default:
+		return nil, fmt.Errorf("invalid firewall_backend %q: must be one of auto, iptables, or nftables", backend)
+	}
 
 	for _, hn := range agentConfig.Client.HostNetworks {
 		conf.HostNetworks[hn.Name] = hn
@@ -1145,6 +1170,9 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 		return nil, fmt.Errorf("invalid artifact config: %v", err)
 	}
 
+	if artifactConfig.CacheEnabled && artifactConfig.CacheDir == "" && agentConfig.DataDir != "" {
+		artifactConfig.CacheDir = filepath.Join(agentConfig.DataDir, "artifact_cache")
+	}
 	conf.Artifact = artifactConfig
 
 	drainConfig, err := clientconfig.DrainConfigFromAgent(agentConfig.Client.Drain)
@@ -1153,6 +1181,12 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	}
 	conf.Drain = drainConfig
 
+	dnsConfig, err := clientconfig.DNSConfigFromAgent(agentConfig.Client.DNS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dns config: %v", err)
+	}
+	conf.DNS = dnsConfig
+
 	conf.Users = clientconfig.UsersConfigFromAgent(agentConfig.Client.Users)
 
 	// Iterate the fingerprinter configs and populate the client mapping. The
@@ -963,6 +963,33 @@ func TestAgent_ClientConfig_JobMaxSourceSize(t *testing.T) {
 	must.Eq(t, 1e6, serverConf.JobMaxSourceSize)
 }
 
+func TestAgent_ClientConfig_ArtifactCache(t *testing.T) {
+	ci.Parallel(t)
+
+	conf := DefaultConfig()
+	conf.DevMode = true
+	conf.DataDir = "/var/lib/nomad"
+	a := &Agent{config: conf}
+
+	// the cache dir is only defaulted when the cache is enabled
+	c, err := a.clientConfig()
+	must.NoError(t, err)
+	must.False(t, c.Artifact.CacheEnabled)
+	must.Eq(t, "", c.Artifact.CacheDir)
+
+	conf.Client.Artifact.CacheEnabled = new(true)
+	c, err = a.clientConfig()
+	must.NoError(t, err)
+	must.True(t, c.Artifact.CacheEnabled)
+	must.Eq(t, "/var/lib/nomad/artifact_cache", c.Artifact.CacheDir)
+	must.Eq(t, 10_000_000_000, c.Artifact.CacheSizeLimit)
+
+	conf.Client.Artifact.CacheDir = new("/mnt/cache")
+	c, err = a.clientConfig()
+	must.NoError(t, err)
+	must.Eq(t, "/mnt/cache", c.Artifact.CacheDir)
+}
+
 // Clients should inherit telemetry configuration
 func TestAgent_Client_TelemetryConfiguration(t *testing.T) {
 	ci.Parallel(t)
@@ -1964,6 +1991,27 @@ func TestAgent_ServerConfig_JobMaxPriority_Bad(t *testing.T) {
 	}
 }
 
+func TestAgent_ServerConfig_CanaryAnalysisHTTPAllowlist(t *testing.T) {
+	ci.Parallel(t)
+
+	conf := DevConfig(nil)
+	must.NoError(t, conf.normalizeAddrs())
+
+	serverConf, err := convertServerConfig(conf)
+	must.NoError(t, err)
+	must.SliceEmpty(t, serverConf.CanaryAnalysisHTTPAllowlist)
+
+	conf.Server.CanaryAnalysisHTTPAllowlist = []string{"10.0.0.0/8", "fd00::/8"}
+	serverConf, err = convertServerConfig(conf)
+	must.NoError(t, err)
+	must.Len(t, 2, serverConf.CanaryAnalysisHTTPAllowlist)
+	must.Eq(t, "10.0.0.0/8", serverConf.CanaryAnalysisHTTPAllowlist[0].String())
+
+	conf.Server.CanaryAnalysisHTTPAllowlist = []string{"10.0.0.1"}
+	_, err = convertServerConfig(conf)
+	must.ErrorContains(t, err, "canary_analysis_http_allowlist: invalid CIDR block")
+}
+
 func TestAgent_ServerConfig_JobDefaultPriority_Ok(t *testing.T) {
 	ci.Parallel(t)
 
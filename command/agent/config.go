@@ -410,6 +410,11 @@ type ClientConfig struct {
 	// internal bridge network
 	BridgeNetworkHairpinMode bool `hcl:"bridge_network_hairpin_mode"`
 
+	// FirewallBackend is the backend managing the firewall rules of bridge
+	// networking mode and of egress policies: "iptables", "nftables", or
+	// "auto" to detect it.
+	FirewallBackend string `hcl:"firewall_backend"`
+
 	// HostNetworks describes the different host networks available to the host
 	// if the host uses multiple interfaces
 	HostNetworks []*structs.ClientHostNetworkConfig `hcl:"host_network"`
@@ -435,6 +440,10 @@ type ClientConfig struct {
 	// Drain specifies whether to drain the client on shutdown; ignored in dev mode.
 	Drain *config.DrainConfig `hcl:"drain_on_shutdown"`
 
+	// DNS configures the DNS interface of the client, which answers queries
+	// for Nomad service registrations.
+	DNS *config.DNSConfig `hcl:"dns"`
+
 	// Users is used to configure parameters around operating system users.
 	Users *config.UsersConfig `hcl:"users"`
 
@@ -476,6 +485,7 @@ func (c *ClientConfig) Copy() *ClientConfig {
 	nc.NomadServiceDiscovery = pointer.Copy(c.NomadServiceDiscovery)
 	nc.Artifact = c.Artifact.Copy()
 	nc.Drain = c.Drain.Copy()
+	nc.DNS = c.DNS.Copy()
 	nc.Users = c.Users.Copy()
 	nc.Fingerprinters = helper.CopySlice(c.Fingerprinters)
 	nc.ExtraKeysHCL = slices.Clone(c.ExtraKeysHCL)
@@ -730,6 +740,16 @@ type ServerConfig struct {
 	// for the EventBufferSize is 1.
 	EventBufferSize *int `hcl:"event_buffer_size"`
 
+	// EnableEventJournal configures whether this server persists the events
+	// it publishes to disk so event stream subscribers can resume from
+	// indexes no longer held in memory.
+	EnableEventJournal *bool `hcl:"enable_event_journal"`
+
+	// EventJournalRetention is how long events are kept in the event
+	// journal.
+	EventJournalRetention    time.Duration
+	EventJournalRetentionHCL string `hcl:"event_journal_retention" json:"-"`
+
 	// LicensePath is the path to search for an enterprise license.
 	LicensePath string `hcl:"license_path"`
 
@@ -751,6 +771,11 @@ type ServerConfig struct {
 	// DeploymentWatcher to throttle the amount of simultaneously deployments
 	DeploymentQueryRateLimit float64 `hcl:"deploy_query_rate_limit"`
 
+	// CanaryAnalysisHTTPAllowlist is the CIDR blocks the leader may query for
+	// the HTTP checks of canary analysis. HTTP checks are disabled if it is
+	// empty.
+	CanaryAnalysisHTTPAllowlist []string `hcl:"canary_analysis_http_allowlist"`
+
 	// RaftLogStoreConfig configures the raft log store backend.
 	RaftLogStoreConfig *RaftLogStoreConfig `hcl:"raft_logstore"`
 
@@ -833,7 +858,9 @@ func (s *ServerConfig) Copy() *ServerConfig {
 	ns.PlanRejectionTracker = s.PlanRejectionTracker.Copy()
 	ns.EnableEventBroker = pointer.Copy(s.EnableEventBroker)
 	ns.EventBufferSize = pointer.Copy(s.EventBufferSize)
+	ns.EnableEventJournal = pointer.Copy(s.EnableEventJournal)
 	ns.JobMaxSourceSize = pointer.Copy(s.JobMaxSourceSize)
+	ns.CanaryAnalysisHTTPAllowlist = slices.Clone(s.CanaryAnalysisHTTPAllowlist)
 	ns.licenseAdditionalPublicKeys = slices.Clone(s.licenseAdditionalPublicKeys)
 	ns.ExtraKeysHCL = slices.Clone(s.ExtraKeysHCL)
 	ns.Search = s.Search.Copy()
@@ -2757,6 +2784,17 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 		result.EventBufferSize = b.EventBufferSize
 	}
 
+	if b.EnableEventJournal != nil {
+		result.EnableEventJournal = b.EnableEventJournal
+	}
+
+	if b.EventJournalRetention != 0 {
+		result.EventJournalRetention = b.EventJournalRetention
+	}
+	if b.EventJournalRetentionHCL != "" {
+		result.EventJournalRetentionHCL = b.EventJournalRetentionHCL
+	}
+
 	result.JobMaxSourceSize = pointer.Merge(s.JobMaxSourceSize, b.JobMaxSourceSize)
 
 	if b.PlanRejectionTracker != nil {
@@ -2775,6 +2813,10 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 		result.DeploymentQueryRateLimit = b.DeploymentQueryRateLimit
 	}
 
+	if len(b.CanaryAnalysisHTTPAllowlist) != 0 {
+		result.CanaryAnalysisHTTPAllowlist = slices.Clone(b.CanaryAnalysisHTTPAllowlist)
+	}
+
 	if b.Search != nil {
 		result.Search = &Search{FuzzyEnabled: b.Search.FuzzyEnabled}
 		if b.Search.LimitQuery > 0 {
@@ -3015,6 +3057,9 @@ func (c *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 	if b.BridgeNetworkHairpinMode {
 		result.BridgeNetworkHairpinMode = true
 	}
+	if b.FirewallBackend != "" {
+		result.FirewallBackend = b.FirewallBackend
+	}
 
 	result.HostNetworks = c.HostNetworks
 
@@ -3042,6 +3087,7 @@ func (c *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 
 	result.Artifact = c.Artifact.Merge(b.Artifact)
 	result.Drain = c.Drain.Merge(b.Drain)
+	result.DNS = c.DNS.Merge(b.DNS)
 	result.Users = c.Users.Merge(b.Users)
 
 	if b.NodeMaxAllocs != 0 {
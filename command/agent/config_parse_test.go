@@ -98,6 +98,14 @@ var basicConfig = &Config{
 		BridgeNetworkName:       "custom_bridge_name",
 		BridgeNetworkSubnet:     "custom_bridge_subnet",
 		BridgeNetworkSubnetIPv6: "custom_bridge_subnet_ipv6",
+		FirewallBackend:         "nftables",
+		DNS: &config.DNSConfig{
+			Enabled: new(true),
+			Address: new("127.0.0.2"),
+			Port:    new(8653),
+			Domain:  new("example"),
+			TTL:     new("5s"),
+		},
 		Fingerprinters: []*client.Fingerprint{
 			{
 				Name:             "env_aws",
@@ -145,6 +153,9 @@ var basicConfig = &Config{
 		EncryptKey:                "abc",
 		EnableEventBroker:         new(false),
 		EventBufferSize:           new(200),
+		EnableEventJournal:        new(true),
+		EventJournalRetention:     12 * time.Hour,
+		EventJournalRetentionHCL:  "12h",
 		PlanRejectionTracker: &PlanRejectionTracker{
 			Enabled:       new(true),
 			NodeThreshold: 100,
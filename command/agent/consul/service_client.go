@@ -1425,6 +1425,9 @@ func (c *ServiceClient) serviceRegs(
 	if err != nil {
 		return nil, err
 	}
+	dualStackIP := serviceregistration.GetDualStackAddress(
+		service.Address, addrMode, service.PortLabel, workload.DriverNetwork, workload.Ports, workload.NetworkStatus)
+	setDualStackTaggedAddresses(taggedAddresses, ip, dualStackIP, port)
 
 	// Build the Consul Service registration request
 	serviceReg := &api.AgentServiceRegistration{
@@ -2207,6 +2210,25 @@ func parseAddress(raw string, port int) (api.ServiceAddress, error) {
 	return result, nil
 }
 
+// setDualStackTaggedAddresses sets the lan_ipv4 and lan_ipv6 tagged addresses
+// of a dual-stack service, unless they are set by its tagged_addresses.
+func setDualStackTaggedAddresses(m map[string]api.ServiceAddress, ip, dualStackIP string, port int) {
+	if dualStackIP == "" {
+		return
+	}
+	for _, addr := range []string{ip, dualStackIP} {
+		key := "lan_ipv4"
+		if parsed := net.ParseIP(addr); parsed == nil {
+			continue
+		} else if parsed.To4() == nil {
+			key = "lan_ipv6"
+		}
+		if _, ok := m[key]; !ok {
+			m[key] = api.ServiceAddress{Address: addr, Port: port}
+		}
+	}
+}
+
 // morph the tagged_addresses map into the structure consul api wants
 func parseTaggedAddresses(m map[string]string, port int) (map[string]api.ServiceAddress, error) {
 	result := make(map[string]api.ServiceAddress, len(m))
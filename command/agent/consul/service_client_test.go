@@ -857,6 +857,36 @@ func TestSyncLogic_parseTaggedAddresses(t *testing.T) {
 	})
 }
 
+func TestSyncLogic_setDualStackTaggedAddresses(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("single stack", func(t *testing.T) {
+		m := map[string]api.ServiceAddress{}
+		setDualStackTaggedAddresses(m, "192.168.1.10", "", 8080)
+		must.MapEmpty(t, m)
+	})
+
+	t.Run("dual stack", func(t *testing.T) {
+		m := map[string]api.ServiceAddress{}
+		setDualStackTaggedAddresses(m, "192.168.1.10", "2001:db8::10", 8080)
+		must.MapEq(t, map[string]api.ServiceAddress{
+			"lan_ipv4": {Address: "192.168.1.10", Port: 8080},
+			"lan_ipv6": {Address: "2001:db8::10", Port: 8080},
+		}, m)
+	})
+
+	t.Run("tagged addresses take precedence", func(t *testing.T) {
+		m := map[string]api.ServiceAddress{
+			"lan_ipv6": {Address: "2001:db8::99", Port: 9999},
+		}
+		setDualStackTaggedAddresses(m, "2001:db8::10", "192.168.1.10", 8080)
+		must.MapEq(t, map[string]api.ServiceAddress{
+			"lan_ipv4": {Address: "192.168.1.10", Port: 8080},
+			"lan_ipv6": {Address: "2001:db8::99", Port: 9999},
+		}, m)
+	})
+}
+
 // TestServiceClient_ConsulTokens exercises the lifecycle of Consul tokens
 // associated with each service and the service client for each cluster.
 func TestServiceClient_ConsulTokens(t *testing.T) {
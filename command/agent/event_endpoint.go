@@ -208,6 +208,50 @@ func (s *HTTPServer) EventStream(resp http.ResponseWriter, req *http.Request) (i
 	return nil, codedErr
 }
 
+// EventReplay returns a page of past events from the event journal, starting
+// at the "index" or the "since" duration ago.
+func (s *HTTPServer) EventReplay(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+
+	query := req.URL.Query()
+	topics, err := parseEventTopics(query)
+	if err != nil {
+		return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("Invalid topic query: %v", err))
+	}
+
+	args := structs.EventReplayRequest{Topics: topics}
+	if indexStr := query.Get("index"); indexStr != "" {
+		args.Index, err = strconv.ParseUint(indexStr, 10, 64)
+		if err != nil {
+			return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("Unable to parse index: %v", err))
+		}
+	}
+	if since := query.Get("since"); since != "" {
+		d, err := time.ParseDuration(since)
+		if err != nil {
+			return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("Unable to parse since: %v", err))
+		}
+		args.StartTime = time.Now().Add(-d).UnixNano()
+	}
+
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.EventReplayResponse
+	if err := s.agent.RPC("Event.Replay", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Events == nil {
+		out.Events = make([]*structs.Events, 0)
+	}
+	return out.Events, nil
+}
+
 func parseEventTopics(query url.Values) (map[structs.Topic][]string, error) {
 	raw, ok := query["topic"]
 	if !ok {
@@ -10,8 +10,10 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-msgpack/v2/codec"
 	cstructs "github.com/hashicorp/nomad/client/structs"
@@ -26,6 +28,7 @@ var (
 	logTypeNotPresentErr  = CodedError(400, "must provide log type (stdout/stderr)")
 	clientNotRunning      = CodedError(400, "node is not running a Nomad Client")
 	invalidOrigin         = CodedError(400, "origin must be start or end")
+	patternNotPresentErr  = CodedError(400, "must provide a search pattern")
 )
 
 func (s *HTTPServer) FsRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
@@ -41,6 +44,8 @@ func (s *HTTPServer) FsRequest(resp http.ResponseWriter, req *http.Request) (int
 		return s.wrapUntrustedContent(s.FileCatRequest)(resp, req)
 	case strings.HasPrefix(path, "stream/"):
 		return s.Stream(resp, req)
+	case strings.HasPrefix(path, "logs/search/"):
+		return s.LogsSearchRequest(resp, req)
 	case strings.HasPrefix(path, "logs/"):
 		// Logs are *trusted* content because the endpoint
 		// explicitly sets the Content-Type to text/plain or
@@ -339,6 +344,96 @@ func (s *HTTPServer) Logs(resp http.ResponseWriter, req *http.Request) (interfac
 	return s.fsStreamImpl(resp, req, "FileSystem.Logs", fsReq, fsReq.AllocID)
 }
 
+// LogsSearchRequest searches the logs of a task for lines matching a regular
+// expression. The parameters are:
+//   - task: task name to search logs for.
+//   - type: stdout/stderr to search.
+//   - pattern: the regular expression matched against each line.
+//   - since: skips the log files last written to before this duration.
+//   - context: the number of lines to return around each match.
+//   - limit: the maximum number of matches to return.
+func (s *HTTPServer) LogsSearchRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var allocID, task, logType, pattern string
+	var err error
+
+	q := req.URL.Query()
+	if allocID = strings.TrimPrefix(req.URL.Path, "/v1/client/fs/logs/search/"); allocID == "" {
+		return nil, allocIDNotPresentErr
+	}
+
+	if task = q.Get("task"); task == "" {
+		return nil, taskNotPresentErr
+	}
+
+	logType = q.Get("type")
+	switch logType {
+	case "stdout", "stderr":
+	default:
+		return nil, logTypeNotPresentErr
+	}
+
+	if pattern = q.Get("pattern"); pattern == "" {
+		return nil, patternNotPresentErr
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, CodedError(400, fmt.Sprintf("invalid search pattern: %v", err))
+	}
+
+	var since time.Duration
+	if sinceStr := q.Get("since"); sinceStr != "" {
+		if since, err = time.ParseDuration(sinceStr); err != nil {
+			return nil, CodedError(400, fmt.Sprintf("failed to parse since field to duration: %v", err))
+		}
+	}
+
+	var contextLines, limit int
+	if contextStr := q.Get("context"); contextStr != "" {
+		if contextLines, err = strconv.Atoi(contextStr); err != nil {
+			return nil, CodedError(400, fmt.Sprintf("failed to parse context field to integer: %v", err))
+		}
+	}
+	if limitStr := q.Get("limit"); limitStr != "" {
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			return nil, CodedError(400, fmt.Sprintf("failed to parse limit field to integer: %v", err))
+		}
+	}
+
+	// Create the request
+	args := &cstructs.FsLogsSearchRequest{
+		AllocID: allocID,
+		Task:    task,
+		LogType: logType,
+		Pattern: pattern,
+		Since:   since,
+		Context: contextLines,
+		Limit:   limit,
+	}
+	s.parse(resp, req, &args.QueryOptions.Region, &args.QueryOptions)
+
+	// Make the RPC
+	localClient, remoteClient, localServer := s.rpcHandlerForAlloc(allocID)
+
+	var reply cstructs.FsLogsSearchResponse
+	var rpcErr error
+	if localClient {
+		rpcErr = s.agent.Client().ClientRPC("FileSystem.SearchLogs", &args, &reply)
+	} else if remoteClient {
+		rpcErr = s.agent.Client().RPC("FileSystem.SearchLogs", &args, &reply)
+	} else if localServer {
+		rpcErr = s.agent.Server().RPC("FileSystem.SearchLogs", &args, &reply)
+	}
+
+	if rpcErr != nil {
+		if structs.IsErrNoNodeConn(rpcErr) || structs.IsErrUnknownAllocation(rpcErr) || structs.IsErrNoSuchFileOrDirectory(rpcErr) {
+			rpcErr = CodedError(404, rpcErr.Error())
+		}
+
+		return nil, rpcErr
+	}
+
+	return reply.Result, nil
+}
+
 // fsStreamImpl is used to make a streaming filesystem call that serializes the
 // args and then expects a stream of StreamErrWrapper results where the payload
 // is copied to the response body.
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
 )
 
@@ -265,6 +266,69 @@ func TestHTTP_FS_Logs_MissingParams(t *testing.T) {
 	})
 }
 
+func TestHTTP_FS_LogsSearch_MissingParams(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		cases := []struct {
+			path    string
+			expCode int
+			expErr  string
+		}{
+			{
+				path:    "/v1/client/fs/logs/search/",
+				expCode: 400,
+				expErr:  allocIDNotPresentErr.Error(),
+			},
+			{
+				path:    "/v1/client/fs/logs/search/foo",
+				expCode: 400,
+				expErr:  taskNotPresentErr.Error(),
+			},
+			{
+				path:    "/v1/client/fs/logs/search/foo?task=foo",
+				expCode: 400,
+				expErr:  logTypeNotPresentErr.Error(),
+			},
+			{
+				path:    "/v1/client/fs/logs/search/foo?task=foo&type=stdout",
+				expCode: 400,
+				expErr:  patternNotPresentErr.Error(),
+			},
+			{
+				path:    "/v1/client/fs/logs/search/foo?task=foo&type=stdout&pattern=(",
+				expCode: 400,
+				expErr:  "invalid search pattern",
+			},
+			{
+				path:    "/v1/client/fs/logs/search/foo?task=foo&type=stdout&pattern=a&since=1",
+				expCode: 400,
+				expErr:  "failed to parse since field",
+			},
+			{
+				path:    "/v1/client/fs/logs/search/foo?task=foo&type=stdout&pattern=a&limit=x",
+				expCode: 400,
+				expErr:  "failed to parse limit field",
+			},
+			{
+				// all parameters are set but alloc isn't found
+				path:    "/v1/client/fs/logs/search/foo?task=foo&type=stdout&pattern=a",
+				expCode: 500,
+				expErr:  "alloc lookup failed",
+			},
+		}
+
+		for _, tc := range cases {
+			req, err := http.NewRequest(http.MethodGet, tc.path, nil)
+			must.NoError(t, err)
+			respW := httptest.NewRecorder()
+
+			s.Server.mux.ServeHTTP(respW, req)
+			must.Eq(t, tc.expCode, respW.Code, must.Sprint(tc.path))
+			must.StrContains(t, respW.Body.String(), tc.expErr)
+		}
+	})
+}
+
 func TestHTTP_FS_List(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
@@ -544,6 +608,28 @@ func TestHTTP_FS_Logs(t *testing.T) {
 	})
 }
 
+func TestHTTP_FS_LogsSearch(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		a := mockFSAlloc(s.client.NodeID(), nil)
+		addAllocToClient(s, a, terminalClientAlloc)
+
+		path := fmt.Sprintf("/v1/client/fs/logs/search/%s?type=stdout&task=web&pattern=other+side&since=1h", a.ID)
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		must.NoError(t, err)
+		respW := httptest.NewRecorder()
+
+		obj, err := s.Server.LogsSearchRequest(respW, req)
+		must.NoError(t, err)
+
+		result := obj.(*cstructs.LogsSearchResult)
+		must.Len(t, 1, result.Matches)
+		must.Eq(t, defaultLoggerMockDriverStdout, result.Matches[0].Text)
+		must.Eq(t, "web.stdout.0", result.Matches[0].File)
+		must.False(t, result.Truncated)
+	})
+}
+
 // TestHTTP_FS_Logs_XSS asserts that the logs endpoint always returns
 // text/plain or application/json content regardless of whether the logs are
 // HTML+Javascript or not.
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/nomad/acl"
 	api "github.com/hashicorp/nomad/api"
 	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/jobspec2"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -85,6 +86,9 @@ func (s *HTTPServer) JobSpecificRequest(resp http.ResponseWriter, req *http.Requ
 	case strings.HasSuffix(path, "/summary"):
 		jobID := strings.TrimSuffix(path, "/summary")
 		return s.jobSummaryRequest(resp, req, jobID)
+	case strings.HasSuffix(path, "/dependencies"):
+		jobID := strings.TrimSuffix(path, "/dependencies")
+		return s.jobDependenciesRequest(resp, req, jobID)
 	case strings.HasSuffix(path, "/dispatch"):
 		jobID := strings.TrimSuffix(path, "/dispatch")
 		return s.jobDispatchRequest(resp, req, jobID)
@@ -878,6 +882,26 @@ func (s *HTTPServer) jobSummaryRequest(resp http.ResponseWriter, req *http.Reque
 	return out.JobSummary, nil
 }
 
+func (s *HTTPServer) jobDependenciesRequest(resp http.ResponseWriter, req *http.Request, jobID string) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+	args := structs.JobSpecificRequest{
+		JobID: jobID,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.JobDependenciesResponse
+	if err := s.agent.RPC("Job.Dependencies", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	return out, nil
+}
+
 func (s *HTTPServer) jobDispatchRequest(resp http.ResponseWriter, req *http.Request, jobID string) (interface{}, error) {
 	if req.Method != http.MethodPut && req.Method != http.MethodPost {
 		return nil, CodedError(405, ErrInvalidMethod)
@@ -1175,6 +1199,7 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 			SpecType:        *job.Periodic.SpecType,
 			ProhibitOverlap: *job.Periodic.ProhibitOverlap,
 			TimeZone:        *job.Periodic.TimeZone,
+			CatchUp:         *job.Periodic.CatchUp,
 		}
 
 		if job.Periodic.Spec != nil {
@@ -1184,6 +1209,14 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		if job.Periodic.Specs != nil {
 			j.Periodic.Specs = job.Periodic.Specs
 		}
+
+		for _, e := range job.Periodic.Exclusions {
+			j.Periodic.Exclusions = append(j.Periodic.Exclusions, &structs.PeriodicExclusion{
+				Start: e.Start,
+				End:   e.End,
+				Cron:  e.Cron,
+			})
+		}
 	}
 
 	if job.ParameterizedJob != nil {
@@ -1194,6 +1227,18 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		}
 	}
 
+	if job.Gang != nil {
+		j.Gang = &structs.GangConfig{
+			Groups: job.Gang.Groups,
+		}
+	}
+
+	if job.DependsOn != nil {
+		j.DependsOn = &structs.JobDependencies{
+			Jobs: job.DependsOn.Jobs,
+		}
+	}
+
 	if job.Multiregion != nil {
 		j.Multiregion = &structs.Multiregion{}
 		j.Multiregion.Strategy = &structs.MultiregionStrategy{
@@ -1291,9 +1336,10 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 	}
 
 	tg.EphemeralDisk = &structs.EphemeralDisk{
-		Sticky:  *taskGroup.EphemeralDisk.Sticky,
-		SizeMB:  *taskGroup.EphemeralDisk.SizeMB,
-		Migrate: *taskGroup.EphemeralDisk.Migrate,
+		Sticky:     *taskGroup.EphemeralDisk.Sticky,
+		SizeMB:     *taskGroup.EphemeralDisk.SizeMB,
+		Migrate:    *taskGroup.EphemeralDisk.Migrate,
+		Checkpoint: *taskGroup.EphemeralDisk.Checkpoint,
 	}
 
 	if len(taskGroup.Spreads) > 0 {
@@ -1353,6 +1399,8 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 		if taskGroup.Update.AutoPromote != nil {
 			tg.Update.AutoPromote = *taskGroup.Update.AutoPromote
 		}
+
+		tg.Update.Analysis = ApiCanaryAnalysisToStructs(taskGroup.Update.Analysis)
 	}
 
 	if len(taskGroup.Tasks) > 0 {
@@ -1373,6 +1421,38 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 
 // ApiTaskToStructsTask is a copy and type conversion between the API
 // representation of a task from a struct representation of a task.
+func ApiCanaryAnalysisToStructs(in *api.CanaryAnalysis) *structs.CanaryAnalysis {
+	if in == nil {
+		return nil
+	}
+
+	out := &structs.CanaryAnalysis{
+		Duration:     *in.Duration,
+		Interval:     *in.Interval,
+		FailureLimit: *in.FailureLimit,
+	}
+
+	if in.HTTP != nil {
+		out.HTTP = &structs.CanaryAnalysisHTTP{
+			URL:     in.HTTP.URL,
+			Method:  in.HTTP.Method,
+			Header:  maps.Clone(in.HTTP.Header),
+			Timeout: *in.HTTP.Timeout,
+		}
+	}
+
+	if in.Variable != nil {
+		out.Variable = &structs.CanaryAnalysisVariable{
+			Path: in.Variable.Path,
+			Key:  in.Variable.Key,
+			Min:  pointer.Copy(in.Variable.Min),
+			Max:  pointer.Copy(in.Variable.Max),
+		}
+	}
+
+	return out
+}
+
 func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 	apiTask *api.Task, structsTask *structs.Task) {
 
@@ -1675,6 +1755,23 @@ func ApiNetworkResourceToStructs(in []*api.NetworkResource) []*structs.NetworkRe
 				Args: nw.CNI.Args,
 			}
 		}
+		if nw.Bandwidth != nil {
+			out[i].Bandwidth = &structs.BandwidthConfig{
+				IngressMBits: nw.Bandwidth.IngressMBits,
+				EgressMBits:  nw.Bandwidth.EgressMBits,
+			}
+		}
+
+		if nw.Egress != nil {
+			out[i].Egress = &structs.EgressConfig{}
+			for _, rule := range nw.Egress.Allow {
+				out[i].Egress.Allow = append(out[i].Egress.Allow, &structs.EgressRule{
+					CIDR:    rule.CIDR,
+					Service: rule.Service,
+					Ports:   slices.Clone(rule.Ports),
+				})
+			}
+		}
 
 		if l := len(nw.DynamicPorts); l != 0 {
 			out[i].DynamicPorts = make([]structs.Port, l)
@@ -2203,11 +2300,39 @@ func apiLogConfigToStructs(in *api.LogConfig) *structs.LogConfig {
 		return nil
 	}
 
-	return &structs.LogConfig{
+	out := &structs.LogConfig{
 		Disabled:      dereferenceBool(in.Disabled),
 		MaxFiles:      dereferenceInt(in.MaxFiles),
 		MaxFileSizeMB: dereferenceInt(in.MaxFileSizeMB),
+		Sinks:         apiLogSinksToStructs(in.Sinks),
+	}
+	if in.MaxAge != nil {
+		out.MaxAge = *in.MaxAge
+	}
+	if in.Compress != nil {
+		out.Compress = *in.Compress
 	}
+	return out
+}
+
+func apiLogSinksToStructs(in []*api.LogSink) []*structs.LogSink {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*structs.LogSink, len(in))
+	for i, sink := range in {
+		out[i] = &structs.LogSink{
+			Name:       sink.Name,
+			Type:       sink.Type,
+			Address:    sink.Address,
+			BufferSize: dereferenceInt(sink.BufferSize),
+		}
+		if len(sink.Headers) > 0 {
+			out[i].Headers = maps.Clone(sink.Headers)
+		}
+	}
+	return out
 }
 
 func dereferenceBool(in *bool) bool {
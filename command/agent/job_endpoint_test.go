@@ -2745,6 +2745,14 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 			SpecType:        new("cron"),
 			ProhibitOverlap: new(true),
 			TimeZone:        new("test zone"),
+			CatchUp:         new("run-all"),
+			Exclusions: []*api.PeriodicExclusion{
+				{Start: "2025-12-24", End: "2025-12-27"},
+				{Cron: "* * * * 0,6"},
+			},
+		},
+		DependsOn: &api.JobDependencies{
+			Jobs: []string{"extract"},
 		},
 		ParameterizedJob: &api.ParameterizedJobConfig{
 			Payload:      "payload",
@@ -2822,9 +2830,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 					},
 				},
 				EphemeralDisk: &api.EphemeralDisk{
-					SizeMB:  new(100),
-					Sticky:  new(true),
-					Migrate: new(true),
+					SizeMB:     new(100),
+					Sticky:     new(true),
+					Migrate:    new(true),
+					Checkpoint: new(true),
 				},
 				Update: &api.UpdateStrategy{
 					HealthCheck:      new(structs.UpdateStrategyHealthCheck_Checks),
@@ -3183,6 +3192,14 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 			SpecType:        "cron",
 			ProhibitOverlap: true,
 			TimeZone:        "test zone",
+			CatchUp:         "run-all",
+			Exclusions: []*structs.PeriodicExclusion{
+				{Start: "2025-12-24", End: "2025-12-27"},
+				{Cron: "* * * * 0,6"},
+			},
+		},
+		DependsOn: &structs.JobDependencies{
+			Jobs: []string{"extract"},
 		},
 		ParameterizedJob: &structs.ParameterizedJobConfig{
 			Payload:      "payload",
@@ -3260,9 +3277,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 					HealthyDeadline: 12 * time.Hour,
 				},
 				EphemeralDisk: &structs.EphemeralDisk{
-					SizeMB:  100,
-					Sticky:  true,
-					Migrate: true,
+					SizeMB:     100,
+					Sticky:     true,
+					Migrate:    true,
+					Checkpoint: true,
 				},
 				Update: &structs.UpdateStrategy{
 					Stagger:          1 * time.Second,
@@ -3878,6 +3896,13 @@ func TestJobs_ApiJobToStructsJobUpdate(t *testing.T) {
 				Update: &api.UpdateStrategy{
 					Canary:      new(3),
 					AutoPromote: new(true),
+					Analysis: &api.CanaryAnalysis{
+						Variable: &api.CanaryAnalysisVariable{
+							Path: "metrics/api",
+							Key:  "error_rate",
+							Max:  new(0.05),
+						},
+					},
 				},
 			},
 		},
@@ -3921,6 +3946,15 @@ func TestJobs_ApiJobToStructsJobUpdate(t *testing.T) {
 		AutoRevert:       false,
 		AutoPromote:      true,
 		Canary:           3,
+		Analysis: &structs.CanaryAnalysis{
+			Duration: 5 * time.Minute,
+			Interval: 30 * time.Second,
+			Variable: &structs.CanaryAnalysisVariable{
+				Path: "metrics/api",
+				Key:  "error_rate",
+				Max:  new(0.05),
+			},
+		},
 	}
 
 	require.Equal(t, jobUpdate, structsJob.Update)
@@ -4008,6 +4042,26 @@ func TestConversion_apiLogConfigToStructs(t *testing.T) {
 		MaxFiles:      new(2),
 		MaxFileSizeMB: new(8),
 	}))
+	must.Eq(t, &structs.LogConfig{
+		MaxAge:   72 * time.Hour,
+		Compress: "zstd",
+	}, apiLogConfigToStructs(&api.LogConfig{
+		MaxAge:   new(72 * time.Hour),
+		Compress: new("zstd"),
+	}))
+	must.Eq(t, &structs.LogConfig{
+		Sinks: []*structs.LogSink{
+			{Name: "journal", Type: "journald", BufferSize: 1024},
+			{Name: "http", Type: "http", Address: "https://logs.example.com",
+				Headers: map[string]string{"Authorization": "Bearer x"}},
+		},
+	}, apiLogConfigToStructs(&api.LogConfig{
+		Sinks: []*api.LogSink{
+			{Name: "journal", Type: "journald", BufferSize: new(1024)},
+			{Name: "http", Type: "http", Address: "https://logs.example.com",
+				Headers: map[string]string{"Authorization": "Bearer x"}},
+		},
+	}))
 
 	// COMPAT(1.6.0): verify backwards compatibility fixes
 	// Note: we're intentionally ignoring the Enabled: false case
@@ -4097,6 +4151,50 @@ func TestConversion_apiResourcesToStructs(t *testing.T) {
 	}
 }
 
+func TestConversion_ApiNetworkResourceToStructs_Bandwidth(t *testing.T) {
+	ci.Parallel(t)
+
+	found := ApiNetworkResourceToStructs([]*api.NetworkResource{
+		{Mode: "bridge"},
+		{
+			Mode: "bridge",
+			Bandwidth: &api.BandwidthConfig{
+				IngressMBits: 100,
+				EgressMBits:  50,
+			},
+		},
+	})
+	must.Len(t, 2, found)
+	must.Nil(t, found[0].Bandwidth)
+	must.Eq(t, &structs.BandwidthConfig{
+		IngressMBits: 100,
+		EgressMBits:  50,
+	}, found[1].Bandwidth)
+}
+
+func TestConversion_ApiNetworkResourceToStructs_Egress(t *testing.T) {
+	ci.Parallel(t)
+
+	found := ApiNetworkResourceToStructs([]*api.NetworkResource{
+		{
+			Mode: "bridge",
+			Egress: &api.EgressConfig{
+				Allow: []*api.EgressRule{
+					{CIDR: "10.0.0.0/8", Ports: []string{"443"}},
+					{Service: "db"},
+				},
+			},
+		},
+	})
+	must.Len(t, 1, found)
+	must.Eq(t, &structs.EgressConfig{
+		Allow: []*structs.EgressRule{
+			{CIDR: "10.0.0.0/8", Ports: []string{"443"}},
+			{Service: "db"},
+		},
+	}, found[0].Egress)
+}
+
 func TestConversion_apiJobSubmissionToStructs(t *testing.T) {
 	ci.Parallel(t)
 
@@ -312,12 +312,17 @@ func (s *HTTPServer) schedulerUpdateConfig(resp http.ResponseWriter, req *http.R
 		RejectJobRegistration:         conf.RejectJobRegistration,
 		PauseEvalBroker:               conf.PauseEvalBroker,
 		NodeLimitForFeasibilityChecks: conf.NodeLimitForFeasibilityChecks,
+		DisableImageLocality:          conf.DisableImageLocality,
 		PreemptionConfig: structs.PreemptionConfig{
 			SystemSchedulerEnabled:   conf.PreemptionConfig.SystemSchedulerEnabled,
 			SysBatchSchedulerEnabled: conf.PreemptionConfig.SysBatchSchedulerEnabled,
 			BatchSchedulerEnabled:    conf.PreemptionConfig.BatchSchedulerEnabled,
 			ServiceSchedulerEnabled:  conf.PreemptionConfig.ServiceSchedulerEnabled,
 		},
+		FairShareConfig: structs.FairShareConfig{
+			Enabled: conf.FairShareConfig.Enabled,
+			Weights: conf.FairShareConfig.Weights,
+		},
 	}
 
 	if err := args.Config.Validate(); err != nil {
@@ -343,6 +348,27 @@ func (s *HTTPServer) schedulerUpdateConfig(resp http.ResponseWriter, req *http.R
 	return reply, nil
 }
 
+// OperatorSchedulerQueues is used to inspect the fair-share queues of the
+// leader's eval broker.
+func (s *HTTPServer) OperatorSchedulerQueues(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	var args structs.GenericRequest
+	if done := s.parse(resp, req, &args.Region, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.SchedulerQueuesResponse
+	if err := s.agent.RPC("Operator.SchedulerGetQueues", &args, &reply); err != nil {
+		return nil, err
+	}
+	setMeta(resp, &reply.QueryMeta)
+
+	return reply, nil
+}
+
 func (s *HTTPServer) SnapshotRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	switch req.Method {
 	case http.MethodGet:
@@ -107,6 +107,15 @@ client {
   bridge_network_name        = "custom_bridge_name"
   bridge_network_subnet      = "custom_bridge_subnet"
   bridge_network_subnet_ipv6 = "custom_bridge_subnet_ipv6"
+  firewall_backend           = "nftables"
+
+  dns {
+    enabled = true
+    address = "127.0.0.2"
+    port    = 8653
+    domain  = "example"
+    ttl     = "5s"
+  }
 
   fingerprint "env_aws" {
     retry_interval  = "1s"
@@ -148,6 +157,8 @@ server {
   raft_multiplier               = 4
   enable_event_broker           = false
   event_buffer_size             = 200
+  enable_event_journal          = true
+  event_journal_retention       = "12h"
   job_default_priority          = 100
   job_max_priority              = 200
   job_max_count                 = 1000
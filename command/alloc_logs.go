@@ -27,6 +27,9 @@ type AllocLogsCommand struct {
 	numLines                                   int64
 	numBytes                                   int64
 	task, group                                string
+	grep                                       string
+	since                                      time.Duration
+	context, limit                             int
 }
 
 func (l *AllocLogsCommand) Help() string {
@@ -83,6 +86,23 @@ Logs Specific Options:
   -c
     Sets the tail location in number of bytes relative to the end of the logs.
 
+  -grep <regex>
+    Only display the log lines matching the regular expression. The search
+    runs on the client running the allocation, across the rotated log files of
+    the task. Can't be used with "-f", "-tail", "-n" or "-c".
+
+  -since <duration>
+    Only search the log files that were written to within the given duration,
+    such as "1h". Requires "-grep".
+
+  -context <lines>
+    Display the given number of lines before and after each matching line.
+    Requires "-grep".
+
+  -limit <matches>
+    Sets the maximum number of matching lines to display. Defaults to 100.
+    Requires "-grep".
+
   Note that the -no-color option applies to Nomad's own output. If the task's
   logs include terminal escape sequences for color codes, Nomad will not
   remove them.
@@ -108,6 +128,10 @@ func (l *AllocLogsCommand) AutocompleteFlags() complete.Flags {
 			"-tail":    complete.PredictAnything,
 			"-n":       complete.PredictAnything,
 			"-c":       complete.PredictAnything,
+			"-grep":    complete.PredictAnything,
+			"-since":   complete.PredictAnything,
+			"-context": complete.PredictAnything,
+			"-limit":   complete.PredictAnything,
 		})
 }
 
@@ -142,6 +166,10 @@ func (l *AllocLogsCommand) Run(args []string) int {
 	flags.Int64Var(&l.numBytes, "c", -1, "")
 	flags.StringVar(&l.task, "task", "", "")
 	flags.StringVar(&l.group, "group", "", "")
+	flags.StringVar(&l.grep, "grep", "", "")
+	flags.DurationVar(&l.since, "since", 0, "")
+	flags.IntVar(&l.context, "context", 0, "")
+	flags.IntVar(&l.limit, "limit", 0, "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -163,6 +191,16 @@ func (l *AllocLogsCommand) Run(args []string) int {
 		return 1
 	}
 
+	if l.grep == "" {
+		if l.since != 0 || l.context != 0 || l.limit != 0 {
+			l.Ui.Error("The -since, -context and -limit options require -grep")
+			return 1
+		}
+	} else if l.follow || l.tail || l.numLines != -1 || l.numBytes != -1 {
+		l.Ui.Error("The -grep option can't be used with -f, -tail, -n or -c")
+		return 1
+	}
+
 	client, err := l.Meta.Client()
 	if err != nil {
 		l.Ui.Error(fmt.Sprintf("Error initializing client: %v", err))
@@ -243,7 +281,21 @@ func (l *AllocLogsCommand) Run(args []string) int {
 	// In order to run the mixed log output, we can only follow the files from
 	// their current positions. There is no way to interleave previous log
 	// lines as there is no timestamp references.
-	if l.follow && !(l.stderr || l.stdout || l.tail || l.numLines > 0 || l.numBytes > 0) {
+	if l.grep != "" {
+		if l.stderr && l.stdout {
+			l.Ui.Error("Unable to support both stdout and stderr")
+			return 1
+		}
+
+		logType := api.FSLogNameStdout
+		if l.stderr {
+			logType = api.FSLogNameStderr
+		}
+		if err := l.searchLogs(client, alloc, logType); err != nil {
+			l.Ui.Error(fmt.Sprintf("Failed to search %s logs: %v", logType, err))
+			return 1
+		}
+	} else if l.follow && !(l.stderr || l.stdout || l.tail || l.numLines > 0 || l.numBytes > 0) {
 		if err := l.tailMultipleFiles(client, alloc); err != nil {
 			l.Ui.Error(fmt.Sprintf("Failed to tail stdout and stderr files: %v", err))
 			return 1
@@ -313,6 +365,48 @@ func (l *AllocLogsCommand) handleSingleFile(client *api.Client, alloc *api.Alloc
 	return nil
 }
 
+// searchLogs outputs the log lines matching the -grep pattern.
+func (l *AllocLogsCommand) searchLogs(client *api.Client, alloc *api.Allocation, logType string) error {
+	opts := &api.LogSearchOptions{
+		Pattern: l.grep,
+		Since:   l.since,
+		Context: l.context,
+		Limit:   l.limit,
+	}
+	result, _, err := client.AllocFS().SearchLogs(alloc, l.task, logType, opts, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range formatLogMatches(result.Matches, l.context) {
+		l.Ui.Output(line)
+	}
+
+	if result.Truncated {
+		l.Ui.Warn(fmt.Sprintf("Displaying the first %d matches, use -limit to display more", len(result.Matches)))
+	}
+	return nil
+}
+
+// formatLogMatches returns the lines of the matches with their context. Like
+// grep, groups of lines from different files or that aren't contiguous are
+// separated by "--" when context lines are displayed.
+func formatLogMatches(matches []*api.LogMatch, context int) []string {
+	var out []string
+	for i, match := range matches {
+		if i > 0 && context > 0 {
+			prev := matches[i-1]
+			if match.File != prev.File || match.Line-len(match.Before) > prev.Line+len(prev.After)+1 {
+				out = append(out, "--")
+			}
+		}
+		out = append(out, match.Before...)
+		out = append(out, match.Text)
+		out = append(out, match.After...)
+	}
+	return out
+}
+
 // followFile outputs the contents of the file to stdout relative to the end of
 // the file.
 func (l *AllocLogsCommand) followFile(client *api.Client, alloc *api.Allocation,
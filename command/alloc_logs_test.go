@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -68,6 +69,40 @@ func TestLogsCommand_Fails(t *testing.T) {
 	must.StrContains(t, out, "No allocation(s) with prefix or id")
 }
 
+func TestLogsCommand_GrepFlags(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		args   []string
+		expErr string
+	}{
+		{
+			args:   []string{"-since=1h", "foobar"},
+			expErr: "The -since, -context and -limit options require -grep",
+		},
+		{
+			args:   []string{"-limit=5", "foobar"},
+			expErr: "The -since, -context and -limit options require -grep",
+		},
+		{
+			args:   []string{"-grep=error", "-f", "foobar"},
+			expErr: "The -grep option can't be used with -f, -tail, -n or -c",
+		},
+		{
+			args:   []string{"-grep=error", "-n=10", "foobar"},
+			expErr: "The -grep option can't be used with -f, -tail, -n or -c",
+		},
+	}
+
+	for _, tc := range cases {
+		ui := cli.NewMockUi()
+		cmd := &AllocLogsCommand{Meta: Meta{Ui: ui}}
+
+		must.One(t, cmd.Run(tc.args))
+		must.StrContains(t, ui.ErrorWriter.String(), tc.expErr)
+	}
+}
+
 func TestLogsCommand_AutocompleteArgs(t *testing.T) {
 	ci.Parallel(t)
 
@@ -91,3 +126,26 @@ func TestLogsCommand_AutocompleteArgs(t *testing.T) {
 	must.Len(t, 1, res)
 	must.Eq(t, a.ID, res[0])
 }
+
+func TestLogsCommand_formatLogMatches(t *testing.T) {
+	ci.Parallel(t)
+
+	matches := []*api.LogMatch{
+		{File: "web.stdout.0", Line: 2, Text: "error: one", After: []string{"a"}},
+		// contiguous with the previous match
+		{File: "web.stdout.0", Line: 4, Text: "error: two", Before: []string{"b"}},
+		// separated by a line without context
+		{File: "web.stdout.0", Line: 6, Text: "error: three"},
+		// in another file
+		{File: "web.stdout.1", Line: 1, Text: "error: four"},
+	}
+
+	must.Eq(t, []string{
+		"error: one", "a", "b", "error: two", "--", "error: three", "--", "error: four",
+	}, formatLogMatches(matches, 1))
+
+	// groups are only separated when displaying context
+	must.Eq(t, []string{
+		"error: one", "a", "b", "error: two", "error: three", "error: four",
+	}, formatLogMatches(matches, 0))
+}
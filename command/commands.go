@@ -403,6 +403,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"event": func() (cli.Command, error) {
+			return &EventCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event replay": func() (cli.Command, error) {
+			return &EventReplayCommand{
+				Meta: meta,
+			}, nil
+		},
 		"exec": func() (cli.Command, error) {
 			return &AllocExecCommand{
 				Meta: meta,
@@ -776,6 +786,31 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"operator event-sink": func() (cli.Command, error) {
+			return &OperatorEventSinkCommand{
+				Meta: meta,
+			}, nil
+		},
+		"operator event-sink apply": func() (cli.Command, error) {
+			return &OperatorEventSinkApplyCommand{
+				Meta: meta,
+			}, nil
+		},
+		"operator event-sink delete": func() (cli.Command, error) {
+			return &OperatorEventSinkDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"operator event-sink info": func() (cli.Command, error) {
+			return &OperatorEventSinkInfoCommand{
+				Meta: meta,
+			}, nil
+		},
+		"operator event-sink list": func() (cli.Command, error) {
+			return &OperatorEventSinkListCommand{
+				Meta: meta,
+			}, nil
+		},
 		"operator gossip": func() (cli.Command, error) {
 			return &OperatorGossipCommand{
 				Meta: meta,
@@ -869,6 +904,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"operator scheduler queues": func() (cli.Command, error) {
+			return &OperatorSchedulerQueues{
+				Meta: meta,
+			}, nil
+		},
 		"operator scheduler set-config": func() (cli.Command, error) {
 			return &OperatorSchedulerSetConfig{
 				Meta: meta,
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"strings"
+
+	"github.com/hashicorp/cli"
+)
+
+type EventCommand struct {
+	Meta
+}
+
+func (c *EventCommand) Name() string {
+	return "event"
+}
+
+func (c *EventCommand) Synopsis() string {
+	return "Interact with the event stream"
+}
+
+func (c *EventCommand) Help() string {
+	helpText := `
+Usage: nomad event <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with Nomad's event stream.
+
+  Replay the events published in the last hour:
+
+    $ nomad event replay -since=1h
+
+  Please refer to individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
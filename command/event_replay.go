@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	flaghelper "github.com/hashicorp/nomad/helper/flags"
+	"github.com/posener/complete"
+)
+
+type EventReplayCommand struct {
+	Meta
+}
+
+func (c *EventReplayCommand) Name() string {
+	return "event replay"
+}
+
+func (c *EventReplayCommand) Synopsis() string {
+	return "Replay past events from the event journal"
+}
+
+func (c *EventReplayCommand) Help() string {
+	helpText := `
+Usage: nomad event replay -since=<index|duration> [options]
+
+  Replay prints the events published since a Raft index or within a duration,
+  one batch of events per line as newline delimited JSON, and exits once it
+  has caught up with the latest event.
+
+  Events older than the in-memory event buffer are read from the event
+  journal, which must be enabled on the servers with the
+  "enable_event_journal" server option. Only events within the journal's
+  retention window can be replayed, and "-since" durations require the
+  journal.
+
+  When ACLs are enabled, this command requires a token with the capabilities
+  needed to read each requested topic, as for the event stream API.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Replay Options:
+
+  -since=<index|duration>
+    Replay events from the given Raft index, or published within the given
+    duration such as "30m". Required.
+
+  -topic=<topic[:key]>
+    Only replay events for the topic, and optionally the key, such as
+    "Job:example". May be specified multiple times. Defaults to all topics.
+
+  -filter=<expression>
+    Only replay events matching the filter expression, such as
+    'Payload.Allocation.ClientStatus == "failed"'.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventReplayCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-since":  complete.PredictAnything,
+			"-topic":  complete.PredictAnything,
+			"-filter": complete.PredictAnything,
+		})
+}
+
+func (c *EventReplayCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *EventReplayCommand) Run(args []string) int {
+	var since, filter string
+	var topicArgs flaghelper.StringFlag
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&since, "since", "", "")
+	flags.StringVar(&filter, "filter", "", "")
+	flags.Var(&topicArgs, "topic", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we don't have any arguments.
+	if len(flags.Args()) != 0 {
+		c.Ui.Error(uiMessageNoArguments)
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	if since == "" {
+		c.Ui.Error("The -since flag is required")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	index, indexErr := strconv.ParseUint(since, 10, 64)
+	duration, durationErr := time.ParseDuration(since)
+	if indexErr != nil && durationErr != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid -since value %q: must be a Raft index or a duration", since))
+		return 1
+	}
+
+	topics := map[api.Topic][]string{}
+	for _, topicArg := range topicArgs {
+		topic, key, _ := strings.Cut(topicArg, ":")
+		if key == "" {
+			key = "*"
+		}
+		topics[api.Topic(topic)] = append(topics[api.Topic(topic)], key)
+	}
+
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	q := &api.QueryOptions{Filter: filter}
+	for {
+		var events []*api.Events
+		var qm *api.QueryMeta
+		if indexErr == nil {
+			events, qm, err = client.EventStream().Replay(topics, index, q)
+		} else {
+			events, qm, err = client.EventStream().ReplaySince(topics, duration, q)
+		}
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error replaying events: %s", err))
+			return 1
+		}
+
+		for _, batch := range events {
+			out, err := json.Marshal(struct {
+				Index  uint64
+				Events []api.Event
+			}{batch.Index, batch.Events})
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error formatting events: %s", err))
+				return 1
+			}
+			c.Ui.Output(string(out))
+		}
+
+		if qm.NextToken == "" {
+			return 0
+		}
+		q.NextToken = qm.NextToken
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+func TestEventReplayCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &EventCommand{}
+	var _ cli.Command = &EventReplayCommand{}
+}
+
+func TestEventReplayCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+
+	ui := cli.NewMockUi()
+	cmd := &EventReplayCommand{Meta: Meta{Ui: ui}}
+
+	// Fails without -since
+	code := cmd.Run([]string{})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "The -since flag is required")
+	ui.ErrorWriter.Reset()
+
+	// Fails on an invalid -since
+	code = cmd.Run([]string{"-since=yesterday"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "must be a Raft index or a duration")
+}
+
+func TestEventReplayCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	publisher, err := srv.Agent.Server().State().EventBroker()
+	must.NoError(t, err)
+	publisher.Publish(&structs.Events{Index: 1001, Events: []structs.Event{{
+		Topic:     structs.TopicJob,
+		Key:       "example",
+		Namespace: structs.DefaultNamespace,
+		Index:     1001,
+		Payload:   &structs.JobEvent{Job: &structs.Job{ID: "example"}},
+	}}})
+
+	// Events are published asynchronously.
+	var out string
+	must.Wait(t, wait.InitialSuccess(
+		wait.ErrorFunc(func() error {
+			ui := cli.NewMockUi()
+			cmd := &EventReplayCommand{Meta: Meta{Ui: ui}}
+			if code := cmd.Run([]string{"-address", url, "-since=1000", "-topic=Job:example"}); code != 0 {
+				return fmt.Errorf("expected exit code 0, got %d: %s", code, ui.ErrorWriter.String())
+			}
+			out = ui.OutputWriter.String()
+			if !strings.Contains(out, `"Index":1001`) {
+				return fmt.Errorf("event not replayed yet: %q", out)
+			}
+			return nil
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(50*time.Millisecond),
+	))
+	must.StrContains(t, out, `"ID":"example"`)
+}
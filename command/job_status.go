@@ -320,6 +320,14 @@ func (c *JobStatusCommand) Run(args []string) int {
 		}
 	}
 
+	// Print the dependency graph of batch jobs. Older servers don't support
+	// the query, so failures only warn.
+	if t := *job.Type; t == api.JobTypeBatch || t == api.JobTypeSysbatch {
+		if err := c.outputDependencies(client, job); err != nil {
+			c.Ui.Warn(err.Error())
+		}
+	}
+
 	hint, _ := c.Meta.showUIPath(UIHintContext{
 		Command: "job status single",
 		PathParams: map[string]string{
@@ -421,6 +429,47 @@ func (c *JobStatusCommand) outputParameterizedInfo(client *api.Client, job *api.
 	return nil
 }
 
+// outputDependencies prints the dependency graph of the passed job, if it
+// has dependencies or dependents. If a request fails, an error is returned.
+func (c *JobStatusCommand) outputDependencies(client *api.Client, job *api.Job) error {
+	q := &api.QueryOptions{Namespace: *job.Namespace}
+	deps, _, err := client.Jobs().Dependencies(*job.ID, q)
+	if err != nil {
+		return fmt.Errorf("Error querying job dependencies: %s", err)
+	}
+
+	if len(deps.Dependencies) != 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Dependencies[reset]"))
+		c.Ui.Output(*job.ID)
+		c.Ui.Output(strings.Join(formatDependencyTree(deps.Dependencies, ""), "\n"))
+	}
+	if len(deps.Dependents) != 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Dependents[reset]"))
+		c.Ui.Output(strings.Join(deps.Dependents, "\n"))
+	}
+	return nil
+}
+
+// formatDependencyTree returns a line for each dependency and, indented
+// below it, its own dependencies.
+func formatDependencyTree(deps []*api.JobDependencyStatus, indent string) []string {
+	var lines []string
+	for i, dep := range deps {
+		branch, child := "├─ ", "│  "
+		if i == len(deps)-1 {
+			branch, child = "└─ ", "   "
+		}
+
+		state := "waiting"
+		if dep.Complete {
+			state = "complete"
+		}
+		lines = append(lines, fmt.Sprintf("%s%s%s (%s, %s)", indent, branch, dep.ID, dep.Status, state))
+		lines = append(lines, formatDependencyTree(dep.DependsOn, indent+child)...)
+	}
+	return lines
+}
+
 // outputJobInfo prints information about the passed non-periodic job. If a
 // request fails, an error is returned.
 func (c *JobStatusCommand) outputJobInfo(client *api.Client, job *api.Job) error {
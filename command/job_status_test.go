@@ -342,6 +342,28 @@ func TestFormatAllocList_MaxRunDeadline(t *testing.T) {
 	must.StrContains(t, out, formatTime(deadline))
 }
 
+func TestFormatDependencyTree(t *testing.T) {
+	ci.Parallel(t)
+
+	deps := []*api.JobDependencyStatus{
+		{
+			ID:     "transform",
+			Status: "pending",
+			DependsOn: []*api.JobDependencyStatus{
+				{ID: "extract", Status: "dead", Complete: true},
+				{ID: "fetch", Status: "missing"},
+			},
+		},
+		{ID: "schema", Status: "dead", Complete: true},
+	}
+	must.Eq(t, []string{
+		"├─ transform (pending, waiting)",
+		"│  ├─ extract (dead, complete)",
+		"│  └─ fetch (missing, waiting)",
+		"└─ schema (dead, complete)",
+	}, formatDependencyTree(deps, ""))
+}
+
 func TestJobStatusCommand_AutocompleteArgs(t *testing.T) {
 	ci.Parallel(t)
 
@@ -229,6 +229,7 @@ func parseNamespaceSpecImpl(result *api.Namespace, list *ast.ObjectList) error {
 	delete(m, "node_pool_config")
 	delete(m, "vault")
 	delete(m, "consul")
+	delete(m, "preemption_config")
 	delete(m, "required_extra_claims")
 	delete(m, "optional_extra_claims")
 
@@ -301,6 +302,22 @@ func parseNamespaceSpecImpl(result *api.Namespace, list *ast.ObjectList) error {
 		}
 	}
 
+	pObj := list.Filter("preemption_config")
+	if len(pObj.Items) > 0 {
+		for _, o := range pObj.Elem().Items {
+			ot, ok := o.Val.(*ast.ObjectType)
+			if !ok {
+				break
+			}
+			var pConfig *api.NamespacePreemptionConfiguration
+			if err := hcl.DecodeObject(&pConfig, ot.List); err != nil {
+				return err
+			}
+			result.PreemptionConfiguration = pConfig
+			break
+		}
+	}
+
 	if metaO := list.Filter("meta"); len(metaO.Items) > 0 {
 		for _, o := range metaO.Elem().Items {
 			var m map[string]interface{}
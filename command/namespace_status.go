@@ -194,6 +194,15 @@ func (c *NamespaceStatusCommand) Run(args []string) int {
 		c.Ui.Output(formatKV(cConfigOut))
 	}
 
+	if ns.PreemptionConfiguration != nil {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Preemption Configuration[reset]"))
+		pConfig := ns.PreemptionConfiguration
+		pConfigOut := []string{
+			fmt.Sprintf("Max Preemptions Per Eval|%d", pConfig.MaxPreemptionsPerEval),
+		}
+		c.Ui.Output(formatKV(pConfigOut))
+	}
+
 	return 0
 }
 
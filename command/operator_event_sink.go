@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+)
+
+type OperatorEventSinkCommand struct {
+	Meta
+}
+
+func (c *OperatorEventSinkCommand) Name() string {
+	return "operator event-sink"
+}
+
+func (c *OperatorEventSinkCommand) Synopsis() string {
+	return "Interact with event sinks"
+}
+
+func (c *OperatorEventSinkCommand) Help() string {
+	helpText := `
+Usage: nomad operator event-sink <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with event sinks. Event
+  sinks are durable, server-side consumers of the event stream. The leader
+  delivers matching events to each sink at least once and checkpoints the
+  index of the last delivered batch in Raft, so delivery resumes where it left
+  off after a leader election.
+
+  Webhook sinks POST each batch of events as newline delimited JSON to an HTTP
+  endpoint, retrying failed deliveries with exponential backoff. File sinks
+  append each batch to a local file on the leader, rotating it when it grows
+  too large.
+
+  Create or update an event sink:
+
+    $ nomad operator event-sink apply <path>
+
+  List all event sinks:
+
+    $ nomad operator event-sink list
+
+  Fetch information on an existing event sink:
+
+    $ nomad operator event-sink info <id>
+
+  Delete an event sink:
+
+    $ nomad operator event-sink delete <id>
+
+  Please refer to individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorEventSinkCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func formatEventSinkList(sinks []*api.EventSink) string {
+	out := make([]string, len(sinks)+1)
+	out[0] = "ID|Type|Namespace|Topics|Latest Index"
+	for i, s := range sinks {
+		out[i+1] = fmt.Sprintf("%s|%s|%s|%s|%d",
+			s.ID,
+			s.Type,
+			s.Namespace,
+			formatEventSinkTopics(s.Topics),
+			s.LatestIndex,
+		)
+	}
+	return formatList(out)
+}
+
+// formatEventSinkTopics formats the topics of a sink in the same Topic:Key
+// form accepted by the event stream API.
+func formatEventSinkTopics(topics map[api.Topic][]string) string {
+	var out []string
+	for topic, keys := range topics {
+		for _, key := range keys {
+			out = append(out, fmt.Sprintf("%s:%s", topic, key))
+		}
+	}
+	sort.Strings(out)
+	return strings.Join(out, ",")
+}
+
+// eventSinkByPrefix returns an event sink that matches the given prefix or a
+// list of all matches if an exact match is not found.
+func eventSinkByPrefix(client *api.Client, prefix string) (*api.EventSink, []*api.EventSink, error) {
+	sinks, _, err := client.EventSinks().PrefixList(prefix, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch len(sinks) {
+	case 0:
+		return nil, nil, fmt.Errorf("No event sink with prefix %q found", prefix)
+	case 1:
+		return sinks[0], nil, nil
+	default:
+		for _, sink := range sinks {
+			if sink.ID == prefix {
+				return sink, nil, nil
+			}
+		}
+		return nil, sinks, nil
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper/hcl"
+	"github.com/posener/complete"
+)
+
+type OperatorEventSinkApplyCommand struct {
+	Meta
+}
+
+func (c *OperatorEventSinkApplyCommand) Name() string {
+	return "operator event-sink apply"
+}
+
+func (c *OperatorEventSinkApplyCommand) Synopsis() string {
+	return "Create or update an event sink"
+}
+
+func (c *OperatorEventSinkApplyCommand) Help() string {
+	helpText := `
+Usage: nomad operator event-sink apply [options] <input>
+
+  Apply is used to create or update an event sink. The specification file is
+  read from stdin by specifying "-", otherwise a path to the file is expected.
+  Updating an existing sink keeps its delivery checkpoint.
+
+  If ACLs are enabled, this command requires a management token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Apply Options:
+
+  -json
+    Parse the input as a JSON event sink specification.
+
+Example Specification:
+
+  event_sink "audit" {
+    type      = "webhook"
+    namespace = "*"
+
+    topics = {
+      Job        = ["*"]
+      Deployment = ["*"]
+    }
+
+    webhook {
+      address = "https://events.example.com/nomad"
+      timeout = "10s"
+      headers = {
+        Authorization = "Bearer <token>"
+      }
+    }
+  }
+
+  event_sink "archive" {
+    type = "file"
+
+    file {
+      path      = "/var/log/nomad/events.ndjson"
+      max_bytes = 104857600
+      max_files = 5
+    }
+  }
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorEventSinkApplyCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+		})
+}
+
+func (c *OperatorEventSinkApplyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictOr(
+		complete.PredictFiles("*.hcl"),
+		complete.PredictFiles("*.json"),
+	)
+}
+
+func (c *OperatorEventSinkApplyCommand) Run(args []string) int {
+	var jsonInput bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&jsonInput, "json", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we only have one argument.
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <input>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Read input content.
+	path := args[0]
+	var content []byte
+	var err error
+	switch path {
+	case "-":
+		content, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read stdin: %v", err))
+			return 1
+		}
+		// Set .hcl extension so the decoder doesn't fail.
+		if !jsonInput {
+			path = "stdin.nomad.hcl"
+		}
+	default:
+		content, err = os.ReadFile(path)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read file %q: %v", path, err))
+			return 1
+		}
+	}
+
+	// Parse input.
+	var sinkSpec eventSinkSpec
+	if jsonInput {
+		err = json.Unmarshal(content, &sinkSpec.EventSink)
+	} else {
+		hclParser := hcl.NewParser()
+
+		if hclDiags := hclParser.Parse(content, &sinkSpec, path); hclDiags.HasErrors() {
+			err = hclDiags
+		}
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to parse input content: %v", err))
+		return 1
+	}
+
+	// Make API request.
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if sinkSpec.EventSink == nil {
+		c.Ui.Error("Failed to parse input content: missing event_sink block")
+		return 1
+	}
+	sink := sinkSpec.EventSink.apiEventSink()
+
+	_, err = client.EventSinks().Register(sink, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error applying event sink: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully applied event sink %q!", sink.ID))
+	return 0
+}
+
+type eventSinkSpec struct {
+	EventSink *eventSinkBlock `hcl:"event_sink,block"`
+}
+
+// eventSinkBlock is the HCL and JSON representation of an event sink. Topics
+// uses plain string keys since the HCL decoder can't decode into maps keyed
+// by api.Topic.
+type eventSinkBlock struct {
+	ID        string                      `hcl:"id,label"`
+	Type      string                      `hcl:"type"`
+	Topics    map[string][]string         `hcl:"topics,optional"`
+	Namespace string                      `hcl:"namespace,optional"`
+	Webhook   *api.EventSinkWebhookConfig `hcl:"webhook,block"`
+	File      *api.EventSinkFileConfig    `hcl:"file,block"`
+}
+
+func (b *eventSinkBlock) apiEventSink() *api.EventSink {
+	sink := &api.EventSink{
+		ID:        b.ID,
+		Type:      b.Type,
+		Namespace: b.Namespace,
+		Webhook:   b.Webhook,
+		File:      b.File,
+	}
+	if len(b.Topics) > 0 {
+		sink.Topics = make(map[api.Topic][]string, len(b.Topics))
+		for topic, keys := range b.Topics {
+			sink.Topics[api.Topic(topic)] = keys
+		}
+	}
+	return sink
+}
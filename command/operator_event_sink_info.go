@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/posener/complete"
+)
+
+type OperatorEventSinkInfoCommand struct {
+	Meta
+}
+
+func (c *OperatorEventSinkInfoCommand) Name() string {
+	return "operator event-sink info"
+}
+
+func (c *OperatorEventSinkInfoCommand) Synopsis() string {
+	return "Fetch information on an existing event sink"
+}
+
+func (c *OperatorEventSinkInfoCommand) Help() string {
+	helpText := `
+Usage: nomad operator event-sink info [options] <id>
+
+  Info is used to fetch information on an existing event sink. The ID may be a
+  prefix of the event sink ID.
+
+  If ACLs are enabled, this command requires a management token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Info Options:
+
+  -json
+    Output the event sink in its JSON format.
+
+  -t
+    Format and display the event sink using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorEventSinkInfoCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *OperatorEventSinkInfoCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *OperatorEventSinkInfoCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we only have one argument.
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	sink, possible, err := eventSinkByPrefix(client, args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving event sink: %s", err))
+		return 1
+	}
+	if len(possible) != 0 {
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple event sinks\n\n%s", formatEventSinkList(possible)))
+		return 1
+	}
+
+	// Format output if requested.
+	if json || tmpl != "" {
+		out, err := Format(json, tmpl, sink)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	basic := []string{
+		fmt.Sprintf("ID|%s", sink.ID),
+		fmt.Sprintf("Type|%s", sink.Type),
+		fmt.Sprintf("Namespace|%s", sink.Namespace),
+		fmt.Sprintf("Topics|%s", formatEventSinkTopics(sink.Topics)),
+		fmt.Sprintf("Latest Index|%d", sink.LatestIndex),
+	}
+	c.Ui.Output(formatKV(basic))
+
+	if webhook := sink.Webhook; webhook != nil {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Webhook[reset]"))
+		// Header values are not printed since they commonly hold
+		// credentials.
+		headers := make([]string, 0, len(webhook.Headers))
+		for k := range webhook.Headers {
+			headers = append(headers, k)
+		}
+		sort.Strings(headers)
+
+		c.Ui.Output(formatKV([]string{
+			fmt.Sprintf("Address|%s", webhook.Address),
+			fmt.Sprintf("Timeout|%s", webhook.Timeout),
+			fmt.Sprintf("Headers|%s", strings.Join(headers, ",")),
+		}))
+	}
+
+	if file := sink.File; file != nil {
+		c.Ui.Output(c.Colorize().Color("\n[bold]File[reset]"))
+		c.Ui.Output(formatKV([]string{
+			fmt.Sprintf("Path|%s", file.Path),
+			fmt.Sprintf("Max Bytes|%d", file.MaxBytes),
+			fmt.Sprintf("Max Files|%d", file.MaxFiles),
+		}))
+	}
+
+	return 0
+}
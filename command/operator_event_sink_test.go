@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestOperatorEventSinkCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &OperatorEventSinkCommand{}
+	var _ cli.Command = &OperatorEventSinkApplyCommand{}
+	var _ cli.Command = &OperatorEventSinkListCommand{}
+	var _ cli.Command = &OperatorEventSinkInfoCommand{}
+	var _ cli.Command = &OperatorEventSinkDeleteCommand{}
+}
+
+func TestOperatorEventSinkCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	// Create an event sink from an HCL specification.
+	spec := `
+event_sink "audit" {
+  type      = "webhook"
+  namespace = "default"
+
+  topics = {
+    Job        = ["*"]
+    Deployment = ["example"]
+  }
+
+  webhook {
+    address = "https://events.example.com/nomad"
+    timeout = "30s"
+    headers = {
+      Authorization = "Bearer secret"
+    }
+  }
+}`
+	path := filepath.Join(t.TempDir(), "sink.hcl")
+	must.NoError(t, os.WriteFile(path, []byte(spec), 0o600))
+
+	ui := cli.NewMockUi()
+	applyCmd := &OperatorEventSinkApplyCommand{Meta: Meta{Ui: ui}}
+	code := applyCmd.Run([]string{"-address", url, path})
+	must.Eq(t, 0, code, must.Sprint(ui.ErrorWriter.String()))
+	must.StrContains(t, ui.OutputWriter.String(), `Successfully applied event sink "audit"!`)
+
+	got, err := srv.Agent.Server().State().EventSinkByID(nil, "audit")
+	must.NoError(t, err)
+	must.NotNil(t, got)
+	must.Eq(t, structs.EventSinkTypeWebhook, got.Type)
+	must.Eq(t, "default", got.Namespace)
+	must.Eq(t, map[structs.Topic][]string{
+		structs.TopicJob:        {"*"},
+		structs.TopicDeployment: {"example"},
+	}, got.Topics)
+	must.Eq(t, 30*time.Second, got.Webhook.Timeout)
+	must.Eq(t, "Bearer secret", got.Webhook.Headers["Authorization"])
+
+	// List the event sinks.
+	ui = cli.NewMockUi()
+	listCmd := &OperatorEventSinkListCommand{Meta: Meta{Ui: ui}}
+	code = listCmd.Run([]string{"-address", url})
+	must.Eq(t, 0, code, must.Sprint(ui.ErrorWriter.String()))
+	must.StrContains(t, ui.OutputWriter.String(), "audit")
+	must.StrContains(t, ui.OutputWriter.String(), "Deployment:example,Job:*")
+
+	// Fetch the event sink by prefix; header values must not be printed.
+	ui = cli.NewMockUi()
+	infoCmd := &OperatorEventSinkInfoCommand{Meta: Meta{Ui: ui}}
+	code = infoCmd.Run([]string{"-address", url, "aud"})
+	must.Eq(t, 0, code, must.Sprint(ui.ErrorWriter.String()))
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, "https://events.example.com/nomad")
+	must.StrContains(t, out, "Authorization")
+	must.StrNotContains(t, out, "Bearer secret")
+
+	// Delete the event sink.
+	ui = cli.NewMockUi()
+	deleteCmd := &OperatorEventSinkDeleteCommand{Meta: Meta{Ui: ui}}
+	code = deleteCmd.Run([]string{"-address", url, "audit"})
+	must.Eq(t, 0, code, must.Sprint(ui.ErrorWriter.String()))
+
+	got, err = srv.Agent.Server().State().EventSinkByID(nil, "audit")
+	must.NoError(t, err)
+	must.Nil(t, got)
+
+	ui = cli.NewMockUi()
+	listCmd = &OperatorEventSinkListCommand{Meta: Meta{Ui: ui}}
+	code = listCmd.Run([]string{"-address", url})
+	must.Eq(t, 0, code)
+	must.StrContains(t, ui.OutputWriter.String(), "No event sinks found")
+}
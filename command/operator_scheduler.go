@@ -31,6 +31,10 @@ Usage: nomad operator scheduler <subcommand> [options]
 
       $ nomad operator scheduler set-config -scheduler-algorithm=spread
 
+  Display the fair-share queues of the eval broker:
+
+      $ nomad operator scheduler queues
+
   Please see the individual subcommand help for detailed usage information.
 `
 	return strings.TrimSpace(helpText)
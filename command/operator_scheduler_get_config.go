@@ -5,6 +5,8 @@ package command
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/cli"
@@ -87,11 +89,28 @@ func (o *OperatorSchedulerGetConfig) Run(args []string) int {
 		fmt.Sprintf("Preemption Batch Scheduler|%v", schedConfig.PreemptionConfig.BatchSchedulerEnabled),
 		fmt.Sprintf("Preemption SysBatch Scheduler|%v", schedConfig.PreemptionConfig.SysBatchSchedulerEnabled),
 		fmt.Sprintf("Node Limit For Feasibility Checks|%v", schedConfig.NodeLimitForFeasibilityChecks),
+		fmt.Sprintf("Image Locality|%v", !schedConfig.DisableImageLocality),
+		fmt.Sprintf("Fair Share|%v", schedConfig.FairShareConfig.Enabled),
+		fmt.Sprintf("Fair Share Weights|%s", formatFairShareWeights(schedConfig.FairShareConfig.Weights)),
 		fmt.Sprintf("Modify Index|%v", resp.SchedulerConfig.ModifyIndex),
 	}))
 	return 0
 }
 
+// formatFairShareWeights formats the fair-share weights as a sorted list of
+// namespace=weight pairs.
+func formatFairShareWeights(weights map[string]int) string {
+	if len(weights) == 0 {
+		return "<none>"
+	}
+
+	pairs := make([]string, 0, len(weights))
+	for _, ns := range slices.Sorted(maps.Keys(weights)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", ns, weights[ns]))
+	}
+	return strings.Join(pairs, ",")
+}
+
 func (o *OperatorSchedulerGetConfig) Synopsis() string {
 	return "Display the current scheduler configuration"
 }
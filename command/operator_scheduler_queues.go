@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/posener/complete"
+)
+
+// Ensure OperatorSchedulerQueues satisfies the cli.Command interface.
+var _ cli.Command = &OperatorSchedulerQueues{}
+
+type OperatorSchedulerQueues struct {
+	Meta
+
+	json bool
+	tmpl string
+}
+
+func (o *OperatorSchedulerQueues) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(o.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		},
+	)
+}
+
+func (o *OperatorSchedulerQueues) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (o *OperatorSchedulerQueues) Name() string { return "operator scheduler queues" }
+
+func (o *OperatorSchedulerQueues) Run(args []string) int {
+
+	flags := o.Meta.FlagSet("queues", FlagSetClient)
+	flags.BoolVar(&o.json, "json", false, "")
+	flags.StringVar(&o.tmpl, "t", "", "")
+	flags.Usage = func() { o.Ui.Output(o.Help()) }
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments.
+	if l := len(flags.Args()); l != 0 {
+		o.Ui.Error(uiMessageNoArguments)
+		o.Ui.Error(commandErrorText(o))
+		return 1
+	}
+
+	// Set up a client.
+	client, err := o.Meta.Client()
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Fetch the current queue state.
+	resp, _, err := client.Operator().SchedulerGetQueues(nil)
+	if err != nil {
+		o.Ui.Error(fmt.Sprintf("Error querying scheduler queues: %s", err))
+		return 1
+	}
+
+	if o.json || len(o.tmpl) > 0 {
+		out, err := Format(o.json, o.tmpl, resp)
+		if err != nil {
+			o.Ui.Error(err.Error())
+			return 1
+		}
+		o.Ui.Output(out)
+		return 0
+	}
+
+	o.Ui.Output(formatKV([]string{
+		fmt.Sprintf("Fair Share|%v", resp.FairShareEnabled),
+	}))
+	o.Ui.Output("")
+
+	if len(resp.Queues) == 0 {
+		o.Ui.Output("No queued or recently dequeued evaluations")
+		return 0
+	}
+
+	rows := make([]string, len(resp.Queues)+1)
+	rows[0] = "Namespace|Weight|Usage|Ready|Unacked"
+	for i, q := range resp.Queues {
+		rows[i+1] = fmt.Sprintf("%s|%d|%.2f|%d|%d",
+			q.Namespace, q.Weight, q.Usage, q.Ready, q.Unacked)
+	}
+	o.Ui.Output(formatList(rows))
+	return 0
+}
+
+func (o *OperatorSchedulerQueues) Synopsis() string {
+	return "Display the fair-share queues of the eval broker"
+}
+
+func (o *OperatorSchedulerQueues) Help() string {
+	helpText := `
+Usage: nomad operator scheduler queues [options]
+
+  Displays the fair-share queue state of the eval broker running on the
+  leader. For each namespace with queued or recently dequeued evaluations,
+  the output includes the namespace's weight, its recent usage, and the
+  number of ready and unacknowledged evaluations.
+
+  If ACLs are enabled, this command requires a token with the 'operator:read'
+  capability.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Scheduler Queues Options:
+
+  -json
+    Output the queue state in its JSON format.
+
+  -t
+    Format and display the queue state using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
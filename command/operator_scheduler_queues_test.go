@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestOperatorSchedulerQueues_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, addr := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := cli.NewMockUi()
+	c := &OperatorSchedulerQueues{Meta: Meta{Ui: ui}}
+
+	// Run the command, so we get the default output and test this.
+	must.Zero(t, c.Run([]string{"-address=" + addr}))
+	must.StrContains(t, ui.OutputWriter.String(), "Fair Share = false")
+	ui.ErrorWriter.Reset()
+	ui.OutputWriter.Reset()
+
+	// Request JSON output and test.
+	must.Zero(t, c.Run([]string{"-address=" + addr, "-json"}))
+	var js api.SchedulerQueuesResponse
+	must.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &js))
+	must.False(t, js.FairShareEnabled)
+	ui.ErrorWriter.Reset()
+	ui.OutputWriter.Reset()
+
+	// Test an unexpected argument.
+	must.One(t, c.Run([]string{"-address=" + addr, "extra"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes no arguments")
+}
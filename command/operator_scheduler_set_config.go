@@ -5,6 +5,7 @@ package command
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/cli"
@@ -32,6 +33,9 @@ type OperatorSchedulerSetConfig struct {
 	preemptSysBatchScheduler      flagHelper.BoolValue
 	preemptSystemScheduler        flagHelper.BoolValue
 	nodeLimitForFeasibilityChecks flagHelper.UintValue
+	fairShare                     flagHelper.BoolValue
+	fairShareWeights              flagHelper.StringFlag
+	imageLocality                 flagHelper.BoolValue
 }
 
 func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
@@ -41,6 +45,8 @@ func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
 			"-scheduler-algorithm": complete.PredictSet(
 				string(api.SchedulerAlgorithmBinpack),
 				string(api.SchedulerAlgorithmSpread),
+				string(api.SchedulerAlgorithmTetris),
+				string(api.SchedulerAlgorithmLeastAllocatedByClass),
 			),
 			"-memory-oversubscription":           complete.PredictSet("true", "false"),
 			"-reject-job-registration":           complete.PredictSet("true", "false"),
@@ -50,6 +56,9 @@ func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
 			"-preempt-sysbatch-scheduler":        complete.PredictSet("true", "false"),
 			"-preempt-system-scheduler":          complete.PredictSet("true", "false"),
 			"-node-limit-for-feasibility-checks": complete.PredictAnything,
+			"-fair-share":                        complete.PredictSet("true", "false"),
+			"-fair-share-weight":                 complete.PredictAnything,
+			"-image-locality":                    complete.PredictSet("true", "false"),
 		},
 	)
 }
@@ -75,6 +84,9 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	flags.Var(&o.preemptSysBatchScheduler, "preempt-sysbatch-scheduler", "")
 	flags.Var(&o.preemptSystemScheduler, "preempt-system-scheduler", "")
 	flags.Var(&o.nodeLimitForFeasibilityChecks, "node-limit-for-feasibility-checks", "")
+	flags.Var(&o.fairShare, "fair-share", "")
+	flags.Var(&o.fairShareWeights, "fair-share-weight", "")
+	flags.Var(&o.imageLocality, "image-locality", "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -138,6 +150,14 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	o.preemptSysBatchScheduler.Merge(&schedulerConfig.PreemptionConfig.SysBatchSchedulerEnabled)
 	o.preemptSystemScheduler.Merge(&schedulerConfig.PreemptionConfig.SystemSchedulerEnabled)
 	o.nodeLimitForFeasibilityChecks.Merge(&schedulerConfig.NodeLimitForFeasibilityChecks)
+	o.fairShare.Merge(&schedulerConfig.FairShareConfig.Enabled)
+	if err := mergeFairShareWeights(&schedulerConfig.FairShareConfig, o.fairShareWeights); err != nil {
+		o.Ui.Error(err.Error())
+		return 1
+	}
+	imageLocality := !schedulerConfig.DisableImageLocality
+	o.imageLocality.Merge(&imageLocality)
+	schedulerConfig.DisableImageLocality = !imageLocality
 
 	// Check-and-set the new configuration.
 	result, _, err := client.Operator().SchedulerCASConfiguration(schedulerConfig, nil)
@@ -153,6 +173,31 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	return 1
 }
 
+// mergeFairShareWeights merges namespace=weight pairs into the fair-share
+// configuration. A weight of zero removes the namespace's weight.
+func mergeFairShareWeights(config *api.FairShareConfig, pairs []string) error {
+	for _, pair := range pairs {
+		ns, raw, ok := strings.Cut(pair, "=")
+		if !ok || ns == "" {
+			return fmt.Errorf("Error parsing fair-share-weight %q: must be in the form <namespace>=<weight>", pair)
+		}
+		weight, err := strconv.Atoi(raw)
+		if err != nil || weight < 0 {
+			return fmt.Errorf("Error parsing fair-share-weight %q: weight must be a non-negative integer", pair)
+		}
+
+		if weight == 0 {
+			delete(config.Weights, ns)
+			continue
+		}
+		if config.Weights == nil {
+			config.Weights = make(map[string]int)
+		}
+		config.Weights[ns] = weight
+	}
+	return nil
+}
+
 func (o *OperatorSchedulerSetConfig) Synopsis() string {
 	return "Modify the current scheduler configuration"
 }
@@ -177,9 +222,13 @@ Scheduler Set Config Options:
     matches the current server side version. If a non-zero value is passed, it
     ensures that the scheduler config is being updated from a known state.
 
-  -scheduler-algorithm=["binpack"|"spread"]
-    Specifies whether scheduler binpacks or spreads allocations on available
-    nodes.
+  -scheduler-algorithm=["binpack"|"spread"|"tetris"|"least-allocated-by-class"]
+    Specifies the algorithm the scheduler uses to score available nodes. The
+    "binpack" algorithm packs allocations onto as few nodes as possible and
+    "spread" distributes them evenly. The "tetris" algorithm performs a
+    multi-dimensional best fit across CPU, memory, disk and devices. The
+    "least-allocated-by-class" algorithm balances placements across node
+    classes, preferring the least allocated nodes within each class.
 
   -memory-oversubscription=[true|false]
     When true, tasks may exceed their reserved memory limit, if the client has
@@ -219,6 +268,21 @@ Scheduler Set Config Options:
 	numbers result in better scheduler performance and more randomization of jobs
 	across nodes. Higher numbers result in more deterministic application of
 	feasibility checks.
+
+  -image-locality=[true|false]
+    Specifies whether the scheduler prefers nodes which already hold the
+    images of a task group. Nodes expose their images when the docker
+    driver's image_cache fingerprint option is enabled. Defaults to true.
+
+  -fair-share=[true|false]
+    When true, the eval broker dequeues evaluations using weighted fair-share
+    queues. The namespace whose recent usage is furthest below its share is
+    dequeued from first, so a single namespace cannot starve the others.
+
+  -fair-share-weight=<namespace>=<weight>
+    Sets the relative fair-share weight of a namespace. Namespaces without a
+    weight have a weight of 1. A weight of 0 removes the namespace's weight.
+    This flag can be specified multiple times.
 `
 	return strings.TrimSpace(helpText)
 }
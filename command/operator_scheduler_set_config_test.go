@@ -52,6 +52,10 @@ func TestOperatorSchedulerSetConfig_Run(t *testing.T) {
 		"-preempt-sysbatch-scheduler=true",
 		"-preempt-system-scheduler=false",
 		"-node-limit-for-feasibility-checks=200",
+		"-fair-share=true",
+		"-fair-share-weight=default=3",
+		"-fair-share-weight=batch=2",
+		"-image-locality=false",
 	}
 	must.Zero(t, c.Run(modifyingArgs))
 	s := ui.OutputWriter.String()
@@ -71,6 +75,11 @@ func TestOperatorSchedulerSetConfig_Run(t *testing.T) {
 		RejectJobRegistration:         true,
 		PauseEvalBroker:               true,
 		NodeLimitForFeasibilityChecks: 200,
+		FairShareConfig: api.FairShareConfig{
+			Enabled: true,
+			Weights: map[string]int{"default": 3, "batch": 2},
+		},
+		DisableImageLocality: true,
 	}, modifiedConfig.SchedulerConfig)
 
 	ui.ErrorWriter.Reset()
@@ -102,6 +111,16 @@ func TestOperatorSchedulerSetConfig_Run(t *testing.T) {
 	must.StrContains(t, ui.OutputWriter.String(), "Scheduler configuration updated!")
 	ui.ErrorWriter.Reset()
 	ui.OutputWriter.Reset()
+
+	// Remove a fair-share weight and ensure an invalid weight is rejected.
+	must.Zero(t, c.Run([]string{"-address=" + addr, "-fair-share-weight=batch=0"}))
+	removedWeightConfig, _, err := srv.APIClient().Operator().SchedulerGetConfiguration(nil)
+	must.NoError(t, err)
+	must.Eq(t, map[string]int{"default": 3}, removedWeightConfig.SchedulerConfig.FairShareConfig.Weights)
+	must.One(t, c.Run([]string{"-address=" + addr, "-fair-share-weight=batch"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "must be in the form <namespace>=<weight>")
+	ui.ErrorWriter.Reset()
+	ui.OutputWriter.Reset()
 }
 
 func schedulerConfigEquals(t *testing.T, expected, actual *api.SchedulerConfiguration) {
@@ -111,4 +130,6 @@ func schedulerConfigEquals(t *testing.T, expected, actual *api.SchedulerConfigur
 	must.Eq(t, expected.PauseEvalBroker, actual.PauseEvalBroker)
 	must.Eq(t, expected.PreemptionConfig, actual.PreemptionConfig)
 	must.Eq(t, expected.NodeLimitForFeasibilityChecks, actual.NodeLimitForFeasibilityChecks)
+	must.Eq(t, expected.FairShareConfig, actual.FairShareConfig)
+	must.Eq(t, expected.DisableImageLocality, actual.DisableImageLocality)
 }
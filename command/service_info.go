@@ -240,9 +240,13 @@ func (s *ServiceInfoCommand) formatVerboseOutput(jobIDs []string, jobServices ma
 				fmt.Sprintf("Alloc ID|%s", service.AllocID),
 				fmt.Sprintf("Node ID|%s", service.NodeID),
 				fmt.Sprintf("Datacenter|%s", service.Datacenter),
-				fmt.Sprintf("Address|%v", fmt.Sprintf("%s:%v", service.Address, service.Port)),
-				fmt.Sprintf("Tags|[%s]\n", strings.Join(service.Tags, ",")),
+				fmt.Sprintf("Address|%s", formatAddress(service.Address, service.Port)),
 			}
+			if service.DualStackAddress != "" {
+				out = append(out, fmt.Sprintf("Dual-Stack Address|%s",
+					formatAddress(service.DualStackAddress, service.Port)))
+			}
+			out = append(out, fmt.Sprintf("Tags|[%s]\n", strings.Join(service.Tags, ",")))
 			s.Ui.Output(formatKV(out))
 			s.Ui.Output("")
 		}
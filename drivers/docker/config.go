@@ -181,6 +181,11 @@ var (
 	//			enabled = true
 	//			selinuxlabel = "z"
 	//		}
+	//		image_cache {
+	//			prepull = ["redis:7"]
+	//			refresh_interval = "1h"
+	//			fingerprint = true
+	//		}
 	//		allow_privileged = false
 	//		allow_caps = ["CHOWN", "NET_RAW" ... ]
 	//		nvidia_runtime = "nvidia"
@@ -259,6 +264,18 @@ var (
 			"enabled":      hclspec.NewAttr("enabled", "bool", false),
 			"selinuxlabel": hclspec.NewAttr("selinuxlabel", "string", false),
 		})), hclspec.NewLiteral("{ enabled = false }")),
+
+		// image pre-pull and caching options
+		"image_cache": hclspec.NewDefault(hclspec.NewBlock("image_cache", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"prepull": hclspec.NewAttr("prepull", "list(string)", false),
+			"refresh_interval": hclspec.NewDefault(
+				hclspec.NewAttr("refresh_interval", "string", false),
+				hclspec.NewLiteral(`"1h"`),
+			),
+			"fingerprint": hclspec.NewAttr("fingerprint", "bool", false),
+		})), hclspec.NewLiteral(`{
+			refresh_interval = "1h"
+		}`)),
 		"allow_privileged": hclspec.NewAttr("allow_privileged", "bool", false),
 		"allow_caps": hclspec.NewDefault(
 			hclspec.NewAttr("allow_caps", "list(string)", false),
@@ -690,6 +707,7 @@ type DriverConfig struct {
 	TLS                                TLSConfig          `codec:"tls"`
 	GC                                 GCConfig           `codec:"gc"`
 	Volumes                            VolumeConfig       `codec:"volumes"`
+	ImageCache                         ImageCacheConfig   `codec:"image_cache"`
 	AllowPrivileged                    bool               `codec:"allow_privileged"`
 	AllowCaps                          []string           `codec:"allow_caps"`
 	GPURuntimeName                     string             `codec:"nvidia_runtime"`
@@ -736,6 +754,25 @@ type GCConfig struct {
 	DanglingContainers ContainerGCConfig `codec:"dangling_containers"`
 }
 
+// ImageCacheConfig controls which images are pulled ahead of placement and
+// whether the images held by the node are fingerprinted.
+type ImageCacheConfig struct {
+	// PrePull is the list of images pulled once the driver is detected.
+	// Pre-pulled images are not garbage collected.
+	PrePull []string `codec:"prepull"`
+
+	// RefreshInterval is the interval at which pre-pulled images are pulled
+	// again, so that mutable tags are kept up to date. Zero disables it.
+	RefreshInterval string        `codec:"refresh_interval"`
+	refreshInterval time.Duration `codec:"-"`
+
+	// Fingerprint exposes the tagged images of the node as the
+	// unique.driver.docker.images attribute, which the scheduler uses to
+	// prefer nodes that already hold the images of a task group. Only the
+	// maxFingerprintImages most recently created images are exposed.
+	Fingerprint bool `codec:"fingerprint"`
+}
+
 type VolumeConfig struct {
 	Enabled      bool   `codec:"enabled"`
 	SelinuxLabel string `codec:"selinuxlabel"`
@@ -814,6 +851,20 @@ func (d *Driver) SetConfig(c *base.Config) error {
 		d.config.pullActivityTimeoutDuration = dur
 	}
 
+	if d.config.ImageCache.RefreshInterval != "" {
+		dur, err := time.ParseDuration(d.config.ImageCache.RefreshInterval)
+		if err != nil {
+			return fmt.Errorf("failed to parse 'refresh_interval' duration: %v", err)
+		}
+		d.config.ImageCache.refreshInterval = dur
+	}
+
+	for _, image := range d.config.ImageCache.PrePull {
+		if _, _, err := parseDockerImage(image); err != nil {
+			return fmt.Errorf("invalid image %q in 'prepull': %v", image, err)
+		}
+	}
+
 	if d.config.InfraImagePullTimeout != "" {
 		dur, err := time.ParseDuration(d.config.InfraImagePullTimeout)
 		if err != nil {
@@ -856,6 +907,7 @@ func (d *Driver) SetConfig(c *base.Config) error {
 	d.coordinator = newDockerCoordinator(coordinatorConfig)
 
 	d.danglingReconciler = newReconciler(d)
+	d.imagePrePuller = newImagePrePuller(d)
 
 	go d.recoverPauseContainers(d.ctx)
 
@@ -814,3 +814,42 @@ func TestConfig_DriverConfig_AllowRuntimes(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_DriverConfig_ImageCache(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name     string
+		config   string
+		expected ImageCacheConfig
+	}{
+		{
+			name:     "default",
+			config:   `{}`,
+			expected: ImageCacheConfig{RefreshInterval: "1h"},
+		},
+		{
+			name: "set explicitly",
+			config: `{
+				image_cache {
+					prepull = ["redis:7", "nginx:1.27"]
+					refresh_interval = "0s"
+					fingerprint = true
+				}
+			}`,
+			expected: ImageCacheConfig{
+				PrePull:         []string{"redis:7", "nginx:1.27"},
+				RefreshInterval: "0s",
+				Fingerprint:     true,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var tc DriverConfig
+			hclutils.NewConfigParser(configSpec).ParseHCL(t, "config "+c.config, &tc)
+			must.Eq(t, c.expected, tc.ImageCache)
+		})
+	}
+}
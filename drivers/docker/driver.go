@@ -183,6 +183,9 @@ type Driver struct {
 	infinityClient   *client.Client // for wait and stop calls (use getInfinityClient())
 
 	danglingReconciler *containerReconciler
+
+	// imagePrePuller pulls images ahead of placement
+	imagePrePuller *imagePrePuller
 }
 
 // NewDockerDriver returns a docker implementation of a driver plugin
@@ -4,8 +4,10 @@
 package docker
 
 import (
+	"cmp"
 	"context"
 	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/hashicorp/nomad/plugins/drivers/utils"
 	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+	"github.com/moby/moby/api/types/image"
 	mclient "github.com/moby/moby/client"
 )
 
@@ -20,6 +23,7 @@ func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint,
 	// Start docker reconcilers when we start fingerprinting, a workaround for
 	// task drivers not having a kind of post-setup hook.
 	d.danglingReconciler.Start()
+	d.imagePrePuller.Start()
 
 	ch := make(chan *drivers.Fingerprint)
 	go d.handleFingerprint(ctx, ch)
@@ -141,6 +145,16 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 		fp.Attributes["driver.docker.volumes.enabled"] = pstructs.NewBoolAttribute(true)
 	}
 
+	if d.config.ImageCache.Fingerprint {
+		if images, err := dockerClient.ImageList(d.ctx, mclient.ImageListOptions{}); err != nil {
+			d.logger.Warn("failed to list images", "error", err)
+		} else {
+			// an empty attribute removes the attribute from the node
+			fp.Attributes["unique.driver.docker.images"] = pstructs.NewStringAttribute(
+				strings.Join(imageTags(images.Items, maxFingerprintImages), ","))
+		}
+	}
+
 	if nets, err := dockerClient.NetworkList(d.ctx, mclient.NetworkListOptions{}); err != nil {
 		d.logger.Warn("error discovering bridge IP", "error", err)
 	} else {
@@ -201,3 +215,33 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 
 	return fp
 }
+
+// maxFingerprintImages is the maximum number of image tags exposed as the
+// unique.driver.docker.images attribute, to bound the size of the node.
+const maxFingerprintImages = 256
+
+// imageTags returns the sorted tags of images, which don't include untagged
+// images, up to limit tags of the most recently created images.
+func imageTags(images []image.Summary, limit int) []string {
+	// Keep the tags of the most recently created images if there are more
+	// than the limit.
+	images = slices.Clone(images)
+	slices.SortStableFunc(images, func(a, b image.Summary) int {
+		return cmp.Compare(b.Created, a.Created)
+	})
+
+	var tags []string
+	for _, img := range images {
+		for _, tag := range img.RepoTags {
+			if tag != "" && tag != "<none>:<none>" && !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) >= limit {
+			tags = tags[:limit]
+			break
+		}
+	}
+	slices.Sort(tags)
+	return tags
+}
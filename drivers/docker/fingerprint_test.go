@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/nomad/client/testutil"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/moby/moby/api/types/image"
 	"github.com/shoenig/test/must"
 )
 
@@ -53,3 +54,21 @@ func TestDockerDriver_NonRoot_CGV2(t *testing.T) {
 	must.Eq(t, drivers.HealthStateUndetected, fp.Health)
 	must.Eq(t, drivers.DriverRequiresRootMessage, fp.HealthDescription)
 }
+
+func TestDockerDriver_imageTags(t *testing.T) {
+	ci.Parallel(t)
+
+	images := []image.Summary{
+		{RepoTags: []string{"redis:7", "redis:latest"}, Created: 3},
+		{RepoTags: []string{"<none>:<none>"}, Created: 4},
+		{RepoTags: nil, Created: 5},
+		{RepoTags: []string{"example.com/app:1.0", "redis:7"}, Created: 1},
+	}
+	tags := imageTags(images, 10)
+	must.Eq(t, []string{"example.com/app:1.0", "redis:7", "redis:latest"}, tags)
+	must.Nil(t, imageTags(nil, 10))
+
+	// only the tags of the most recently created images are kept
+	must.Eq(t, []string{"redis:7", "redis:latest"}, imageTags(images, 2))
+	must.Eq(t, []string{"redis:7"}, imageTags(images, 1))
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package docker
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/moby/moby/api/types/registry"
+)
+
+// imagePrePullCallerID is the prefix of the image references held by the
+// pre-puller, which keep pre-pulled images from being garbage collected.
+const imagePrePullCallerID = "image-prepull:"
+
+// imagePrePuller pulls images ahead of placement, so tasks using them don't
+// wait for a cold pull. Images are pulled through the coordinator, so they
+// are deduplicated with the pulls of tasks.
+type imagePrePuller struct {
+	ctx         context.Context
+	logger      hclog.Logger
+	coordinator *dockerCoordinator
+	auth        authBackend
+
+	// configImages are the images of the plugin configuration
+	configImages    []string
+	refreshInterval time.Duration
+
+	pullTimeout         time.Duration
+	pullActivityTimeout time.Duration
+
+	// metaImages are the images set through node metadata
+	metaImages []string
+	metaLock   sync.Mutex
+
+	// pulled maps the pre-pulled images to their ID. It is only accessed by
+	// the run goroutine.
+	pulled map[string]string
+
+	updateCh chan struct{}
+	once     sync.Once
+}
+
+func newImagePrePuller(d *Driver) *imagePrePuller {
+	// image_pull_timeout is validated by SetConfig
+	pullTimeout, _ := time.ParseDuration(d.config.ImagePullTimeout)
+
+	return &imagePrePuller{
+		ctx:         d.ctx,
+		logger:      d.logger.Named("image_prepull"),
+		coordinator: d.coordinator,
+		auth: func(repo string) (*registry.AuthConfig, error) {
+			return firstValidAuth(repo, []authBackend{
+				authFromDockerConfig(d.config.Auth.Config),
+				authFromHelper(d.config.Auth.Helper),
+			})
+		},
+		configImages:        d.config.ImageCache.PrePull,
+		refreshInterval:     d.config.ImageCache.refreshInterval,
+		pullTimeout:         pullTimeout,
+		pullActivityTimeout: d.config.pullActivityTimeoutDuration,
+		pulled:              make(map[string]string),
+		updateCh:            make(chan struct{}, 1),
+	}
+}
+
+// Start starts pulling images in the background. It is safe to call multiple
+// times.
+func (p *imagePrePuller) Start() {
+	p.once.Do(func() {
+		go p.run()
+	})
+}
+
+// SetImages sets the images of the node metadata, which are pulled in
+// addition to the images of the plugin configuration. Pre-pulled images that
+// are no longer set are released for garbage collection.
+func (p *imagePrePuller) SetImages(images []string) {
+	p.metaLock.Lock()
+	p.metaImages = images
+	p.metaLock.Unlock()
+
+	select {
+	case p.updateCh <- struct{}{}:
+	default:
+	}
+}
+
+// images returns the sorted set of images to pre-pull.
+func (p *imagePrePuller) images() []string {
+	p.metaLock.Lock()
+	defer p.metaLock.Unlock()
+
+	images := slices.Concat(p.configImages, p.metaImages)
+	slices.Sort(images)
+	return slices.Compact(images)
+}
+
+func (p *imagePrePuller) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-p.updateCh:
+			p.sync(false)
+		case <-timer.C:
+			p.sync(true)
+			if p.refreshInterval > 0 {
+				timer.Reset(p.refreshInterval)
+			}
+		}
+	}
+}
+
+// sync pulls the images that are not pulled yet, or all of them if refresh is
+// set, and releases the references of the images which are no longer set.
+func (p *imagePrePuller) sync(refresh bool) {
+	images := p.images()
+
+	for image, id := range p.pulled {
+		if !slices.Contains(images, image) {
+			p.logger.Debug("releasing pre-pulled image", "image", image, "image_id", id)
+			p.coordinator.RemoveImage(id, imagePrePullCallerID+image)
+			delete(p.pulled, image)
+		}
+	}
+
+	for _, image := range images {
+		if p.ctx.Err() != nil {
+			return
+		}
+
+		oldID, ok := p.pulled[image]
+		if ok && !refresh {
+			continue
+		}
+
+		id, err := p.pull(image)
+		if err != nil {
+			p.logger.Warn("failed to pre-pull image", "image", image, "error", err)
+			continue
+		}
+
+		// release the previous image of a mutable tag
+		if ok && oldID != id {
+			p.coordinator.RemoveImage(oldID, imagePrePullCallerID+image)
+		}
+		p.pulled[image] = id
+	}
+}
+
+// pull pulls the image through the coordinator, referencing it on behalf of
+// the pre-puller.
+func (p *imagePrePuller) pull(image string) (string, error) {
+	repo, _, err := parseDockerImage(image)
+	if err != nil {
+		return "", err
+	}
+
+	authOptions, err := p.auth(repo)
+	if err != nil {
+		p.logger.Warn("failed to find docker repo auth", "repo", repo, "error", err)
+	}
+
+	p.logger.Debug("pre-pulling image", "image", image)
+	id, _, err := p.coordinator.PullImage(image, authOptions, imagePrePullCallerID+image,
+		noopLogEventFn, p.pullTimeout, p.pullActivityTimeout)
+	return id, err
+}
+
+// PrePullImages implements drivers.DriverImagePrePuller. The images are set
+// through the node metadata of the client.
+func (d *Driver) PrePullImages(images []string) {
+	if d.imagePrePuller == nil {
+		return
+	}
+	d.imagePrePuller.SetImages(images)
+}
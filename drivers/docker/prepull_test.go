@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package docker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/moby/moby/api/types/registry"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+func TestImagePrePuller_sync(t *testing.T) {
+	ci.Parallel(t)
+
+	mock := newMockImageClient(map[string]string{
+		"redis:7":    "sha256:redis",
+		"nginx:1.27": "sha256:nginx",
+	}, 0)
+	coordinator := newDockerCoordinator(&dockerCoordinatorConfig{
+		ctx:         context.Background(),
+		logger:      testlog.HCLogger(t),
+		cleanup:     true,
+		client:      mock,
+		removeDelay: 10 * time.Millisecond,
+	})
+
+	p := &imagePrePuller{
+		ctx:                 context.Background(),
+		logger:              testlog.HCLogger(t),
+		coordinator:         coordinator,
+		auth:                func(string) (*registry.AuthConfig, error) { return nil, nil },
+		configImages:        []string{"redis:7"},
+		pullTimeout:         time.Minute,
+		pullActivityTimeout: time.Minute,
+		pulled:              make(map[string]string),
+		updateCh:            make(chan struct{}, 1),
+	}
+
+	pulls := func(image string) int {
+		mock.lock.Lock()
+		defer mock.lock.Unlock()
+		return mock.pulled[image]
+	}
+	references := func(id string) map[string]struct{} {
+		coordinator.imageLock.Lock()
+		defer coordinator.imageLock.Unlock()
+		return coordinator.imageRefCount[id]
+	}
+
+	// images of the configuration and the node metadata are pulled and
+	// referenced
+	p.SetImages([]string{"nginx:1.27", "redis:7"})
+	p.sync(false)
+	must.Eq(t, map[string]string{"redis:7": "sha256:redis", "nginx:1.27": "sha256:nginx"}, p.pulled)
+	must.Eq(t, 1, pulls("redis:7"))
+	must.Eq(t, 1, pulls("nginx:1.27"))
+	must.MapContainsKey(t, references("sha256:redis"), imagePrePullCallerID+"redis:7")
+	must.MapContainsKey(t, references("sha256:nginx"), imagePrePullCallerID+"nginx:1.27")
+
+	// pulled images are only pulled again on refresh
+	p.sync(false)
+	must.Eq(t, 1, pulls("redis:7"))
+	p.sync(true)
+	must.Eq(t, 2, pulls("redis:7"))
+	must.Eq(t, 2, pulls("nginx:1.27"))
+
+	// images removed from the node metadata are released
+	p.SetImages(nil)
+	p.sync(false)
+	must.Eq(t, map[string]string{"redis:7": "sha256:redis"}, p.pulled)
+	must.MapEmpty(t, references("sha256:nginx"))
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			mock.lock.Lock()
+			defer mock.lock.Unlock()
+			return mock.removed["sha256:nginx"] == 1
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+
+	// the previous image of a mutable tag is released on refresh
+	mock.lock.Lock()
+	mock.idToName["redis:7"] = "sha256:redis-new"
+	mock.lock.Unlock()
+	p.sync(true)
+	must.Eq(t, map[string]string{"redis:7": "sha256:redis-new"}, p.pulled)
+	must.MapEmpty(t, references("sha256:redis"))
+	must.MapContainsKey(t, references("sha256:redis-new"), imagePrePullCallerID+"redis:7")
+}
+
+func TestImagePrePuller_images(t *testing.T) {
+	ci.Parallel(t)
+
+	p := &imagePrePuller{
+		configImages: []string{"redis:7", "nginx:1.27"},
+		updateCh:     make(chan struct{}, 1),
+	}
+	must.Eq(t, []string{"nginx:1.27", "redis:7"}, p.images())
+
+	// setting images doesn't block while an update is pending
+	p.SetImages([]string{"redis:7", "busybox:1"})
+	p.SetImages([]string{"busybox:1", "redis:7"})
+	must.Eq(t, []string{"busybox:1", "nginx:1.27", "redis:7"}, p.images())
+	must.Eq(t, 1, len(p.updateCh))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"sync"
@@ -113,6 +114,14 @@ var (
 	}
 )
 
+const (
+	// criuBinary is the name of the CRIU binary used to checkpoint tasks
+	criuBinary = "criu"
+
+	// criuCheckTimeout is the timeout of `criu check`
+	criuCheckTimeout = 30 * time.Second
+)
+
 // Driver fork/execs tasks using many of the underlying OS's isolation
 // features where configured.
 type Driver struct {
@@ -145,6 +154,13 @@ type Driver struct {
 	compute cpustats.Compute
 
 	userIDValidator UserIDValidator
+
+	// criuPath is the CRIU binary checked for checkpoint support, and
+	// checkpoint is whether it supports checkpointing tasks on this node.
+	// CRIU is only checked once.
+	criuPath   string
+	checkpoint bool
+	criuOnce   sync.Once
 }
 
 // Config is the driver configuration set by the SetConfig RPC call
@@ -265,10 +281,11 @@ type UserIDValidator interface {
 func NewExecDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugin {
 	logger = logger.Named(pluginName)
 	return &Driver{
-		eventer: eventer.NewEventer(ctx, logger),
-		tasks:   newTaskStore(),
-		ctx:     ctx,
-		logger:  logger,
+		eventer:  eventer.NewEventer(ctx, logger),
+		tasks:    newTaskStore(),
+		ctx:      ctx,
+		logger:   logger,
+		criuPath: criuBinary,
 	}
 }
 
@@ -341,7 +358,34 @@ func (d *Driver) TaskConfigSchema() (*hclspec.Spec, error) {
 // Capabilities is returned by the Capabilities RPC and indicates what
 // optional features this driver supports
 func (d *Driver) Capabilities() (*drivers.Capabilities, error) {
-	return driverCapabilities, nil
+	caps := *driverCapabilities
+	caps.Checkpoint = d.checkpointSupported()
+	return &caps, nil
+}
+
+// checkpointSupported returns whether tasks can be checkpointed, which
+// requires the CRIU binary and kernel support for it.
+func (d *Driver) checkpointSupported() bool {
+	d.criuOnce.Do(func() {
+		if runtime.GOOS != "linux" || d.criuPath == "" {
+			return
+		}
+		path, err := exec.LookPath(d.criuPath)
+		if err != nil {
+			d.logger.Debug("criu not found, task checkpoints are disabled", "error", err)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(d.ctx, criuCheckTimeout)
+		defer cancel()
+		if out, err := exec.CommandContext(ctx, path, "check").CombinedOutput(); err != nil {
+			d.logger.Debug("criu check failed, task checkpoints are disabled",
+				"error", err, "output", string(out))
+			return
+		}
+		d.checkpoint = true
+	})
+	return d.checkpoint
 }
 
 func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
@@ -396,6 +440,9 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 	}
 
 	fp.Attributes["driver.exec"] = pstructs.NewBoolAttribute(true)
+	if d.checkpointSupported() {
+		fp.Attributes["driver.exec.checkpoint"] = pstructs.NewBoolAttribute(true)
+	}
 	d.setFingerprintSuccess()
 	return fp
 }
@@ -455,7 +502,42 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 	return nil
 }
 
-func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle, network *drivers.DriverNetwork, err error) {
+func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drivers.DriverNetwork, error) {
+	return d.startTask(cfg, func(exec executor.Executor, cmd *executor.ExecCommand) (*executor.ProcessState, error) {
+		ps, err := exec.Launch(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to launch command with executor: %v", err)
+		}
+		return ps, nil
+	})
+}
+
+var _ drivers.DriverCheckpointer = (*Driver)(nil)
+
+// RestoreTask starts the task from the checkpoint image in imageDir, written
+// by CheckpointTask on this or another node.
+func (d *Driver) RestoreTask(cfg *drivers.TaskConfig, imageDir string) (*drivers.TaskHandle, *drivers.DriverNetwork, error) {
+	return d.startTask(cfg, func(exec executor.Executor, cmd *executor.ExecCommand) (*executor.ProcessState, error) {
+		ps, err := exec.Restore(cmd, imageDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore task with executor: %v", err)
+		}
+		return ps, nil
+	})
+}
+
+// CheckpointTask writes the state of the task to a checkpoint image with CRIU.
+func (d *Driver) CheckpointTask(taskID string, opts *drivers.CheckpointOptions) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Checkpoint(opts.ImageDir, opts.LeaveRunning)
+}
+
+// startTask starts the task in a new executor with the launch function.
+func (d *Driver) startTask(cfg *drivers.TaskConfig, launch func(executor.Executor, *executor.ExecCommand) (*executor.ProcessState, error)) (handle *drivers.TaskHandle, network *drivers.DriverNetwork, err error) {
 	if _, ok := d.tasks.Get(cfg.ID); ok {
 		return nil, nil, fmt.Errorf("task with ID %q already started", cfg.ID)
 	}
@@ -541,9 +623,9 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 		Capabilities:     caps,
 	}
 
-	ps, err := exec.Launch(execCmd)
+	ps, err := launch(exec, execCmd)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to launch command with executor: %v", err)
+		return nil, nil, err
 	}
 
 	h := &taskHandle{
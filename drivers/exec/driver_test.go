@@ -129,6 +129,43 @@ func TestExecDriver_Fingerprint(t *testing.T) {
 	}
 }
 
+func TestExecDriver_Capabilities_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("Test only available on Linux")
+	}
+
+	dir := t.TempDir()
+	writeCRIU := func(name string, exitCode int) string {
+		path := filepath.Join(dir, name)
+		script := fmt.Sprintf("#!/bin/sh\nexit %d\n", exitCode)
+		must.NoError(t, os.WriteFile(path, []byte(script), 0o755))
+		return path
+	}
+
+	testCases := []struct {
+		name     string
+		criuPath string
+		expected bool
+	}{
+		{name: "supported", criuPath: writeCRIU("criu-ok", 0), expected: true},
+		{name: "check fails", criuPath: writeCRIU("criu-fail", 1), expected: false},
+		{name: "not installed", criuPath: filepath.Join(dir, "missing"), expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := newExecDriverTest(t, t.Context()).(*Driver)
+			d.criuPath = tc.criuPath
+
+			caps, err := d.Capabilities()
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, caps.Checkpoint)
+			must.False(t, driverCapabilities.Checkpoint)
+		})
+	}
+}
+
 func TestExecDriver_WorkDir(t *testing.T) {
 	ci.Parallel(t)
 
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package agent implements the guest agent of the firecracker driver. The
+// agent runs inside the microVM and serves the executor gRPC service over the
+// vsock device of the VM, so the driver supervises the workload of the VM as
+// it would supervise a local executor.
+package agent
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/lib/cgroupslib"
+	"github.com/hashicorp/nomad/client/lib/numalib"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"google.golang.org/grpc"
+)
+
+const (
+	// DefaultPort is the vsock port the agent listens on by default.
+	DefaultPort = 1024
+
+	// ConsolePath is the path of the console of the guest, which the driver
+	// uses as the stdout and stderr of the workload so its output is written
+	// to the logs of the task by the VMM.
+	ConsolePath = "/dev/console"
+
+	// workloadCgroup is the cgroup of the workload on guests using cgroups v2.
+	workloadCgroup = "nomad-workload"
+)
+
+// Serve serves the executor of the workload of the VM on l, until l is closed.
+func Serve(l net.Listener, logger hclog.Logger) error {
+	compute := numalib.Scan(numalib.PlatformScanners(true)).Compute()
+
+	s := grpc.NewServer()
+	executor.RegisterExecutorServer(s, &guestExecutor{
+		Executor: executor.NewExecutor(logger, compute),
+		logger:   logger,
+	})
+	return s.Serve(l)
+}
+
+// guestExecutor is the executor of the workload of the VM. The command it
+// receives from the driver carries the resources of the task, which are
+// rewritten to the cgroups of the guest before launching the workload.
+type guestExecutor struct {
+	executor.Executor
+	logger hclog.Logger
+}
+
+func (e *guestExecutor) Launch(command *executor.ExecCommand) (*executor.ProcessState, error) {
+	if err := guestResources(command, cgroupslib.GetMode(), cgroupslib.GetDefaultRoot()); err != nil {
+		return nil, err
+	}
+	e.logger.Info("launching workload", "command", command.Cmd, "args", command.Args)
+	return e.Executor.Launch(command)
+}
+
+// guestResources replaces the resources of the task in command with the
+// cgroup of the workload in the guest. The memory and CPU of the task are
+// those of the VM, so they are not limited further.
+func guestResources(command *executor.ExecCommand, mode cgroupslib.Mode, root string) error {
+	switch mode {
+	case cgroupslib.OFF:
+		command.Resources = nil
+		return nil
+	case cgroupslib.CG1:
+		return fmt.Errorf("guests using cgroups v1 are not supported")
+	}
+
+	cgroup := filepath.Join(root, workloadCgroup)
+	if err := os.MkdirAll(cgroup, 0o755); err != nil {
+		return fmt.Errorf("failed to create workload cgroup: %w", err)
+	}
+
+	command.Resources = &drivers.Resources{
+		NomadResources: &structs.AllocatedTaskResources{
+			Memory: structs.AllocatedMemoryResources{
+				MemoryMaxMB: executor.MemoryNoLimit,
+			},
+		},
+		LinuxResources: &drivers.LinuxResources{
+			CPUShares:        1024,
+			CpusetCgroupPath: cgroup,
+		},
+	}
+	return nil
+}
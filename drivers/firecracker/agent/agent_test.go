@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/lib/cgroupslib"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/shoenig/test/must"
+)
+
+func TestAgent_guestResources(t *testing.T) {
+	ci.Parallel(t)
+
+	hostResources := func() *executor.ExecCommand {
+		return &executor.ExecCommand{
+			Resources: &drivers.Resources{
+				NomadResources: &structs.AllocatedTaskResources{
+					Memory: structs.AllocatedMemoryResources{MemoryMB: 512},
+				},
+				LinuxResources: &drivers.LinuxResources{
+					CpusetCpus:       "4,5",
+					CpusetCgroupPath: "/sys/fs/cgroup/nomad.slice/share.slice/1234.web.scope",
+				},
+			},
+		}
+	}
+
+	t.Run("off", func(t *testing.T) {
+		command := hostResources()
+		must.NoError(t, guestResources(command, cgroupslib.OFF, t.TempDir()))
+		must.Nil(t, command.Resources)
+	})
+
+	t.Run("v1", func(t *testing.T) {
+		err := guestResources(hostResources(), cgroupslib.CG1, t.TempDir())
+		must.ErrorContains(t, err, "cgroups v1 are not supported")
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		root := t.TempDir()
+		command := hostResources()
+		must.NoError(t, guestResources(command, cgroupslib.CG2, root))
+
+		cgroup := filepath.Join(root, workloadCgroup)
+		must.DirExists(t, cgroup)
+		must.Eq(t, cgroup, command.Resources.LinuxResources.CpusetCgroupPath)
+		must.Eq(t, "", command.Resources.LinuxResources.CpusetCpus)
+		must.Eq(t, executor.MemoryNoLimit, command.Resources.NomadResources.Memory.MemoryMaxMB)
+	})
+}
@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package agent
+
+import (
+	"errors"
+	"net"
+)
+
+// Listen returns a listener accepting connections from the host on the given
+// vsock port of the guest.
+func Listen(port uint32) (net.Listener, error) {
+	return nil, errors.New("vsock is only supported on linux guests")
+}
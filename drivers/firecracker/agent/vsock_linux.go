@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package agent
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// Listen returns a listener accepting connections from the host on the given
+// vsock port of the guest.
+func Listen(port uint32) (net.Listener, error) {
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vsock socket: %w", err)
+	}
+
+	addr := &unix.SockaddrVM{CID: unix.VMADDR_CID_ANY, Port: port}
+	if err := unix.Bind(fd, addr); err != nil {
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("failed to bind vsock port %d: %w", port, err)
+	}
+	if err := unix.Listen(fd, unix.SOMAXCONN); err != nil {
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("failed to listen on vsock port %d: %w", port, err)
+	}
+
+	return &vsockListener{fd: fd, addr: &vsockAddr{cid: unix.VMADDR_CID_ANY, port: port}}, nil
+}
+
+type vsockListener struct {
+	fd   int
+	addr *vsockAddr
+}
+
+func (l *vsockListener) Accept() (net.Conn, error) {
+	nfd, sa, err := unix.Accept4(l.fd, unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := &vsockAddr{}
+	if vm, ok := sa.(*unix.SockaddrVM); ok {
+		remote.cid, remote.port = vm.CID, vm.Port
+	}
+
+	// the socket is non-blocking so the file is registered with the runtime
+	// poller, which supports deadlines
+	return &vsockConn{
+		File:   os.NewFile(uintptr(nfd), "vsock"),
+		local:  l.addr,
+		remote: remote,
+	}, nil
+}
+
+func (l *vsockListener) Close() error {
+	return unix.Close(l.fd)
+}
+
+func (l *vsockListener) Addr() net.Addr {
+	return l.addr
+}
+
+type vsockConn struct {
+	*os.File
+	local  *vsockAddr
+	remote *vsockAddr
+}
+
+func (c *vsockConn) LocalAddr() net.Addr {
+	return c.local
+}
+
+func (c *vsockConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+type vsockAddr struct {
+	cid  uint32
+	port uint32
+}
+
+func (a *vsockAddr) Network() string {
+	return "vsock"
+}
+
+func (a *vsockAddr) String() string {
+	return fmt.Sprintf("vm(%d):%d", a.cid, a.port)
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// Install a cli handler running the agent when the nomad binary is started
+// inside the microVM as "nomad firecracker-agent [port]".
+func init() {
+	if len(os.Args) > 1 && os.Args[1] == "firecracker-agent" {
+		logger := hclog.New(&hclog.LoggerOptions{
+			Name:   "firecracker-agent",
+			Level:  hclog.LevelFromString(os.Getenv("NOMAD_AGENT_LOG_LEVEL")),
+			Output: os.Stderr,
+		})
+
+		port := uint64(DefaultPort)
+		if len(os.Args) > 2 {
+			var err error
+			if port, err = strconv.ParseUint(os.Args[2], 10, 32); err != nil {
+				logger.Error("invalid vsock port", "port", os.Args[2])
+				os.Exit(1)
+			}
+		}
+
+		l, err := Listen(uint32(port))
+		if err != nil {
+			logger.Error("failed to listen", "error", err)
+			os.Exit(1)
+		}
+
+		logger.Info("serving workload executor", "port", port)
+		if err := Serve(l, logger); err != nil {
+			logger.Error("failed to serve", "error", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+}
@@ -0,0 +1,874 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package firecracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul-template/signals"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/drivers/firecracker/agent"
+	"github.com/hashicorp/nomad/drivers/shared/eventer"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/drivers/fsisolation"
+	"github.com/hashicorp/nomad/plugins/shared/hclspec"
+	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+)
+
+const (
+	// pluginName is the name of the plugin
+	pluginName = "firecracker"
+
+	// fingerprintPeriod is the interval at which the driver will send fingerprint responses
+	fingerprintPeriod = 30 * time.Second
+
+	// The key populated in Node Attributes to indicate presence of the
+	// firecracker driver
+	driverAttr        = "driver.firecracker"
+	driverVersionAttr = "driver.firecracker.version"
+
+	// kvmDevice is the device firecracker requires to run VMs
+	kvmDevice = "/dev/kvm"
+
+	// vmmMemoryOverheadMB is the memory of the task reserved for the VMM
+	// itself, which runs in the cgroup of the task along with the memory of
+	// the VM.
+	vmmMemoryOverheadMB = 32
+
+	// minMemoryMB is the minimum memory of a task.
+	minMemoryMB = 128
+
+	// maxVcpus is the maximum number of vCPUs of a VM.
+	maxVcpus = 32
+
+	// taskHandleVersion is the version of task handle which this driver sets
+	// and understands how to decode driver state
+	taskHandleVersion = 1
+)
+
+var (
+	// PluginID is the firecracker plugin metadata registered in the plugin
+	// catalog.
+	PluginID = loader.PluginID{
+		Name:       pluginName,
+		PluginType: base.PluginTypeDriver,
+	}
+
+	// PluginConfig is the firecracker driver factory function registered in
+	// the plugin catalog.
+	PluginConfig = &loader.InternalPluginConfig{
+		Config:  map[string]interface{}{},
+		Factory: func(ctx context.Context, l hclog.Logger) interface{} { return NewFirecrackerDriver(ctx, l) },
+	}
+
+	versionRegex = regexp.MustCompile(`Firecracker v(\d[\.\d]+)`)
+
+	// pluginInfo is the response returned for the PluginInfo RPC
+	pluginInfo = &base.PluginInfoResponse{
+		Type:              base.PluginTypeDriver,
+		PluginApiVersions: []string{drivers.ApiVersion010},
+		PluginVersion:     "0.1.0",
+		Name:              pluginName,
+	}
+
+	// configSpec is the hcl specification returned by the ConfigSchema RPC
+	configSpec = hclspec.NewObject(map[string]*hclspec.Spec{
+		"firecracker_path": hclspec.NewAttr("firecracker_path", "string", false),
+		"image_paths":      hclspec.NewAttr("image_paths", "list(string)", false),
+	})
+
+	// taskConfigSpec is the hcl specification for the driver config section of
+	// a taskConfig within a job. It is returned in the TaskConfigSchema RPC
+	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
+		"kernel_image":     hclspec.NewAttr("kernel_image", "string", true),
+		"rootfs_image":     hclspec.NewAttr("rootfs_image", "string", true),
+		"read_only_rootfs": hclspec.NewAttr("read_only_rootfs", "bool", false),
+		"boot_args":        hclspec.NewAttr("boot_args", "string", false),
+		"vcpus":            hclspec.NewAttr("vcpus", "number", false),
+		"command":          hclspec.NewAttr("command", "string", true),
+		"args":             hclspec.NewAttr("args", "list(string)", false),
+		"work_dir":         hclspec.NewAttr("work_dir", "string", false),
+		"agent_port": hclspec.NewDefault(
+			hclspec.NewAttr("agent_port", "number", false),
+			hclspec.NewLiteral(fmt.Sprintf("%d", agent.DefaultPort)),
+		),
+		"boot_timeout": hclspec.NewDefault(
+			hclspec.NewAttr("boot_timeout", "string", false),
+			hclspec.NewLiteral(`"30s"`),
+		),
+	})
+
+	// capabilities is returned by the Capabilities RPC and indicates what
+	// optional features this driver supports
+	capabilities = &drivers.Capabilities{
+		SendSignals: true,
+		Exec:        true,
+		FSIsolation: fsisolation.Image,
+		NetIsolationModes: []drivers.NetIsolationMode{
+			drivers.NetIsolationModeHost,
+			drivers.NetIsolationModeGroup,
+		},
+		MountConfigs: drivers.MountConfigSupportNone,
+	}
+
+	_ drivers.DriverPlugin = (*Driver)(nil)
+)
+
+// TaskConfig is the driver configuration of a taskConfig within a job
+type TaskConfig struct {
+	// KernelImage is the path of the uncompressed kernel of the VM.
+	KernelImage string `codec:"kernel_image"`
+
+	// RootfsImage is the path of the image of the root block device of the
+	// VM, which must run the agent of the driver.
+	RootfsImage string `codec:"rootfs_image"`
+
+	// ReadOnlyRootfs attaches the root block device as read-only, so the
+	// image can be shared between tasks.
+	ReadOnlyRootfs bool `codec:"read_only_rootfs"`
+
+	// BootArgs are appended to the kernel arguments of the VM.
+	BootArgs string `codec:"boot_args"`
+
+	// Vcpus is the number of vCPUs of the VM. It defaults to the number of
+	// reserved cores of the task, or 1.
+	Vcpus int `codec:"vcpus"`
+
+	// Command and Args are the workload run by the agent in the VM.
+	Command string   `codec:"command"`
+	Args    []string `codec:"args"`
+
+	// WorkDir is the working directory of the workload in the VM.
+	WorkDir string `codec:"work_dir"`
+
+	// AgentPort is the vsock port the agent listens on in the VM.
+	AgentPort uint32 `codec:"agent_port"`
+
+	// BootTimeout is the maximum time for the agent to be reachable once the
+	// VM is started.
+	BootTimeout string `codec:"boot_timeout"`
+}
+
+func (tc *TaskConfig) validate() error {
+	if tc.Command == "" {
+		return errors.New("command must be set")
+	}
+	if tc.Vcpus < 0 || tc.Vcpus > maxVcpus {
+		return fmt.Errorf("vcpus must be between 1 and %d, got %d", maxVcpus, tc.Vcpus)
+	}
+	if tc.WorkDir != "" && !filepath.IsAbs(tc.WorkDir) {
+		return fmt.Errorf("work_dir must be absolute but got relative path %q", tc.WorkDir)
+	}
+	if tc.AgentPort == 0 {
+		return errors.New("agent_port must be set")
+	}
+	if _, err := time.ParseDuration(tc.BootTimeout); err != nil {
+		return fmt.Errorf("boot_timeout must be a duration, got %q", tc.BootTimeout)
+	}
+	return nil
+}
+
+// TaskState is the state which is encoded in the handle returned in StartTask.
+// This information is needed to rebuild the taskConfig state and handler
+// during recovery.
+type TaskState struct {
+	ReattachConfig *pstructs.ReattachConfig
+	TaskConfig     *drivers.TaskConfig
+	Pid            int
+	StartedAt      time.Time
+	VsockPath      string
+	AgentPort      uint32
+}
+
+// Config is the driver configuration set by SetConfig RPC call
+type Config struct {
+	// FirecrackerPath is the path of the firecracker binary. It is looked up
+	// in the PATH if not set.
+	FirecrackerPath string `codec:"firecracker_path"`
+
+	// ImagePaths is an allow-list of paths firecracker is allowed to load
+	// kernels and images from, in addition to the allocation directory.
+	ImagePaths []string `codec:"image_paths"`
+}
+
+// Driver is a driver for running workloads in firecracker microVMs
+type Driver struct {
+	// eventer is used to handle multiplexing of TaskEvents calls such that an
+	// event can be broadcast to all callers
+	eventer *eventer.Eventer
+
+	// config is the driver configuration set by the SetConfig RPC
+	config Config
+
+	// tasks is the in memory datastore mapping taskIDs to taskHandle
+	tasks *taskStore
+
+	// ctx is the context for the driver. It is passed to other subsystems to
+	// coordinate shutdown
+	ctx context.Context
+
+	// nomadConf is the client agent's configuration
+	nomadConfig *base.ClientDriverConfig
+
+	// logger will log to the Nomad agent
+	logger hclog.Logger
+}
+
+// NewFirecrackerDriver returns a new DriverPlugin implementation
+func NewFirecrackerDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugin {
+	logger = logger.Named(pluginName)
+	return &Driver{
+		eventer: eventer.NewEventer(ctx, logger),
+		tasks:   newTaskStore(),
+		ctx:     ctx,
+		logger:  logger,
+	}
+}
+
+func (d *Driver) PluginInfo() (*base.PluginInfoResponse, error) {
+	return pluginInfo, nil
+}
+
+func (d *Driver) ConfigSchema() (*hclspec.Spec, error) {
+	return configSpec, nil
+}
+
+func (d *Driver) SetConfig(cfg *base.Config) error {
+	var config Config
+	if len(cfg.PluginConfig) != 0 {
+		if err := base.MsgPackDecode(cfg.PluginConfig, &config); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range config.ImagePaths {
+		if !filepath.IsAbs(path) {
+			return fmt.Errorf("image_paths must be absolute, got %q", path)
+		}
+	}
+
+	d.config = config
+	if cfg.AgentConfig != nil {
+		d.nomadConfig = cfg.AgentConfig.Driver
+	}
+	return nil
+}
+
+func (d *Driver) TaskConfigSchema() (*hclspec.Spec, error) {
+	return taskConfigSpec, nil
+}
+
+func (d *Driver) Capabilities() (*drivers.Capabilities, error) {
+	return capabilities, nil
+}
+
+func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
+	ch := make(chan *drivers.Fingerprint)
+	go d.handleFingerprint(ctx, ch)
+	return ch, nil
+}
+
+func (d *Driver) handleFingerprint(ctx context.Context, ch chan *drivers.Fingerprint) {
+	ticker := time.NewTimer(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			ticker.Reset(fingerprintPeriod)
+			ch <- d.buildFingerprint()
+		}
+	}
+}
+
+func (d *Driver) buildFingerprint() *drivers.Fingerprint {
+	fingerprint := &drivers.Fingerprint{
+		Attributes:        map[string]*pstructs.Attribute{},
+		Health:            drivers.HealthStateHealthy,
+		HealthDescription: drivers.DriverHealthy,
+	}
+
+	if runtime.GOOS != "linux" {
+		fingerprint.Health = drivers.HealthStateUndetected
+		fingerprint.HealthDescription = "firecracker is only supported on linux"
+		return fingerprint
+	}
+
+	bin, err := d.firecrackerPath()
+	if err != nil {
+		fingerprint.Health = drivers.HealthStateUndetected
+		fingerprint.HealthDescription = ""
+		return fingerprint
+	}
+
+	outBytes, err := exec.Command(bin, "--version").Output()
+	if err != nil {
+		fingerprint.Health = drivers.HealthStateUndetected
+		fingerprint.HealthDescription = fmt.Sprintf("Failed to execute firecracker binary: %v", err)
+		return fingerprint
+	}
+
+	version, err := parseVersion(string(outBytes))
+	if err != nil {
+		fingerprint.Health = drivers.HealthStateUndetected
+		fingerprint.HealthDescription = err.Error()
+		return fingerprint
+	}
+
+	if _, err := os.Stat(kvmDevice); err != nil {
+		fingerprint.Health = drivers.HealthStateUnhealthy
+		fingerprint.HealthDescription = fmt.Sprintf("KVM is not available: %v", err)
+		return fingerprint
+	}
+
+	fingerprint.Attributes[driverAttr] = pstructs.NewBoolAttribute(true)
+	fingerprint.Attributes[driverVersionAttr] = pstructs.NewStringAttribute(version)
+	return fingerprint
+}
+
+// parseVersion returns the version of firecracker from the output of
+// "firecracker --version".
+func parseVersion(out string) (string, error) {
+	matches := versionRegex.FindStringSubmatch(out)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("Failed to parse firecracker version from %v", strings.TrimSpace(out))
+	}
+	return matches[1], nil
+}
+
+// firecrackerPath returns the absolute path of the firecracker binary.
+func (d *Driver) firecrackerPath() (string, error) {
+	bin := d.config.FirecrackerPath
+	if bin == "" {
+		bin = pluginName
+	}
+
+	lp, err := exec.LookPath(bin)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path to %q executable: %v", bin, err)
+	}
+	return filepath.EvalSymlinks(lp)
+}
+
+func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
+	if handle == nil {
+		return fmt.Errorf("error: handle cannot be nil")
+	}
+
+	// If already attached to handle there's nothing to recover.
+	if _, ok := d.tasks.Get(handle.Config.ID); ok {
+		d.logger.Trace("nothing to recover; task already exists",
+			"task_id", handle.Config.ID,
+			"task_name", handle.Config.Name,
+		)
+		return nil
+	}
+
+	var taskState TaskState
+	if err := handle.GetDriverState(&taskState); err != nil {
+		d.logger.Error("failed to decode task state from handle", "error", err, "task_id", handle.Config.ID)
+		return fmt.Errorf("failed to decode task state from handle: %v", err)
+	}
+
+	plugRC, err := pstructs.ReattachConfigToGoPlugin(taskState.ReattachConfig)
+	if err != nil {
+		d.logger.Error("failed to build ReattachConfig from task state", "error", err, "task_id", handle.Config.ID)
+		return fmt.Errorf("failed to build ReattachConfig from task state: %v", err)
+	}
+
+	logger := d.logger.With("task_name", handle.Config.Name, "alloc_id", handle.Config.AllocID)
+	execImpl, pluginClient, err := executor.ReattachToExecutor(plugRC, logger, d.nomadConfig.Topology.Compute())
+	if err != nil {
+		d.logger.Error("failed to reattach to executor", "error", err, "task_id", handle.Config.ID)
+		return fmt.Errorf("failed to reattach to executor: %v", err)
+	}
+
+	guestCtx, guestCancel := context.WithCancel(d.ctx)
+	guest, guestConn, err := dialGuest(guestCtx, taskState.VsockPath, taskState.AgentPort, logger)
+	if err != nil {
+		guestCancel()
+		pluginClient.Kill()
+		return fmt.Errorf("failed to reconnect to VM agent: %v", err)
+	}
+
+	h := &taskHandle{
+		exec:         execImpl,
+		pid:          taskState.Pid,
+		pluginClient: pluginClient,
+		guest:        guest,
+		guestConn:    guestConn,
+		guestCancel:  guestCancel,
+		taskConfig:   taskState.TaskConfig,
+		procState:    drivers.TaskStateRunning,
+		startedAt:    taskState.StartedAt,
+		exitResult:   &drivers.ExitResult{},
+		doneCh:       make(chan struct{}),
+		logger:       logger,
+	}
+
+	d.tasks.Set(taskState.TaskConfig.ID, h)
+
+	go h.run()
+	return nil
+}
+
+func isAllowedImagePath(allowedPaths []string, allocDir, imagePath string) bool {
+	if !filepath.IsAbs(imagePath) {
+		imagePath = filepath.Join(allocDir, imagePath)
+	}
+
+	isParent := func(parent, path string) bool {
+		rel, err := filepath.Rel(parent, path)
+		return err == nil && !strings.HasPrefix(rel, "..")
+	}
+
+	// check if path is under alloc dir
+	if isParent(allocDir, imagePath) {
+		return true
+	}
+
+	// check allowed paths
+	for _, ap := range allowedPaths {
+		if isParent(ap, imagePath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// imagePath returns the absolute path of an image of the task, which must be
+// in the allocation directory or in the image_paths of the driver.
+func (d *Driver) imagePath(cfg *drivers.TaskConfig, name, path string) (string, error) {
+	if !isAllowedImagePath(d.config.ImagePaths, cfg.AllocDir, path) {
+		return "", fmt.Errorf("%s is not in the allowed paths", name)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(cfg.AllocDir, path)
+	}
+	return path, nil
+}
+
+// vcpus returns the number of vCPUs of the VM.
+func vcpus(cfg *drivers.TaskConfig, driverConfig *TaskConfig) int {
+	if driverConfig.Vcpus > 0 {
+		return driverConfig.Vcpus
+	}
+	if cfg.Resources.LinuxResources != nil && cfg.Resources.LinuxResources.CpusetCpus != "" {
+		cores := len(strings.Split(cfg.Resources.LinuxResources.CpusetCpus, ","))
+		return min(cores, maxVcpus)
+	}
+	return 1
+}
+
+func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drivers.DriverNetwork, error) {
+	if _, ok := d.tasks.Get(cfg.ID); ok {
+		return nil, nil, fmt.Errorf("task with ID %q already started", cfg.ID)
+	}
+
+	var driverConfig TaskConfig
+	if err := cfg.DecodeDriverConfig(&driverConfig); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode driver config: %v", err)
+	}
+	if err := driverConfig.validate(); err != nil {
+		return nil, nil, fmt.Errorf("failed driver config validation: %v", err)
+	}
+	bootTimeout, _ := time.ParseDuration(driverConfig.BootTimeout)
+
+	handle := drivers.NewTaskHandle(taskHandleVersion)
+	handle.Config = cfg
+
+	kernel, err := d.imagePath(cfg, "kernel_image", driverConfig.KernelImage)
+	if err != nil {
+		return nil, nil, err
+	}
+	rootfs, err := d.imagePath(cfg, "rootfs_image", driverConfig.RootfsImage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mb := cfg.Resources.NomadResources.Memory.MemoryMB
+	if mb < minMemoryMB {
+		return nil, nil, fmt.Errorf("firecracker tasks require at least %dMB of memory", minMemoryMB)
+	}
+
+	bin, err := d.firecrackerPath()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The sockets and configuration of the VM are written to the task
+	// directory, which is not visible to the VM.
+	taskDir := cfg.TaskDir().Dir
+	apiPath := filepath.Join(taskDir, apiSocketName)
+	vsockPath := filepath.Join(taskDir, vsockSocketName)
+	for _, path := range []string{apiPath, vsockPath} {
+		if err := validateSocketPath(path); err != nil {
+			return nil, nil, err
+		}
+		// firecracker fails to start if the sockets of a previous VM of the
+		// task are left over
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, nil, fmt.Errorf("failed to remove socket: %v", err)
+		}
+	}
+
+	var network *vmNetwork
+	if cfg.NetworkIsolation != nil && cfg.NetworkIsolation.Path != "" {
+		if network, err = setupNetwork(cfg.NetworkIsolation); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var dns []string
+	if cfg.DNS != nil {
+		dns = cfg.DNS.Servers
+	}
+
+	vm := &vmConfig{
+		BootSource: vmBootSource{
+			KernelImagePath: kernel,
+			BootArgs:        bootArgs(driverConfig.BootArgs, network, cfg.Name, dns),
+		},
+		Drives: []vmDrive{{
+			DriveID:      "rootfs",
+			PathOnHost:   rootfs,
+			IsRootDevice: true,
+			IsReadOnly:   driverConfig.ReadOnlyRootfs,
+		}},
+		MachineConfig: vmMachineConfig{
+			VcpuCount:  vcpus(cfg, &driverConfig),
+			MemSizeMib: mb - vmmMemoryOverheadMB,
+		},
+		Vsock: vmVsock{
+			GuestCID: guestCID,
+			UDSPath:  vsockPath,
+		},
+	}
+	if network != nil {
+		vm.NetworkInterfaces = []vmInterface{{
+			IfaceID:     "eth0",
+			GuestMAC:    network.MAC,
+			HostDevName: network.TapName,
+		}}
+	}
+
+	vmConfigPath := filepath.Join(taskDir, vmConfigName)
+	if err := writeVMConfig(vmConfigPath, vm); err != nil {
+		return nil, nil, fmt.Errorf("failed to write VM configuration: %v", err)
+	}
+
+	logger := d.logger.With("task_name", handle.Config.Name, "alloc_id", handle.Config.AllocID)
+	pluginLogFile := filepath.Join(taskDir, fmt.Sprintf("%s-executor.out", cfg.Name))
+	executorConfig := &executor.ExecutorConfig{
+		LogFile:  pluginLogFile,
+		LogLevel: "debug",
+		Compute:  d.nomadConfig.Topology.Compute(),
+	}
+
+	execImpl, pluginClient, err := executor.CreateExecutor(logger, d.nomadConfig, executorConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The VMM runs in the network namespace of the allocation, where its tap
+	// device is, and writes the serial console of the VM to the task logs.
+	execCmd := &executor.ExecCommand{
+		Cmd:              bin,
+		Args:             []string{"--api-sock", apiPath, "--config-file", vmConfigPath},
+		TaskDir:          taskDir,
+		StdoutPath:       cfg.StdoutPath,
+		StderrPath:       cfg.StderrPath,
+		NetworkIsolation: cfg.NetworkIsolation,
+		Resources:        cfg.Resources.Copy(),
+	}
+	ps, err := execImpl.Launch(execCmd)
+	if err != nil {
+		pluginClient.Kill()
+		return nil, nil, err
+	}
+	logger.Debug("started VM", "pid", ps.Pid)
+
+	stopVM := func() {
+		execImpl.Shutdown("", 0)
+		pluginClient.Kill()
+	}
+
+	guestCtx, guestCancel := context.WithCancel(d.ctx)
+	guest, guestConn, err := dialGuest(guestCtx, vsockPath, driverConfig.AgentPort, logger)
+	if err != nil {
+		guestCancel()
+		stopVM()
+		return nil, nil, fmt.Errorf("failed to connect to VM agent: %v", err)
+	}
+
+	h := &taskHandle{
+		exec:         execImpl,
+		pid:          ps.Pid,
+		pluginClient: pluginClient,
+		guest:        guest,
+		guestConn:    guestConn,
+		guestCancel:  guestCancel,
+		taskConfig:   cfg,
+		procState:    drivers.TaskStateRunning,
+		startedAt:    time.Now().Round(time.Millisecond),
+		doneCh:       make(chan struct{}),
+		logger:       logger,
+	}
+
+	fail := func(err error) (*drivers.TaskHandle, *drivers.DriverNetwork, error) {
+		h.closeGuest()
+		stopVM()
+		return nil, nil, err
+	}
+
+	if err := waitForAgent(d.ctx, guest, bootTimeout); err != nil {
+		return fail(fmt.Errorf("VM agent did not become reachable: %v", err))
+	}
+
+	// The workload writes to the console of the VM, which the VMM writes to
+	// the logs of the task.
+	workload := &executor.ExecCommand{
+		Cmd:        driverConfig.Command,
+		Args:       driverConfig.Args,
+		Env:        cfg.EnvList(),
+		User:       cfg.User,
+		TaskDir:    "/",
+		WorkDir:    driverConfig.WorkDir,
+		StdoutPath: agent.ConsolePath,
+		StderrPath: agent.ConsolePath,
+	}
+	if _, err := guest.Launch(workload); err != nil {
+		return fail(fmt.Errorf("failed to launch workload in VM: %v", err))
+	}
+
+	driverState := TaskState{
+		ReattachConfig: pstructs.ReattachConfigFromGoPlugin(pluginClient.ReattachConfig()),
+		Pid:            ps.Pid,
+		TaskConfig:     cfg,
+		StartedAt:      h.startedAt,
+		VsockPath:      vsockPath,
+		AgentPort:      driverConfig.AgentPort,
+	}
+
+	if err := handle.SetDriverState(&driverState); err != nil {
+		logger.Error("failed to start task, error setting driver state", "error", err)
+		return fail(fmt.Errorf("failed to set driver state: %v", err))
+	}
+
+	d.tasks.Set(cfg.ID, h)
+	go h.run()
+
+	var driverNetwork *drivers.DriverNetwork
+	if network != nil {
+		driverNetwork = &drivers.DriverNetwork{
+			IP: network.Address.IP.String(),
+		}
+	}
+	return handle, driverNetwork, nil
+}
+
+// waitForAgent waits for the agent of the VM to serve the executor of the
+// workload, which happens once the VM booted.
+func waitForAgent(ctx context.Context, guest executor.Executor, timeout time.Duration) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		_, err := guest.Version()
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Driver) WaitTask(ctx context.Context, taskID string) (<-chan *drivers.ExitResult, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	ch := make(chan *drivers.ExitResult)
+	go d.handleWait(ctx, handle, ch)
+
+	return ch, nil
+}
+
+func (d *Driver) handleWait(ctx context.Context, handle *taskHandle, ch chan *drivers.ExitResult) {
+	defer close(ch)
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-d.ctx.Done():
+		return
+	case <-handle.doneCh:
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-d.ctx.Done():
+	case ch <- handle.TaskStatus().ExitResult:
+	}
+}
+
+// StopTask stops the workload through the agent of the VM, which stops the
+// VM once the workload exited.
+func (d *Driver) StopTask(taskID string, timeout time.Duration, signal string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if err := handle.guest.Shutdown(signal, timeout); err != nil {
+		handle.logger.Debug("failed to stop workload, stopping VM", "error", err)
+	}
+
+	if err := handle.exec.Shutdown("", 0); err != nil {
+		if handle.pluginClient.Exited() {
+			return nil
+		}
+		return fmt.Errorf("executor Shutdown failed: %v", err)
+	}
+
+	return nil
+}
+
+func (d *Driver) DestroyTask(taskID string, force bool) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if handle.IsRunning() && !force {
+		return fmt.Errorf("cannot destroy running task")
+	}
+
+	if !handle.pluginClient.Exited() {
+		if err := handle.exec.Shutdown("", 0); err != nil {
+			handle.logger.Error("destroying executor failed", "error", err)
+		}
+
+		handle.pluginClient.Kill()
+	}
+
+	d.tasks.Delete(taskID)
+	return nil
+}
+
+func (d *Driver) InspectTask(taskID string) (*drivers.TaskStatus, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	return handle.TaskStatus(), nil
+}
+
+// TaskStats returns the usage of the workload, as measured in the VM.
+func (d *Driver) TaskStats(ctx context.Context, taskID string, interval time.Duration) (<-chan *drivers.TaskResourceUsage, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	return handle.guest.Stats(ctx, interval)
+}
+
+func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, error) {
+	return d.eventer.TaskEvents(ctx)
+}
+
+func (d *Driver) SignalTask(taskID string, signal string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	sig := os.Interrupt
+	if s, ok := signals.SignalLookup[signal]; ok {
+		sig = s
+	} else {
+		d.logger.Warn("unknown signal to send to task, using SIGINT instead", "signal", signal, "task_id", handle.taskConfig.ID)
+	}
+	return handle.guest.Signal(sig)
+}
+
+func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
+	if len(cmd) == 0 {
+		return nil, fmt.Errorf("error cmd must have at least one value")
+	}
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	out, exitCode, err := handle.guest.Exec(time.Now().Add(timeout), cmd[0], cmd[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &drivers.ExecTaskResult{
+		Stdout: out,
+		ExitResult: &drivers.ExitResult{
+			ExitCode: exitCode,
+		},
+	}, nil
+}
+
+var _ drivers.ExecTaskStreamingRawDriver = (*Driver)(nil)
+
+func (d *Driver) ExecTaskStreamingRaw(ctx context.Context,
+	taskID string,
+	command []string,
+	tty bool,
+	stream drivers.ExecTaskStream) error {
+
+	if len(command) == 0 {
+		return fmt.Errorf("error cmd must have at least one value")
+	}
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.guest.ExecStreaming(ctx, command, tty, stream)
+}
+
+// validateSocketPath provides best effort validation of socket paths since
+// some rules may be platform-dependant.
+func validateSocketPath(path string) error {
+	if maxSocketPathLen > 0 && len(path) > maxSocketPathLen {
+		return fmt.Errorf(
+			"socket path %s is longer than the maximum length allowed (%d), try to reduce the task name or Nomad's data_dir if possible.",
+			path, maxSocketPathLen)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package firecracker
+
+const (
+	// Don't enforce any path limit, the driver is unsupported anyway.
+	maxSocketPathLen = 0
+)
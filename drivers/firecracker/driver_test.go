@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package firecracker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/lib/cpustats"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/shoenig/test/must"
+	"google.golang.org/grpc"
+)
+
+func TestConfig_ParseAllHCL(t *testing.T) {
+	ci.Parallel(t)
+
+	cfgStr := `
+config {
+  kernel_image = "local/vmlinux"
+  rootfs_image = "local/rootfs.ext4"
+  read_only_rootfs = true
+  boot_args = "quiet"
+  vcpus = 2
+  command = "/usr/bin/app"
+  args = ["-port", "8080"]
+  work_dir = "/srv"
+}`
+
+	expected := &TaskConfig{
+		KernelImage:    "local/vmlinux",
+		RootfsImage:    "local/rootfs.ext4",
+		ReadOnlyRootfs: true,
+		BootArgs:       "quiet",
+		Vcpus:          2,
+		Command:        "/usr/bin/app",
+		Args:           []string{"-port", "8080"},
+		WorkDir:        "/srv",
+		AgentPort:      1024,
+		BootTimeout:    "30s",
+	}
+
+	var tc *TaskConfig
+	hclutils.NewConfigParser(taskConfigSpec).ParseHCL(t, cfgStr, &tc)
+	must.Eq(t, expected, tc)
+	must.NoError(t, tc.validate())
+}
+
+func TestTaskConfig_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	valid := func() TaskConfig {
+		return TaskConfig{Command: "/app", AgentPort: 1024, BootTimeout: "30s"}
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(*TaskConfig)
+		err    string
+	}{
+		{name: "valid", modify: func(*TaskConfig) {}},
+		{name: "command", modify: func(tc *TaskConfig) { tc.Command = "" }, err: "command must be set"},
+		{name: "vcpus", modify: func(tc *TaskConfig) { tc.Vcpus = 64 }, err: "vcpus"},
+		{name: "work dir", modify: func(tc *TaskConfig) { tc.WorkDir = "srv" }, err: "work_dir"},
+		{name: "agent port", modify: func(tc *TaskConfig) { tc.AgentPort = 0 }, err: "agent_port"},
+		{name: "boot timeout", modify: func(tc *TaskConfig) { tc.BootTimeout = "soon" }, err: "boot_timeout"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := valid()
+			tc.modify(&config)
+			err := config.validate()
+			if tc.err != "" {
+				must.ErrorContains(t, err, tc.err)
+				return
+			}
+			must.NoError(t, err)
+		})
+	}
+}
+
+func TestDriver_parseVersion(t *testing.T) {
+	ci.Parallel(t)
+
+	version, err := parseVersion("Firecracker v1.7.0\n\nSupported snapshot data format versions: v1.0.0\n")
+	must.NoError(t, err)
+	must.Eq(t, "1.7.0", version)
+
+	_, err = parseVersion("qemu-system-x86_64 version 8.2.0")
+	must.ErrorContains(t, err, "Failed to parse firecracker version")
+}
+
+func TestDriver_imagePath(t *testing.T) {
+	ci.Parallel(t)
+
+	d := &Driver{config: Config{ImagePaths: []string{"/var/lib/images"}}}
+	cfg := &drivers.TaskConfig{AllocDir: "/var/nomad/alloc/1234"}
+
+	path, err := d.imagePath(cfg, "kernel_image", "local/vmlinux")
+	must.NoError(t, err)
+	must.Eq(t, "/var/nomad/alloc/1234/local/vmlinux", path)
+
+	path, err = d.imagePath(cfg, "kernel_image", "/var/lib/images/vmlinux")
+	must.NoError(t, err)
+	must.Eq(t, "/var/lib/images/vmlinux", path)
+
+	_, err = d.imagePath(cfg, "rootfs_image", "../../etc/shadow")
+	must.ErrorContains(t, err, "rootfs_image is not in the allowed paths")
+
+	_, err = d.imagePath(cfg, "rootfs_image", "/etc/shadow")
+	must.ErrorContains(t, err, "rootfs_image is not in the allowed paths")
+}
+
+func TestDriver_vcpus(t *testing.T) {
+	ci.Parallel(t)
+
+	cfg := &drivers.TaskConfig{Resources: &drivers.Resources{}}
+	must.Eq(t, 1, vcpus(cfg, &TaskConfig{}))
+	must.Eq(t, 4, vcpus(cfg, &TaskConfig{Vcpus: 4}))
+
+	cfg.Resources.LinuxResources = &drivers.LinuxResources{CpusetCpus: "2,3,5"}
+	must.Eq(t, 3, vcpus(cfg, &TaskConfig{}))
+}
+
+func TestVM_bootArgs(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Eq(t, defaultBootArgs, bootArgs("", nil, "web", nil))
+	must.Eq(t, defaultBootArgs+" quiet", bootArgs("quiet", nil, "web", nil))
+
+	network := &vmNetwork{
+		TapName: tapName,
+		MAC:     "02:42:ac:11:00:02",
+		Address: &net.IPNet{IP: net.ParseIP("172.26.64.5").To4(), Mask: net.CIDRMask(20, 32)},
+		Gateway: net.ParseIP("172.26.64.1"),
+	}
+	must.Eq(t,
+		defaultBootArgs+" ip=172.26.64.5::172.26.64.1:255.255.240.0:web:eth0:off:1.1.1.1:8.8.8.8 quiet",
+		bootArgs("quiet", network, "web", []string{"1.1.1.1", "8.8.8.8", "9.9.9.9"}),
+	)
+}
+
+func TestVM_writeVMConfig(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), vmConfigName)
+	must.NoError(t, writeVMConfig(path, &vmConfig{
+		BootSource:    vmBootSource{KernelImagePath: "/vmlinux", BootArgs: defaultBootArgs},
+		Drives:        []vmDrive{{DriveID: "rootfs", PathOnHost: "/rootfs.ext4", IsRootDevice: true}},
+		MachineConfig: vmMachineConfig{VcpuCount: 1, MemSizeMib: 224},
+		Vsock:         vmVsock{GuestCID: guestCID, UDSPath: "/v.sock"},
+	}))
+
+	b, err := os.ReadFile(path)
+	must.NoError(t, err)
+
+	var config map[string]any
+	must.NoError(t, json.Unmarshal(b, &config))
+	must.MapContainsKeys(t, config, []string{"boot-source", "drives", "machine-config", "vsock"})
+	must.MapNotContainsKey(t, config, "network-interfaces")
+	must.Eq[any](t, map[string]any{"vcpu_count": 1.0, "mem_size_mib": 224.0}, config["machine-config"])
+}
+
+// vsockListener emulates the unix socket of the vsock device of a VM, which
+// acknowledges the CONNECT command of the host before forwarding the
+// connection to the guest.
+type vsockListener struct {
+	net.Listener
+	port uint32
+}
+
+func (l *vsockListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if line != fmt.Sprintf("CONNECT %d\n", l.port) {
+		_, _ = conn.Write([]byte("FAILURE\n"))
+		conn.Close()
+		return l.Accept()
+	}
+	_, _ = conn.Write([]byte("OK 1073741824\n"))
+	return conn, nil
+}
+
+func TestVM_dialGuest(t *testing.T) {
+	ci.Parallel(t)
+
+	udsPath := filepath.Join(t.TempDir(), vsockSocketName)
+	l, err := net.Listen("unix", udsPath)
+	must.NoError(t, err)
+
+	logger := testlog.HCLogger(t)
+	s := grpc.NewServer()
+	executor.RegisterExecutorServer(s, executor.NewExecutor(logger, cpustats.Compute{}))
+	go s.Serve(&vsockListener{Listener: l, port: 1024})
+	t.Cleanup(s.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	guest, conn, err := dialGuest(ctx, udsPath, 1024, logger)
+	must.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	must.NoError(t, waitForAgent(ctx, guest, 5*time.Second))
+	version, err := guest.Version()
+	must.NoError(t, err)
+	must.Eq(t, "2.0.0", version.Version)
+
+	// connections to another port are refused by the VMM
+	_, err = dialVsock(ctx, udsPath, 1025)
+	must.ErrorContains(t, err, "failed to connect to vsock port 1025")
+
+	other, otherConn, err := dialGuest(ctx, udsPath, 1025, logger)
+	must.NoError(t, err)
+	t.Cleanup(func() { otherConn.Close() })
+	must.Error(t, waitForAgent(ctx, other, 500*time.Millisecond))
+}
+
+func TestDriver_Fingerprint_noFirecracker(t *testing.T) {
+	ci.Parallel(t)
+
+	d := NewFirecrackerDriver(context.Background(), testlog.HCLogger(t)).(*Driver)
+	d.config.FirecrackerPath = filepath.Join(t.TempDir(), "firecracker")
+
+	fp := d.buildFingerprint()
+	must.Eq(t, drivers.HealthStateUndetected, fp.Health)
+	must.MapNotContainsKey(t, fp.Attributes, driverAttr)
+}
+
+func TestDriver_StartTask_memory(t *testing.T) {
+	ci.Parallel(t)
+
+	d := NewFirecrackerDriver(context.Background(), testlog.HCLogger(t)).(*Driver)
+
+	cfg := &drivers.TaskConfig{
+		ID:       "task",
+		AllocDir: t.TempDir(),
+		Resources: &drivers.Resources{
+			NomadResources: &structs.AllocatedTaskResources{
+				Memory: structs.AllocatedMemoryResources{MemoryMB: 64},
+			},
+		},
+	}
+	must.NoError(t, cfg.EncodeConcreteDriverConfig(&TaskConfig{
+		KernelImage: "vmlinux",
+		RootfsImage: "rootfs.ext4",
+		Command:     "/app",
+		AgentPort:   1024,
+		BootTimeout: "30s",
+	}))
+
+	_, _, err := d.StartTask(cfg)
+	must.ErrorContains(t, err, "at least 128MB of memory")
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package firecracker
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"google.golang.org/grpc"
+)
+
+type taskHandle struct {
+	// exec is the executor of the VMM on the host
+	exec         executor.Executor
+	pid          int
+	pluginClient *plugin.Client
+	logger       hclog.Logger
+
+	// guest is the executor of the workload, served by the agent of the VM
+	guest       executor.Executor
+	guestConn   *grpc.ClientConn
+	guestCancel context.CancelFunc
+
+	// doneCh is closed once the task exited
+	doneCh chan struct{}
+
+	// stateLock syncs access to all fields below
+	stateLock sync.RWMutex
+
+	taskConfig  *drivers.TaskConfig
+	procState   drivers.TaskState
+	startedAt   time.Time
+	completedAt time.Time
+	exitResult  *drivers.ExitResult
+}
+
+func (h *taskHandle) TaskStatus() *drivers.TaskStatus {
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+
+	return &drivers.TaskStatus{
+		ID:          h.taskConfig.ID,
+		Name:        h.taskConfig.Name,
+		State:       h.procState,
+		StartedAt:   h.startedAt,
+		CompletedAt: h.completedAt,
+		ExitResult:  h.exitResult,
+		DriverAttributes: map[string]string{
+			"pid": strconv.Itoa(h.pid),
+		},
+	}
+}
+
+func (h *taskHandle) IsRunning() bool {
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+	return h.procState == drivers.TaskStateRunning
+}
+
+// run waits for the workload of the VM to exit, and stops the VM once it did.
+// If the agent of the VM can't be reached, it waits for the VMM instead.
+func (h *taskHandle) run() {
+	h.stateLock.Lock()
+	if h.exitResult == nil {
+		h.exitResult = &drivers.ExitResult{}
+	}
+	h.stateLock.Unlock()
+	defer close(h.doneCh)
+
+	ps, err := h.guest.Wait(context.Background())
+	if err == nil {
+		if err := h.exec.Shutdown("", 0); err != nil {
+			h.logger.Debug("failed to stop VM after workload exited", "error", err)
+		}
+	} else {
+		h.logger.Debug("failed to wait on workload, waiting on VM", "error", err)
+		ps, err = h.exec.Wait(context.Background())
+	}
+	h.closeGuest()
+
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+
+	if err != nil {
+		h.exitResult.Err = err
+		h.procState = drivers.TaskStateUnknown
+		h.completedAt = time.Now()
+		return
+	}
+	h.procState = drivers.TaskStateExited
+	h.exitResult.ExitCode = ps.ExitCode
+	h.exitResult.Signal = ps.Signal
+	h.exitResult.OOMKilled = ps.OOMKilled
+	h.completedAt = ps.Time
+}
+
+// closeGuest closes the connection to the agent of the VM.
+func (h *taskHandle) closeGuest() {
+	h.guestCancel()
+	if err := h.guestConn.Close(); err != nil {
+		h.logger.Debug("failed to close connection to VM agent", "error", err)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package firecracker
+
+import (
+	"errors"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+func setupNetwork(*drivers.NetworkIsolationSpec) (*vmNetwork, error) {
+	return nil, errors.New("network isolation is only supported on linux")
+}
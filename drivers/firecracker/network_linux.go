@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package firecracker
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/nomad/client/lib/nsutil"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// setupNetwork attaches the VM to the network namespace of the allocation. A
+// tap device is created in the namespace, and all traffic of the interface of
+// the namespace is redirected to the tap device and back. The VM then owns
+// the address and MAC address of that interface.
+func setupNetwork(spec *drivers.NetworkIsolationSpec) (*vmNetwork, error) {
+	var network *vmNetwork
+	err := nsutil.WithNetNSPath(spec.Path, func(nsutil.NetNS) error {
+		link, gateway, err := defaultLink()
+		if err != nil {
+			return err
+		}
+
+		addr, err := linkAddr(link)
+		if err != nil {
+			return err
+		}
+
+		tap, err := tapDevice(link)
+		if err != nil {
+			return err
+		}
+
+		if err := redirect(link, tap); err != nil {
+			return err
+		}
+		if err := redirect(tap, link); err != nil {
+			return err
+		}
+
+		network = &vmNetwork{
+			TapName: tap.Attrs().Name,
+			MAC:     link.Attrs().HardwareAddr.String(),
+			Address: addr,
+			Gateway: gateway,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach the VM to the network namespace: %w", err)
+	}
+	return network, nil
+}
+
+// defaultLink returns the interface and gateway of the default route.
+func defaultLink() (netlink.Link, net.IP, error) {
+	routes, err := netlink.RouteList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list routes: %w", err)
+	}
+
+	for _, route := range routes {
+		if route.Dst != nil {
+			if ones, _ := route.Dst.Mask.Size(); ones != 0 {
+				continue
+			}
+		}
+		link, err := netlink.LinkByIndex(route.LinkIndex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to find interface of the default route: %w", err)
+		}
+		return link, route.Gw, nil
+	}
+	return nil, nil, fmt.Errorf("no default route in network namespace")
+}
+
+func linkAddr(link netlink.Link) (*net.IPNet, error) {
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addresses of %s: %w", link.Attrs().Name, err)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("interface %s has no address", link.Attrs().Name)
+	}
+	return addrs[0].IPNet, nil
+}
+
+// tapDevice creates the tap device of the VM, unless it already exists.
+func tapDevice(link netlink.Link) (netlink.Link, error) {
+	if tap, err := netlink.LinkByName(tapName); err == nil {
+		return tap, nil
+	}
+
+	tap := &netlink.Tuntap{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: tapName,
+			MTU:  link.Attrs().MTU,
+		},
+		Mode: netlink.TUNTAP_MODE_TAP,
+	}
+	if err := netlink.LinkAdd(tap); err != nil {
+		return nil, fmt.Errorf("failed to create tap device: %w", err)
+	}
+	if err := netlink.LinkSetUp(tap); err != nil {
+		return nil, fmt.Errorf("failed to set tap device up: %w", err)
+	}
+	return tap, nil
+}
+
+// redirect redirects all traffic received by from to the egress of to.
+func redirect(from, to netlink.Link) error {
+	qdisc := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: from.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscReplace(qdisc); err != nil {
+		return fmt.Errorf("failed to add ingress qdisc to %s: %w", from.Attrs().Name, err)
+	}
+
+	filter := &netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: from.Attrs().Index,
+			Parent:    qdisc.Handle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		ClassId: netlink.MakeHandle(1, 1),
+		Actions: []netlink.Action{
+			&netlink.MirredAction{
+				ActionAttrs: netlink.ActionAttrs{
+					Action: netlink.TC_ACT_STOLEN,
+				},
+				MirredAction: netlink.TCA_EGRESS_REDIR,
+				Ifindex:      to.Attrs().Index,
+			},
+		},
+	}
+	if err := netlink.FilterReplace(filter); err != nil {
+		return fmt.Errorf("failed to redirect %s to %s: %w", from.Attrs().Name, to.Attrs().Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package firecracker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// tapName is the name of the tap device of the VM in the network
+	// namespace of the allocation.
+	tapName = "tap0"
+
+	// guestCID is the context ID of the vsock device of the VM.
+	guestCID = 3
+
+	// Use short file names since socket paths have a maximum length.
+	apiSocketName   = "fc.sock"
+	vsockSocketName = "v.sock"
+
+	// vmConfigName is the name of the firecracker configuration file in the
+	// task directory.
+	vmConfigName = "firecracker.json"
+
+	// defaultBootArgs are the kernel arguments of the VM, which has a serial
+	// console but no PCI bus, and exits when rebooted.
+	defaultBootArgs = "console=ttyS0 reboot=k panic=1 pci=off"
+)
+
+// vmNetwork is the network of the VM within the network namespace of the
+// allocation.
+type vmNetwork struct {
+	TapName string
+	MAC     string
+	Address *net.IPNet
+	Gateway net.IP
+}
+
+// vmConfig is the firecracker configuration file of a VM.
+type vmConfig struct {
+	BootSource        vmBootSource    `json:"boot-source"`
+	Drives            []vmDrive       `json:"drives"`
+	MachineConfig     vmMachineConfig `json:"machine-config"`
+	NetworkInterfaces []vmInterface   `json:"network-interfaces,omitempty"`
+	Vsock             vmVsock         `json:"vsock"`
+}
+
+type vmBootSource struct {
+	KernelImagePath string `json:"kernel_image_path"`
+	BootArgs        string `json:"boot_args"`
+}
+
+type vmDrive struct {
+	DriveID      string `json:"drive_id"`
+	PathOnHost   string `json:"path_on_host"`
+	IsRootDevice bool   `json:"is_root_device"`
+	IsReadOnly   bool   `json:"is_read_only"`
+}
+
+type vmMachineConfig struct {
+	VcpuCount  int   `json:"vcpu_count"`
+	MemSizeMib int64 `json:"mem_size_mib"`
+}
+
+type vmInterface struct {
+	IfaceID     string `json:"iface_id"`
+	GuestMAC    string `json:"guest_mac"`
+	HostDevName string `json:"host_dev_name"`
+}
+
+type vmVsock struct {
+	GuestCID int    `json:"guest_cid"`
+	UDSPath  string `json:"uds_path"`
+}
+
+// bootArgs returns the kernel arguments of the VM. The network of the VM is
+// configured by the kernel, using the address of the network namespace.
+func bootArgs(extra string, network *vmNetwork, hostname string, dns []string) string {
+	args := []string{defaultBootArgs}
+	if network != nil {
+		// ip=<client>:<server>:<gateway>:<netmask>:<hostname>:<device>:<autoconf>:<dns0>:<dns1>
+		ip := []string{
+			network.Address.IP.String(),
+			"",
+			"",
+			net.IP(network.Address.Mask).String(),
+			hostname,
+			"eth0",
+			"off",
+		}
+		if network.Gateway != nil {
+			ip[2] = network.Gateway.String()
+		}
+		for i := 0; i < len(dns) && i < 2; i++ {
+			ip = append(ip, dns[i])
+		}
+		args = append(args, "ip="+strings.Join(ip, ":"))
+	}
+	if extra != "" {
+		args = append(args, extra)
+	}
+	return strings.Join(args, " ")
+}
+
+// writeVMConfig writes the firecracker configuration file to path.
+func writeVMConfig(path string, config *vmConfig) error {
+	b, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o600)
+}
+
+// dialVsock connects to port of the VM through the unix socket of its vsock
+// device. The VMM forwards the connection to the guest once it acknowledged
+// the CONNECT command.
+func dialVsock(ctx context.Context, udsPath string, port uint32) (net.Conn, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", udsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if _, err := fmt.Fprintf(conn, "CONNECT %d\n", port); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to connect to vsock port %d: %w", port, err)
+	}
+
+	// read the acknowledgment one byte at a time, so no data of the
+	// connection is buffered
+	line, err := bufio.NewReaderSize(oneByteReader{conn}, 16).ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to connect to vsock port %d: %w", port, err)
+	}
+	if !strings.HasPrefix(line, "OK ") {
+		conn.Close()
+		return nil, fmt.Errorf("failed to connect to vsock port %d: %s", port, strings.TrimSpace(line))
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// oneByteReader reads at most one byte at a time from the underlying conn.
+type oneByteReader struct {
+	conn net.Conn
+}
+
+func (r oneByteReader) Read(p []byte) (int, error) {
+	if len(p) > 1 {
+		p = p[:1]
+	}
+	return r.conn.Read(p)
+}
+
+// dialGuest returns the executor of the workload of the VM, served by the
+// guest agent on port. The ctx is closed once the VM is gone.
+func dialGuest(ctx context.Context, udsPath string, port uint32, logger hclog.Logger) (executor.Executor, *grpc.ClientConn, error) {
+	conn, err := grpc.NewClient("passthrough:///"+udsPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return dialVsock(ctx, udsPath, port)
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return executor.NewExecutorClient(ctx, conn, logger), conn, nil
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -157,6 +159,7 @@ func NewMockDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugi
 		Exec:         true,
 		FSIsolation:  drivers.FSIsolationNone,
 		MountConfigs: drivers.MountConfigSupportNone,
+		Checkpoint:   true,
 	}
 
 	return &Driver{
@@ -701,6 +704,40 @@ func (d *Driver) GetHandle(taskID string) *taskHandle {
 	return h
 }
 
+var _ drivers.DriverCheckpointer = (*Driver)(nil)
+
+// checkpointFile is the file written to the image directory by CheckpointTask.
+const checkpointFile = "checkpoint"
+
+// CheckpointTask writes the ID of the task to the image directory and kills
+// the task unless it is left running.
+func (d *Driver) CheckpointTask(taskID string, opts *drivers.CheckpointOptions) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if err := os.MkdirAll(opts.ImageDir, 0o700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(opts.ImageDir, checkpointFile), []byte(taskID), 0o600); err != nil {
+		return err
+	}
+
+	if !opts.LeaveRunning {
+		h.kill()
+	}
+	return nil
+}
+
+// RestoreTask starts the task if the image directory holds a checkpoint.
+func (d *Driver) RestoreTask(cfg *drivers.TaskConfig, imageDir string) (*drivers.TaskHandle, *drivers.DriverNetwork, error) {
+	if _, err := os.Stat(filepath.Join(imageDir, checkpointFile)); err != nil {
+		return nil, nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	return d.StartTask(cfg)
+}
+
 var _ drivers.DriverNetworkManager = (*Driver)(nil)
 
 func (d *Driver) CreateNetwork(allocID string, request *drivers.NetworkCreateRequest) (*drivers.NetworkIsolationSpec, bool, error) {
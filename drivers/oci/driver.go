@@ -0,0 +1,1041 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/hashicorp/consul-template/signals"
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/client/lib/cgroupslib"
+	"github.com/hashicorp/nomad/client/lib/cpustats"
+	"github.com/hashicorp/nomad/drivers/shared/capabilities"
+	"github.com/hashicorp/nomad/drivers/shared/eventer"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
+	"github.com/hashicorp/nomad/drivers/shared/validators"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/drivers/fsisolation"
+	"github.com/hashicorp/nomad/plugins/drivers/utils"
+	"github.com/hashicorp/nomad/plugins/shared/hclspec"
+	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+)
+
+const (
+	// pluginName is the name of the plugin
+	pluginName = "oci"
+
+	// fingerprintPeriod is the interval at which the driver will send fingerprint responses
+	fingerprintPeriod = 30 * time.Second
+
+	// taskHandleVersion is the version of task handle which this driver sets
+	// and understands how to decode driver state
+	taskHandleVersion = 1
+
+	// imageMarkerFile is the file of the task directory recording the digest
+	// of the image unpacked into it, so that restarts don't unpack it again.
+	imageMarkerFile = ".oci-image"
+
+	// userNamespacesFile is the sysctl limiting the number of user namespaces.
+	userNamespacesFile = "/proc/sys/user/max_user_namespaces"
+)
+
+var (
+	// PluginID is the oci plugin metadata registered in the plugin
+	// catalog.
+	PluginID = loader.PluginID{
+		Name:       pluginName,
+		PluginType: base.PluginTypeDriver,
+	}
+
+	// PluginConfig is the oci driver factory function registered in the
+	// plugin catalog.
+	PluginConfig = &loader.InternalPluginConfig{
+		Config:  map[string]interface{}{},
+		Factory: func(ctx context.Context, l hclog.Logger) interface{} { return NewOCIDriver(ctx, l) },
+	}
+
+	// pluginInfo is the response returned for the PluginInfo RPC
+	pluginInfo = &base.PluginInfoResponse{
+		Type:              base.PluginTypeDriver,
+		PluginApiVersions: []string{drivers.ApiVersion010},
+		PluginVersion:     "0.1.0",
+		Name:              pluginName,
+	}
+
+	// configSpec is the hcl specification returned by the ConfigSchema RPC
+	configSpec = hclspec.NewObject(map[string]*hclspec.Spec{
+		"image_store": hclspec.NewDefault(
+			hclspec.NewAttr("image_store", "string", false),
+			hclspec.NewLiteral(`"/var/lib/nomad-oci"`),
+		),
+		"rootless": hclspec.NewDefault(
+			hclspec.NewAttr("rootless", "bool", false),
+			hclspec.NewLiteral("true"),
+		),
+		"userns_host_id": hclspec.NewDefault(
+			hclspec.NewAttr("userns_host_id", "number", false),
+			hclspec.NewLiteral("100000"),
+		),
+		"userns_size": hclspec.NewDefault(
+			hclspec.NewAttr("userns_size", "number", false),
+			hclspec.NewLiteral("65536"),
+		),
+		"no_pivot_root": hclspec.NewDefault(
+			hclspec.NewAttr("no_pivot_root", "bool", false),
+			hclspec.NewLiteral("false"),
+		),
+		"default_pid_mode": hclspec.NewDefault(
+			hclspec.NewAttr("default_pid_mode", "string", false),
+			hclspec.NewLiteral(`"private"`),
+		),
+		"default_ipc_mode": hclspec.NewDefault(
+			hclspec.NewAttr("default_ipc_mode", "string", false),
+			hclspec.NewLiteral(`"private"`),
+		),
+		"allow_caps": hclspec.NewDefault(
+			hclspec.NewAttr("allow_caps", "list(string)", false),
+			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
+		),
+		"denied_host_uids": hclspec.NewAttr("denied_host_uids", "string", false),
+		"denied_host_gids": hclspec.NewAttr("denied_host_gids", "string", false),
+	})
+
+	// taskConfigSpec is the hcl specification for the driver config section of
+	// a task within a job. It is returned in the TaskConfigSchema RPC
+	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
+		"image":      hclspec.NewAttr("image", "string", true),
+		"entrypoint": hclspec.NewAttr("entrypoint", "list(string)", false),
+		"command":    hclspec.NewAttr("command", "string", false),
+		"args":       hclspec.NewAttr("args", "list(string)", false),
+		"auth": hclspec.NewBlock("auth", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"username": hclspec.NewAttr("username", "string", false),
+			"password": hclspec.NewAttr("password", "string", false),
+		})),
+		"force_pull": hclspec.NewAttr("force_pull", "bool", false),
+		"image_pull_timeout": hclspec.NewDefault(
+			hclspec.NewAttr("image_pull_timeout", "string", false),
+			hclspec.NewLiteral(`"5m"`),
+		),
+		"pid_mode": hclspec.NewAttr("pid_mode", "string", false),
+		"ipc_mode": hclspec.NewAttr("ipc_mode", "string", false),
+		"cap_add":  hclspec.NewAttr("cap_add", "list(string)", false),
+		"cap_drop": hclspec.NewAttr("cap_drop", "list(string)", false),
+		"work_dir": hclspec.NewAttr("work_dir", "string", false),
+	})
+
+	// driverCapabilities represents the RPC response for what features are
+	// implemented by the oci task driver
+	driverCapabilities = &drivers.Capabilities{
+		SendSignals: true,
+		Exec:        true,
+		FSIsolation: fsisolation.Image,
+		NetIsolationModes: []drivers.NetIsolationMode{
+			drivers.NetIsolationModeHost,
+			drivers.NetIsolationModeGroup,
+		},
+		MountConfigs: drivers.MountConfigSupportAll,
+	}
+)
+
+// Driver runs tasks from OCI images without a container daemon. Images are
+// pulled into a local content store and unpacked as the root filesystem of
+// the task, which is then launched by the shared executor like the exec
+// driver. In rootless mode, the default, tasks run in a user namespace so that
+// root in the image is an unprivileged user on the host.
+type Driver struct {
+	// eventer is used to handle multiplexing of TaskEvents calls such that an
+	// event can be broadcast to all callers
+	eventer *eventer.Eventer
+
+	// config is the driver configuration set by the SetConfig RPC
+	config Config
+
+	// nomadConfig is the client config from nomad
+	nomadConfig *base.ClientDriverConfig
+
+	// tasks is the in memory datastore mapping taskIDs to driverHandles
+	tasks *taskStore
+
+	// images is the content store of the images pulled by the driver
+	images *imageStore
+
+	// ctx is the context for the driver. It is passed to other subsystems to
+	// coordinate shutdown
+	ctx context.Context
+
+	// logger will log to the Nomad agent
+	logger hclog.Logger
+
+	// A tri-state boolean to know if the fingerprinting has happened and
+	// whether it has been successful
+	fingerprintSuccess *bool
+	fingerprintLock    sync.Mutex
+
+	// compute contains cpu compute information
+	compute cpustats.Compute
+
+	userIDValidator UserIDValidator
+}
+
+// Config is the driver configuration set by the SetConfig RPC call
+type Config struct {
+	// ImageStore is the directory of the content store of pulled images.
+	ImageStore string `codec:"image_store"`
+
+	// Rootless runs tasks in a user namespace, mapping the users of the image
+	// to the unprivileged host IDs starting at UsernsHostID.
+	Rootless bool `codec:"rootless"`
+
+	// UsernsHostID is the first host ID of the user namespace of tasks.
+	UsernsHostID int64 `codec:"userns_host_id"`
+
+	// UsernsSize is the number of IDs mapped in the user namespace of tasks.
+	UsernsSize int64 `codec:"userns_size"`
+
+	// NoPivotRoot disables the use of pivot_root, useful when the root partition
+	// is on ramdisk
+	NoPivotRoot bool `codec:"no_pivot_root"`
+
+	// DefaultModePID is the default PID isolation set for all tasks using
+	// the oci driver.
+	DefaultModePID string `codec:"default_pid_mode"`
+
+	// DefaultModeIPC is the default IPC isolation set for all tasks using
+	// the oci driver.
+	DefaultModeIPC string `codec:"default_ipc_mode"`
+
+	// AllowCaps configures which Linux Capabilities are enabled for tasks
+	// running on this node.
+	AllowCaps []string `codec:"allow_caps"`
+
+	DeniedHostUids string `codec:"denied_host_uids"`
+	DeniedHostGids string `codec:"denied_host_gids"`
+}
+
+func (c *Config) validate() error {
+	if c.ImageStore == "" || !filepath.IsAbs(c.ImageStore) {
+		return fmt.Errorf("image_store must be an absolute path, got %q", c.ImageStore)
+	}
+
+	if c.Rootless {
+		if c.UsernsHostID <= 0 || c.UsernsHostID > 1<<32-1 {
+			return fmt.Errorf("userns_host_id must be a non-root host ID, got %d", c.UsernsHostID)
+		}
+		if c.UsernsSize <= 0 || c.UsernsHostID+c.UsernsSize > 1<<32-1 {
+			return fmt.Errorf("userns_size must be positive and within the range of host IDs, got %d", c.UsernsSize)
+		}
+	}
+
+	switch c.DefaultModePID {
+	case executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("default_pid_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, c.DefaultModePID)
+	}
+
+	switch c.DefaultModeIPC {
+	case executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("default_ipc_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, c.DefaultModeIPC)
+	}
+
+	badCaps := capabilities.Supported().Difference(capabilities.New(c.AllowCaps))
+	if !badCaps.Empty() {
+		return fmt.Errorf("allow_caps configured with capabilities not supported by system: %s", badCaps)
+	}
+
+	return nil
+}
+
+// userNamespace returns the user namespace of tasks, or nil if the driver is
+// not rootless.
+func (c *Config) userNamespace() *executor.UserNamespace {
+	if !c.Rootless {
+		return nil
+	}
+	return &executor.UserNamespace{
+		HostID: uint32(c.UsernsHostID),
+		Size:   uint32(c.UsernsSize),
+	}
+}
+
+// RegistryAuth are the credentials used to pull an image.
+type RegistryAuth struct {
+	Username string `codec:"username"`
+	Password string `codec:"password"`
+}
+
+// TaskConfig is the driver configuration of a task within a job
+type TaskConfig struct {
+	// Image is the reference of the image of the task.
+	Image string `codec:"image"`
+
+	// Entrypoint overrides the entrypoint of the image.
+	Entrypoint []string `codec:"entrypoint"`
+
+	// Command overrides the command of the image, along with Args.
+	Command string `codec:"command"`
+
+	// Args are passed along to Command, or override the command of the image
+	// if Command is not set.
+	Args []string `codec:"args"`
+
+	// Auth are the credentials used to pull the image.
+	Auth RegistryAuth `codec:"auth"`
+
+	// ForcePull pulls the image even if it is in the image store.
+	ForcePull bool `codec:"force_pull"`
+
+	// ImagePullTimeout is the maximum time to pull the image.
+	ImagePullTimeout string `codec:"image_pull_timeout"`
+
+	// ModePID indicates whether PID namespace isolation is enabled for the task.
+	// Must be "private" or "host" if set.
+	ModePID string `codec:"pid_mode"`
+
+	// ModeIPC indicates whether IPC namespace isolation is enabled for the task.
+	// Must be "private" or "host" if set.
+	ModeIPC string `codec:"ipc_mode"`
+
+	// CapAdd is a set of linux capabilities to enable.
+	CapAdd []string `codec:"cap_add"`
+
+	// CapDrop is a set of linux capabilities to disable.
+	CapDrop []string `codec:"cap_drop"`
+
+	// WorkDir overrides the working directory of the image.
+	WorkDir string `codec:"work_dir"`
+}
+
+func (tc *TaskConfig) validate() error {
+	if _, err := parseReference(tc.Image); err != nil {
+		return err
+	}
+
+	if _, err := time.ParseDuration(tc.ImagePullTimeout); err != nil {
+		return fmt.Errorf("image_pull_timeout must be a duration, got %q", tc.ImagePullTimeout)
+	}
+
+	switch tc.ModePID {
+	case "", executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("pid_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, tc.ModePID)
+	}
+
+	switch tc.ModeIPC {
+	case "", executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("ipc_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, tc.ModeIPC)
+	}
+
+	supported := capabilities.Supported()
+	badAdds := supported.Difference(capabilities.New(tc.CapAdd))
+	if !badAdds.Empty() {
+		return fmt.Errorf("cap_add configured with capabilities not supported by system: %s", badAdds)
+	}
+
+	badDrops := supported.Difference(capabilities.New(tc.CapDrop))
+	if !badDrops.Empty() {
+		return fmt.Errorf("cap_drop configured with capabilities not supported by system: %s", badDrops)
+	}
+
+	if tc.WorkDir != "" && !filepath.IsAbs(tc.WorkDir) {
+		return fmt.Errorf("work_dir must be absolute but got relative path %q", tc.WorkDir)
+	}
+
+	return nil
+}
+
+// TaskState is the state which is encoded in the handle returned in
+// StartTask. This information is needed to rebuild the task state and handler
+// during recovery.
+type TaskState struct {
+	ReattachConfig *pstructs.ReattachConfig
+	TaskConfig     *drivers.TaskConfig
+	Pid            int
+	StartedAt      time.Time
+	ImageDigest    string
+}
+
+type UserIDValidator interface {
+	HasValidIDs(userName string) error
+}
+
+// NewOCIDriver returns a new DriverPlugin implementation
+func NewOCIDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugin {
+	logger = logger.Named(pluginName)
+	return &Driver{
+		eventer: eventer.NewEventer(ctx, logger),
+		tasks:   newTaskStore(),
+		ctx:     ctx,
+		logger:  logger,
+	}
+}
+
+// setFingerprintSuccess marks the driver as having fingerprinted successfully
+func (d *Driver) setFingerprintSuccess() {
+	d.fingerprintLock.Lock()
+	d.fingerprintSuccess = new(true)
+	d.fingerprintLock.Unlock()
+}
+
+// setFingerprintFailure marks the driver as having failed fingerprinting
+func (d *Driver) setFingerprintFailure() {
+	d.fingerprintLock.Lock()
+	d.fingerprintSuccess = new(false)
+	d.fingerprintLock.Unlock()
+}
+
+// fingerprintSuccessful returns true if the driver has
+// never fingerprinted or has successfully fingerprinted
+func (d *Driver) fingerprintSuccessful() bool {
+	d.fingerprintLock.Lock()
+	defer d.fingerprintLock.Unlock()
+	return d.fingerprintSuccess == nil || *d.fingerprintSuccess
+}
+
+func (d *Driver) PluginInfo() (*base.PluginInfoResponse, error) {
+	return pluginInfo, nil
+}
+
+func (d *Driver) ConfigSchema() (*hclspec.Spec, error) {
+	return configSpec, nil
+}
+
+func (d *Driver) SetConfig(cfg *base.Config) error {
+	// unpack, validate, and set agent plugin config
+	var config Config
+
+	if len(cfg.PluginConfig) != 0 {
+		if err := base.MsgPackDecode(cfg.PluginConfig, &config); err != nil {
+			return err
+		}
+	}
+
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	if d.userIDValidator == nil {
+		idValidator, err := validators.NewValidator(d.logger, config.DeniedHostUids, config.DeniedHostGids)
+		if err != nil {
+			return fmt.Errorf("unable to start validator: %w", err)
+		}
+
+		d.userIDValidator = idValidator
+	}
+
+	d.config = config
+	d.images = newImageStore(config.ImageStore, d.logger)
+	if cfg != nil && cfg.AgentConfig != nil {
+		d.nomadConfig = cfg.AgentConfig.Driver
+		d.compute = cfg.AgentConfig.Compute()
+	}
+	return nil
+}
+
+func (d *Driver) TaskConfigSchema() (*hclspec.Spec, error) {
+	return taskConfigSpec, nil
+}
+
+// Capabilities is returned by the Capabilities RPC and indicates what
+// optional features this driver supports
+func (d *Driver) Capabilities() (*drivers.Capabilities, error) {
+	return driverCapabilities, nil
+}
+
+func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
+	ch := make(chan *drivers.Fingerprint)
+	go d.handleFingerprint(ctx, ch)
+	return ch, nil
+}
+
+func (d *Driver) handleFingerprint(ctx context.Context, ch chan<- *drivers.Fingerprint) {
+	defer close(ch)
+	ticker := time.NewTimer(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			ticker.Reset(fingerprintPeriod)
+			ch <- d.buildFingerprint()
+		}
+	}
+}
+
+func (d *Driver) buildFingerprint() *drivers.Fingerprint {
+	if runtime.GOOS != "linux" {
+		d.setFingerprintFailure()
+		return &drivers.Fingerprint{
+			Health:            drivers.HealthStateUndetected,
+			HealthDescription: "oci driver unsupported on client OS",
+		}
+	}
+
+	fp := &drivers.Fingerprint{
+		Attributes:        map[string]*pstructs.Attribute{},
+		Health:            drivers.HealthStateHealthy,
+		HealthDescription: drivers.DriverHealthy,
+	}
+
+	if !utils.IsUnixRoot() {
+		fp.Health = drivers.HealthStateUndetected
+		fp.HealthDescription = drivers.DriverRequiresRootMessage
+		d.setFingerprintFailure()
+		return fp
+	}
+
+	if cgroupslib.GetMode() == cgroupslib.OFF {
+		fp.Health = drivers.HealthStateUnhealthy
+		fp.HealthDescription = drivers.NoCgroupMountMessage
+		d.setFingerprintFailure()
+		return fp
+	}
+
+	if d.config.Rootless && !userNamespacesEnabled() {
+		fp.Health = drivers.HealthStateUnhealthy
+		fp.HealthDescription = "User namespaces are disabled, required by rootless mode"
+		d.setFingerprintFailure()
+		return fp
+	}
+
+	fp.Attributes["driver.oci"] = pstructs.NewBoolAttribute(true)
+	fp.Attributes["driver.oci.rootless"] = pstructs.NewBoolAttribute(d.config.Rootless)
+	d.setFingerprintSuccess()
+	return fp
+}
+
+// userNamespacesEnabled returns whether user namespaces can be created.
+func userNamespacesEnabled() bool {
+	b, err := os.ReadFile(userNamespacesFile)
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(string(b)) != "0"
+}
+
+func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
+	if handle == nil {
+		return fmt.Errorf("handle cannot be nil")
+	}
+
+	// If already attached to handle there's nothing to recover.
+	if _, ok := d.tasks.Get(handle.Config.ID); ok {
+		d.logger.Trace("nothing to recover; task already exists",
+			"task_id", handle.Config.ID,
+			"task_name", handle.Config.Name,
+		)
+		return nil
+	}
+
+	// Handle doesn't already exist, try to reattach
+	var taskState TaskState
+	if err := handle.GetDriverState(&taskState); err != nil {
+		d.logger.Error("failed to decode task state from handle", "error", err, "task_id", handle.Config.ID)
+		return fmt.Errorf("failed to decode task state from handle: %v", err)
+	}
+
+	// Create client for reattached executor
+	plugRC, err := pstructs.ReattachConfigToGoPlugin(taskState.ReattachConfig)
+	if err != nil {
+		d.logger.Error("failed to build ReattachConfig from task state", "error", err, "task_id", handle.Config.ID)
+		return fmt.Errorf("failed to build ReattachConfig from task state: %v", err)
+	}
+
+	exec, pluginClient, err := executor.ReattachToExecutor(
+		plugRC,
+		d.logger.With("task_name", handle.Config.Name, "alloc_id", handle.Config.AllocID),
+		d.compute,
+	)
+	if err != nil {
+		d.logger.Error("failed to reattach to executor", "error", err, "task_id", handle.Config.ID)
+		return fmt.Errorf("failed to reattach to executor: %v", err)
+	}
+
+	h := &taskHandle{
+		exec:         exec,
+		pid:          taskState.Pid,
+		imageDigest:  taskState.ImageDigest,
+		pluginClient: pluginClient,
+		taskConfig:   taskState.TaskConfig,
+		procState:    drivers.TaskStateRunning,
+		startedAt:    taskState.StartedAt,
+		exitResult:   &drivers.ExitResult{},
+		logger:       d.logger,
+	}
+
+	d.tasks.Set(taskState.TaskConfig.ID, h)
+
+	go h.run()
+	return nil
+}
+
+func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle, network *drivers.DriverNetwork, err error) {
+	if _, ok := d.tasks.Get(cfg.ID); ok {
+		return nil, nil, fmt.Errorf("task with ID %q already started", cfg.ID)
+	}
+
+	var driverConfig TaskConfig
+	if err := cfg.DecodeDriverConfig(&driverConfig); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode driver config: %v", err)
+	}
+
+	if err := driverConfig.validate(); err != nil {
+		return nil, nil, fmt.Errorf("failed driver config validation: %v", err)
+	}
+
+	img, err := d.pullImage(cfg, &driverConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootfs := cfg.TaskDir().Dir
+	userns := d.config.userNamespace()
+	if err := d.unpackImage(img, rootfs, userns); err != nil {
+		return nil, nil, fmt.Errorf("failed to unpack image: %v", err)
+	}
+
+	user, err := d.taskUser(cfg, img, rootfs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argv, err := taskCommand(img, &driverConfig, rootfs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig), "image_digest", img.Digest)
+	handle = drivers.NewTaskHandle(taskHandleVersion)
+	handle.Config = cfg
+
+	pluginLogFile := filepath.Join(cfg.TaskDir().Dir, "executor.out")
+	executorConfig := &executor.ExecutorConfig{
+		LogFile:     pluginLogFile,
+		LogLevel:    "debug",
+		FSIsolation: true,
+		Compute:     d.compute,
+	}
+
+	// The shared alloc dir is not linked into the task dir for image based
+	// isolation, so bind it into the rootfs.
+	cfg.Mounts = append(cfg.Mounts, &drivers.MountConfig{
+		TaskPath: allocdir.SharedAllocContainerPath,
+		HostPath: cfg.TaskDir().SharedAllocDir,
+	})
+
+	if cfg.DNS != nil {
+		dnsMount, err := resolvconf.GenerateDNSMount(cfg.TaskDir().Dir, cfg.DNS)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to build mount for resolv.conf: %v", err)
+		}
+		cfg.Mounts = append(cfg.Mounts, dnsMount)
+	}
+
+	caps, err := capabilities.Calculate(
+		capabilities.NomadDefaults(), d.config.AllowCaps, driverConfig.CapAdd, driverConfig.CapDrop,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	d.logger.Debug("task capabilities", "capabilities", caps)
+
+	workDir := driverConfig.WorkDir
+	if workDir == "" {
+		workDir = img.Config.Config.WorkingDir
+	}
+
+	exec, pluginClient, err := executor.CreateExecutor(
+		d.logger.With("task_name", handle.Config.Name, "alloc_id", handle.Config.AllocID),
+		d.nomadConfig, executorConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create executor: %v", err)
+	}
+	// prevent leaking executor in error scenarios
+	defer func() {
+		if err != nil {
+			pluginClient.Kill()
+		}
+	}()
+
+	execCmd := &executor.ExecCommand{
+		Cmd:              argv[0],
+		Args:             argv[1:],
+		Env:              taskEnv(img.Config.Config.Env, cfg.EnvList()),
+		User:             user,
+		ResourceLimits:   true,
+		NoPivotRoot:      d.config.NoPivotRoot,
+		Resources:        cfg.Resources,
+		TaskDir:          rootfs,
+		WorkDir:          workDir,
+		StdoutPath:       cfg.StdoutPath,
+		StderrPath:       cfg.StderrPath,
+		Mounts:           cfg.Mounts,
+		Devices:          cfg.Devices,
+		NetworkIsolation: cfg.NetworkIsolation,
+		ModePID:          executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID),
+		ModeIPC:          executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC),
+		Capabilities:     caps,
+		UserNamespace:    userns,
+	}
+
+	ps, err := exec.Launch(execCmd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to launch command with executor: %v", err)
+	}
+
+	h := &taskHandle{
+		exec:         exec,
+		pid:          ps.Pid,
+		imageDigest:  img.Digest.String(),
+		pluginClient: pluginClient,
+		taskConfig:   cfg,
+		procState:    drivers.TaskStateRunning,
+		startedAt:    time.Now().Round(time.Millisecond),
+		logger:       d.logger,
+	}
+
+	driverState := TaskState{
+		ReattachConfig: pstructs.ReattachConfigFromGoPlugin(pluginClient.ReattachConfig()),
+		Pid:            ps.Pid,
+		TaskConfig:     cfg,
+		StartedAt:      h.startedAt,
+		ImageDigest:    h.imageDigest,
+	}
+
+	if err := handle.SetDriverState(&driverState); err != nil {
+		d.logger.Error("failed to start task, error setting driver state", "error", err)
+		_ = exec.Shutdown("", 0)
+		return nil, nil, fmt.Errorf("failed to set driver state: %v", err)
+	}
+
+	d.tasks.Set(cfg.ID, h)
+	go h.run()
+	return handle, nil, nil
+}
+
+// pullImage pulls the image of the task into the image store.
+func (d *Driver) pullImage(cfg *drivers.TaskConfig, driverConfig *TaskConfig) (*image, error) {
+	ref, err := parseReference(driverConfig.Image)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := time.ParseDuration(driverConfig.ImagePullTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	d.eventer.EmitEvent(&drivers.TaskEvent{
+		TaskID:    cfg.ID,
+		AllocID:   cfg.AllocID,
+		TaskName:  cfg.Name,
+		Timestamp: time.Now(),
+		Message:   "Downloading image",
+		Annotations: map[string]string{
+			"image": ref.String(),
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(d.ctx, timeout)
+	defer cancel()
+
+	img, err := d.images.pull(ctx, ref, &driverConfig.Auth, driverConfig.ForcePull)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull image %s: %v", ref, err)
+	}
+	return img, nil
+}
+
+// unpackImage unpacks the image into the rootfs, unless it was already
+// unpacked by a previous run of the task.
+func (d *Driver) unpackImage(img *image, rootfs string, userns *executor.UserNamespace) error {
+	marker := filepath.Join(rootfs, imageMarkerFile)
+	if b, err := os.ReadFile(marker); err == nil && string(b) == img.Digest.String() {
+		return nil
+	}
+
+	if err := d.images.unpack(img, rootfs, userns); err != nil {
+		return err
+	}
+	return os.WriteFile(marker, []byte(img.Digest.String()), 0o600)
+}
+
+// taskUser returns the user running the task. In rootless mode it is the user
+// of the task, or of the image by default, resolved inside the image.
+// Otherwise it is a user of the host like for the exec driver.
+func (d *Driver) taskUser(cfg *drivers.TaskConfig, img *image, rootfs string) (string, error) {
+	if d.config.Rootless {
+		user := cfg.User
+		if user == "" {
+			user = img.Config.Config.User
+		}
+		uid, err := resolveUser(rootfs, user)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve user %q: %v", user, err)
+		}
+		return uid, nil
+	}
+
+	if cfg.User == "" {
+		cfg.User = "nobody"
+	}
+
+	d.logger.Debug("setting up user", "user", cfg.User)
+
+	if err := d.userIDValidator.HasValidIDs(cfg.User); err != nil {
+		return "", fmt.Errorf("failed host user validation: %v", err)
+	}
+	return cfg.User, nil
+}
+
+// taskCommand returns the command line of the task, which is the entrypoint
+// followed by the command of the image unless overridden by the task. Commands
+// without a slash are looked up in the PATH of the image.
+func taskCommand(img *image, driverConfig *TaskConfig, rootfs string) ([]string, error) {
+	entrypoint := img.Config.Config.Entrypoint
+	if len(driverConfig.Entrypoint) > 0 {
+		entrypoint = driverConfig.Entrypoint
+	}
+
+	command := img.Config.Config.Cmd
+	switch {
+	case driverConfig.Command != "":
+		command = append([]string{driverConfig.Command}, driverConfig.Args...)
+	case len(driverConfig.Args) > 0:
+		command = driverConfig.Args
+	}
+
+	argv := append(append([]string{}, entrypoint...), command...)
+	if len(argv) == 0 {
+		return nil, fmt.Errorf("image %s has no command, and none was set for the task", driverConfig.Image)
+	}
+
+	if !strings.Contains(argv[0], "/") {
+		argv[0] = lookPath(rootfs, argv[0], img.Config.Config.Env)
+	}
+	return argv, nil
+}
+
+// lookPath returns the absolute path inside the rootfs of the executable in the
+// PATH of the image, or bin itself if it is not found.
+func lookPath(rootfs, bin string, env []string) string {
+	for _, kv := range env {
+		value, ok := strings.CutPrefix(kv, "PATH=")
+		if !ok {
+			continue
+		}
+		for _, dir := range filepath.SplitList(value) {
+			if !path.IsAbs(dir) {
+				continue
+			}
+			p, err := securejoin.SecureJoin(rootfs, path.Join(dir, bin))
+			if err != nil {
+				continue
+			}
+			if fi, err := os.Stat(p); err == nil && fi.Mode().IsRegular() {
+				return path.Join(dir, bin)
+			}
+		}
+	}
+	return bin
+}
+
+// taskEnv returns the environment of the task, which is the environment of
+// the image overridden by the environment of the task.
+func taskEnv(imageEnv, env []string) []string {
+	keys := make(map[string]struct{}, len(env))
+	for _, kv := range env {
+		key, _, _ := strings.Cut(kv, "=")
+		keys[key] = struct{}{}
+	}
+
+	result := make([]string, 0, len(imageEnv)+len(env))
+	for _, kv := range imageEnv {
+		key, _, _ := strings.Cut(kv, "=")
+		if _, ok := keys[key]; !ok {
+			result = append(result, kv)
+		}
+	}
+	return append(result, env...)
+}
+
+func (d *Driver) WaitTask(ctx context.Context, taskID string) (<-chan *drivers.ExitResult, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	ch := make(chan *drivers.ExitResult)
+	go d.handleWait(ctx, handle, ch)
+
+	return ch, nil
+}
+
+func (d *Driver) handleWait(ctx context.Context, handle *taskHandle, ch chan *drivers.ExitResult) {
+	defer close(ch)
+	var result *drivers.ExitResult
+	ps, err := handle.exec.Wait(ctx)
+	if err != nil {
+		result = &drivers.ExitResult{
+			Err: fmt.Errorf("executor: error waiting on process: %v", err),
+		}
+		// if process state is nil, we've probably been killed, so return a reasonable
+		// exit state to the handlers
+		if ps == nil {
+			result.ExitCode = -1
+			result.OOMKilled = false
+		}
+	} else {
+		result = &drivers.ExitResult{
+			ExitCode:  ps.ExitCode,
+			Signal:    ps.Signal,
+			OOMKilled: ps.OOMKilled,
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-d.ctx.Done():
+		return
+	case ch <- result:
+	}
+}
+
+func (d *Driver) StopTask(taskID string, timeout time.Duration, signal string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if err := handle.exec.Shutdown(signal, timeout); err != nil {
+		if handle.pluginClient.Exited() {
+			return nil
+		}
+		return fmt.Errorf("executor Shutdown failed: %v", err)
+	}
+
+	return nil
+}
+
+func (d *Driver) DestroyTask(taskID string, force bool) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if handle.IsRunning() && !force {
+		return fmt.Errorf("cannot destroy running task")
+	}
+
+	if !handle.pluginClient.Exited() {
+		if err := handle.exec.Shutdown("", 0); err != nil {
+			handle.logger.Error("destroying executor failed", "error", err)
+		}
+
+		handle.pluginClient.Kill()
+	}
+
+	d.tasks.Delete(taskID)
+	return nil
+}
+
+func (d *Driver) InspectTask(taskID string) (*drivers.TaskStatus, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	return handle.TaskStatus(), nil
+}
+
+func (d *Driver) TaskStats(ctx context.Context, taskID string, interval time.Duration) (<-chan *drivers.TaskResourceUsage, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Stats(ctx, interval)
+}
+
+func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, error) {
+	return d.eventer.TaskEvents(ctx)
+}
+
+func (d *Driver) SignalTask(taskID string, signal string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	sig := os.Interrupt
+	if s, ok := signals.SignalLookup[signal]; ok {
+		sig = s
+	} else {
+		d.logger.Warn("unknown signal to send to task, using SIGINT instead", "signal", signal, "task_id", handle.taskConfig.ID)
+
+	}
+	return handle.exec.Signal(sig)
+}
+
+func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
+	if len(cmd) == 0 {
+		return nil, fmt.Errorf("error cmd must have at least one value")
+	}
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	args := []string{}
+	if len(cmd) > 1 {
+		args = cmd[1:]
+	}
+
+	out, exitCode, err := handle.exec.Exec(time.Now().Add(timeout), cmd[0], args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drivers.ExecTaskResult{
+		Stdout: out,
+		ExitResult: &drivers.ExitResult{
+			ExitCode: exitCode,
+		},
+	}, nil
+}
+
+var _ drivers.ExecTaskStreamingRawDriver = (*Driver)(nil)
+
+func (d *Driver) ExecTaskStreamingRaw(ctx context.Context,
+	taskID string,
+	command []string,
+	tty bool,
+	stream drivers.ExecTaskStream) error {
+
+	if len(command) == 0 {
+		return fmt.Errorf("error cmd must have at least one value")
+	}
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.ExecStreaming(ctx, command, tty, stream)
+}
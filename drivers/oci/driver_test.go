@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/shoenig/test/must"
+)
+
+func TestConfig_ParseAllHCL(t *testing.T) {
+	ci.Parallel(t)
+
+	cfgStr := `
+config {
+  image = "ghcr.io/org/app:v1"
+  entrypoint = ["/bin/sh", "-c"]
+  args = ["echo hello"]
+  auth {
+    username = "user"
+    password = "secret"
+  }
+  force_pull = true
+  work_dir = "/srv"
+}`
+
+	expected := &TaskConfig{
+		Image:            "ghcr.io/org/app:v1",
+		Entrypoint:       []string{"/bin/sh", "-c"},
+		Args:             []string{"echo hello"},
+		Auth:             RegistryAuth{Username: "user", Password: "secret"},
+		ForcePull:        true,
+		ImagePullTimeout: "5m",
+		WorkDir:          "/srv",
+	}
+
+	var tc *TaskConfig
+	hclutils.NewConfigParser(taskConfigSpec).ParseHCL(t, cfgStr, &tc)
+	must.Eq(t, expected, tc)
+	must.NoError(t, tc.validate())
+}
+
+func TestConfig_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	valid := func() *Config {
+		return &Config{
+			ImageStore:     "/var/lib/nomad-oci",
+			Rootless:       true,
+			UsernsHostID:   100000,
+			UsernsSize:     65536,
+			DefaultModePID: executor.IsolationModePrivate,
+			DefaultModeIPC: executor.IsolationModePrivate,
+			AllowCaps:      []string{"chown"},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{name: "valid", modify: func(*Config) {}},
+		{name: "relative store", modify: func(c *Config) { c.ImageStore = "images" }, err: "image_store"},
+		{name: "root host id", modify: func(c *Config) { c.UsernsHostID = 0 }, err: "userns_host_id"},
+		{name: "empty userns", modify: func(c *Config) { c.UsernsSize = 0 }, err: "userns_size"},
+		{name: "overflowing userns", modify: func(c *Config) { c.UsernsSize = 1 << 32 }, err: "userns_size"},
+		{name: "not rootless", modify: func(c *Config) { c.Rootless, c.UsernsHostID = false, 0 }},
+		{name: "pid mode", modify: func(c *Config) { c.DefaultModePID = "other" }, err: "default_pid_mode"},
+		{name: "ipc mode", modify: func(c *Config) { c.DefaultModeIPC = "other" }, err: "default_ipc_mode"},
+		{name: "caps", modify: func(c *Config) { c.AllowCaps = []string{"bogus"} }, err: "allow_caps"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := valid()
+			tc.modify(c)
+			err := c.validate()
+			if tc.err != "" {
+				must.ErrorContains(t, err, tc.err)
+				return
+			}
+			must.NoError(t, err)
+		})
+	}
+
+	must.Eq(t, &executor.UserNamespace{HostID: 100000, Size: 65536}, valid().userNamespace())
+	c := valid()
+	c.Rootless = false
+	must.Nil(t, c.userNamespace())
+}
+
+func TestTaskConfig_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		config TaskConfig
+		err    string
+	}{
+		{name: "valid", config: TaskConfig{Image: "busybox", ImagePullTimeout: "1m"}},
+		{name: "bad image", config: TaskConfig{Image: "Busybox", ImagePullTimeout: "1m"}, err: "invalid image reference"},
+		{name: "bad timeout", config: TaskConfig{Image: "busybox", ImagePullTimeout: "soon"}, err: "image_pull_timeout"},
+		{name: "pid mode", config: TaskConfig{Image: "busybox", ImagePullTimeout: "1m", ModePID: "other"}, err: "pid_mode"},
+		{name: "ipc mode", config: TaskConfig{Image: "busybox", ImagePullTimeout: "1m", ModeIPC: "other"}, err: "ipc_mode"},
+		{name: "cap add", config: TaskConfig{Image: "busybox", ImagePullTimeout: "1m", CapAdd: []string{"bogus"}}, err: "cap_add"},
+		{name: "work dir", config: TaskConfig{Image: "busybox", ImagePullTimeout: "1m", WorkDir: "srv"}, err: "work_dir"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.validate()
+			if tc.err != "" {
+				must.ErrorContains(t, err, tc.err)
+				return
+			}
+			must.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskCommand(t *testing.T) {
+	ci.Parallel(t)
+
+	rootfs := t.TempDir()
+	must.NoError(t, os.MkdirAll(filepath.Join(rootfs, "usr/bin"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(rootfs, "usr/bin/app"), nil, 0o755))
+	must.NoError(t, os.Symlink("/etc", filepath.Join(rootfs, "usr/bin/escape")))
+
+	img := &image{}
+	img.Config.Config.Entrypoint = []string{"app"}
+	img.Config.Config.Cmd = []string{"serve"}
+	img.Config.Config.Env = []string{"PATH=/bin:/usr/bin"}
+
+	testCases := []struct {
+		name     string
+		config   TaskConfig
+		expected []string
+	}{
+		{name: "image", expected: []string{"/usr/bin/app", "serve"}},
+		{name: "args", config: TaskConfig{Args: []string{"check"}}, expected: []string{"/usr/bin/app", "check"}},
+		{name: "command", config: TaskConfig{Command: "run", Args: []string{"-v"}}, expected: []string{"/usr/bin/app", "run", "-v"}},
+		{name: "entrypoint", config: TaskConfig{Entrypoint: []string{"/bin/sh", "-c"}}, expected: []string{"/bin/sh", "-c", "serve"}},
+		{name: "not found", config: TaskConfig{Entrypoint: []string{"missing"}}, expected: []string{"missing", "serve"}},
+		{name: "symlink", config: TaskConfig{Entrypoint: []string{"escape"}}, expected: []string{"escape", "serve"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			argv, err := taskCommand(img, &tc.config, rootfs)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, argv)
+		})
+	}
+
+	_, err := taskCommand(&image{}, &TaskConfig{Image: "busybox"}, rootfs)
+	must.ErrorContains(t, err, "image busybox has no command")
+}
+
+func TestTaskEnv(t *testing.T) {
+	ci.Parallel(t)
+
+	env := taskEnv(
+		[]string{"PATH=/usr/bin", "LANG=C.UTF-8", "HOME=/root"},
+		[]string{"HOME=/local", "NOMAD_TASK_NAME=web"},
+	)
+	must.Eq(t, []string{"PATH=/usr/bin", "LANG=C.UTF-8", "HOME=/local", "NOMAD_TASK_NAME=web"}, env)
+}
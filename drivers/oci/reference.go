@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	// defaultRegistry is the registry of image references without one.
+	defaultRegistry = "docker.io"
+
+	// dockerHubHost is the host serving the registry API of Docker Hub.
+	dockerHubHost = "registry-1.docker.io"
+
+	// defaultTag is the tag of image references without a tag or digest.
+	defaultTag = "latest"
+)
+
+var (
+	repositoryRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegexp        = regexp.MustCompile(`^\w[\w.-]{0,127}$`)
+)
+
+// reference is a parsed image reference of the form
+// [registry/]repository[:tag][@digest].
+type reference struct {
+	registry   string
+	repository string
+	tag        string
+	digest     digest.Digest
+}
+
+// parseReference parses an image reference. References without a registry
+// refer to Docker Hub, and references without a tag or digest to the latest
+// tag.
+func parseReference(s string) (*reference, error) {
+	ref := &reference{}
+
+	name := s
+	if i := strings.Index(name, "@"); i >= 0 {
+		d, err := digest.Parse(name[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid image reference %q: %v", s, err)
+		}
+		ref.digest = d
+		name = name[:i]
+	}
+
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		ref.tag = name[i+1:]
+		name = name[:i]
+		if !tagRegexp.MatchString(ref.tag) {
+			return nil, fmt.Errorf("invalid image reference %q: invalid tag %q", s, ref.tag)
+		}
+	}
+
+	ref.registry = defaultRegistry
+	if i := strings.Index(name, "/"); i >= 0 {
+		domain := name[:i]
+		if strings.ContainsAny(domain, ".:") || domain == "localhost" {
+			ref.registry, name = domain, name[i+1:]
+		}
+	}
+	if ref.registry == defaultRegistry && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	if !repositoryRegexp.MatchString(name) {
+		return nil, fmt.Errorf("invalid image reference %q: invalid repository %q", s, name)
+	}
+	ref.repository = name
+
+	if ref.tag == "" && ref.digest == "" {
+		ref.tag = defaultTag
+	}
+	return ref, nil
+}
+
+// String returns the fully qualified form of the reference.
+func (r *reference) String() string {
+	s := r.registry + "/" + r.repository
+	if r.tag != "" {
+		s += ":" + r.tag
+	}
+	if r.digest != "" {
+		s += "@" + r.digest.String()
+	}
+	return s
+}
+
+// object returns the digest of the reference if set, or its tag otherwise,
+// which identifies its manifest in the registry.
+func (r *reference) object() string {
+	if r.digest != "" {
+		return r.digest.String()
+	}
+	return r.tag
+}
+
+// baseURL returns the base URL of the registry API. Registries on loopback
+// addresses are accessed over plain HTTP.
+func (r *reference) baseURL() string {
+	host := r.registry
+	if host == defaultRegistry {
+		host = dockerHubHost
+	}
+
+	scheme := "https"
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	if ip := net.ParseIP(hostname); hostname == "localhost" || (ip != nil && ip.IsLoopback()) {
+		scheme = "http"
+	}
+	return scheme + "://" + host
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReference_parse(t *testing.T) {
+	ci.Parallel(t)
+
+	const sum = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+
+	testCases := []struct {
+		input    string
+		expected string
+		baseURL  string
+		err      bool
+	}{
+		{input: "busybox", expected: "docker.io/library/busybox:latest", baseURL: "https://registry-1.docker.io"},
+		{input: "busybox:1.36", expected: "docker.io/library/busybox:1.36", baseURL: "https://registry-1.docker.io"},
+		{input: "hashicorp/nomad", expected: "docker.io/hashicorp/nomad:latest", baseURL: "https://registry-1.docker.io"},
+		{input: "ghcr.io/org/app:v1", expected: "ghcr.io/org/app:v1", baseURL: "https://ghcr.io"},
+		{input: "localhost:5000/app", expected: "localhost:5000/app:latest", baseURL: "http://localhost:5000"},
+		{input: "127.0.0.1:5000/app", expected: "127.0.0.1:5000/app:latest", baseURL: "http://127.0.0.1:5000"},
+		{input: "quay.io/app@" + sum, expected: "quay.io/app@" + sum, baseURL: "https://quay.io"},
+		{input: "quay.io/app:v2@" + sum, expected: "quay.io/app:v2@" + sum, baseURL: "https://quay.io"},
+		{input: "", err: true},
+		{input: "Busybox", err: true},
+		{input: "busybox:", err: true},
+		{input: "busybox@sha256:abc", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			ref, err := parseReference(tc.input)
+			if tc.err {
+				must.Error(t, err)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, ref.String())
+			must.Eq(t, tc.baseURL, ref.baseURL())
+		})
+	}
+}
+
+func TestReference_parseChallenge(t *testing.T) {
+	ci.Parallel(t)
+
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/busybox:pull"`)
+	must.Eq(t, "Bearer", scheme)
+	must.Eq(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/busybox:pull",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	must.Eq(t, "Basic", scheme)
+	must.Eq(t, map[string]string{"realm": "registry"}, params)
+}
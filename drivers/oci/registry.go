@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// mediaTypeDockerManifest and mediaTypeDockerManifestList are the media
+	// types of Docker image manifests, which are compatible with their OCI
+	// counterparts.
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// maxManifestSize is the maximum size of the manifests read from
+	// registries.
+	maxManifestSize = 4 << 20
+)
+
+// manifestMediaTypes are the manifest media types accepted from registries.
+var manifestMediaTypes = []string{
+	ocispec.MediaTypeImageIndex,
+	ocispec.MediaTypeImageManifest,
+	mediaTypeDockerManifestList,
+	mediaTypeDockerManifest,
+}
+
+// registryClient reads manifests and blobs from the registry of an image,
+// using the registry HTTP API.
+type registryClient struct {
+	client *http.Client
+	ref    *reference
+	auth   *RegistryAuth
+
+	// authorization is the Authorization header of requests, set once the
+	// registry challenged the client.
+	authorization string
+}
+
+func newRegistryClient(client *http.Client, ref *reference, auth *RegistryAuth) *registryClient {
+	return &registryClient{
+		client: client,
+		ref:    ref,
+		auth:   auth,
+	}
+}
+
+// fetchManifest returns the manifest of the object (tag or digest) along with
+// its media type and digest.
+func (c *registryClient) fetchManifest(ctx context.Context, object string) ([]byte, string, digest.Digest, error) {
+	path := fmt.Sprintf("/v2/%s/manifests/%s", c.ref.repository, object)
+	resp, err := c.get(ctx, path, manifestMediaTypes)
+	if err != nil {
+		return nil, "", "", err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to read manifest: %v", err)
+	}
+
+	d := digest.FromBytes(b)
+	if expected, err := digest.Parse(object); err == nil && expected != d {
+		return nil, "", "", fmt.Errorf("manifest digest mismatch: expected %s, got %s", expected, d)
+	}
+
+	mediaType := resp.Header.Get("Content-Type")
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = mediaType[:i]
+	}
+	return b, strings.TrimSpace(mediaType), d, nil
+}
+
+// fetchBlob returns a reader of the blob with the digest. Callers must verify
+// the content read matches the digest.
+func (c *registryClient) fetchBlob(ctx context.Context, d digest.Digest) (io.ReadCloser, error) {
+	path := fmt.Sprintf("/v2/%s/blobs/%s", c.ref.repository, d)
+	resp, err := c.get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// get sends a GET request to the registry, authenticating and retrying once
+// if the registry challenges the client.
+func (c *registryClient) get(ctx context.Context, path string, accept []string) (*http.Response, error) {
+	resp, err := c.do(ctx, c.ref.baseURL()+path, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.authorization == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := c.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = c.do(ctx, c.ref.baseURL()+path, accept); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %s from registry %s: %s", path, c.ref.registry, resp.Status)
+	}
+	return resp, nil
+}
+
+func (c *registryClient) do(ctx context.Context, u string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if c.authorization != "" {
+		req.Header.Set("Authorization", c.authorization)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to registry %s: %v", c.ref.registry, err)
+	}
+	return resp, nil
+}
+
+// authenticate sets the authorization of the client for the challenge of the
+// registry, requesting a token from the authorization service of the registry
+// for bearer challenges.
+func (c *registryClient) authenticate(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.auth == nil || c.auth.Username == "" {
+			return fmt.Errorf("registry %s requires credentials", c.ref.registry)
+		}
+		credentials := c.auth.Username + ":" + c.auth.Password
+		c.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+		return nil
+
+	case "bearer":
+		token, err := c.fetchToken(ctx, params)
+		if err != nil {
+			return err
+		}
+		c.authorization = "Bearer " + token
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported authentication challenge from registry %s: %q", c.ref.registry, challenge)
+	}
+}
+
+// fetchToken requests a pull token from the authorization service named by
+// the parameters of a bearer challenge.
+func (c *registryClient) fetchToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid authentication realm from registry %s: %q", c.ref.registry, params["realm"])
+	}
+
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", c.ref.repository)
+	}
+	query := realm.Query()
+	query.Set("scope", scope)
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.auth != nil && c.auth.Username != "" {
+		req.SetBasicAuth(c.auth.Username, c.auth.Password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request token for registry %s: %v", c.ref.registry, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to request token for registry %s: %s", c.ref.registry, resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxManifestSize)).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode token for registry %s: %v", c.ref.registry, err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", fmt.Errorf("no token returned for registry %s", c.ref.registry)
+}
+
+// parseChallenge parses a WWW-Authenticate header of the form
+// `<scheme> key="value",key="value"`.
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := make(map[string]string)
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(rest, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		rest = strings.TrimSpace(rest)
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+			_, rest, _ = strings.Cut(rest, ",")
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key != "" {
+			params[key] = strings.TrimSpace(value)
+		}
+	}
+	return scheme, params
+}
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// image is an image pulled into the store.
+type image struct {
+	// Digest is the digest of the image manifest.
+	Digest digest.Digest
+
+	// Config is the configuration of the image.
+	Config ocispec.Image
+
+	// Layers are the layers of the image, in the order they are applied.
+	Layers []ocispec.Descriptor
+}
+
+// imageStore is a local content store of the images pulled by the driver.
+// Blobs are stored by their digest, so layers shared by images are only
+// downloaded once, and tags are resolved to the digest of their manifest:
+//
+//	<root>/blobs/<algorithm>/<hex>
+//	<root>/refs/<sha256 of the reference>
+type imageStore struct {
+	root   string
+	client *http.Client
+	logger hclog.Logger
+
+	// pullLocks serializes pulls of the same reference.
+	pullLocks     map[string]*sync.Mutex
+	pullLocksLock sync.Mutex
+}
+
+func newImageStore(root string, logger hclog.Logger) *imageStore {
+	return &imageStore{
+		root:      root,
+		client:    &http.Client{Transport: http.DefaultTransport},
+		logger:    logger.Named("image_store"),
+		pullLocks: make(map[string]*sync.Mutex),
+	}
+}
+
+// pull returns the image of the reference, pulling it from its registry if it
+// is not in the store or force is set.
+func (s *imageStore) pull(ctx context.Context, ref *reference, auth *RegistryAuth, force bool) (*image, error) {
+	unlock := s.lockRef(ref.String())
+	defer unlock()
+
+	if !force || ref.digest != "" {
+		img, err := s.lookup(ref)
+		if err == nil {
+			return img, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			s.logger.Warn("failed to read image from store, pulling it again", "image", ref, "error", err)
+		}
+	}
+
+	s.logger.Debug("pulling image", "image", ref)
+	registry := newRegistryClient(s.client, ref, auth)
+
+	manifest, d, err := s.pullManifest(ctx, registry, ref.object())
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull manifest of image %s: %w", ref, err)
+	}
+
+	blobs := append([]ocispec.Descriptor{manifest.Config}, manifest.Layers...)
+	for _, desc := range blobs {
+		if err := s.pullBlob(ctx, registry, desc); err != nil {
+			return nil, fmt.Errorf("failed to pull image %s: %w", ref, err)
+		}
+	}
+
+	if err := s.writeRef(ref, d); err != nil {
+		return nil, err
+	}
+	return s.load(d)
+}
+
+// lookup returns the image of the reference if it was pulled before.
+func (s *imageStore) lookup(ref *reference) (*image, error) {
+	d := ref.digest
+	if d == "" {
+		b, err := os.ReadFile(s.refPath(ref))
+		if err != nil {
+			return nil, err
+		}
+		if d, err = digest.Parse(strings.TrimSpace(string(b))); err != nil {
+			return nil, err
+		}
+	}
+	return s.load(d)
+}
+
+// load reads the image with the manifest digest from the store, ensuring all
+// its blobs are present.
+func (s *imageStore) load(d digest.Digest) (*image, error) {
+	var manifest ocispec.Manifest
+	if err := s.readJSON(d, &manifest); err != nil {
+		return nil, err
+	}
+
+	img := &image{Digest: d, Layers: manifest.Layers}
+	if err := s.readJSON(manifest.Config.Digest, &img.Config); err != nil {
+		return nil, err
+	}
+	for _, layer := range manifest.Layers {
+		if _, err := os.Stat(s.blobPath(layer.Digest)); err != nil {
+			return nil, err
+		}
+	}
+	return img, nil
+}
+
+// pullManifest pulls the image manifest of the object into the store,
+// selecting the manifest of the platform of the client from image indexes.
+func (s *imageStore) pullManifest(ctx context.Context, registry *registryClient, object string) (*ocispec.Manifest, digest.Digest, error) {
+	b, mediaType, d, err := registry.fetchManifest(ctx, object)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var probe struct {
+		MediaType string `json:"mediaType"`
+	}
+	if err := json.Unmarshal(b, &probe); err != nil {
+		return nil, "", fmt.Errorf("failed to decode manifest: %v", err)
+	}
+	if probe.MediaType != "" {
+		mediaType = probe.MediaType
+	}
+
+	switch mediaType {
+	case ocispec.MediaTypeImageIndex, mediaTypeDockerManifestList:
+		var index ocispec.Index
+		if err := json.Unmarshal(b, &index); err != nil {
+			return nil, "", fmt.Errorf("failed to decode image index: %v", err)
+		}
+		desc, err := selectPlatform(index.Manifests)
+		if err != nil {
+			return nil, "", err
+		}
+		return s.pullManifest(ctx, registry, desc.Digest.String())
+
+	case ocispec.MediaTypeImageManifest, mediaTypeDockerManifest:
+		var manifest ocispec.Manifest
+		if err := json.Unmarshal(b, &manifest); err != nil {
+			return nil, "", fmt.Errorf("failed to decode manifest: %v", err)
+		}
+		if err := s.writeBlob(d, bytes.NewReader(b)); err != nil {
+			return nil, "", err
+		}
+		return &manifest, d, nil
+
+	default:
+		return nil, "", fmt.Errorf("unsupported manifest media type %q", mediaType)
+	}
+}
+
+// selectPlatform returns the manifest of the platform of the client.
+func selectPlatform(manifests []ocispec.Descriptor) (*ocispec.Descriptor, error) {
+	for _, desc := range manifests {
+		if desc.Platform == nil {
+			continue
+		}
+		if desc.Platform.OS == runtime.GOOS && desc.Platform.Architecture == runtime.GOARCH {
+			return &desc, nil
+		}
+	}
+	return nil, fmt.Errorf("no image for platform %s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+// pullBlob pulls the blob of the descriptor into the store if it is not
+// already present.
+func (s *imageStore) pullBlob(ctx context.Context, registry *registryClient, desc ocispec.Descriptor) error {
+	if _, err := os.Stat(s.blobPath(desc.Digest)); err == nil {
+		return nil
+	}
+
+	s.logger.Trace("pulling blob", "digest", desc.Digest, "size", desc.Size)
+	body, err := registry.fetchBlob(ctx, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return s.writeBlob(desc.Digest, body)
+}
+
+// writeBlob writes the content of the blob to the store, verifying it matches
+// the digest.
+func (s *imageStore) writeBlob(d digest.Digest, r io.Reader) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	path := s.blobPath(d)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create image store: %v", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-"+d.Encoded())
+	if err != nil {
+		return fmt.Errorf("failed to write blob %s: %v", d, err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	verifier := d.Verifier()
+	if _, err := io.Copy(f, io.TeeReader(r, verifier)); err != nil {
+		return fmt.Errorf("failed to write blob %s: %v", d, err)
+	}
+	if !verifier.Verified() {
+		return fmt.Errorf("blob %s does not match its digest", d)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write blob %s: %v", d, err)
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// openBlob opens the blob with the digest.
+func (s *imageStore) openBlob(d digest.Digest) (*os.File, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return os.Open(s.blobPath(d))
+}
+
+func (s *imageStore) readJSON(d digest.Digest, v any) error {
+	f, err := s.openBlob(d)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewDecoder(f).Decode(v)
+}
+
+// writeRef records the manifest digest the reference resolved to.
+func (s *imageStore) writeRef(ref *reference, d digest.Digest) error {
+	path := s.refPath(ref)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create image store: %v", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(d.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write image reference: %v", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *imageStore) blobPath(d digest.Digest) string {
+	return filepath.Join(s.root, "blobs", d.Algorithm().String(), d.Encoded())
+}
+
+func (s *imageStore) refPath(ref *reference) string {
+	sum := sha256.Sum256([]byte(ref.String()))
+	return filepath.Join(s.root, "refs", hex.EncodeToString(sum[:]))
+}
+
+// lockRef locks the pulls of the reference and returns the unlock function.
+func (s *imageStore) lockRef(ref string) func() {
+	s.pullLocksLock.Lock()
+	l, ok := s.pullLocks[ref]
+	if !ok {
+		l = new(sync.Mutex)
+		s.pullLocks[ref] = l
+	}
+	s.pullLocksLock.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
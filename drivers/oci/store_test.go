@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/shoenig/test"
+	"github.com/shoenig/test/must"
+)
+
+// tarEntry is an entry of a test layer.
+type tarEntry struct {
+	name     string
+	typeflag byte
+	content  string
+	linkname string
+	mode     int64
+}
+
+// testLayer returns a gzipped tar of the entries, owned by the current user.
+func testLayer(t *testing.T, entries ...tarEntry) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for _, e := range entries {
+		mode := e.mode
+		if mode == 0 {
+			mode = 0o644
+			if e.typeflag == tar.TypeDir {
+				mode = 0o755
+			}
+		}
+		must.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     e.name,
+			Typeflag: e.typeflag,
+			Linkname: e.linkname,
+			Size:     int64(len(e.content)),
+			Mode:     mode,
+			Uid:      os.Getuid(),
+			Gid:      os.Getgid(),
+			ModTime:  time.Unix(1700000000, 0),
+		}))
+		_, err := tw.Write([]byte(e.content))
+		must.NoError(t, err)
+	}
+	must.NoError(t, tw.Close())
+	must.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// testRegistry is a registry serving a single multi-platform image, which
+// requires a bearer token.
+type testRegistry struct {
+	server *httptest.Server
+	blobs  map[digest.Digest][]byte
+	tags   map[string]digest.Digest
+
+	lock     sync.Mutex
+	requests map[string]int
+}
+
+func newTestRegistry(t *testing.T, config ocispec.Image, layers ...[]byte) *testRegistry {
+	r := &testRegistry{
+		blobs:    make(map[digest.Digest][]byte),
+		tags:     make(map[string]digest.Digest),
+		requests: make(map[string]int),
+	}
+
+	manifest := ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    r.add(t, ocispec.MediaTypeImageConfig, config),
+	}
+	manifest.SchemaVersion = 2
+	for _, layer := range layers {
+		d := digest.FromBytes(layer)
+		r.blobs[d] = layer
+		manifest.Layers = append(manifest.Layers, ocispec.Descriptor{
+			MediaType: ocispec.MediaTypeImageLayerGzip,
+			Digest:    d,
+			Size:      int64(len(layer)),
+		})
+	}
+	manifestDesc := r.add(t, ocispec.MediaTypeImageManifest, manifest)
+	manifestDesc.Platform = &ocispec.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}
+
+	index := ocispec.Index{
+		MediaType: ocispec.MediaTypeImageIndex,
+		Manifests: []ocispec.Descriptor{
+			{
+				MediaType: ocispec.MediaTypeImageManifest,
+				Digest:    digest.FromString("other"),
+				Platform:  &ocispec.Platform{OS: "windows", Architecture: "amd64"},
+			},
+			manifestDesc,
+		},
+	}
+	index.SchemaVersion = 2
+	r.tags["latest"] = r.add(t, ocispec.MediaTypeImageIndex, index).Digest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, req *http.Request) {
+		test.Eq(t, "repository:test/app:pull", req.URL.Query().Get("scope"))
+		user, password, _ := req.BasicAuth()
+		test.Eq(t, "user", user)
+		test.Eq(t, "secret", password)
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": "t0k3n"})
+	})
+	mux.HandleFunc("/v2/test/app/", r.serve)
+	r.server = httptest.NewServer(mux)
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *testRegistry) add(t *testing.T, mediaType string, v any) ocispec.Descriptor {
+	b, err := json.Marshal(v)
+	must.NoError(t, err)
+	d := digest.FromBytes(b)
+	r.blobs[d] = b
+	return ocispec.Descriptor{MediaType: mediaType, Digest: d, Size: int64(len(b))}
+}
+
+func (r *testRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Authorization") != "Bearer t0k3n" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="`+r.server.URL+`/token",service="test"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	r.lock.Lock()
+	r.requests[req.URL.Path]++
+	r.lock.Unlock()
+
+	kind, object, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/v2/test/app/"), "/")
+	d, ok := r.tags[object]
+	if !ok {
+		d = digest.Digest(object)
+	}
+	b, ok := r.blobs[d]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if kind == "manifests" {
+		var probe struct {
+			MediaType string `json:"mediaType"`
+		}
+		_ = json.Unmarshal(b, &probe)
+		w.Header().Set("Content-Type", probe.MediaType)
+	}
+	_, _ = w.Write(b)
+}
+
+func (r *testRegistry) reference(t *testing.T, tag string) *reference {
+	ref, err := parseReference(strings.TrimPrefix(r.server.URL, "http://") + "/test/app:" + tag)
+	must.NoError(t, err)
+	return ref
+}
+
+func (r *testRegistry) count(path string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.requests[path]
+}
+
+func TestImageStore_pull(t *testing.T) {
+	ci.Parallel(t)
+
+	config := ocispec.Image{}
+	config.Config.Cmd = []string{"/bin/app"}
+	layer := testLayer(t, tarEntry{name: "bin/app", typeflag: tar.TypeReg, content: "app", mode: 0o755})
+	registry := newTestRegistry(t, config, layer)
+	ref := registry.reference(t, "latest")
+	auth := &RegistryAuth{Username: "user", Password: "secret"}
+
+	store := newImageStore(t.TempDir(), testlog.HCLogger(t))
+	img, err := store.pull(context.Background(), ref, auth, false)
+	must.NoError(t, err)
+	must.Eq(t, []string{"/bin/app"}, img.Config.Config.Cmd)
+	must.Len(t, 1, img.Layers)
+	must.Eq(t, digest.FromBytes(layer), img.Layers[0].Digest)
+
+	// The image is read from the store unless forced.
+	_, err = store.pull(context.Background(), ref, auth, false)
+	must.NoError(t, err)
+	must.Eq(t, 1, registry.count("/v2/test/app/manifests/latest"))
+
+	img2, err := store.pull(context.Background(), ref, auth, true)
+	must.NoError(t, err)
+	must.Eq(t, img.Digest, img2.Digest)
+	must.Eq(t, 2, registry.count("/v2/test/app/manifests/latest"))
+	must.Eq(t, 1, registry.count("/v2/test/app/blobs/"+digest.FromBytes(layer).String()))
+
+	// Digest references are always read from the store once pulled.
+	byDigest := registry.reference(t, "latest")
+	byDigest.tag, byDigest.digest = "", img.Digest
+	img3, err := store.pull(context.Background(), byDigest, nil, true)
+	must.NoError(t, err)
+	must.Eq(t, img.Digest, img3.Digest)
+}
+
+func TestImageStore_pull_errors(t *testing.T) {
+	ci.Parallel(t)
+
+	registry := newTestRegistry(t, ocispec.Image{})
+	store := newImageStore(t.TempDir(), testlog.HCLogger(t))
+	auth := &RegistryAuth{Username: "user", Password: "secret"}
+
+	_, err := store.pull(context.Background(), registry.reference(t, "missing"), auth, false)
+	must.ErrorContains(t, err, "404 Not Found")
+
+	// Blobs not matching their digest are rejected.
+	d := digest.FromString("expected")
+	err = store.writeBlob(d, strings.NewReader("actual"))
+	must.ErrorContains(t, err, "does not match its digest")
+	_, err = os.Stat(store.blobPath(d))
+	must.True(t, os.IsNotExist(err))
+
+	entries, err := os.ReadDir(filepath.Dir(store.blobPath(d)))
+	must.NoError(t, err)
+	must.SliceEmpty(t, entries)
+}
@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/klauspost/compress/zstd"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// whiteoutPrefix prefixes the names of the files removed by a layer.
+	whiteoutPrefix = ".wh."
+
+	// whiteoutOpaque is the name of the file marking a directory whose
+	// content in lower layers is removed by a layer.
+	whiteoutOpaque = ".wh..wh..opq"
+)
+
+// reservedDirs are the directories of the task directory managed by the
+// client, which layers can't modify.
+var reservedDirs = map[string]struct{}{
+	allocdir.SharedAllocName: {},
+	allocdir.TaskLocal:       {},
+	allocdir.TaskSecrets:     {},
+	allocdir.TaskPrivate:     {},
+}
+
+// unpack extracts the layers of the image into rootfs, in order. If userns is
+// set, the owners of the files are shifted into the host IDs of the user
+// namespace, so they keep their owners inside the container.
+func (s *imageStore) unpack(img *image, rootfs string, userns *executor.UserNamespace) error {
+	for _, layer := range img.Layers {
+		if err := s.applyLayer(layer, rootfs, userns); err != nil {
+			return fmt.Errorf("failed to unpack layer %s: %w", layer.Digest, err)
+		}
+	}
+	return nil
+}
+
+func (s *imageStore) applyLayer(layer ocispec.Descriptor, rootfs string, userns *executor.UserNamespace) error {
+	f, err := s.openBlob(layer.Digest)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := decompress(layer.MediaType, f)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return applyTar(r, rootfs, userns)
+}
+
+// decompress returns a reader of the uncompressed content of a layer with the
+// media type.
+func decompress(mediaType string, r io.Reader) (io.ReadCloser, error) {
+	switch {
+	case strings.HasSuffix(mediaType, "gzip"):
+		return gzip.NewReader(r)
+	case strings.HasSuffix(mediaType, "zstd"):
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	case strings.HasSuffix(mediaType, "tar"):
+		return io.NopCloser(r), nil
+	default:
+		return nil, fmt.Errorf("unsupported layer media type %q", mediaType)
+	}
+}
+
+// applyTar applies the changes of a layer to rootfs. Paths are resolved
+// within rootfs, so that symlinks of the image can't escape it.
+func applyTar(r io.Reader, rootfs string, userns *executor.UserNamespace) error {
+	// written are the paths written by the layer, which are kept by opaque
+	// whiteouts of their directory.
+	written := make(map[string]struct{})
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean("/" + hdr.Name)
+		if name == "/" || isReserved(name) {
+			continue
+		}
+		dir, base := filepath.Split(name)
+		parent, err := securejoin.SecureJoin(rootfs, dir)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(parent, 0o755); err != nil {
+			return err
+		}
+		path := filepath.Join(parent, base)
+
+		switch {
+		case base == whiteoutOpaque:
+			entries, err := os.ReadDir(parent)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				p := filepath.Join(parent, entry.Name())
+				if _, ok := written[p]; !ok && !isReserved(filepath.Join(dir, entry.Name())) {
+					if err := os.RemoveAll(p); err != nil {
+						return err
+					}
+				}
+			}
+
+		case strings.HasPrefix(base, whiteoutPrefix):
+			removed := strings.TrimPrefix(base, whiteoutPrefix)
+			if isReserved(filepath.Join(dir, removed)) {
+				continue
+			}
+			if err := os.RemoveAll(filepath.Join(parent, removed)); err != nil {
+				return err
+			}
+
+		default:
+			if err := extractEntry(tr, hdr, rootfs, path, userns); err != nil {
+				return fmt.Errorf("failed to extract %s: %w", hdr.Name, err)
+			}
+			written[path] = struct{}{}
+		}
+	}
+}
+
+// isReserved returns whether the path of the image is in a reserved directory.
+func isReserved(name string) bool {
+	top, _, _ := strings.Cut(strings.TrimPrefix(name, "/"), "/")
+	_, ok := reservedDirs[top]
+	return ok
+}
+
+// extractEntry writes the entry of the header to path, replacing whatever
+// lower layers left there except directories, which are merged.
+func extractEntry(tr *tar.Reader, hdr *tar.Header, rootfs, path string, userns *executor.UserNamespace) error {
+	if fi, err := os.Lstat(path); err == nil && !(fi.IsDir() && hdr.Typeflag == tar.TypeDir) {
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.Mkdir(path, 0o755); err != nil && !os.IsExist(err) {
+			return err
+		}
+
+	case tar.TypeReg:
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, tr)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+
+	case tar.TypeSymlink:
+		if err := os.Symlink(hdr.Linkname, path); err != nil {
+			return err
+		}
+		return lchown(path, hdr, userns)
+
+	case tar.TypeLink:
+		target, err := securejoin.SecureJoin(rootfs, hdr.Linkname)
+		if err != nil {
+			return err
+		}
+		return os.Link(target, path)
+
+	default:
+		// Device nodes and fifos can't be created by tasks running in a user
+		// namespace, and the devices of tasks are provided by the executor.
+		return nil
+	}
+
+	if err := lchown(path, hdr, userns); err != nil {
+		return err
+	}
+
+	// chmod after chown, which clears the setuid and setgid bits
+	mode := hdr.FileInfo().Mode()
+	if err := os.Chmod(path, mode.Perm()|mode&(os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+		return err
+	}
+
+	atime := hdr.AccessTime
+	if atime.IsZero() {
+		atime = hdr.ModTime
+	}
+	return os.Chtimes(path, atime, hdr.ModTime)
+}
+
+// lchown sets the owner of the path to the owner of the entry.
+func lchown(path string, hdr *tar.Header, userns *executor.UserNamespace) error {
+	uid, gid, err := shiftIDs(hdr.Uid, hdr.Gid, userns)
+	if err != nil {
+		return err
+	}
+	return os.Lchown(path, uid, gid)
+}
+
+// shiftIDs returns the host IDs of the container IDs in the user namespace.
+func shiftIDs(uid, gid int, userns *executor.UserNamespace) (int, int, error) {
+	if userns == nil {
+		return uid, gid, nil
+	}
+	size := int(userns.Size)
+	if uid < 0 || gid < 0 || uid >= size || gid >= size {
+		return 0, 0, fmt.Errorf("owner %d:%d is outside of the user namespace of size %d", uid, gid, size)
+	}
+	return int(userns.HostID) + uid, int(userns.HostID) + gid, nil
+}
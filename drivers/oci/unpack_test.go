@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/shoenig/test/must"
+)
+
+func applyTestLayer(t *testing.T, rootfs string, entries ...tarEntry) error {
+	zr, err := gzip.NewReader(bytes.NewReader(testLayer(t, entries...)))
+	must.NoError(t, err)
+	return applyTar(zr, rootfs, nil)
+}
+
+func TestUnpack_layers(t *testing.T) {
+	ci.Parallel(t)
+
+	rootfs := t.TempDir()
+	must.NoError(t, os.Mkdir(filepath.Join(rootfs, "local"), 0o777))
+	must.NoError(t, os.WriteFile(filepath.Join(rootfs, "local", "keep"), nil, 0o644))
+
+	must.NoError(t, applyTestLayer(t, rootfs,
+		tarEntry{name: "bin/", typeflag: tar.TypeDir},
+		tarEntry{name: "bin/app", typeflag: tar.TypeReg, content: "v1", mode: 0o755},
+		tarEntry{name: "bin/sh", typeflag: tar.TypeSymlink, linkname: "/bin/app"},
+		tarEntry{name: "bin/hard", typeflag: tar.TypeLink, linkname: "bin/app"},
+		tarEntry{name: "etc/", typeflag: tar.TypeDir},
+		tarEntry{name: "etc/old", typeflag: tar.TypeReg, content: "old"},
+		tarEntry{name: "var/", typeflag: tar.TypeDir},
+		tarEntry{name: "var/lib/", typeflag: tar.TypeDir},
+		tarEntry{name: "var/lib/a", typeflag: tar.TypeReg},
+	))
+
+	must.NoError(t, applyTestLayer(t, rootfs,
+		tarEntry{name: "bin/app", typeflag: tar.TypeReg, content: "v2", mode: 0o700},
+		tarEntry{name: "etc/.wh.old", typeflag: tar.TypeReg},
+		tarEntry{name: "var/lib/", typeflag: tar.TypeDir},
+		tarEntry{name: "var/lib/b", typeflag: tar.TypeReg},
+		tarEntry{name: "var/lib/.wh..wh..opq", typeflag: tar.TypeReg},
+		tarEntry{name: "local/file", typeflag: tar.TypeReg},
+		tarEntry{name: ".wh.local", typeflag: tar.TypeReg},
+	))
+
+	b, err := os.ReadFile(filepath.Join(rootfs, "bin/app"))
+	must.NoError(t, err)
+	must.Eq(t, "v2", string(b))
+	fi, err := os.Stat(filepath.Join(rootfs, "bin/app"))
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o700), fi.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(rootfs, "bin/sh"))
+	must.NoError(t, err)
+	must.Eq(t, "/bin/app", link)
+
+	b, err = os.ReadFile(filepath.Join(rootfs, "bin/hard"))
+	must.NoError(t, err)
+	must.Eq(t, "v1", string(b))
+
+	must.FileNotExists(t, filepath.Join(rootfs, "etc/old"))
+	must.DirExists(t, filepath.Join(rootfs, "etc"))
+
+	// The opaque directory only keeps the files of the upper layer.
+	must.FileNotExists(t, filepath.Join(rootfs, "var/lib/a"))
+	must.FileExists(t, filepath.Join(rootfs, "var/lib/b"))
+
+	// Reserved directories of the task are left untouched.
+	must.FileExists(t, filepath.Join(rootfs, "local/keep"))
+	must.FileNotExists(t, filepath.Join(rootfs, "local/file"))
+}
+
+func TestUnpack_escape(t *testing.T) {
+	ci.Parallel(t)
+
+	parent := t.TempDir()
+	rootfs := filepath.Join(parent, "rootfs")
+	must.NoError(t, os.Mkdir(rootfs, 0o755))
+
+	must.NoError(t, applyTestLayer(t, rootfs,
+		tarEntry{name: "../escaped", typeflag: tar.TypeReg},
+		tarEntry{name: "up", typeflag: tar.TypeSymlink, linkname: "../"},
+		tarEntry{name: "up/escaped-link", typeflag: tar.TypeReg},
+		tarEntry{name: "abs", typeflag: tar.TypeSymlink, linkname: parent},
+		tarEntry{name: "abs/escaped-abs", typeflag: tar.TypeReg},
+	))
+
+	must.FileNotExists(t, filepath.Join(parent, "escaped"))
+	must.FileNotExists(t, filepath.Join(parent, "escaped-link"))
+	must.FileNotExists(t, filepath.Join(parent, "escaped-abs"))
+	must.FileExists(t, filepath.Join(rootfs, "escaped"))
+	must.FileExists(t, filepath.Join(rootfs, "escaped-link"))
+}
+
+func TestUnpack_shiftIDs(t *testing.T) {
+	ci.Parallel(t)
+
+	uid, gid, err := shiftIDs(1000, 100, nil)
+	must.NoError(t, err)
+	must.Eq(t, 1000, uid)
+	must.Eq(t, 100, gid)
+
+	userns := &executor.UserNamespace{HostID: 100000, Size: 65536}
+	uid, gid, err = shiftIDs(0, 5, userns)
+	must.NoError(t, err)
+	must.Eq(t, 100000, uid)
+	must.Eq(t, 100005, gid)
+
+	_, _, err = shiftIDs(65536, 0, userns)
+	must.ErrorContains(t, err, "outside of the user namespace")
+}
+
+func TestUnpack_decompress(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, mediaType := range []string{
+		"application/vnd.oci.image.layer.v1.tar",
+		"application/vnd.oci.image.layer.v1.tar+gzip",
+		"application/vnd.oci.image.layer.v1.tar+zstd",
+		"application/vnd.docker.image.rootfs.diff.tar.gzip",
+	} {
+		_, err := decompress(mediaType, bytes.NewReader(testLayer(t)))
+		must.NoError(t, err, must.Sprint(mediaType))
+	}
+
+	_, err := decompress("application/vnd.oci.image.layer.v1.tar+bzip2", nil)
+	must.ErrorContains(t, err, "unsupported layer media type")
+}
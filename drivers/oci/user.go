@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+)
+
+// resolveUser returns the numeric "uid:gid" of a user of the form
+// user[:group] inside the rootfs of an image, looking up names in its
+// /etc/passwd and /etc/group. The group defaults to the primary group of the
+// user, or root if the user is not in /etc/passwd.
+func resolveUser(rootfs, user string) (string, error) {
+	if user == "" {
+		return "0:0", nil
+	}
+	userPart, groupPart, hasGroup := strings.Cut(user, ":")
+
+	passwd, err := readIDFile(rootfs, "/etc/passwd")
+	if err != nil {
+		return "", err
+	}
+
+	uid, gid := -1, 0
+	if id, err := strconv.Atoi(userPart); err == nil {
+		uid = id
+	}
+	for _, fields := range passwd {
+		if len(fields) < 4 {
+			continue
+		}
+		id, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+		if fields[0] == userPart || (uid >= 0 && id == uid) {
+			uid = id
+			if g, err := strconv.Atoi(fields[3]); err == nil {
+				gid = g
+			}
+			break
+		}
+	}
+	if uid < 0 {
+		return "", fmt.Errorf("unable to find user %s in image", userPart)
+	}
+
+	if hasGroup {
+		if gid, err = strconv.Atoi(groupPart); err != nil {
+			gid, err = lookupGroup(rootfs, groupPart)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if gid < 0 {
+		return "", fmt.Errorf("invalid group %s", groupPart)
+	}
+	return fmt.Sprintf("%d:%d", uid, gid), nil
+}
+
+// lookupGroup returns the gid of the group with the name in the /etc/group of
+// the rootfs.
+func lookupGroup(rootfs, name string) (int, error) {
+	groups, err := readIDFile(rootfs, "/etc/group")
+	if err != nil {
+		return 0, err
+	}
+	for _, fields := range groups {
+		if len(fields) >= 3 && fields[0] == name {
+			if gid, err := strconv.Atoi(fields[2]); err == nil {
+				return gid, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unable to find group %s in image", name)
+}
+
+// readIDFile returns the colon separated fields of the lines of a passwd or
+// group file in the rootfs. Missing files have no lines.
+func readIDFile(rootfs, name string) ([][]string, error) {
+	path, err := securejoin.SecureJoin(rootfs, name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines [][]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, strings.Split(line, ":"))
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package oci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestResolveUser(t *testing.T) {
+	ci.Parallel(t)
+
+	rootfs := t.TempDir()
+	must.NoError(t, os.Mkdir(filepath.Join(rootfs, "etc"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(rootfs, "etc/passwd"), []byte(`root:x:0:0:root:/root:/bin/sh
+# comment
+nginx:x:101:102:nginx:/var/cache/nginx:/sbin/nologin
+`), 0o644))
+	must.NoError(t, os.WriteFile(filepath.Join(rootfs, "etc/group"), []byte(`root:x:0:
+www:x:33:nginx
+`), 0o644))
+
+	testCases := []struct {
+		user     string
+		expected string
+		err      string
+	}{
+		{user: "", expected: "0:0"},
+		{user: "root", expected: "0:0"},
+		{user: "nginx", expected: "101:102"},
+		{user: "101", expected: "101:102"},
+		{user: "1000", expected: "1000:0"},
+		{user: "nginx:www", expected: "101:33"},
+		{user: "1000:50", expected: "1000:50"},
+		{user: "missing", err: "unable to find user missing"},
+		{user: "nginx:missing", err: "unable to find group missing"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.user, func(t *testing.T) {
+			result, err := resolveUser(rootfs, tc.user)
+			if tc.err != "" {
+				must.ErrorContains(t, err, tc.err)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, result)
+		})
+	}
+}
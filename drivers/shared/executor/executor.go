@@ -94,14 +94,22 @@ type Executor interface {
 
 	ExecStreaming(ctx context.Context, cmd []string, tty bool,
 		stream drivers.ExecTaskStream) error
+
+	// Checkpoint writes the state of the user process to a checkpoint image
+	// in imageDir. The process is stopped unless leaveRunning is set.
+	Checkpoint(imageDir string, leaveRunning bool) error
+
+	// Restore restores the user process configured by the given ExecCommand
+	// from the checkpoint image in imageDir. It is used in place of Launch.
+	Restore(cmd *ExecCommand, imageDir string) (*ProcessState, error)
 }
 
 // ExecCommand holds the user command, args, and other isolation related
 // settings.
 //
-// Important (!): when adding fields, make sure to update the RPC methods in
-// grpcExecutorClient.Launch and grpcExecutorServer.Launch. Number of hours
-// spent tracking this down: too many.
+// Important (!): when adding fields, make sure to update the RPC conversions in
+// launchRequestToProto and execCommandFromProto. Number of hours spent tracking
+// this down: too many.
 type ExecCommand struct {
 	// Cmd is the command that the user wants to run.
 	Cmd string
@@ -175,6 +183,19 @@ type ExecCommand struct {
 	// OOMScoreAdj allows setting oom_score_adj (likelihood of process being
 	// OOM killed) on Linux systems
 	OOMScoreAdj int32
+
+	// UserNamespace runs the task in a new user namespace if set, so that
+	// root inside the container is an unprivileged user on the host. The User
+	// is then a numeric "uid[:gid]" inside the container and is not looked up
+	// on the host.
+	UserNamespace *UserNamespace
+}
+
+// UserNamespace maps the user and group IDs 0 to Size-1 inside the container
+// to the host IDs starting at HostID.
+type UserNamespace struct {
+	HostID uint32
+	Size   uint32
 }
 
 func (c *ExecCommand) getCgroupOr(controller, fallback string) string {
@@ -566,6 +587,17 @@ func (e *UniversalExecutor) UpdateResources(resources *drivers.Resources) error
 	return nil
 }
 
+// Checkpoint is not supported by the universal executor, which doesn't isolate
+// the user process in a container.
+func (e *UniversalExecutor) Checkpoint(imageDir string, leaveRunning bool) error {
+	return fmt.Errorf("checkpoint is not supported by the universal executor")
+}
+
+// Restore is not supported by the universal executor.
+func (e *UniversalExecutor) Restore(command *ExecCommand, imageDir string) (*ProcessState, error) {
+	return nil, fmt.Errorf("restore is not supported by the universal executor")
+}
+
 func (e *UniversalExecutor) wait() {
 	defer close(e.processExited)
 	defer e.command.Close()
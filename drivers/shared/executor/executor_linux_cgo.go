@@ -182,6 +182,56 @@ func (l *LibcontainerExecutor) cleanOldProcessesInCGroup(nomadRelativePath strin
 
 // Launch creates a new container in libcontainer and starts a new process with it
 func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, error) {
+	return l.launch(command, func(container *libcontainer.Container, process *libcontainer.Process) error {
+		return container.Run(process)
+	})
+}
+
+// Restore restores the user process from the checkpoint image in imageDir with
+// CRIU, in a container configured like the one of Launch.
+func (l *LibcontainerExecutor) Restore(command *ExecCommand, imageDir string) (*ProcessState, error) {
+	return l.launch(command, func(container *libcontainer.Container, process *libcontainer.Process) error {
+		l.logger.Debug("restoring container from checkpoint", "image_dir", imageDir)
+		if err := container.Restore(process, criuOpts(imageDir, false)); err != nil {
+			return fmt.Errorf("failed to restore container(%s): %v", l.id, err)
+		}
+		return nil
+	})
+}
+
+// Checkpoint writes the state of the container to a checkpoint image in
+// imageDir with CRIU.
+func (l *LibcontainerExecutor) Checkpoint(imageDir string, leaveRunning bool) error {
+	if l.container == nil {
+		return fmt.Errorf("container(%s) is not running", l.id)
+	}
+	if err := os.MkdirAll(imageDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create checkpoint directory: %v", err)
+	}
+
+	l.logger.Debug("checkpointing container", "image_dir", imageDir, "leave_running", leaveRunning)
+	if err := l.container.Checkpoint(criuOpts(imageDir, leaveRunning)); err != nil {
+		return fmt.Errorf("failed to checkpoint container(%s): %v", l.id, err)
+	}
+	return nil
+}
+
+// criuOpts returns the CRIU options used to checkpoint and restore containers.
+// Established TCP connections and unix sockets connected outside the container
+// are included in the image, so that they survive a restore on the same node.
+func criuOpts(imageDir string, leaveRunning bool) *libcontainer.CriuOpts {
+	return &libcontainer.CriuOpts{
+		ImagesDirectory:         imageDir,
+		LeaveRunning:            leaveRunning,
+		TcpEstablished:          true,
+		ExternalUnixConnections: true,
+		FileLocks:               true,
+	}
+}
+
+// launch starts the user process of the command in a new container with the
+// start function.
+func (l *LibcontainerExecutor) launch(command *ExecCommand, start func(*libcontainer.Container, *libcontainer.Process) error) (*ProcessState, error) {
 	l.logger.Trace("preparing to launch command", "command", command.Cmd, "args", strings.Join(command.Args, " "))
 
 	if command.Resources == nil {
@@ -239,7 +289,14 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 		Init:   true,
 	}
 
-	if command.User != "" {
+	if command.UserNamespace != nil {
+		// The user of the image is only meaningful inside the container
+		uid, gid, err := parseNamespaceUser(command.User)
+		if err != nil {
+			return nil, err
+		}
+		process.UID, process.GID = uid, gid
+	} else if command.User != "" {
 		// Override HOME and USER environment variables
 		u, err := users.Lookup(command.User)
 		if err != nil {
@@ -261,7 +318,7 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 	l.systemCpuStats = cpustats.New(l.compute)
 
 	// Starts the task
-	if err := container.Run(process); err != nil {
+	if err := start(container, process); err != nil {
 		container.Destroy()
 		return nil, err
 	}
@@ -697,6 +754,17 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 		})
 	}
 
+	if command.UserNamespace != nil {
+		cfg.Namespaces = append(cfg.Namespaces, runc.Namespace{Type: runc.NEWUSER})
+		idMap := []runc.IDMap{{
+			ContainerID: 0,
+			HostID:      int64(command.UserNamespace.HostID),
+			Size:        int64(command.UserNamespace.Size),
+		}}
+		cfg.UIDMappings = idMap
+		cfg.GIDMappings = idMap
+	}
+
 	// paths to mask using a bind mount to /dev/null to prevent reading
 	cfg.MaskPaths = []string{
 		"/proc/kcore",
@@ -763,6 +831,10 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 		},
 	}
 
+	if command.UserNamespace != nil {
+		cfg.Mounts = userNamespaceMounts(cfg.Mounts, command.ModeIPC)
+	}
+
 	if len(command.Mounts) > 0 {
 		cfg.Mounts = append(cfg.Mounts, cmdMounts(command.Mounts)...)
 	}
@@ -770,6 +842,53 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 	return nil
 }
 
+// userNamespaceMounts adapts the default mounts for containers running in a
+// user namespace, which can't mount a sysfs for a network namespace they don't
+// own or an mqueue for the IPC namespace of the host.
+func userNamespaceMounts(mounts []*runc.Mount, ipcMode string) []*runc.Mount {
+	result := make([]*runc.Mount, 0, len(mounts))
+	for _, m := range mounts {
+		switch m.Device {
+		case "sysfs":
+			result = append(result, &runc.Mount{
+				Source:      "/sys",
+				Destination: "/sys",
+				Device:      "bind",
+				Flags:       unix.MS_BIND | unix.MS_REC | unix.MS_NOSUID | unix.MS_NOEXEC | unix.MS_NODEV | unix.MS_RDONLY,
+			})
+		case "mqueue":
+			if ipcMode == IsolationModePrivate {
+				result = append(result, m)
+			}
+		default:
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// parseNamespaceUser parses the "uid[:gid]" user of a task running in a user
+// namespace. An empty user is root, and the group defaults to root.
+func parseNamespaceUser(user string) (int, int, error) {
+	if user == "" {
+		return 0, 0, nil
+	}
+
+	uidStr, gidStr, hasGid := strings.Cut(user, ":")
+	uid, err := strconv.Atoi(uidStr)
+	if err != nil || uid < 0 {
+		return 0, 0, fmt.Errorf("invalid user %q: must be a numeric uid[:gid]", user)
+	}
+	if !hasGid {
+		return uid, 0, nil
+	}
+	gid, err := strconv.Atoi(gidStr)
+	if err != nil || gid < 0 {
+		return 0, 0, fmt.Errorf("invalid user %q: must be a numeric uid[:gid]", user)
+	}
+	return uid, gid, nil
+}
+
 func (l *LibcontainerExecutor) configureCgroups(cfg *runc.Config, command *ExecCommand) error {
 	// note: an alloc TR hook pre-creates the cgroup(s) in both v1 and v2
 
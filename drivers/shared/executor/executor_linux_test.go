@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/hashicorp/nomad/plugins/drivers/fsisolation"
 	tu "github.com/hashicorp/nomad/testutil"
+	"github.com/opencontainers/cgroups"
 	"github.com/opencontainers/cgroups/devices/config"
 	lconfigs "github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -803,6 +804,61 @@ func TestExecutor_cmdMounts(t *testing.T) {
 	require.EqualValues(t, expected, cmdMounts(input))
 }
 
+func TestExecutor_userNamespace(t *testing.T) {
+	ci.Parallel(t)
+
+	command := &ExecCommand{
+		TaskDir:       t.TempDir(),
+		ModePID:       IsolationModePrivate,
+		ModeIPC:       IsolationModeHost,
+		UserNamespace: &UserNamespace{HostID: 100000, Size: 65536},
+	}
+	cfg := &lconfigs.Config{Cgroups: &cgroups.Cgroup{Resources: &cgroups.Resources{}}}
+	must.NoError(t, configureIsolation(cfg, command))
+
+	must.True(t, cfg.Namespaces.Contains(lconfigs.NEWUSER))
+	idMap := []lconfigs.IDMap{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	must.Eq(t, idMap, cfg.UIDMappings)
+	must.Eq(t, idMap, cfg.GIDMappings)
+
+	devices := map[string]string{}
+	for _, m := range cfg.Mounts {
+		devices[m.Destination] = m.Device
+	}
+	must.Eq(t, "bind", devices["/sys"])
+	must.MapNotContainsKey(t, devices, "/dev/mqueue")
+}
+
+func TestExecutor_parseNamespaceUser(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		user     string
+		uid, gid int
+		err      bool
+	}{
+		{user: "", uid: 0, gid: 0},
+		{user: "1000", uid: 1000, gid: 0},
+		{user: "1000:100", uid: 1000, gid: 100},
+		{user: "nobody", err: true},
+		{user: "1000:users", err: true},
+		{user: "-1", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.user, func(t *testing.T) {
+			uid, gid, err := parseNamespaceUser(tc.user)
+			if tc.err {
+				must.Error(t, err)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.uid, uid)
+			must.Eq(t, tc.gid, gid)
+		})
+	}
+}
+
 func TestExecutor_WorkDir(t *testing.T) {
 	t.Parallel()
 	testutil.ExecCompatible(t)
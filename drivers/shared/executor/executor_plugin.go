@@ -37,3 +37,20 @@ func (p *ExecutorPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBrok
 		logger:  p.logger,
 	}, nil
 }
+
+// NewExecutorClient returns an Executor backed by the executor gRPC service
+// reachable over conn, for executors which are not run as go-plugin
+// subprocesses. The ctx is closed once the remote executor is gone.
+func NewExecutorClient(ctx context.Context, conn *grpc.ClientConn, logger hclog.Logger) Executor {
+	return &grpcExecutorClient{
+		client:  proto.NewExecutorClient(conn),
+		doneCtx: ctx,
+		logger:  logger,
+	}
+}
+
+// RegisterExecutorServer registers impl as the executor gRPC service of s, for
+// executors which are not run as go-plugin subprocesses.
+func RegisterExecutorServer(s *grpc.Server, impl Executor) {
+	proto.RegisterExecutorServer(s, &grpcExecutorServer{impl: impl})
+}
@@ -35,7 +35,52 @@ type grpcExecutorClient struct {
 
 func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 	ctx := context.Background()
-	req := &proto.LaunchRequest{
+	req := launchRequestToProto(cmd)
+	resp, err := c.client.Launch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := processStateFromProto(resp.Process)
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func (c *grpcExecutorClient) Checkpoint(imageDir string, leaveRunning bool) error {
+	ctx := context.Background()
+	req := &proto.CheckpointRequest{
+		ImageDir:     imageDir,
+		LeaveRunning: leaveRunning,
+	}
+	if _, err := c.client.Checkpoint(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *grpcExecutorClient) Restore(cmd *ExecCommand, imageDir string) (*ProcessState, error) {
+	ctx := context.Background()
+	req := &proto.RestoreRequest{
+		Launch:   launchRequestToProto(cmd),
+		ImageDir: imageDir,
+	}
+	resp, err := c.client.Restore(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := processStateFromProto(resp.Process)
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func launchRequestToProto(cmd *ExecCommand) *proto.LaunchRequest {
+	return &proto.LaunchRequest{
 		Cmd:              cmd.Cmd,
 		Args:             cmd.Args,
 		Resources:        drivers.ResourcesToProto(cmd.Resources),
@@ -56,17 +101,8 @@ func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 		CgroupV1Override: cmd.OverrideCgroupV1,
 		OomScoreAdj:      cmd.OOMScoreAdj,
 		WorkDir:          cmd.WorkDir,
+		UserNamespace:    userNamespaceToProto(cmd.UserNamespace),
 	}
-	resp, err := c.client.Launch(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	ps, err := processStateFromProto(resp.Process)
-	if err != nil {
-		return nil, err
-	}
-	return ps, nil
 }
 
 func (c *grpcExecutorClient) Wait(ctx context.Context) (*ProcessState, error) {
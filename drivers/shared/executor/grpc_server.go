@@ -24,7 +24,46 @@ type grpcExecutorServer struct {
 }
 
 func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchRequest) (*proto.LaunchResponse, error) {
-	ps, err := s.impl.Launch(&ExecCommand{
+	ps, err := s.impl.Launch(execCommandFromProto(req))
+	if err != nil {
+		return nil, err
+	}
+
+	process, err := processStateToProto(ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.LaunchResponse{
+		Process: process,
+	}, nil
+}
+
+func (s *grpcExecutorServer) Checkpoint(ctx context.Context, req *proto.CheckpointRequest) (*proto.CheckpointResponse, error) {
+	if err := s.impl.Checkpoint(req.ImageDir, req.LeaveRunning); err != nil {
+		return nil, err
+	}
+	return &proto.CheckpointResponse{}, nil
+}
+
+func (s *grpcExecutorServer) Restore(ctx context.Context, req *proto.RestoreRequest) (*proto.RestoreResponse, error) {
+	ps, err := s.impl.Restore(execCommandFromProto(req.Launch), req.ImageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	process, err := processStateToProto(ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RestoreResponse{
+		Process: process,
+	}, nil
+}
+
+func execCommandFromProto(req *proto.LaunchRequest) *ExecCommand {
+	return &ExecCommand{
 		Cmd:              req.Cmd,
 		Args:             req.Args,
 		Resources:        drivers.ResourcesFromProto(req.Resources),
@@ -45,20 +84,8 @@ func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchReques
 		OverrideCgroupV1: req.CgroupV1Override,
 		OOMScoreAdj:      req.OomScoreAdj,
 		WorkDir:          req.WorkDir,
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	process, err := processStateToProto(ps)
-	if err != nil {
-		return nil, err
+		UserNamespace:    userNamespaceFromProto(req.UserNamespace),
 	}
-
-	return &proto.LaunchResponse{
-		Process: process,
-	}, nil
 }
 
 func (s *grpcExecutorServer) Wait(ctx context.Context, req *proto.WaitRequest) (*proto.WaitResponse, error) {
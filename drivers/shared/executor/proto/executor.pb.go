@@ -50,6 +50,7 @@ type LaunchRequest struct {
 	CgroupV1Override     map[string]string            `protobuf:"bytes,21,rep,name=cgroup_v1_override,json=cgroupV1Override,proto3" json:"cgroup_v1_override,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	OomScoreAdj          int32                        `protobuf:"varint,22,opt,name=oom_score_adj,json=oomScoreAdj,proto3" json:"oom_score_adj,omitempty"`
 	WorkDir              string                       `protobuf:"bytes,23,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	UserNamespace        *UserNamespace               `protobuf:"bytes,24,opt,name=user_namespace,json=userNamespace,proto3" json:"user_namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -242,6 +243,13 @@ func (m *LaunchRequest) GetWorkDir() string {
 	return ""
 }
 
+func (m *LaunchRequest) GetUserNamespace() *UserNamespace {
+	if m != nil {
+		return m.UserNamespace
+	}
+	return nil
+}
+
 type LaunchResponse struct {
 	Process              *ProcessState `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -890,6 +898,217 @@ func (m *ProcessState) GetOomKilled() bool {
 	return false
 }
 
+type CheckpointRequest struct {
+	ImageDir             string   `protobuf:"bytes,1,opt,name=image_dir,json=imageDir,proto3" json:"image_dir,omitempty"`
+	LeaveRunning         bool     `protobuf:"varint,2,opt,name=leave_running,json=leaveRunning,proto3" json:"leave_running,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointRequest) Reset()         { *m = CheckpointRequest{} }
+func (m *CheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointRequest) ProtoMessage()    {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{17}
+}
+
+func (m *CheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointRequest.Unmarshal(m, b)
+}
+func (m *CheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointRequest.Merge(m, src)
+}
+func (m *CheckpointRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckpointRequest.Size(m)
+}
+func (m *CheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointRequest proto.InternalMessageInfo
+
+func (m *CheckpointRequest) GetImageDir() string {
+	if m != nil {
+		return m.ImageDir
+	}
+	return ""
+}
+
+func (m *CheckpointRequest) GetLeaveRunning() bool {
+	if m != nil {
+		return m.LeaveRunning
+	}
+	return false
+}
+
+type CheckpointResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointResponse) Reset()         { *m = CheckpointResponse{} }
+func (m *CheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointResponse) ProtoMessage()    {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{18}
+}
+
+func (m *CheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointResponse.Unmarshal(m, b)
+}
+func (m *CheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointResponse.Merge(m, src)
+}
+func (m *CheckpointResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckpointResponse.Size(m)
+}
+func (m *CheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointResponse proto.InternalMessageInfo
+
+type RestoreRequest struct {
+	Launch               *LaunchRequest `protobuf:"bytes,1,opt,name=launch,proto3" json:"launch,omitempty"`
+	ImageDir             string         `protobuf:"bytes,2,opt,name=image_dir,json=imageDir,proto3" json:"image_dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RestoreRequest) Reset()         { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()    {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{19}
+}
+
+func (m *RestoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreRequest.Unmarshal(m, b)
+}
+func (m *RestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRequest.Merge(m, src)
+}
+func (m *RestoreRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreRequest.Size(m)
+}
+func (m *RestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreRequest proto.InternalMessageInfo
+
+func (m *RestoreRequest) GetLaunch() *LaunchRequest {
+	if m != nil {
+		return m.Launch
+	}
+	return nil
+}
+
+func (m *RestoreRequest) GetImageDir() string {
+	if m != nil {
+		return m.ImageDir
+	}
+	return ""
+}
+
+type RestoreResponse struct {
+	Process              *ProcessState `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RestoreResponse) Reset()         { *m = RestoreResponse{} }
+func (m *RestoreResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreResponse) ProtoMessage()    {}
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{20}
+}
+
+func (m *RestoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreResponse.Unmarshal(m, b)
+}
+func (m *RestoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreResponse.Merge(m, src)
+}
+func (m *RestoreResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreResponse.Size(m)
+}
+func (m *RestoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreResponse proto.InternalMessageInfo
+
+func (m *RestoreResponse) GetProcess() *ProcessState {
+	if m != nil {
+		return m.Process
+	}
+	return nil
+}
+
+type UserNamespace struct {
+	HostId               uint32   `protobuf:"varint,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Size                 uint32   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserNamespace) Reset()         { *m = UserNamespace{} }
+func (m *UserNamespace) String() string { return proto.CompactTextString(m) }
+func (*UserNamespace) ProtoMessage()    {}
+func (*UserNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{21}
+}
+
+func (m *UserNamespace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserNamespace.Unmarshal(m, b)
+}
+func (m *UserNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserNamespace.Marshal(b, m, deterministic)
+}
+func (m *UserNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserNamespace.Merge(m, src)
+}
+func (m *UserNamespace) XXX_Size() int {
+	return xxx_messageInfo_UserNamespace.Size(m)
+}
+func (m *UserNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserNamespace proto.InternalMessageInfo
+
+func (m *UserNamespace) GetHostId() uint32 {
+	if m != nil {
+		return m.HostId
+	}
+	return 0
+}
+
+func (m *UserNamespace) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LaunchRequest)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchRequest")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchRequest.CgroupV1OverrideEntry")
@@ -909,6 +1128,11 @@ func init() {
 	proto.RegisterType((*ExecRequest)(nil), "hashicorp.nomad.plugins.executor.proto.ExecRequest")
 	proto.RegisterType((*ExecResponse)(nil), "hashicorp.nomad.plugins.executor.proto.ExecResponse")
 	proto.RegisterType((*ProcessState)(nil), "hashicorp.nomad.plugins.executor.proto.ProcessState")
+	proto.RegisterType((*CheckpointRequest)(nil), "hashicorp.nomad.plugins.executor.proto.CheckpointRequest")
+	proto.RegisterType((*CheckpointResponse)(nil), "hashicorp.nomad.plugins.executor.proto.CheckpointResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "hashicorp.nomad.plugins.executor.proto.RestoreRequest")
+	proto.RegisterType((*RestoreResponse)(nil), "hashicorp.nomad.plugins.executor.proto.RestoreResponse")
+	proto.RegisterType((*UserNamespace)(nil), "hashicorp.nomad.plugins.executor.proto.UserNamespace")
 }
 
 func init() {
@@ -916,83 +1140,94 @@ func init() {
 }
 
 var fileDescriptor_66b85426380683f3 = []byte{
-	// 1205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xfb, 0x6e, 0x1b, 0xc5,
-	0x17, 0xee, 0xc6, 0x71, 0x6c, 0x1f, 0xdb, 0x89, 0x3b, 0xbf, 0x36, 0xdd, 0xfa, 0x27, 0xd4, 0xb0,
-	0x48, 0xd4, 0x82, 0xb2, 0x69, 0xd3, 0xab, 0x40, 0xa2, 0xd0, 0xa4, 0xa0, 0xaa, 0x17, 0xa2, 0x4d,
-	0x69, 0x25, 0xfe, 0x60, 0x99, 0xee, 0x4e, 0xed, 0xa9, 0xd7, 0x3b, 0xcb, 0xcc, 0xac, 0x9b, 0x48,
-	0x48, 0xbc, 0x04, 0x48, 0x3c, 0x00, 0xaf, 0xc7, 0x3b, 0xa0, 0xb9, 0x6d, 0xec, 0xb4, 0x54, 0x9b,
-	0x22, 0xfe, 0xca, 0x9e, 0xcf, 0xdf, 0xb9, 0xcc, 0x39, 0x73, 0xbe, 0x09, 0x5c, 0x49, 0x39, 0x9d,
-	0x13, 0x2e, 0xb6, 0xc5, 0x04, 0x73, 0x92, 0x6e, 0x93, 0x43, 0x92, 0x94, 0x92, 0xf1, 0xed, 0x82,
-	0x33, 0xc9, 0x2a, 0x33, 0xd4, 0x26, 0xfa, 0x78, 0x82, 0xc5, 0x84, 0x26, 0x8c, 0x17, 0x61, 0xce,
-	0x66, 0x38, 0x0d, 0x8b, 0xac, 0x1c, 0xd3, 0x5c, 0x84, 0xcb, 0xbc, 0xe1, 0xa5, 0x31, 0x63, 0xe3,
-	0x8c, 0x98, 0x20, 0x2f, 0xca, 0x97, 0xdb, 0x92, 0xce, 0x88, 0x90, 0x78, 0x56, 0x58, 0x42, 0x60,
-	0x1d, 0xb7, 0x5d, 0x7a, 0x93, 0xce, 0x58, 0x86, 0x13, 0xfc, 0xd5, 0x86, 0xfe, 0x23, 0x5c, 0xe6,
-	0xc9, 0x24, 0x22, 0x3f, 0x97, 0x44, 0x48, 0x34, 0x80, 0x46, 0x32, 0x4b, 0x7d, 0x6f, 0xcb, 0x1b,
-	0x75, 0x22, 0xf5, 0x89, 0x10, 0xac, 0x62, 0x3e, 0x16, 0xfe, 0xca, 0x56, 0x63, 0xd4, 0x89, 0xf4,
-	0x37, 0x7a, 0x02, 0x1d, 0x4e, 0x04, 0x2b, 0x79, 0x42, 0x84, 0xdf, 0xd8, 0xf2, 0x46, 0xdd, 0x9d,
-	0xab, 0xe1, 0x3f, 0x15, 0x6e, 0xf3, 0x9b, 0x94, 0x61, 0xe4, 0xfc, 0xa2, 0xe3, 0x10, 0xe8, 0x12,
-	0x74, 0x85, 0x4c, 0x59, 0x29, 0xe3, 0x02, 0xcb, 0x89, 0xbf, 0xaa, 0xb3, 0x83, 0x81, 0xf6, 0xb1,
-	0x9c, 0x58, 0x02, 0xe1, 0xdc, 0x10, 0x9a, 0x15, 0x81, 0x70, 0xae, 0x09, 0x03, 0x68, 0x90, 0x7c,
-	0xee, 0xaf, 0xe9, 0x22, 0xd5, 0xa7, 0xaa, 0xbb, 0x14, 0x84, 0xfb, 0x2d, 0xcd, 0xd5, 0xdf, 0xe8,
-	0x22, 0xb4, 0x25, 0x16, 0xd3, 0x38, 0xa5, 0xdc, 0x6f, 0x6b, 0xbc, 0xa5, 0xec, 0x3d, 0xca, 0xd1,
-	0x65, 0xd8, 0x70, 0xf5, 0xc4, 0x19, 0x9d, 0x51, 0x29, 0xfc, 0xce, 0x96, 0x37, 0x6a, 0x47, 0xeb,
-	0x0e, 0x7e, 0xa4, 0x51, 0x74, 0x03, 0xce, 0xbd, 0xc0, 0x82, 0x26, 0x71, 0xc1, 0x59, 0x42, 0x84,
-	0x88, 0x93, 0x31, 0x67, 0x65, 0xe1, 0x83, 0x62, 0xdf, 0x5b, 0xf1, 0xbd, 0x08, 0xe9, 0xdf, 0xf7,
-	0xcd, 0xcf, 0xbb, 0xfa, 0x57, 0xb4, 0x07, 0x6b, 0x33, 0x56, 0xe6, 0x52, 0xf8, 0xdd, 0xad, 0xc6,
-	0xa8, 0xbb, 0x73, 0xa5, 0x66, 0xbb, 0x1e, 0x2b, 0xa7, 0xc8, 0xfa, 0xa2, 0x6f, 0xa1, 0x95, 0x92,
-	0x39, 0x55, 0x5d, 0xef, 0xe9, 0x30, 0x9f, 0xd5, 0x0c, 0xb3, 0xa7, 0xbd, 0x22, 0xe7, 0x8d, 0x26,
-	0x70, 0x36, 0x27, 0xf2, 0x35, 0xe3, 0xd3, 0x98, 0x0a, 0x96, 0x61, 0x49, 0x59, 0xee, 0xf7, 0xf5,
-	0x20, 0xbf, 0xa8, 0x19, 0xf2, 0x89, 0xf1, 0x7f, 0xe0, 0xdc, 0x0f, 0x0a, 0x92, 0x44, 0x83, 0xfc,
-	0x04, 0x8a, 0x02, 0xe8, 0xe7, 0x2c, 0x2e, 0xe8, 0x9c, 0xc9, 0x98, 0x33, 0x26, 0xfd, 0x75, 0xdd,
-	0xd5, 0x6e, 0xce, 0xf6, 0x15, 0x16, 0x31, 0x26, 0xd1, 0x08, 0x06, 0x29, 0x79, 0x89, 0xcb, 0x4c,
-	0xc6, 0x05, 0x4d, 0xe3, 0x19, 0x4b, 0x89, 0xbf, 0xa1, 0xc7, 0xb3, 0x6e, 0xf1, 0x7d, 0x9a, 0x3e,
-	0x66, 0x29, 0x59, 0x64, 0xd2, 0x22, 0x31, 0xcc, 0xc1, 0x12, 0xf3, 0x41, 0x91, 0x68, 0xe6, 0x47,
-	0xd0, 0x4f, 0x8a, 0x52, 0x10, 0xe9, 0xe6, 0x73, 0x56, 0xd3, 0x7a, 0x06, 0xb4, 0x53, 0xf9, 0x00,
-	0x00, 0x67, 0x19, 0x7b, 0x1d, 0x27, 0xb8, 0x10, 0x3e, 0xd2, 0x97, 0xa7, 0xa3, 0x91, 0x5d, 0x5c,
-	0x08, 0x14, 0x40, 0x2f, 0xc1, 0x05, 0x7e, 0x41, 0x33, 0x2a, 0x29, 0x11, 0xfe, 0xff, 0x34, 0x61,
-	0x09, 0x43, 0x57, 0x00, 0x99, 0x04, 0xf1, 0x7c, 0x27, 0x66, 0x73, 0xc2, 0x39, 0x4d, 0x89, 0x7f,
-	0x4e, 0x27, 0x1b, 0x98, 0x5f, 0x9e, 0xed, 0x7c, 0x67, 0x71, 0x74, 0x74, 0xcc, 0xbe, 0x76, 0xcc,
-	0x3e, 0xaf, 0x67, 0xf9, 0x30, 0xac, 0xb7, 0xfa, 0xe1, 0xd2, 0xc6, 0x86, 0xe6, 0x28, 0xcf, 0xae,
-	0xb9, 0x1c, 0xf7, 0x73, 0xc9, 0x8f, 0xaa, 0xd4, 0x15, 0xac, 0x06, 0xc1, 0xd8, 0x2c, 0x16, 0x09,
-	0xe3, 0x24, 0xc6, 0xe9, 0x2b, 0x7f, 0x73, 0xcb, 0x1b, 0x35, 0xa3, 0x2e, 0x63, 0xb3, 0x03, 0x85,
-	0x7d, 0x9d, 0xbe, 0x52, 0xfb, 0xa1, 0xef, 0x84, 0xda, 0x8f, 0x0b, 0x66, 0x3f, 0x94, 0xbd, 0x47,
-	0xf9, 0x70, 0x17, 0xce, 0xbf, 0x35, 0x93, 0xda, 0xbc, 0x29, 0x39, 0x72, 0x8a, 0x31, 0x25, 0x47,
-	0xe8, 0x1c, 0x34, 0xe7, 0x38, 0x2b, 0x89, 0xbf, 0xa2, 0x31, 0x63, 0x7c, 0xbe, 0x72, 0xc7, 0x0b,
-	0x7e, 0x82, 0x75, 0x57, 0xbc, 0x28, 0x58, 0x2e, 0x08, 0x7a, 0x02, 0x2d, 0xbb, 0x47, 0x3a, 0x42,
-	0x77, 0xe7, 0x46, 0xdd, 0x2e, 0xd8, 0xfd, 0x3a, 0x90, 0x58, 0x92, 0xc8, 0x05, 0x09, 0xfa, 0xd0,
-	0x7d, 0x8e, 0xa9, 0xb4, 0xcd, 0x09, 0x7e, 0x84, 0x9e, 0x31, 0xff, 0xa3, 0x74, 0x8f, 0x60, 0xe3,
-	0x60, 0x52, 0xca, 0x94, 0xbd, 0xce, 0x9d, 0x82, 0x6e, 0xc2, 0x9a, 0xa0, 0xe3, 0x1c, 0x67, 0xb6,
-	0x25, 0xd6, 0x42, 0x1f, 0x42, 0x6f, 0xcc, 0x71, 0x42, 0xe2, 0x82, 0x70, 0xca, 0x52, 0xdd, 0x9c,
-	0x46, 0xd4, 0xd5, 0xd8, 0xbe, 0x86, 0x02, 0x04, 0x83, 0xe3, 0x68, 0xa6, 0xe2, 0x60, 0x02, 0x9b,
-	0xdf, 0x17, 0xa9, 0x4a, 0x5a, 0x09, 0xa7, 0x4d, 0xb4, 0x24, 0xc2, 0xde, 0xbf, 0x16, 0xe1, 0xe0,
-	0x22, 0x5c, 0x78, 0x23, 0x93, 0x2d, 0x62, 0x00, 0xeb, 0xcf, 0x08, 0x17, 0x94, 0xb9, 0x53, 0x06,
-	0x9f, 0xc2, 0x46, 0x85, 0xd8, 0xde, 0xfa, 0xd0, 0x9a, 0x1b, 0xc8, 0x9e, 0xdc, 0x99, 0xc1, 0x27,
-	0xd0, 0x53, 0x7d, 0xab, 0x2a, 0x1f, 0x42, 0x9b, 0xe6, 0x92, 0xf0, 0xb9, 0x6d, 0x52, 0x23, 0xaa,
-	0xec, 0xe0, 0x39, 0xf4, 0x2d, 0xd7, 0x86, 0xfd, 0x06, 0x9a, 0x42, 0x01, 0xa7, 0x3c, 0xe2, 0x53,
-	0x2c, 0xa6, 0x26, 0x90, 0x71, 0x0f, 0x2e, 0x43, 0xff, 0x40, 0x4f, 0xe2, 0xed, 0x83, 0x6a, 0xba,
-	0x41, 0xa9, 0xc3, 0x3a, 0xa2, 0x3d, 0xfe, 0x14, 0xba, 0xf7, 0x0f, 0x49, 0xe2, 0x1c, 0x6f, 0x41,
-	0x3b, 0x25, 0x38, 0xcd, 0x68, 0x4e, 0x6c, 0x51, 0xc3, 0xd0, 0xbc, 0xc6, 0xa1, 0x7b, 0x8d, 0xc3,
-	0xa7, 0xee, 0x35, 0x8e, 0x2a, 0xae, 0x7b, 0x5b, 0x57, 0xde, 0x7c, 0x5b, 0x1b, 0xc7, 0x6f, 0x6b,
-	0xb0, 0x0b, 0x3d, 0x93, 0xcc, 0x9e, 0x7f, 0x13, 0xd6, 0x58, 0x29, 0x8b, 0x52, 0xea, 0x5c, 0xbd,
-	0xc8, 0x5a, 0xe8, 0xff, 0xd0, 0x21, 0x87, 0x54, 0xc6, 0x89, 0xd2, 0xc0, 0x15, 0x7d, 0x82, 0xb6,
-	0x02, 0x76, 0x59, 0x4a, 0x82, 0x3f, 0x3d, 0xe8, 0x2d, 0xde, 0x58, 0x95, 0xbb, 0xa0, 0xa9, 0x3d,
-	0xa9, 0xfa, 0x7c, 0xa7, 0xff, 0x42, 0x6f, 0x1a, 0x8b, 0xbd, 0x41, 0x21, 0xac, 0xaa, 0xff, 0x33,
-	0xf4, 0x0b, 0xfd, 0xee, 0x63, 0x6b, 0x9e, 0x12, 0x58, 0x25, 0x3a, 0x53, 0x9a, 0x65, 0x24, 0xd5,
-	0xcf, 0x76, 0x3b, 0xea, 0x30, 0x36, 0x7b, 0xa8, 0x81, 0x9d, 0xdf, 0x3b, 0xd0, 0xbe, 0x6f, 0xf7,
-	0x0c, 0x1d, 0xc1, 0x9a, 0x11, 0x07, 0x74, 0xf3, 0xbd, 0x94, 0x70, 0x78, 0xeb, 0xb4, 0x6e, 0x76,
-	0xbc, 0x67, 0x90, 0x80, 0x55, 0x25, 0x13, 0xe8, 0x7a, 0xdd, 0x08, 0x0b, 0x1a, 0x33, 0xbc, 0x71,
-	0x3a, 0xa7, 0x2a, 0xe9, 0xaf, 0xd0, 0x76, 0xdb, 0x8e, 0x6e, 0xd7, 0x8d, 0x71, 0x42, 0x6d, 0x86,
-	0x77, 0x4e, 0xef, 0x58, 0x15, 0xf0, 0x9b, 0x07, 0x1b, 0x27, 0x36, 0x1e, 0x7d, 0x59, 0x37, 0xde,
-	0xdb, 0x45, 0x69, 0x78, 0xf7, 0xbd, 0xfd, 0xab, 0xb2, 0x7e, 0x81, 0x96, 0x95, 0x16, 0x54, 0x7b,
-	0xa2, 0xcb, 0xea, 0x34, 0xbc, 0x7d, 0x6a, 0xbf, 0x2a, 0xfb, 0x21, 0x34, 0xb5, 0x6c, 0xa0, 0xda,
-	0x63, 0x5d, 0x94, 0xb6, 0xe1, 0xcd, 0x53, 0x7a, 0xb9, 0xbc, 0x57, 0x3d, 0x75, 0xff, 0x8d, 0xee,
-	0xd4, 0xbf, 0xff, 0x4b, 0x82, 0x56, 0xff, 0xfe, 0x9f, 0x90, 0x37, 0x7d, 0xff, 0xd5, 0x1a, 0xd6,
-	0xbf, 0xff, 0x0b, 0x72, 0x58, 0xff, 0xfe, 0x2f, 0xca, 0x5a, 0x70, 0x06, 0xfd, 0xe1, 0x41, 0x5f,
-	0x41, 0x07, 0x92, 0x13, 0x3c, 0xa3, 0xf9, 0x18, 0xdd, 0xad, 0xa9, 0xed, 0xca, 0xcb, 0xe8, 0xbb,
-	0xf5, 0x74, 0xa5, 0x7c, 0xf5, 0xfe, 0x01, 0x5c, 0x59, 0x23, 0xef, 0xaa, 0x77, 0xaf, 0xf5, 0x43,
-	0xd3, 0x48, 0xda, 0x9a, 0xfe, 0x73, 0xfd, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xc0, 0xa1,
-	0x7e, 0xc4, 0x0d, 0x00, 0x00,
+	// 1385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x6b, 0x6f, 0x1b, 0x45,
+	0x17, 0x7e, 0x37, 0x4e, 0x7c, 0x39, 0xb6, 0x13, 0x77, 0xde, 0x34, 0xdd, 0xee, 0xab, 0x57, 0x0d,
+	0x5b, 0x89, 0x5a, 0x50, 0x9c, 0x36, 0x4d, 0x2f, 0x14, 0x44, 0xa1, 0x69, 0x41, 0x55, 0xdb, 0x10,
+	0x6d, 0x7a, 0x91, 0x10, 0x62, 0x99, 0xee, 0x4e, 0xed, 0xa9, 0xd7, 0x3b, 0xcb, 0xcc, 0xac, 0x9b,
+	0xa0, 0x4a, 0x7c, 0xe2, 0x1f, 0xf0, 0x01, 0x89, 0xaf, 0xfc, 0x1b, 0xfe, 0x14, 0x9a, 0xcb, 0x6e,
+	0xec, 0xb6, 0xc0, 0x3a, 0xa8, 0x9f, 0xb2, 0xe7, 0xf1, 0xb9, 0x9f, 0x39, 0xcf, 0x09, 0x5c, 0x8c,
+	0x39, 0x9d, 0x12, 0x2e, 0xb6, 0xc4, 0x08, 0x73, 0x12, 0x6f, 0x91, 0x43, 0x12, 0xe5, 0x92, 0xf1,
+	0xad, 0x8c, 0x33, 0xc9, 0x4a, 0x71, 0xa0, 0x45, 0xf4, 0xfe, 0x08, 0x8b, 0x11, 0x8d, 0x18, 0xcf,
+	0x06, 0x29, 0x9b, 0xe0, 0x78, 0x90, 0x25, 0xf9, 0x90, 0xa6, 0x62, 0x30, 0xaf, 0xe7, 0x9d, 0x1b,
+	0x32, 0x36, 0x4c, 0x88, 0x71, 0xf2, 0x2c, 0x7f, 0xbe, 0x25, 0xe9, 0x84, 0x08, 0x89, 0x27, 0x99,
+	0x55, 0xf0, 0xad, 0xe1, 0x56, 0x11, 0xde, 0x84, 0x33, 0x92, 0xd1, 0xf1, 0xff, 0x68, 0x41, 0xf7,
+	0x01, 0xce, 0xd3, 0x68, 0x14, 0x90, 0x1f, 0x72, 0x22, 0x24, 0xea, 0x41, 0x2d, 0x9a, 0xc4, 0xae,
+	0xb3, 0xe9, 0xf4, 0x5b, 0x81, 0xfa, 0x44, 0x08, 0x96, 0x31, 0x1f, 0x0a, 0x77, 0x69, 0xb3, 0xd6,
+	0x6f, 0x05, 0xfa, 0x1b, 0xed, 0x41, 0x8b, 0x13, 0xc1, 0x72, 0x1e, 0x11, 0xe1, 0xd6, 0x36, 0x9d,
+	0x7e, 0x7b, 0xfb, 0xd2, 0xe0, 0xaf, 0x12, 0xb7, 0xf1, 0x4d, 0xc8, 0x41, 0x50, 0xd8, 0x05, 0xc7,
+	0x2e, 0xd0, 0x39, 0x68, 0x0b, 0x19, 0xb3, 0x5c, 0x86, 0x19, 0x96, 0x23, 0x77, 0x59, 0x47, 0x07,
+	0x03, 0xed, 0x63, 0x39, 0xb2, 0x0a, 0x84, 0x73, 0xa3, 0xb0, 0x52, 0x2a, 0x10, 0xce, 0xb5, 0x42,
+	0x0f, 0x6a, 0x24, 0x9d, 0xba, 0x75, 0x9d, 0xa4, 0xfa, 0x54, 0x79, 0xe7, 0x82, 0x70, 0xb7, 0xa1,
+	0x75, 0xf5, 0x37, 0x3a, 0x0b, 0x4d, 0x89, 0xc5, 0x38, 0x8c, 0x29, 0x77, 0x9b, 0x1a, 0x6f, 0x28,
+	0xf9, 0x0e, 0xe5, 0xe8, 0x02, 0xac, 0x15, 0xf9, 0x84, 0x09, 0x9d, 0x50, 0x29, 0xdc, 0xd6, 0xa6,
+	0xd3, 0x6f, 0x06, 0xab, 0x05, 0xfc, 0x40, 0xa3, 0x68, 0x07, 0xd6, 0x9f, 0x61, 0x41, 0xa3, 0x30,
+	0xe3, 0x2c, 0x22, 0x42, 0x84, 0xd1, 0x90, 0xb3, 0x3c, 0x73, 0x41, 0x69, 0xdf, 0x5e, 0x72, 0x9d,
+	0x00, 0xe9, 0xdf, 0xf7, 0xcd, 0xcf, 0xbb, 0xfa, 0x57, 0x74, 0x07, 0xea, 0x13, 0x96, 0xa7, 0x52,
+	0xb8, 0xed, 0xcd, 0x5a, 0xbf, 0xbd, 0x7d, 0xb1, 0x62, 0xbb, 0x1e, 0x2a, 0xa3, 0xc0, 0xda, 0xa2,
+	0xaf, 0xa0, 0x11, 0x93, 0x29, 0x55, 0x5d, 0xef, 0x68, 0x37, 0x1f, 0x55, 0x74, 0x73, 0x47, 0x5b,
+	0x05, 0x85, 0x35, 0x1a, 0xc1, 0xa9, 0x94, 0xc8, 0x97, 0x8c, 0x8f, 0x43, 0x2a, 0x58, 0x82, 0x25,
+	0x65, 0xa9, 0xdb, 0xd5, 0x83, 0xfc, 0xa4, 0xa2, 0xcb, 0x3d, 0x63, 0x7f, 0xaf, 0x30, 0x3f, 0xc8,
+	0x48, 0x14, 0xf4, 0xd2, 0xd7, 0x50, 0xe4, 0x43, 0x37, 0x65, 0x61, 0x46, 0xa7, 0x4c, 0x86, 0x9c,
+	0x31, 0xe9, 0xae, 0xea, 0xae, 0xb6, 0x53, 0xb6, 0xaf, 0xb0, 0x80, 0x31, 0x89, 0xfa, 0xd0, 0x8b,
+	0xc9, 0x73, 0x9c, 0x27, 0x32, 0xcc, 0x68, 0x1c, 0x4e, 0x58, 0x4c, 0xdc, 0x35, 0x3d, 0x9e, 0x55,
+	0x8b, 0xef, 0xd3, 0xf8, 0x21, 0x8b, 0xc9, 0xac, 0x26, 0xcd, 0x22, 0xa3, 0xd9, 0x9b, 0xd3, 0xbc,
+	0x97, 0x45, 0x5a, 0xf3, 0x3c, 0x74, 0xa3, 0x2c, 0x17, 0x44, 0x16, 0xf3, 0x39, 0xa5, 0xd5, 0x3a,
+	0x06, 0xb4, 0x53, 0xf9, 0x3f, 0x00, 0x4e, 0x12, 0xf6, 0x32, 0x8c, 0x70, 0x26, 0x5c, 0xa4, 0x1f,
+	0x4f, 0x4b, 0x23, 0xbb, 0x38, 0x13, 0xc8, 0x87, 0x4e, 0x84, 0x33, 0xfc, 0x8c, 0x26, 0x54, 0x52,
+	0x22, 0xdc, 0xff, 0x6a, 0x85, 0x39, 0x0c, 0x5d, 0x04, 0x64, 0x02, 0x84, 0xd3, 0xed, 0x90, 0x4d,
+	0x09, 0xe7, 0x34, 0x26, 0xee, 0xba, 0x0e, 0xd6, 0x33, 0xbf, 0x3c, 0xd9, 0xfe, 0xda, 0xe2, 0xe8,
+	0xe8, 0x58, 0xfb, 0xf2, 0xb1, 0xf6, 0x69, 0x3d, 0xcb, 0xfb, 0x83, 0x6a, 0xab, 0x3f, 0x98, 0xdb,
+	0xd8, 0x81, 0x29, 0xe5, 0xc9, 0xe5, 0x22, 0xc6, 0xdd, 0x54, 0xf2, 0xa3, 0x32, 0x74, 0x09, 0xab,
+	0x41, 0x30, 0x36, 0x09, 0x45, 0xc4, 0x38, 0x09, 0x71, 0xfc, 0xc2, 0xdd, 0xd8, 0x74, 0xfa, 0x2b,
+	0x41, 0x9b, 0xb1, 0xc9, 0x81, 0xc2, 0xbe, 0x88, 0x5f, 0xa8, 0xfd, 0xd0, 0x6f, 0x42, 0xed, 0xc7,
+	0x19, 0xb3, 0x1f, 0x4a, 0x56, 0xfb, 0xf1, 0x2d, 0xac, 0xaa, 0x15, 0x0a, 0x53, 0x3c, 0x21, 0x22,
+	0xc3, 0x11, 0x71, 0x5d, 0xfd, 0x5c, 0xae, 0x56, 0xcd, 0xfa, 0xb1, 0x20, 0x7c, 0xaf, 0x30, 0x0e,
+	0xba, 0xf9, 0xac, 0xe8, 0xed, 0xc2, 0xe9, 0xb7, 0xd6, 0xa1, 0xf6, 0x7a, 0x4c, 0x8e, 0x0a, 0x3e,
+	0x1a, 0x93, 0x23, 0xb4, 0x0e, 0x2b, 0x53, 0x9c, 0xe4, 0xc4, 0x5d, 0xd2, 0x98, 0x11, 0x6e, 0x2e,
+	0xdd, 0x70, 0xfc, 0xef, 0x61, 0xb5, 0x68, 0x8d, 0xc8, 0x58, 0x2a, 0x08, 0xda, 0x83, 0x86, 0xdd,
+	0x52, 0xed, 0xa1, 0xbd, 0xbd, 0x53, 0x35, 0x5b, 0xbb, 0xbd, 0x07, 0x12, 0x4b, 0x12, 0x14, 0x4e,
+	0xfc, 0x2e, 0xb4, 0x9f, 0x62, 0x2a, 0x6d, 0xeb, 0xfd, 0xef, 0xa0, 0x63, 0xc4, 0x77, 0x14, 0xee,
+	0x01, 0xac, 0x1d, 0x8c, 0x72, 0x19, 0xb3, 0x97, 0x69, 0xc1, 0xcf, 0x1b, 0x50, 0x17, 0x74, 0x98,
+	0xe2, 0xc4, 0xb6, 0xc4, 0x4a, 0xe8, 0x3d, 0xe8, 0x0c, 0x39, 0x8e, 0x48, 0x98, 0x11, 0x4e, 0x59,
+	0xac, 0x9b, 0x53, 0x0b, 0xda, 0x1a, 0xdb, 0xd7, 0x90, 0x8f, 0xa0, 0x77, 0xec, 0xcd, 0x64, 0xec,
+	0x8f, 0x60, 0xe3, 0x71, 0x16, 0xab, 0xa0, 0x25, 0x2d, 0xdb, 0x40, 0x73, 0x14, 0xef, 0xfc, 0x6b,
+	0x8a, 0xf7, 0xcf, 0xc2, 0x99, 0x37, 0x22, 0xd9, 0x24, 0x7a, 0xb0, 0xfa, 0x84, 0x70, 0x41, 0x59,
+	0x51, 0xa5, 0xff, 0x21, 0xac, 0x95, 0x88, 0xed, 0xad, 0x0b, 0x8d, 0xa9, 0x81, 0x6c, 0xe5, 0x85,
+	0xe8, 0x7f, 0x00, 0x1d, 0xd5, 0xb7, 0x32, 0x73, 0x0f, 0x9a, 0x34, 0x95, 0x84, 0x4f, 0x6d, 0x93,
+	0x6a, 0x41, 0x29, 0xfb, 0x4f, 0xa1, 0x6b, 0x75, 0xad, 0xdb, 0x2f, 0x61, 0x45, 0x28, 0x60, 0xc1,
+	0x12, 0x1f, 0x61, 0x31, 0x36, 0x8e, 0x8c, 0xb9, 0x7f, 0x01, 0xba, 0x07, 0x7a, 0x12, 0x6f, 0x1f,
+	0xd4, 0x4a, 0x31, 0x28, 0x55, 0x6c, 0xa1, 0x68, 0xcb, 0x1f, 0x43, 0xfb, 0xee, 0x21, 0x89, 0x0a,
+	0xc3, 0x6b, 0xd0, 0x8c, 0x09, 0x8e, 0x13, 0x9a, 0x12, 0x9b, 0x94, 0x37, 0x30, 0xb7, 0x7e, 0x50,
+	0xdc, 0xfa, 0xc1, 0xa3, 0xe2, 0xd6, 0x07, 0xa5, 0x6e, 0x71, 0xb9, 0x97, 0xde, 0xbc, 0xdc, 0xb5,
+	0xe3, 0xcb, 0xed, 0xef, 0x42, 0xc7, 0x04, 0xb3, 0xf5, 0x6f, 0x40, 0x9d, 0xe5, 0x32, 0xcb, 0xa5,
+	0x8e, 0xd5, 0x09, 0xac, 0x84, 0xfe, 0x07, 0x2d, 0x72, 0x48, 0x65, 0x18, 0x29, 0x86, 0x5d, 0xd2,
+	0x15, 0x34, 0x15, 0xb0, 0xcb, 0x62, 0xe2, 0xff, 0xee, 0x40, 0x67, 0xf6, 0xc5, 0xaa, 0xd8, 0x19,
+	0x8d, 0x6d, 0xa5, 0xea, 0xf3, 0x6f, 0xed, 0x67, 0x7a, 0x53, 0x9b, 0xed, 0x0d, 0x1a, 0xc0, 0xb2,
+	0xfa, 0x2f, 0xc6, 0x5d, 0xfe, 0xc7, 0xb2, 0xb5, 0x9e, 0xa2, 0x6f, 0x45, 0x69, 0x63, 0x9a, 0x24,
+	0x24, 0xd6, 0xff, 0x14, 0x34, 0x83, 0x16, 0x63, 0x93, 0xfb, 0x1a, 0xf0, 0x1f, 0xc3, 0xa9, 0xdd,
+	0x11, 0x89, 0xc6, 0x19, 0xa3, 0x69, 0xb1, 0xb3, 0x2a, 0x31, 0x3a, 0xc1, 0x43, 0xa2, 0x39, 0xce,
+	0xbc, 0xa4, 0xa6, 0x06, 0x14, 0xc9, 0x9d, 0x87, 0x6e, 0x42, 0xf0, 0x94, 0x84, 0x3c, 0x4f, 0x53,
+	0x9a, 0x0e, 0x75, 0xe6, 0xcd, 0xa0, 0xa3, 0xc1, 0xc0, 0x60, 0xfe, 0x3a, 0xa0, 0x59, 0xb7, 0x76,
+	0x8a, 0xaf, 0x60, 0x35, 0x20, 0x42, 0x32, 0x4e, 0x8a, 0x48, 0x0f, 0xa1, 0x9e, 0x68, 0x3a, 0x72,
+	0x9d, 0xc5, 0x98, 0x72, 0x8e, 0xdf, 0x03, 0xeb, 0x64, 0x3e, 0xf1, 0xa5, 0xf9, 0xc4, 0x7d, 0x0c,
+	0x6b, 0x65, 0xf4, 0x77, 0x44, 0x46, 0x9f, 0x42, 0x77, 0x8e, 0xc2, 0xd1, 0x19, 0x68, 0x8c, 0x98,
+	0x90, 0xa1, 0x1d, 0x7c, 0x37, 0xa8, 0x2b, 0xf1, 0x9e, 0x7e, 0x77, 0x82, 0xfe, 0x68, 0xc6, 0xde,
+	0x0d, 0xf4, 0xf7, 0xf6, 0x6f, 0x6d, 0x68, 0xde, 0xb5, 0x61, 0xd0, 0x11, 0xd4, 0x4d, 0x8d, 0xe8,
+	0x64, 0x3d, 0xf1, 0xae, 0x2d, 0x6a, 0x66, 0x87, 0xf4, 0x1f, 0x24, 0x60, 0x59, 0x51, 0x36, 0xba,
+	0x52, 0xd5, 0xc3, 0x0c, 0xdf, 0x7b, 0x3b, 0x8b, 0x19, 0x95, 0x41, 0x7f, 0x82, 0x66, 0xc1, 0xbc,
+	0xe8, 0x7a, 0x55, 0x1f, 0xaf, 0x31, 0xbf, 0x77, 0x63, 0x71, 0xc3, 0x32, 0x81, 0x5f, 0x1c, 0x58,
+	0x7b, 0x8d, 0x7d, 0xd1, 0x67, 0x95, 0x0f, 0xf7, 0x5b, 0x0f, 0x84, 0x77, 0xeb, 0xc4, 0xf6, 0x65,
+	0x5a, 0xaf, 0xa0, 0x61, 0x69, 0x1e, 0x55, 0x9e, 0xe8, 0xfc, 0xa5, 0xf0, 0xae, 0x2f, 0x6c, 0x57,
+	0x46, 0x3f, 0x84, 0x15, 0x4d, 0xe1, 0xa8, 0xf2, 0x58, 0x67, 0xcf, 0x8c, 0x77, 0x75, 0x41, 0xab,
+	0x22, 0xee, 0x25, 0x47, 0xbd, 0x7f, 0x73, 0x03, 0xaa, 0xbf, 0xff, 0xb9, 0xe3, 0xe2, 0x5d, 0x5b,
+	0xd4, 0x6c, 0xf6, 0xfd, 0xab, 0x35, 0xac, 0xfe, 0xfe, 0x67, 0x4e, 0x93, 0xb7, 0xb3, 0x98, 0x51,
+	0x19, 0xf4, 0x57, 0x07, 0xba, 0x0a, 0x3a, 0x90, 0x9c, 0xe0, 0x09, 0x4d, 0x87, 0xe8, 0x56, 0xc5,
+	0x3b, 0xab, 0xac, 0xcc, 0xad, 0xb5, 0x96, 0x45, 0x2a, 0x9f, 0x9f, 0xdc, 0x41, 0x91, 0x56, 0xdf,
+	0xb9, 0xe4, 0xa0, 0x9f, 0x1d, 0x80, 0x63, 0x36, 0x47, 0x1f, 0x57, 0xad, 0xf0, 0x8d, 0xc3, 0xe2,
+	0xdd, 0x3c, 0x89, 0xe9, 0xec, 0x2a, 0x58, 0x02, 0xaf, 0xbe, 0x0a, 0xf3, 0xf7, 0xc6, 0xbb, 0xbe,
+	0xb0, 0x5d, 0x11, 0xfd, 0x76, 0xe3, 0x9b, 0x15, 0x73, 0x64, 0xeb, 0xfa, 0xcf, 0x95, 0x3f, 0x07,
+	0x00, 0xe2, 0xf2, 0xdd, 0xea, 0xb4, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1017,6 +1252,8 @@ type ExecutorClient interface {
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExecStreaming(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecStreamingClient, error)
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error)
 }
 
 type executorClient struct {
@@ -1122,6 +1359,24 @@ func (c *executorClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *executorClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error) {
+	out := new(RestoreResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *executorClient) ExecStreaming(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecStreamingClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Executor_serviceDesc.Streams[1], "/hashicorp.nomad.plugins.executor.proto.Executor/ExecStreaming", opts...)
 	if err != nil {
@@ -1165,6 +1420,8 @@ type ExecutorServer interface {
 	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExecStreaming(Executor_ExecStreamingServer) error
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	Restore(context.Context, *RestoreRequest) (*RestoreResponse, error)
 }
 
 // UnimplementedExecutorServer can be embedded to have forward compatible implementations.
@@ -1198,6 +1455,12 @@ func (*UnimplementedExecutorServer) Exec(ctx context.Context, req *ExecRequest)
 func (*UnimplementedExecutorServer) ExecStreaming(srv Executor_ExecStreamingServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExecStreaming not implemented")
 }
+func (*UnimplementedExecutorServer) Checkpoint(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedExecutorServer) Restore(ctx context.Context, req *RestoreRequest) (*RestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 
 func RegisterExecutorServer(s *grpc.Server, srv ExecutorServer) {
 	s.RegisterService(&_Executor_serviceDesc, srv)
@@ -1376,6 +1639,42 @@ func (x *executorExecStreamingServer) Recv() (*proto1.ExecTaskStreamingRequest,
 	return m, nil
 }
 
+func _Executor_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Executor_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.executor.proto.Executor",
 	HandlerType: (*ExecutorServer)(nil),
@@ -1408,6 +1707,14 @@ var _Executor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Executor_Exec_Handler,
 		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Executor_Checkpoint_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Executor_Restore_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -148,6 +148,26 @@ func processStateFromProto(pb *proto.ProcessState) (*ProcessState, error) {
 	}, nil
 }
 
+func userNamespaceToProto(userns *UserNamespace) *proto.UserNamespace {
+	if userns == nil {
+		return nil
+	}
+	return &proto.UserNamespace{
+		HostId: userns.HostID,
+		Size:   userns.Size,
+	}
+}
+
+func userNamespaceFromProto(pb *proto.UserNamespace) *UserNamespace {
+	if pb == nil {
+		return nil
+	}
+	return &UserNamespace{
+		HostID: pb.HostId,
+		Size:   pb.Size,
+	}
+}
+
 // IsolationMode returns the namespace isolation mode as determined from agent
 // plugin configuration and task driver configuration. The task configuration
 // takes precedence, if it is configured.
@@ -8,7 +8,9 @@ package catalog
 import (
 	"github.com/hashicorp/nomad/drivers/docker"
 	"github.com/hashicorp/nomad/drivers/exec"
+	"github.com/hashicorp/nomad/drivers/firecracker"
 	"github.com/hashicorp/nomad/drivers/java"
+	"github.com/hashicorp/nomad/drivers/oci"
 	"github.com/hashicorp/nomad/drivers/qemu"
 	"github.com/hashicorp/nomad/drivers/rawexec"
 )
@@ -19,7 +21,9 @@ import (
 func init() {
 	RegisterDeferredConfig(rawexec.PluginID, rawexec.PluginConfig, rawexec.PluginLoader)
 	Register(exec.PluginID, exec.PluginConfig)
+	Register(oci.PluginID, oci.PluginConfig)
 	Register(qemu.PluginID, qemu.PluginConfig)
+	Register(firecracker.PluginID, firecracker.PluginConfig)
 	Register(java.PluginID, java.PluginConfig)
 	RegisterDeferredConfig(docker.PluginID, docker.PluginConfig, docker.PluginLoader)
 }
@@ -1120,6 +1120,93 @@ func TestWaitConfig(t *testing.T) {
 	must.Eq(t, 60*time.Second, *tmpl.Wait.Max)
 }
 
+func TestParse_Gang(t *testing.T) {
+	t.Parallel()
+
+	hclBytes, err := os.ReadFile("test-fixtures/gang.hcl")
+	must.NoError(t, err)
+
+	job, err := ParseWithConfig(&ParseConfig{
+		Path:    "test-fixtures/gang.hcl",
+		Body:    hclBytes,
+		AllowFS: false,
+	})
+	must.NoError(t, err)
+
+	must.NotNil(t, job.Gang)
+	must.Eq(t, []string{"launcher", "workers"}, job.Gang.Groups)
+}
+
+func TestParse_DependsOn(t *testing.T) {
+	t.Parallel()
+
+	hclBytes, err := os.ReadFile("test-fixtures/depends-on.hcl")
+	must.NoError(t, err)
+
+	job, err := ParseWithConfig(&ParseConfig{
+		Path:    "test-fixtures/depends-on.hcl",
+		Body:    hclBytes,
+		AllowFS: false,
+	})
+	must.NoError(t, err)
+
+	must.NotNil(t, job.DependsOn)
+	must.Eq(t, []string{"extract", "transform"}, job.DependsOn.Jobs)
+}
+
+func TestParse_PeriodicExclude(t *testing.T) {
+	t.Parallel()
+
+	hclBytes, err := os.ReadFile("test-fixtures/periodic-exclude.hcl")
+	must.NoError(t, err)
+
+	job, err := ParseWithConfig(&ParseConfig{
+		Path:    "test-fixtures/periodic-exclude.hcl",
+		Body:    hclBytes,
+		AllowFS: false,
+	})
+	must.NoError(t, err)
+
+	must.NotNil(t, job.Periodic)
+	must.Eq(t, "run-all", *job.Periodic.CatchUp)
+	must.Eq(t, []*api.PeriodicExclusion{
+		{Start: "2025-12-24", End: "2025-12-27"},
+		{Cron: "* * * * 0,6"},
+	}, job.Periodic.Exclusions)
+}
+
+func TestParse_UpdateAnalysis(t *testing.T) {
+	t.Parallel()
+
+	hclBytes, err := os.ReadFile("test-fixtures/update-analysis.hcl")
+	must.NoError(t, err)
+
+	job, err := ParseWithConfig(&ParseConfig{
+		Path:    "test-fixtures/update-analysis.hcl",
+		Body:    hclBytes,
+		AllowFS: false,
+	})
+	must.NoError(t, err)
+
+	must.Eq(t, &api.CanaryAnalysis{
+		Duration:     new(10 * time.Minute),
+		Interval:     new(time.Minute),
+		FailureLimit: new(2),
+		HTTP: &api.CanaryAnalysisHTTP{
+			URL:    "http://prometheus.service.consul:9090/api/v1/query?query=up",
+			Header: map[string]string{"Authorization": "Bearer token"},
+		},
+	}, job.TaskGroups[0].Update.Analysis)
+
+	must.Eq(t, &api.CanaryAnalysis{
+		Variable: &api.CanaryAnalysisVariable{
+			Path: "metrics/api",
+			Key:  "error_rate",
+			Max:  new(0.05),
+		},
+	}, job.TaskGroups[1].Update.Analysis)
+}
+
 func TestErrMissingKey(t *testing.T) {
 	t.Parallel()
 	hclBytes, err := os.ReadFile("test-fixtures/template-err-missing-key.hcl")
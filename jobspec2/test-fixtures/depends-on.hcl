@@ -0,0 +1,16 @@
+# Copyright IBM Corp. 2015, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+job "load" {
+  type = "batch"
+
+  depends_on {
+    jobs = ["extract", "transform"]
+  }
+
+  group "load" {
+    task "load" {
+      driver = "exec"
+    }
+  }
+}
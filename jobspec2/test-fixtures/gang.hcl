@@ -0,0 +1,24 @@
+# Copyright IBM Corp. 2015, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+job "mpi" {
+  type = "batch"
+
+  gang {
+    groups = ["launcher", "workers"]
+  }
+
+  group "launcher" {
+    task "mpirun" {
+      driver = "exec"
+    }
+  }
+
+  group "workers" {
+    count = 4
+
+    task "worker" {
+      driver = "exec"
+    }
+  }
+}
@@ -0,0 +1,27 @@
+# Copyright IBM Corp. 2015, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+job "backup" {
+  type = "batch"
+
+  periodic {
+    crons     = ["0 2 * * *"]
+    time_zone = "America/New_York"
+    catch_up  = "run-all"
+
+    exclude {
+      start = "2025-12-24"
+      end   = "2025-12-27"
+    }
+
+    exclude {
+      cron = "* * * * 0,6"
+    }
+  }
+
+  group "backup" {
+    task "backup" {
+      driver = "exec"
+    }
+  }
+}
@@ -0,0 +1,46 @@
+# Copyright IBM Corp. 2015, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+job "web" {
+  group "web" {
+    update {
+      canary = 1
+
+      analysis {
+        duration      = "10m"
+        interval      = "1m"
+        failure_limit = 2
+
+        http {
+          url = "http://prometheus.service.consul:9090/api/v1/query?query=up"
+
+          header = {
+            Authorization = "Bearer token"
+          }
+        }
+      }
+    }
+
+    task "web" {
+      driver = "docker"
+    }
+  }
+
+  group "api" {
+    update {
+      canary = 1
+
+      analysis {
+        variable {
+          path = "metrics/api"
+          key  = "error_rate"
+          max  = 0.05
+        }
+      }
+    }
+
+    task "api" {
+      driver = "docker"
+    }
+  }
+}
@@ -40,6 +40,7 @@ var (
 		"debug",
 		"eval-status",
 		"executor",
+		"firecracker-agent",
 		"logmon",
 		"node-drain",
 		"node-status",
@@ -454,6 +454,79 @@ func TestClientFS_Stat_ACL(t *testing.T) {
 	}
 }
 
+func TestClientFS_SearchLogs_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start a server
+	s, root, cleanupS := TestACLServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	// Create a bad token
+	policyBad := mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityListJobs})
+	tokenBad := mock.CreatePolicyAndToken(t, s.State(), 1005, "invalid", policyBad)
+
+	policyLogs := mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadLogs})
+	tokenLogs := mock.CreatePolicyAndToken(t, s.State(), 1007, "valid", policyLogs)
+
+	policyFS := mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadFS})
+	tokenFS := mock.CreatePolicyAndToken(t, s.State(), 1009, "valid2", policyFS)
+
+	// Upsert the allocation
+	state := s.State()
+	alloc := mock.Alloc()
+	must.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1010, nil, alloc.Job.Copy()))
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1011, []*structs.Allocation{alloc.Copy()}))
+
+	cases := []struct {
+		Name          string
+		Token         string
+		ExpectedError string
+	}{
+		{
+			Name:          "bad token",
+			Token:         tokenBad.SecretID,
+			ExpectedError: structs.ErrPermissionDenied.Error(),
+		},
+		{
+			Name:          "read-logs token",
+			Token:         tokenLogs.SecretID,
+			ExpectedError: structs.ErrUnknownNodePrefix,
+		},
+		{
+			Name:          "read-fs token",
+			Token:         tokenFS.SecretID,
+			ExpectedError: structs.ErrUnknownNodePrefix,
+		},
+		{
+			Name:          "root token",
+			Token:         root.SecretID,
+			ExpectedError: structs.ErrUnknownNodePrefix,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			req := &cstructs.FsLogsSearchRequest{
+				AllocID: alloc.ID,
+				Task:    "web",
+				LogType: "stdout",
+				Pattern: "error",
+				QueryOptions: structs.QueryOptions{
+					Region:    "global",
+					Namespace: structs.DefaultNamespace,
+					AuthToken: c.Token,
+				},
+			}
+
+			var resp cstructs.FsLogsSearchResponse
+			err := msgpackrpc.CallWithCodec(codec, "FileSystem.SearchLogs", req, &resp)
+			must.ErrorContains(t, err, c.ExpectedError)
+		})
+	}
+}
+
 func TestClientFS_Stat_Remote(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/deploymentwatcher"
+	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/nomad/structs/config"
 	"github.com/hashicorp/nomad/scheduler"
@@ -69,6 +70,15 @@ type Config struct {
 	// EventBufferSize is the amount of events to hold in memory.
 	EventBufferSize int64
 
+	// EnableEventJournal is used to persist published events to disk so
+	// event stream subscribers can resume from indexes that are no longer
+	// held in memory.
+	EnableEventJournal bool
+
+	// EventJournalRetention is how long events are kept in the event
+	// journal.
+	EventJournalRetention time.Duration
+
 	// JobMaxSourceSize limits the maximum size of a jobs source hcl/json
 	// before being discarded automatically. A value of zero indicates no job
 	// sources will be stored.
@@ -437,6 +447,11 @@ type Config struct {
 	// DeploymentWatcher to throttle the amount of simultaneously deployments
 	DeploymentQueryRateLimit float64
 
+	// CanaryAnalysisHTTPAllowlist is the networks the leader may query for
+	// the HTTP checks of canary analysis. HTTP checks are disabled if it is
+	// empty.
+	CanaryAnalysisHTTPAllowlist []*net.IPNet
+
 	// JobDefaultPriority is the default Job priority if not specified.
 	JobDefaultPriority int
 
@@ -694,6 +709,7 @@ func DefaultConfig() *Config {
 		LicenseConfig:            &LicenseConfig{},
 		EnableEventBroker:        true,
 		EventBufferSize:          100,
+		EventJournalRetention:    stream.DefaultJournalRetention,
 		ACLTokenMinExpirationTTL: 1 * time.Minute,
 		ACLTokenMaxExpirationTTL: 24 * time.Hour,
 		AutopilotConfig: &structs.AutopilotConfig{
@@ -4,6 +4,9 @@
 package nomad
 
 import (
+	"encoding/json"
+
+	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -57,3 +60,30 @@ func (d *deploymentWatcherRaftShim) UpdateAllocDesiredTransition(req *structs.Al
 	fsmErrIntf, index, raftErr := d.apply(structs.AllocUpdateDesiredTransitionRequestType, req)
 	return d.convertApplyErrors(fsmErrIntf, index, raftErr)
 }
+
+// deploymentWatcherVariableShim is the shim that provides the deployment
+// watcher with the decrypted variables checked by canary analysis.
+type deploymentWatcherVariableShim struct {
+	// state returns the current state store
+	state func() *state.StateStore
+
+	// encrypter is used to decrypt variables
+	encrypter *Encrypter
+}
+
+func (d *deploymentWatcherVariableShim) ReadVariable(namespace, path string) (map[string]string, error) {
+	ev, err := d.state().GetVariable(nil, namespace, path)
+	if err != nil || ev == nil {
+		return nil, err
+	}
+
+	b, err := d.encrypter.Decrypt(ev.Data, ev.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[string]string)
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
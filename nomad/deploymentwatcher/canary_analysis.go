@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package deploymentwatcher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// newCanaryAnalysisClient returns the HTTP client used to run the queries of
+// canary analysis, or nil if no networks are allowed. The client only connects
+// to addresses in the allowlist, which is checked when dialing so redirects
+// and DNS names can't be used to reach other addresses.
+func newCanaryAnalysisClient(allowlist []*net.IPNet) *http.Client {
+	if len(allowlist) == 0 {
+		return nil
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("invalid address %q", address)
+			}
+			for _, ipNet := range allowlist {
+				if ipNet.Contains(ip) {
+					return nil
+				}
+			}
+			return fmt.Errorf("address %s is not in the canary analysis HTTP allowlist", ip)
+		},
+	}
+
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Transport: transport}
+}
+
+// canaryAnalysisResult is the result of the canary analysis of a task group.
+type canaryAnalysisResult struct {
+	// group is the task group analyzed
+	group string
+
+	// autoRevert is whether the task group rolls back on failure
+	autoRevert bool
+
+	// checks and failures are the number of checks run and failed
+	checks   int
+	failures int
+
+	// err is the error of the last failed check if the analysis failed
+	err error
+}
+
+// canaryAnalyses returns the canary analysis of each task group of the
+// deployment that has unpromoted canaries.
+func (w *deploymentWatcher) canaryAnalyses(d *structs.Deployment) map[string]*structs.CanaryAnalysis {
+	analyses := make(map[string]*structs.CanaryAnalysis)
+	for name, dstate := range d.TaskGroups {
+		if dstate.DesiredCanaries < 1 || dstate.Promoted {
+			continue
+		}
+
+		tg := w.j.LookupTaskGroup(name)
+		if tg == nil || tg.Update == nil || tg.Update.Analysis == nil {
+			continue
+		}
+		analyses[name] = tg.Update.Analysis
+	}
+	return analyses
+}
+
+// startCanaryAnalysis starts the canary analysis of the task groups, unless it
+// is already running, and records it in the deployment status description.
+func (w *deploymentWatcher) startCanaryAnalysis(d *structs.Deployment, analyses map[string]*structs.CanaryAnalysis) error {
+	// Don't start the analysis twice, or resume a paused deployment.
+	if w.analysisCh != nil || d.Status != structs.DeploymentStatusRunning {
+		return nil
+	}
+
+	u := w.getDeploymentStatusUpdate(structs.DeploymentStatusRunning, structs.DeploymentStatusDescriptionRunningAnalysis)
+	if _, err := w.upsertDeploymentStatusUpdate(u, nil, nil); err != nil {
+		return err
+	}
+
+	w.analysisCh = make(chan *canaryAnalysisResult, len(analyses))
+	w.analysisPending = len(analyses)
+	for name, analysis := range analyses {
+		w.logger.Debug("starting canary analysis", "task_group", name,
+			"duration", analysis.Duration, "interval", analysis.Interval)
+
+		res := &canaryAnalysisResult{
+			group:      name,
+			autoRevert: d.TaskGroups[name].AutoRevert,
+		}
+		go w.runCanaryAnalysis(analysis, res, w.analysisCh)
+	}
+	return nil
+}
+
+// runCanaryAnalysis checks the analysis every interval for its duration and
+// sends the result once the analysis passed or more checks than the failure
+// limit failed.
+func (w *deploymentWatcher) runCanaryAnalysis(analysis *structs.CanaryAnalysis, res *canaryAnalysisResult, resultCh chan<- *canaryAnalysisResult) {
+	checks := max(int(analysis.Duration/analysis.Interval), 1)
+	timer := time.NewTimer(analysis.Interval)
+	defer timer.Stop()
+
+	for res.checks < checks {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		res.checks++
+		if err := w.checkCanaryAnalysis(analysis); err != nil {
+			res.failures++
+			w.logger.Warn("canary analysis check failed", "task_group", res.group, "error", err)
+			if res.failures > analysis.FailureLimit {
+				res.err = err
+				break
+			}
+		}
+		timer.Reset(analysis.Interval)
+	}
+
+	select {
+	case resultCh <- res:
+	case <-w.ctx.Done():
+	}
+}
+
+// checkCanaryAnalysis runs a single check of the analysis.
+func (w *deploymentWatcher) checkCanaryAnalysis(analysis *structs.CanaryAnalysis) error {
+	if analysis.Variable != nil {
+		if w.variables == nil {
+			return fmt.Errorf("variables are not available to canary analysis")
+		}
+		items, err := w.variables.ReadVariable(w.j.Namespace, analysis.Variable.Path)
+		if err != nil {
+			return fmt.Errorf("failed to read variable %q: %w", analysis.Variable.Path, err)
+		}
+		if items == nil {
+			return fmt.Errorf("variable %q not found", analysis.Variable.Path)
+		}
+		return analysis.Variable.Check(items)
+	}
+
+	if w.analysisClient == nil {
+		return fmt.Errorf("HTTP canary analysis is not enabled on the servers")
+	}
+
+	timeout := analysis.HTTP.Timeout
+	if timeout == 0 {
+		timeout = analysis.Interval
+	}
+	ctx, cancel := context.WithTimeout(w.ctx, timeout)
+	defer cancel()
+
+	method := analysis.HTTP.Method
+	if method == "" {
+		method = structs.CanaryAnalysisHTTPMethodDefault
+	}
+	req, err := http.NewRequestWithContext(ctx, method, analysis.HTTP.URL, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range analysis.HTTP.Header {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.analysisClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("query %s %s returned status %d", method, analysis.HTTP.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+// canaryAnalysisPassed records that the canary analysis of a task group
+// passed. Once the analysis of every task group passed, the deployment is
+// promoted, unless a task group without analysis requires manual promotion.
+func (w *deploymentWatcher) canaryAnalysisPassed(d *structs.Deployment, res *canaryAnalysisResult) error {
+	w.logger.Debug("canary analysis passed", "task_group", res.group,
+		"checks", res.checks, "failures", res.failures)
+
+	w.analysisPending--
+	if w.analysisPending > 0 {
+		return nil
+	}
+
+	// Leave a paused deployment to be promoted manually.
+	if d.Status != structs.DeploymentStatusRunning {
+		w.logger.Debug("skipping promotion of deployment that isn't running", "status", d.Status)
+		return nil
+	}
+
+	analyses := w.canaryAnalyses(d)
+	desc := structs.DeploymentStatusDescriptionAnalysisPassed
+	for name, dstate := range d.TaskGroups {
+		if dstate.DesiredCanaries < 1 || dstate.Promoted || dstate.AutoPromote {
+			continue
+		}
+		if _, ok := analyses[name]; !ok {
+			desc = structs.DeploymentStatusDescriptionAnalysisNeedsPromotion
+			break
+		}
+	}
+
+	u := w.getDeploymentStatusUpdate(structs.DeploymentStatusRunning, desc)
+	if _, err := w.upsertDeploymentStatusUpdate(u, nil, nil); err != nil {
+		return err
+	}
+	if desc == structs.DeploymentStatusDescriptionAnalysisNeedsPromotion {
+		return nil
+	}
+
+	_, err := w.upsertDeploymentPromotion(&structs.ApplyDeploymentPromoteRequest{
+		DeploymentPromoteRequest: structs.DeploymentPromoteRequest{DeploymentID: d.GetID(), All: true},
+		Eval:                     w.getEval(),
+	})
+	return err
+}
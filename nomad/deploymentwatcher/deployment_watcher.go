@@ -6,6 +6,7 @@ package deploymentwatcher
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -72,6 +73,13 @@ type deploymentWatcher struct {
 	// in enterprise edition
 	JobRPC
 
+	// variables is used to read the variables checked by canary analysis
+	variables VariableReader
+
+	// analysisClient is used to query the HTTP checks of canary analysis. It
+	// is nil if HTTP checks are disabled.
+	analysisClient *http.Client
+
 	// state is the state that is watched for state changes.
 	state *state.StateStore
 
@@ -95,6 +103,15 @@ type deploymentWatcher struct {
 	// marked as allowing a replacement. Access should be done through the lock.
 	outstandingAllowReplacements map[string]*structs.DesiredTransition
 
+	// analysisCh receives the result of the canary analysis of each task
+	// group. It is nil until the analysis is started. The analysis fields are
+	// only accessed by the watch loop.
+	analysisCh chan *canaryAnalysisResult
+
+	// analysisPending is the number of task groups whose canary analysis
+	// hasn't passed yet.
+	analysisPending int
+
 	// latestEval is the latest eval for the job. It is updated by the watch
 	// loop and any time an evaluation is created. The field should be accessed
 	// by holding the lock or using the setter and getter methods.
@@ -110,8 +127,8 @@ type deploymentWatcher struct {
 // deployments and trigger the scheduler as needed.
 func newDeploymentWatcher(parent context.Context, queryLimiter *rate.Limiter,
 	logger log.Logger, state *state.StateStore, d *structs.Deployment,
-	j *structs.Job, triggers deploymentTriggers,
-	deploymentRPC DeploymentRPC, jobRPC JobRPC) *deploymentWatcher {
+	j *structs.Job, triggers deploymentTriggers, variables VariableReader,
+	analysisClient *http.Client, deploymentRPC DeploymentRPC, jobRPC JobRPC) *deploymentWatcher {
 
 	ctx, exitFn := context.WithCancel(parent)
 	w := &deploymentWatcher{
@@ -124,6 +141,8 @@ func newDeploymentWatcher(parent context.Context, queryLimiter *rate.Limiter,
 		deploymentTriggers: triggers,
 		DeploymentRPC:      deploymentRPC,
 		JobRPC:             jobRPC,
+		variables:          variables,
+		analysisClient:     analysisClient,
 		logger:             logger.With("deployment_id", d.ID, "job", j.NamespacedID()),
 		ctx:                ctx,
 		exitFn:             exitFn,
@@ -279,7 +298,9 @@ func (w *deploymentWatcher) PromoteDeployment(
 	return nil
 }
 
-// autoPromoteDeployment creates a synthetic promotion request, and upserts it for processing
+// autoPromoteDeployment creates a synthetic promotion request, and upserts it
+// for processing. If any task group has canary analysis, the analysis is
+// started instead and gates the promotion.
 func (w *deploymentWatcher) autoPromoteDeployment(allocs []*structs.AllocListStub) error {
 	d := w.getDeployment()
 	if !d.HasPlacedCanaries() || !d.RequiresPromotion() {
@@ -288,6 +309,7 @@ func (w *deploymentWatcher) autoPromoteDeployment(allocs []*structs.AllocListStu
 
 	// AutoPromote iff every task group with canaries is marked auto_promote and is healthy. The whole
 	// job version has been incremented, so we promote together. See also AutoRevert
+	autoPromote := true
 	for _, dstate := range d.TaskGroups {
 
 		// skip auto promote canary validation if the task group has no canaries
@@ -296,7 +318,7 @@ func (w *deploymentWatcher) autoPromoteDeployment(allocs []*structs.AllocListStu
 			continue
 		}
 
-		if !dstate.AutoPromote || len(dstate.PlacedCanaries) < dstate.DesiredCanaries {
+		if len(dstate.PlacedCanaries) < dstate.DesiredCanaries {
 			return nil
 		}
 
@@ -312,6 +334,16 @@ func (w *deploymentWatcher) autoPromoteDeployment(allocs []*structs.AllocListStu
 		if healthyCanaries != dstate.DesiredCanaries {
 			return nil
 		}
+
+		autoPromote = autoPromote && dstate.AutoPromote
+	}
+
+	// Canary analysis decides whether the deployment is promoted
+	if analyses := w.canaryAnalyses(d); len(analyses) != 0 {
+		return w.startCanaryAnalysis(d, analyses)
+	}
+	if !autoPromote {
+		return nil
 	}
 
 	// Send the request
@@ -433,7 +465,7 @@ func (w *deploymentWatcher) watch() {
 	allocsCh := w.getAllocsCh(allocIndex)
 	var updates *allocUpdates
 
-	rollback, deadlineHit := false, false
+	rollback, deadlineHit, analysisFailed := false, false, false
 
 FAIL:
 	for {
@@ -549,6 +581,32 @@ FAIL:
 
 			// only start a new blocking query if we haven't returned early
 			allocsCh = w.getAllocsCh(allocIndex)
+
+		case res := <-w.analysisCh:
+			// The canary analysis of a task group has finished, so either
+			// fail the deployment or promote it once all analyses passed.
+			// Results are ignored if the deployment was promoted or stopped
+			// while the analysis ran.
+			d := w.getDeployment()
+			if !d.Active() || !d.RequiresPromotion() {
+				continue
+			}
+			if res.err == nil {
+				if err := w.canaryAnalysisPassed(d, res); err != nil {
+					w.logger.Error("failed to promote deployment after canary analysis", "error", err)
+				}
+				continue
+			}
+
+			w.logger.Debug("canary analysis failed", "task_group", res.group,
+				"checks", res.checks, "failures", res.failures, "error", res.err)
+			analysisFailed = true
+			rollback = res.autoRevert
+			err := w.nextRegion(structs.DeploymentStatusFailed)
+			if err != nil {
+				w.logger.Error("multiregion deployment error", "error", err)
+			}
+			break FAIL
 		}
 	}
 
@@ -557,6 +615,9 @@ FAIL:
 	if deadlineHit {
 		desc = structs.DeploymentStatusDescriptionProgressDeadline
 	}
+	if analysisFailed {
+		desc = structs.DeploymentStatusDescriptionFailedAnalysis
+	}
 
 	// Rollback to the old job if necessary
 	var j *structs.Job
@@ -6,6 +6,8 @@ package deploymentwatcher
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/http"
 	"sync"
 	"time"
 
@@ -57,6 +59,14 @@ type DeploymentRaftEndpoints interface {
 	UpdateAllocDesiredTransition(req *structs.AllocUpdateDesiredTransitionRequest) (uint64, error)
 }
 
+// VariableReader is used to read the items of the variables checked by the
+// canary analysis of deployments.
+type VariableReader interface {
+	// ReadVariable returns the decrypted items of the variable, or nil if the
+	// variable doesn't exist.
+	ReadVariable(namespace, path string) (map[string]string, error)
+}
+
 // Watcher is used to watch deployments and their allocations created
 // by the scheduler and trigger the scheduler when allocation health
 // transitions.
@@ -75,6 +85,13 @@ type Watcher struct {
 	// deployments watcher
 	raft DeploymentRaftEndpoints
 
+	// variables is used to read the variables checked by canary analysis
+	variables VariableReader
+
+	// analysisClient is used to query the HTTP checks of canary analysis. It
+	// is nil if HTTP checks are disabled.
+	analysisClient *http.Client
+
 	// state is the state that is watched for state changes.
 	state *state.StateStore
 
@@ -102,6 +119,8 @@ type Watcher struct {
 // deployments and trigger the scheduler as needed.
 func NewDeploymentsWatcher(logger log.Logger,
 	raft DeploymentRaftEndpoints,
+	variables VariableReader,
+	analysisAllowlist []*net.IPNet,
 	deploymentRPC DeploymentRPC, jobRPC JobRPC,
 	stateQueriesPerSecond float64,
 	updateBatchDuration time.Duration,
@@ -109,6 +128,8 @@ func NewDeploymentsWatcher(logger log.Logger,
 
 	return &Watcher{
 		raft:                raft,
+		variables:           variables,
+		analysisClient:      newCanaryAnalysisClient(analysisAllowlist),
 		deploymentRPC:       deploymentRPC,
 		jobRPC:              jobRPC,
 		queryLimiter:        rate.NewLimiter(rate.Limit(stateQueriesPerSecond), 100),
@@ -301,7 +322,7 @@ func (w *Watcher) addLocked(d *structs.Deployment) (*deploymentWatcher, error) {
 	}
 
 	watcher := newDeploymentWatcher(w.ctx, w.queryLimiter, w.logger, w.state, d, job,
-		w, w.deploymentRPC, w.jobRPC)
+		w, w.variables, w.analysisClient, w.deploymentRPC, w.jobRPC)
 	w.watchers[d.ID] = watcher
 	return watcher, nil
 }
@@ -7,7 +7,11 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,7 +30,7 @@ import (
 
 func testDeploymentWatcher(t *testing.T, qps float64, batchDur time.Duration) (*Watcher, *mockBackend) {
 	m := newMockBackend(t)
-	w := NewDeploymentsWatcher(testlog.HCLogger(t), m, nil, nil, qps, batchDur)
+	w := NewDeploymentsWatcher(testlog.HCLogger(t), m, m, nil, nil, nil, qps, batchDur)
 	return w, m
 }
 
@@ -47,7 +51,7 @@ func logRecorderTestDeploymentWatcher(t *testing.T) (*Watcher, *mockBackend, fun
 		DisableTime:     true,
 	})
 	m := newMockBackend(t)
-	w := NewDeploymentsWatcher(logger, m, nil, nil, LimitStateQueriesPerSecond, CrossDeploymentUpdateBatchDuration)
+	w := NewDeploymentsWatcher(logger, m, m, nil, nil, nil, LimitStateQueriesPerSecond, CrossDeploymentUpdateBatchDuration)
 	return w, m, func() string {
 		bts, err := io.ReadAll(buf)
 		test.NoError(t, err)
@@ -664,6 +668,179 @@ func TestWatcher_AutoPromoteDeployment_UnhealthyCanaries(t *testing.T) {
 }
 
 // Test pausing a deployment that is running
+// setupCanaryAnalysisDeployment creates a job with a single task group running
+// the canary analysis, its deployment, and its healthy canaries.
+func setupCanaryAnalysisDeployment(t *testing.T, m *mockBackend, analysis *structs.CanaryAnalysis) (*structs.Job, *structs.Deployment) {
+	t.Helper()
+
+	upd := structs.DefaultUpdateStrategy.Copy()
+	upd.Canary = 1
+	upd.AutoRevert = true
+	upd.Analysis = analysis
+
+	j := mock.Job()
+	j.TaskGroups[0].Update = upd
+	j.Stable = false
+
+	d := mock.Deployment()
+	d.JobID = j.ID
+	d.TaskGroups["web"].DesiredCanaries = 1
+	d.TaskGroups["web"].AutoRevert = true
+
+	a := mock.Alloc()
+	a.Job = j
+	a.JobID = j.ID
+	a.DeploymentID = d.ID
+	a.DeploymentStatus = &structs.AllocDeploymentStatus{
+		Canary:  true,
+		Healthy: new(true),
+	}
+	d.TaskGroups["web"].PlacedCanaries = []string{a.ID}
+
+	must.NoError(t, m.state.UpsertJob(structs.MsgTypeTestSetup, m.nextIndex(), nil, j))
+	must.NoError(t, m.state.UpsertDeployment(m.nextIndex(), d))
+	must.NoError(t, m.state.UpsertAllocs(structs.MsgTypeTestSetup, m.nextIndex(), []*structs.Allocation{a}))
+	return j, d
+}
+
+func TestWatcher_CanaryAnalysis_Promote(t *testing.T) {
+	ci.Parallel(t)
+	m := newMockBackend(t)
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	w := NewDeploymentsWatcher(testlog.HCLogger(t), m, m, []*net.IPNet{loopback}, nil, nil,
+		LimitStateQueriesPerSecond, CrossDeploymentUpdateBatchDuration)
+
+	var queries atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		must.Eq(t, "Bearer token", r.Header.Get("Authorization"))
+		if queries.Add(1) == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	// The first failed query is tolerated by the failure limit.
+	_, d := setupCanaryAnalysisDeployment(t, m, &structs.CanaryAnalysis{
+		Duration:     300 * time.Millisecond,
+		Interval:     100 * time.Millisecond,
+		FailureLimit: 1,
+		HTTP: &structs.CanaryAnalysisHTTP{
+			URL:    srv.URL + "/query?q=errors",
+			Header: map[string]string{"Authorization": "Bearer token"},
+		},
+	})
+
+	w.SetEnabled(true, m.state)
+	waitForWatchers(t, w, 1)
+
+	must.Wait(t, wait.InitialSuccess(wait.ErrorFunc(func() error {
+		d, err := m.state.DeploymentByID(nil, d.ID)
+		if err != nil {
+			return err
+		}
+		if !d.TaskGroups["web"].Promoted {
+			return fmt.Errorf("expected task group to be promoted")
+		}
+		return nil
+	}),
+		wait.Gap(10*time.Millisecond), wait.Timeout(5*time.Second)))
+
+	must.Eq(t, 3, queries.Load())
+	m.assertCalls(t, "UpdateDeploymentPromotion", 1)
+
+	// The analysis start and pass are recorded as status updates.
+	m.assertCalls(t, "UpdateDeploymentStatus", 2)
+}
+
+func TestCanaryAnalysisClient(t *testing.T) {
+	ci.Parallel(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	must.Nil(t, newCanaryAnalysisClient(nil))
+
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	resp, err := newCanaryAnalysisClient([]*net.IPNet{loopback}).Get(srv.URL)
+	must.NoError(t, err)
+	resp.Body.Close()
+
+	_, private, _ := net.ParseCIDR("10.0.0.0/8")
+	_, err = newCanaryAnalysisClient([]*net.IPNet{private}).Get(srv.URL)
+	must.ErrorContains(t, err, "is not in the canary analysis HTTP allowlist")
+}
+
+func TestWatcher_CanaryAnalysis_HTTPDisabled(t *testing.T) {
+	ci.Parallel(t)
+	w, m := defaultTestDeploymentWatcher(t)
+
+	var queries atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		queries.Add(1)
+	}))
+	defer srv.Close()
+
+	_, d := setupCanaryAnalysisDeployment(t, m, &structs.CanaryAnalysis{
+		Duration: 10 * time.Second,
+		Interval: 50 * time.Millisecond,
+		HTTP:     &structs.CanaryAnalysisHTTP{URL: srv.URL},
+	})
+
+	w.SetEnabled(true, m.state)
+	waitForWatchers(t, w, 1)
+
+	must.Wait(t, wait.InitialSuccess(wait.ErrorFunc(func() error {
+		d, err := m.state.DeploymentByID(nil, d.ID)
+		if err != nil {
+			return err
+		}
+		if d.Status != structs.DeploymentStatusFailed {
+			return fmt.Errorf("expected deployment to be failed, got %q", d.Status)
+		}
+		return nil
+	}),
+		wait.Gap(10*time.Millisecond), wait.Timeout(5*time.Second)))
+
+	must.Eq(t, 0, queries.Load())
+}
+
+func TestWatcher_CanaryAnalysis_Fail(t *testing.T) {
+	ci.Parallel(t)
+	w, m := defaultTestDeploymentWatcher(t)
+
+	j, d := setupCanaryAnalysisDeployment(t, m, &structs.CanaryAnalysis{
+		Duration: 10 * time.Second,
+		Interval: 50 * time.Millisecond,
+		Variable: &structs.CanaryAnalysisVariable{
+			Path: "metrics/web",
+			Key:  "error_rate",
+			Max:  new(0.05),
+		},
+	})
+	m.setVariable(j.Namespace, "metrics/web", map[string]string{"error_rate": "0.2"})
+
+	w.SetEnabled(true, m.state)
+	waitForWatchers(t, w, 1)
+
+	must.Wait(t, wait.InitialSuccess(wait.ErrorFunc(func() error {
+		d, err := m.state.DeploymentByID(nil, d.ID)
+		if err != nil {
+			return err
+		}
+		if d.Status != structs.DeploymentStatusFailed {
+			return fmt.Errorf("expected deployment to be failed, got %q", d.Status)
+		}
+		if !strings.HasPrefix(d.StatusDescription, structs.DeploymentStatusDescriptionFailedAnalysis) {
+			return fmt.Errorf("unexpected status description %q", d.StatusDescription)
+		}
+		return nil
+	}),
+		wait.Gap(10*time.Millisecond), wait.Timeout(5*time.Second)))
+
+	m.assertCalls(t, "UpdateDeploymentPromotion", 0)
+	m.assertCalls(t, "ReadVariable", 1)
+}
+
 func TestWatcher_PauseDeployment_Pause_Running(t *testing.T) {
 	ci.Parallel(t)
 	w, m := defaultTestDeploymentWatcher(t)
@@ -13,17 +13,19 @@ import (
 )
 
 type mockBackend struct {
-	index uint64
-	state *state.StateStore
-	l     sync.Mutex
-	calls map[string]int
+	index     uint64
+	state     *state.StateStore
+	l         sync.Mutex
+	calls     map[string]int
+	variables map[string]map[string]string
 }
 
 func newMockBackend(t *testing.T) *mockBackend {
 	m := &mockBackend{
-		index: 10000,
-		state: state.TestStateStore(t),
-		calls: map[string]int{},
+		index:     10000,
+		state:     state.TestStateStore(t),
+		calls:     map[string]int{},
+		variables: map[string]map[string]string{},
 	}
 	return m
 }
@@ -79,3 +81,16 @@ func (m *mockBackend) UpdateDeploymentAllocHealth(req *structs.ApplyDeploymentAl
 	i := m.nextIndex()
 	return i, m.state.UpdateDeploymentAllocHealth(structs.MsgTypeTestSetup, i, req)
 }
+
+func (m *mockBackend) setVariable(namespace, path string, items map[string]string) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	m.variables[namespace+"/"+path] = items
+}
+
+func (m *mockBackend) ReadVariable(namespace, path string) (map[string]string, error) {
+	m.trackCall("ReadVariable")
+	m.l.Lock()
+	defer m.l.Unlock()
+	return m.variables[namespace+"/"+path], nil
+}
@@ -8,8 +8,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"math/rand"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,6 +31,11 @@ const (
 	// they've reached the deliveryLimit. This allows the leader to
 	// set the status to failed.
 	failedQueue = "_failed"
+
+	// fairShareUsageHalfLife is the half life of the per-namespace usage
+	// tracked for fair-share queueing. Usage older than a few half lives has
+	// little influence on the dequeue order.
+	fairShareUsageHalfLife = 5 * time.Minute
 )
 
 var (
@@ -74,8 +83,21 @@ type EvalBroker struct {
 	// now safe for the Eval.Ack RPC to cancel in batches
 	cancelable []*structs.Evaluation
 
-	// ready tracks the ready jobs by scheduler in a priority queue
-	ready map[string]ReadyEvaluations
+	// ready tracks the ready jobs by scheduler and namespace in priority
+	// queues
+	ready map[string]map[string]ReadyEvaluations
+
+	// fairShare is the fair-share queueing configuration, which controls
+	// the order in which namespaces are dequeued from
+	fairShare structs.FairShareConfig
+
+	// usage tracks the recent number of dequeued evaluations by namespace
+	usage map[string]*namespaceUsage
+
+	// readySeq tracks the order in which ready evaluations were enqueued, so
+	// that evaluations in different ready queues can be dequeued FIFO
+	readySeq   map[string]uint64
+	enqueueSeq uint64
 
 	// unack is a map of evalID to an un-acknowledged evaluation
 	unack map[string]*unackEval
@@ -128,6 +150,25 @@ type unackEval struct {
 	NackTimer *time.Timer
 }
 
+// namespaceUsage is an exponentially decaying count of the evaluations
+// dequeued for a namespace.
+type namespaceUsage struct {
+	value   float64
+	updated time.Time
+}
+
+// valueAt returns the usage decayed to the given time.
+func (u *namespaceUsage) valueAt(now time.Time) float64 {
+	if u == nil {
+		return 0
+	}
+	elapsed := now.Sub(u.updated)
+	if elapsed <= 0 {
+		return u.value
+	}
+	return u.value * math.Exp2(-elapsed.Seconds()/fairShareUsageHalfLife.Seconds())
+}
+
 // ReadyEvaluations is a list of ready evaluations across multiple jobs. We
 // implement the container/heap interface so that this is a priority queue.
 type ReadyEvaluations []*structs.Evaluation
@@ -157,7 +198,9 @@ func NewEvalBroker(ctx context.Context, timeout, initialNackDelay, subsequentNac
 		jobEvals:             make(map[structs.NamespacedID]string),
 		pending:              make(map[structs.NamespacedID]PendingEvaluations),
 		cancelable:           make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest),
-		ready:                make(map[string]ReadyEvaluations),
+		ready:                make(map[string]map[string]ReadyEvaluations),
+		usage:                make(map[string]*namespaceUsage),
+		readySeq:             make(map[string]uint64),
 		unack:                make(map[string]*unackEval),
 		waiting:              make(map[string]chan struct{}),
 		requeue:              make(map[string]*structs.Evaluation),
@@ -170,6 +213,7 @@ func NewEvalBroker(ctx context.Context, timeout, initialNackDelay, subsequentNac
 		delayedEvalsUpdateCh: make(chan struct{}, 1),
 	}
 	b.stats.ByScheduler = make(map[string]*SchedulerStats)
+	b.stats.ByNamespace = make(map[string]*NamespaceStats)
 	b.stats.DelayedEvals = make(map[string]*structs.Evaluation)
 
 	return b, nil
@@ -210,6 +254,17 @@ func (b *EvalBroker) SetEnabled(enabled bool) {
 	b.enabledNotifier.Notify("eval broker enabled status changed to " + strconv.FormatBool(enabled))
 }
 
+// SetFairShareConfig is used to update the fair-share queueing configuration
+// of the broker. The recent usage of each namespace is retained across
+// updates.
+func (b *EvalBroker) SetFairShareConfig(config structs.FairShareConfig) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	config.Weights = maps.Clone(config.Weights)
+	b.fairShare = config
+}
+
 // Enqueue is used to enqueue a new evaluation
 func (b *EvalBroker) Enqueue(eval *structs.Evaluation) {
 	b.l.Lock()
@@ -348,18 +403,25 @@ func (b *EvalBroker) enqueueLocked(eval *structs.Evaluation, sched string, track
 		return
 	}
 
-	// Find the next ready eval by scheduler class
-	readyQueue, ok := b.ready[sched]
+	// Find the next ready eval by scheduler class and namespace
+	readyQueues, ok := b.ready[sched]
 	if !ok {
-		readyQueue = make([]*structs.Evaluation, 0, 16)
+		readyQueues = make(map[string]ReadyEvaluations)
+		b.ready[sched] = readyQueues
 		if _, ok := b.waiting[sched]; !ok {
 			b.waiting[sched] = make(chan struct{}, 1)
 		}
 	}
+	readyQueue, ok := readyQueues[eval.Namespace]
+	if !ok {
+		readyQueue = make([]*structs.Evaluation, 0, 16)
+	}
 
 	// Push onto the heap
 	heap.Push(&readyQueue, eval)
-	b.ready[sched] = readyQueue
+	readyQueues[eval.Namespace] = readyQueue
+	b.enqueueSeq++
+	b.readySeq[eval.ID] = b.enqueueSeq
 
 	// Update the stats
 	b.stats.TotalReady += 1
@@ -369,6 +431,7 @@ func (b *EvalBroker) enqueueLocked(eval *structs.Evaluation, sched string, track
 		b.stats.ByScheduler[sched] = bySched
 	}
 	bySched.Ready += 1
+	b.namespaceStats(eval.Namespace).Ready += 1
 
 	// Unblock any pending dequeues
 	select {
@@ -441,18 +504,17 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 		return nil, "", fmt.Errorf("eval broker disabled")
 	}
 
+	if b.fairShare.Enabled {
+		return b.scanFairShare(schedulers)
+	}
+
 	// Scan for eligible work
 	var eligibleSched []string
+	var eligibleNamespaces []string
 	var eligiblePriority int
 	for _, sched := range schedulers {
-		// Get the ready queue for this scheduler
-		readyQueue, ok := b.ready[sched]
-		if !ok {
-			continue
-		}
-
-		// Peek at the next item
-		ready := readyQueue.Peek()
+		// Peek at the next item across the namespaces of this scheduler
+		namespace, ready := b.peekSched(sched, "")
 		if ready == nil {
 			continue
 		}
@@ -460,6 +522,7 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 		// Add to eligible if equal or greater priority
 		if len(eligibleSched) == 0 || ready.Priority > eligiblePriority {
 			eligibleSched = []string{sched}
+			eligibleNamespaces = []string{namespace}
 			eligiblePriority = ready.Priority
 
 		} else if eligiblePriority > ready.Priority {
@@ -467,34 +530,139 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 
 		} else if eligiblePriority == ready.Priority {
 			eligibleSched = append(eligibleSched, sched)
+			eligibleNamespaces = append(eligibleNamespaces, namespace)
+		}
+	}
+
+	return b.dequeueEligible(eligibleSched, eligibleNamespaces)
+}
+
+// scanFairShare scans for work on any of the schedulers using the fair-share
+// queues. The namespace with the lowest usage relative to its weight is
+// dequeued from first, and the highest priority work within that namespace
+// is dequeued first. This must be called with the lock held.
+func (b *EvalBroker) scanFairShare(schedulers []string) (*structs.Evaluation, string, error) {
+	now := time.Now()
+
+	// Find the namespace furthest below its share, breaking ties using the
+	// usual priority and FIFO order of the next evaluation.
+	var namespace string
+	var next *structs.Evaluation
+	var nextUsage float64
+	for _, sched := range schedulers {
+		for ns, readyQueue := range b.ready[sched] {
+			ready := readyQueue.Peek()
+			if ready == nil {
+				continue
+			}
+
+			usage := b.usage[ns].valueAt(now) / float64(b.fairShare.WeightFor(ns))
+			switch {
+			case next == nil, usage < nextUsage:
+			case usage == nextUsage && b.readyBefore(ready, next):
+			default:
+				continue
+			}
+			namespace, next, nextUsage = ns, ready, usage
+		}
+	}
+	if next == nil {
+		// No work to do!
+		return nil, "", nil
+	}
+
+	// Scan for eligible work within the namespace
+	var eligibleSched []string
+	var eligibleNamespaces []string
+	var eligiblePriority int
+	for _, sched := range schedulers {
+		_, ready := b.peekSched(sched, namespace)
+		if ready == nil {
+			continue
+		}
+
+		if len(eligibleSched) == 0 || ready.Priority > eligiblePriority {
+			eligibleSched = []string{sched}
+			eligibleNamespaces = []string{namespace}
+			eligiblePriority = ready.Priority
+
+		} else if eligiblePriority == ready.Priority {
+			eligibleSched = append(eligibleSched, sched)
+			eligibleNamespaces = append(eligibleNamespaces, namespace)
 		}
 	}
 
+	return b.dequeueEligible(eligibleSched, eligibleNamespaces)
+}
+
+// dequeueEligible dequeues from one of the eligible scheduler and namespace
+// pairs. This assumes locks are held.
+func (b *EvalBroker) dequeueEligible(scheds, namespaces []string) (*structs.Evaluation, string, error) {
 	// Determine behavior based on eligible work
-	switch n := len(eligibleSched); n {
+	switch n := len(scheds); n {
 	case 0:
 		// No work to do!
 		return nil, "", nil
 
 	case 1:
 		// Only a single task, dequeue
-		return b.dequeueForSched(eligibleSched[0])
+		return b.dequeueForSched(scheds[0], namespaces[0])
 
 	default:
 		// Multiple tasks. We pick a random task so that we fairly
 		// distribute work.
 		offset := rand.Intn(n)
-		return b.dequeueForSched(eligibleSched[offset])
+		return b.dequeueForSched(scheds[offset], namespaces[offset])
+	}
+}
+
+// peekSched returns the next evaluation that would be dequeued for the
+// scheduler, along with its namespace. If namespace is set, only the ready
+// queue of that namespace is considered. This assumes locks are held.
+func (b *EvalBroker) peekSched(sched, namespace string) (string, *structs.Evaluation) {
+	if namespace != "" {
+		return namespace, b.ready[sched][namespace].Peek()
+	}
+
+	var next *structs.Evaluation
+	for ns, readyQueue := range b.ready[sched] {
+		ready := readyQueue.Peek()
+		if ready == nil {
+			continue
+		}
+		if next == nil || b.readyBefore(ready, next) {
+			namespace, next = ns, ready
+		}
 	}
+	return namespace, next
 }
 
-// dequeueForSched is used to dequeue the next work item for a given scheduler.
-// This assumes locks are held and that this scheduler has work
-func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string, error) {
-	readyQueue := b.ready[sched]
+// readyBefore returns whether the ready evaluation x should be dequeued
+// before y when they belong to different ready queues. This assumes locks are
+// held.
+func (b *EvalBroker) readyBefore(x, y *structs.Evaluation) bool {
+	if x.Priority != y.Priority {
+		return x.Priority > y.Priority
+	}
+	if x.CreateIndex != y.CreateIndex {
+		return x.CreateIndex < y.CreateIndex
+	}
+	return b.readySeq[x.ID] < b.readySeq[y.ID]
+}
+
+// dequeueForSched is used to dequeue the next work item for a given scheduler
+// and namespace. This assumes locks are held and that this scheduler has work
+func (b *EvalBroker) dequeueForSched(sched, namespace string) (*structs.Evaluation, string, error) {
+	readyQueues := b.ready[sched]
+	readyQueue := readyQueues[namespace]
 	raw := heap.Pop(&readyQueue)
-	b.ready[sched] = readyQueue
+	if len(readyQueue) > 0 {
+		readyQueues[namespace] = readyQueue
+	} else {
+		delete(readyQueues, namespace)
+	}
 	eval := raw.(*structs.Evaluation)
+	delete(b.readySeq, eval.ID)
 
 	// Generate a UUID for the token
 	token := uuid.Generate()
@@ -520,10 +688,45 @@ func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string,
 	bySched := b.stats.ByScheduler[sched]
 	bySched.Ready -= 1
 	bySched.Unacked += 1
+	byNamespace := b.namespaceStats(eval.Namespace)
+	byNamespace.Ready -= 1
+	byNamespace.Unacked += 1
+
+	// Track the usage of the namespace for fair-share queueing
+	now := time.Now()
+	usage, ok := b.usage[eval.Namespace]
+	if !ok {
+		usage = &namespaceUsage{}
+		b.usage[eval.Namespace] = usage
+	}
+	usage.value = usage.valueAt(now) + 1
+	usage.updated = now
 
 	return eval, token, nil
 }
 
+// namespaceStats returns the stats of the namespace, creating them if
+// necessary. This assumes locks are held.
+func (b *EvalBroker) namespaceStats(namespace string) *NamespaceStats {
+	byNamespace, ok := b.stats.ByNamespace[namespace]
+	if !ok {
+		byNamespace = &NamespaceStats{}
+		b.stats.ByNamespace[namespace] = byNamespace
+	}
+	return byNamespace
+}
+
+// unackNamespaceStats decrements the unacked evaluations of the namespace
+// and removes the namespace stats once it has no more evaluations. This
+// assumes locks are held.
+func (b *EvalBroker) unackNamespaceStats(namespace string) {
+	byNamespace := b.namespaceStats(namespace)
+	byNamespace.Unacked -= 1
+	if byNamespace.Ready == 0 && byNamespace.Unacked == 0 {
+		delete(b.stats.ByNamespace, namespace)
+	}
+}
+
 // waitForSchedulers is used to wait for work on any of the scheduler or until a timeout.
 // Returns if there is work waiting potentially.
 func (b *EvalBroker) waitForSchedulers(schedulers []string, timeoutCh <-chan time.Time) bool {
@@ -629,6 +832,7 @@ func (b *EvalBroker) Ack(evalID, token string) error {
 	}
 	bySched := b.stats.ByScheduler[queue]
 	bySched.Unacked -= 1
+	b.unackNamespaceStats(unack.Eval.Namespace)
 
 	// Cleanup
 	delete(b.unack, evalID)
@@ -703,6 +907,7 @@ func (b *EvalBroker) Nack(evalID, token string) error {
 	b.stats.TotalUnacked -= 1
 	bySched := b.stats.ByScheduler[unack.Eval.Type]
 	bySched.Unacked -= 1
+	b.unackNamespaceStats(unack.Eval.Namespace)
 
 	// Check if we've hit the delivery limit, and re-enqueue
 	// in the failedQueue
@@ -852,11 +1057,14 @@ func (b *EvalBroker) flush() {
 	b.stats.TotalCancelable = 0
 	b.stats.DelayedEvals = make(map[string]*structs.Evaluation)
 	b.stats.ByScheduler = make(map[string]*SchedulerStats)
+	b.stats.ByNamespace = make(map[string]*NamespaceStats)
 	b.evals = make(map[string]int)
 	b.jobEvals = make(map[structs.NamespacedID]string)
 	b.pending = make(map[structs.NamespacedID]PendingEvaluations)
 	b.cancelable = make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest)
-	b.ready = make(map[string]ReadyEvaluations)
+	b.ready = make(map[string]map[string]ReadyEvaluations)
+	b.usage = make(map[string]*namespaceUsage)
+	b.readySeq = make(map[string]uint64)
 	b.unack = make(map[string]*unackEval)
 	b.timeWait = make(map[string]*time.Timer)
 	b.delayHeap = delayheap.NewDelayHeap()
@@ -941,6 +1149,7 @@ func (b *EvalBroker) Stats() *BrokerStats {
 	stats := new(BrokerStats)
 	stats.DelayedEvals = make(map[string]*structs.Evaluation)
 	stats.ByScheduler = make(map[string]*SchedulerStats)
+	stats.ByNamespace = make(map[string]*NamespaceStats)
 
 	b.l.RLock()
 	defer b.l.RUnlock()
@@ -959,9 +1168,58 @@ func (b *EvalBroker) Stats() *BrokerStats {
 		subStatCopy := *subStat
 		stats.ByScheduler[sched] = &subStatCopy
 	}
+	for ns, subStat := range b.stats.ByNamespace {
+		subStatCopy := *subStat
+		stats.ByNamespace[ns] = &subStatCopy
+	}
 	return stats
 }
 
+// SchedulerQueues returns whether fair-share queueing is enabled along with
+// the queue state of each namespace that has evaluations in the broker or has
+// recently had evaluations dequeued.
+func (b *EvalBroker) SchedulerQueues() (bool, []*structs.SchedulerQueue) {
+	b.l.RLock()
+	defer b.l.RUnlock()
+
+	now := time.Now()
+	queues := make(map[string]*structs.SchedulerQueue)
+	queue := func(ns string) *structs.SchedulerQueue {
+		q, ok := queues[ns]
+		if !ok {
+			q = &structs.SchedulerQueue{
+				Namespace: ns,
+				Weight:    b.fairShare.WeightFor(ns),
+				Usage:     b.usage[ns].valueAt(now),
+			}
+			queues[ns] = q
+		}
+		return q
+	}
+
+	for ns, subStat := range b.stats.ByNamespace {
+		q := queue(ns)
+		q.Ready = subStat.Ready
+		q.Unacked = subStat.Unacked
+	}
+	for ns, usage := range b.usage {
+		// Skip namespaces whose usage has decayed to nothing
+		if usage.valueAt(now) < 0.01 {
+			continue
+		}
+		queue(ns)
+	}
+
+	out := make([]*structs.SchedulerQueue, 0, len(queues))
+	for _, q := range queues {
+		out = append(out, q)
+	}
+	slices.SortFunc(out, func(a, b *structs.SchedulerQueue) int {
+		return strings.Compare(a.Namespace, b.Namespace)
+	})
+	return b.fairShare.Enabled, out
+}
+
 // Cancelable retrieves a batch of previously-pending evaluations that are now
 // stale and ready to mark for canceling. The eval RPC will call this with a
 // batch size set to avoid sending overly large raft messages.
@@ -1024,6 +1282,7 @@ type BrokerStats struct {
 	TotalCancelable int
 	DelayedEvals    map[string]*structs.Evaluation
 	ByScheduler     map[string]*SchedulerStats
+	ByNamespace     map[string]*NamespaceStats
 }
 
 // SchedulerStats returns the stats per scheduler
@@ -1032,6 +1291,12 @@ type SchedulerStats struct {
 	Unacked int
 }
 
+// NamespaceStats returns the stats per namespace
+type NamespaceStats struct {
+	Ready   int
+	Unacked int
+}
+
 // Len is for the sorting interface
 func (r ReadyEvaluations) Len() int {
 	return len(r)
@@ -1068,11 +1333,10 @@ func (r *ReadyEvaluations) Pop() any {
 
 // Peek is used to peek at the next element that would be popped
 func (r ReadyEvaluations) Peek() *structs.Evaluation {
-	n := len(r)
-	if n == 0 {
+	if len(r) == 0 {
 		return nil
 	}
-	return r[n-1]
+	return r[0]
 }
 
 // Len is for the sorting interface
@@ -436,6 +436,7 @@ func TestEvalBroker_Serialize_DuplicateJobID(t *testing.T) {
 		stats := b.Stats()
 		stats.DelayedEvals = nil
 		stats.ByScheduler = nil
+		stats.ByNamespace = nil
 		return *stats
 	}
 
@@ -688,6 +689,37 @@ func TestEvalBroker_Dequeue_Priority(t *testing.T) {
 	}
 }
 
+// Ensure the highest priority evaluation is dequeued across schedulers when
+// fair-share is disabled
+func TestEvalBroker_Dequeue_PriorityAcrossSchedulers(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+
+	enqueue := func(sched string, priority int) *structs.Evaluation {
+		eval := mock.Eval()
+		eval.Type = sched
+		eval.Priority = priority
+		b.Enqueue(eval)
+		return eval
+	}
+
+	// the next evaluation of each scheduler is compared, regardless of the
+	// order in which the lower priority evaluations were enqueued
+	service10 := enqueue(structs.JobTypeService, 10)
+	batch30 := enqueue(structs.JobTypeBatch, 30)
+	service50 := enqueue(structs.JobTypeService, 50)
+	batch20 := enqueue(structs.JobTypeBatch, 20)
+	service40 := enqueue(structs.JobTypeService, 40)
+
+	schedulers := []string{structs.JobTypeService, structs.JobTypeBatch}
+	for _, expected := range []*structs.Evaluation{service50, service40, batch30, batch20, service10} {
+		out, _, err := b.Dequeue(schedulers, time.Second)
+		must.NoError(t, err)
+		must.Eq(t, expected, out)
+	}
+}
+
 // Ensure FIFO at fixed priority
 func TestEvalBroker_Dequeue_FIFO(t *testing.T) {
 	ci.Parallel(t)
@@ -752,6 +784,143 @@ func TestEvalBroker_Dequeue_Fairness(t *testing.T) {
 	}
 }
 
+// Ensure fair-share queueing across namespaces
+func TestEvalBroker_Dequeue_FairShare(t *testing.T) {
+	ci.Parallel(t)
+
+	newEval := func(ns string, index uint64) *structs.Evaluation {
+		eval := mock.Eval()
+		eval.Namespace = ns
+		eval.CreateIndex = index
+		eval.ModifyIndex = index
+		return eval
+	}
+
+	t.Run("disabled dequeues FIFO", func(t *testing.T) {
+		b := testBroker(t, 0)
+		b.SetEnabled(true)
+
+		for i := 1; i <= 10; i++ {
+			b.Enqueue(newEval("flood", uint64(i)))
+		}
+		b.Enqueue(newEval("small", 11))
+
+		for range 10 {
+			out, _, err := b.Dequeue(defaultSched, time.Second)
+			must.NoError(t, err)
+			must.Eq(t, "flood", out.Namespace)
+		}
+	})
+
+	t.Run("enabled interleaves namespaces", func(t *testing.T) {
+		b := testBroker(t, 0)
+		b.SetEnabled(true)
+		b.SetFairShareConfig(structs.FairShareConfig{Enabled: true})
+
+		for i := 1; i <= 10; i++ {
+			b.Enqueue(newEval("flood", uint64(i)))
+		}
+		b.Enqueue(newEval("small", 11))
+		b.Enqueue(newEval("small", 12))
+
+		var namespaces []string
+		for range 5 {
+			out, _, err := b.Dequeue(defaultSched, time.Second)
+			must.NoError(t, err)
+			namespaces = append(namespaces, out.Namespace)
+		}
+		must.Eq(t, []string{"flood", "small", "flood", "small", "flood"}, namespaces)
+	})
+
+	t.Run("enabled respects weights", func(t *testing.T) {
+		b := testBroker(t, 0)
+		b.SetEnabled(true)
+		b.SetFairShareConfig(structs.FairShareConfig{
+			Enabled: true,
+			Weights: map[string]int{"heavy": 3},
+		})
+
+		for i := 1; i <= 40; i++ {
+			b.Enqueue(newEval("heavy", uint64(i)))
+			b.Enqueue(newEval("light", uint64(100+i)))
+		}
+
+		counts := map[string]int{}
+		for range 20 {
+			out, _, err := b.Dequeue(defaultSched, time.Second)
+			must.NoError(t, err)
+			counts[out.Namespace]++
+		}
+		must.Between(t, 14, counts["heavy"], 16)
+		must.Between(t, 4, counts["light"], 6)
+	})
+
+	t.Run("priority within namespace", func(t *testing.T) {
+		b := testBroker(t, 0)
+		b.SetEnabled(true)
+		b.SetFairShareConfig(structs.FairShareConfig{Enabled: true})
+
+		low := newEval("default", 1)
+		low.Priority = 10
+		high := newEval("default", 2)
+		high.Priority = 90
+		b.Enqueue(low)
+		b.Enqueue(high)
+
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		must.Eq(t, high.ID, out.ID)
+	})
+}
+
+func TestEvalBroker_SchedulerQueues(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+	b.SetFairShareConfig(structs.FairShareConfig{
+		Enabled: true,
+		Weights: map[string]int{"one": 2},
+	})
+
+	for _, ns := range []string{"one", "one", "two"} {
+		eval := mock.Eval()
+		eval.Namespace = ns
+		b.Enqueue(eval)
+	}
+
+	out, token, err := b.Dequeue(defaultSched, time.Second)
+	must.NoError(t, err)
+	must.Eq(t, "one", out.Namespace)
+
+	enabled, queues := b.SchedulerQueues()
+	must.True(t, enabled)
+	must.Len(t, 2, queues)
+
+	must.Eq(t, "one", queues[0].Namespace)
+	must.Eq(t, 2, queues[0].Weight)
+	must.Eq(t, 1, queues[0].Ready)
+	must.Eq(t, 1, queues[0].Unacked)
+	must.Greater(t, 0.99, queues[0].Usage)
+
+	must.Eq(t, "two", queues[1].Namespace)
+	must.Eq(t, 1, queues[1].Weight)
+	must.Eq(t, 1, queues[1].Ready)
+	must.Eq(t, 0, queues[1].Unacked)
+	must.Eq(t, 0, queues[1].Usage)
+
+	// Acking the eval removes it from the unacked count but keeps the
+	// namespace's usage.
+	must.NoError(t, b.Ack(out.ID, token))
+	_, queues = b.SchedulerQueues()
+	must.Len(t, 2, queues)
+	must.Eq(t, 0, queues[0].Unacked)
+
+	// Disabling the broker resets the queues.
+	b.SetEnabled(false)
+	_, queues = b.SchedulerQueues()
+	must.Len(t, 0, queues)
+}
+
 // Ensure we get unblocked
 func TestEvalBroker_Dequeue_Blocked(t *testing.T) {
 	ci.Parallel(t)
@@ -1585,6 +1754,7 @@ func TestEvalBroker_IntegrationTest(t *testing.T) {
 		stats := srv.evalBroker.Stats()
 		stats.DelayedEvals = nil
 		stats.ByScheduler = nil
+		stats.ByNamespace = nil
 		return *stats
 	}
 
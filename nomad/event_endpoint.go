@@ -5,10 +5,14 @@ package nomad
 
 import (
 	"context"
+	"errors"
 	"io"
+	"net/http"
+	"strconv"
 	"sync/atomic"
 	"time"
 
+	metrics "github.com/hashicorp/go-metrics/compat"
 	"github.com/hashicorp/go-msgpack/v2/codec"
 
 	"github.com/hashicorp/nomad/acl"
@@ -17,12 +21,17 @@ import (
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
+// defaultEventReplayPerPage is the number of batches of events returned by
+// Event.Replay if the request doesn't set a page size.
+const defaultEventReplayPerPage = 100
+
 type Event struct {
 	srv *Server
+	ctx *RPCContext
 }
 
-func NewEventEndpoint(srv *Server) *Event {
-	return &Event{srv: srv}
+func NewEventEndpoint(srv *Server, ctx *RPCContext) *Event {
+	return &Event{srv: srv, ctx: ctx}
 }
 
 func (e *Event) register() {
@@ -73,18 +82,9 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 	var resolvedACLForFilter atomic.Value
 	resolvedACLForFilter.Store(resolvedACL)
 
-	var variableFilterFn func(structs.Event) bool
-	for topic := range args.Topics {
-		if topic == structs.TopicVariable || topic == structs.TopicAll {
-			variableFilterFn = func(event structs.Event) bool {
-				if event.Topic != structs.TopicVariable {
-					return true
-				}
-				return resolvedACLForFilter.Load().(*acl.ACL).AllowVariableOperation(event.Namespace, event.Key, acl.VariablesCapabilityList, nil)
-			}
-			break
-		}
-	}
+	variableFilterFn := variableFilter(args.Topics, func() *acl.ACL {
+		return resolvedACLForFilter.Load().(*acl.ACL)
+	})
 
 	// Generate the subscription request
 	subReq := &stream.SubscribeRequest{
@@ -95,6 +95,7 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 		// more NSes, the current event stream will not include the new NSes.
 		Namespaces: validatedNses,
 		FilterFn:   variableFilterFn,
+		Filter:     args.Filter,
 		Authenticate: func() error {
 			if err := e.srv.Authenticate(nil, &args); err != nil {
 				return err
@@ -122,7 +123,10 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 	var subErr error
 
 	subscription, subErr = publisher.Subscribe(subReq)
-	if subErr != nil {
+	if errors.Is(subErr, stream.ErrInvalidFilter) {
+		handleJsonResultError(subErr, new(int64(400)), encoder)
+		return
+	} else if subErr != nil {
 		handleJsonResultError(subErr, new(int64(500)), encoder)
 		return
 	}
@@ -222,6 +226,119 @@ OUTER:
 
 }
 
+// Replay returns a page of past events from the server's event journal and
+// in-memory buffer, starting at the requested index or time. Unlike Stream it
+// returns once it has caught up with the latest event.
+func (e *Event) Replay(args *structs.EventReplayRequest, reply *structs.EventReplayResponse) error {
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("Event.Replay", args, args, reply); done {
+		return err
+	}
+	e.srv.MeasureRPCRate("event", structs.RateMetricList, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event", "replay"}, time.Now())
+
+	resolvedACL, err := e.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	namespaces, err := e.validateACL(args.RequestNamespace(), args.Topics, resolvedACL)
+	if err != nil {
+		return err
+	}
+
+	index := args.Index
+	switch {
+	case args.NextToken != "":
+		index, err = strconv.ParseUint(args.NextToken, 10, 64)
+		if err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid next token %q", args.NextToken)
+		}
+	case args.StartTime != 0:
+		if e.srv.eventJournal == nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "event journal is not enabled on this server")
+		}
+		index, err = e.srv.eventJournal.IndexAtTime(time.Unix(0, args.StartTime))
+		if err != nil {
+			return err
+		}
+	}
+
+	broker, err := e.srv.State().EventBroker()
+	if err != nil {
+		return err
+	}
+
+	// Subscribing at index 0 starts at the head of the buffer, so start at
+	// index 1 to replay the whole journal instead.
+	sub, err := broker.Subscribe(&stream.SubscribeRequest{
+		Token:      args.AuthToken,
+		Index:      max(1, index),
+		Topics:     args.Topics,
+		Namespaces: namespaces,
+		FilterFn:   variableFilter(args.Topics, func() *acl.ACL { return resolvedACL }),
+		Filter:     args.Filter,
+	})
+	if errors.Is(err, stream.ErrInvalidFilter) {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "%v", err)
+	} else if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	perPage := int(args.PerPage)
+	if perPage <= 0 {
+		perPage = defaultEventReplayPerPage
+	}
+
+	reply.Events = []*structs.Events{}
+	for {
+		events, err := sub.NextNoBlock()
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		batch := &structs.Events{Index: events[0].Index, Events: events}
+		if len(reply.Events) == perPage {
+			reply.NextToken = strconv.FormatUint(batch.Index, 10)
+			break
+		}
+
+		// Events from the buffer are encoded like the journal's so replayed
+		// payloads have the same shape and sanitization as streamed ones.
+		batch, err = stream.JournalEvents(batch)
+		if err != nil {
+			return err
+		}
+		reply.Events = append(reply.Events, batch)
+	}
+
+	e.srv.setQueryMeta(&reply.QueryMeta)
+	return nil
+}
+
+// variableFilter returns a subscription FilterFn that excludes the variable
+// events that the ACL returned by aclFn can't list, or nil if the topics
+// can't include variable events.
+func variableFilter(topics map[structs.Topic][]string, aclFn func() *acl.ACL) func(structs.Event) bool {
+	for topic := range topics {
+		if topic == structs.TopicVariable || topic == structs.TopicAll {
+			return func(event structs.Event) bool {
+				if event.Topic != structs.TopicVariable {
+					return true
+				}
+				return aclFn().AllowVariableOperation(event.Namespace, event.Key, acl.VariablesCapabilityList, nil)
+			}
+		}
+	}
+	return nil
+}
+
 func (e *Event) forwardStreamingRPC(region string, method string, args any, in io.ReadWriteCloser) error {
 	server, err := e.srv.findRegionServer(region)
 	if err != nil {
@@ -287,6 +404,7 @@ func validateNsOp(namespace string, topics map[structs.Topic][]string, aclObj *a
 			structs.TopicEvaluation,
 			structs.TopicAllocation,
 			structs.TopicJob,
+			structs.TopicPreemption,
 			structs.TopicService:
 			if ok := aclObj.AllowNsOp(namespace, acl.NamespaceCapabilityReadJob); !ok {
 				return structs.ErrPermissionDenied
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
 	"github.com/stretchr/testify/require"
 )
 
@@ -991,3 +992,106 @@ OUTER:
 		},
 	}, got)
 }
+
+func TestEvent_Replay(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.EnableEventBroker = true
+		c.EnableEventJournal = true
+		c.EventBufferSize = 2
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	publisher, err := s1.State().EventBroker()
+	must.NoError(t, err)
+
+	for i := uint64(1001); i <= 1005; i++ {
+		publisher.Publish(&structs.Events{Index: i, Events: []structs.Event{{
+			Topic:     structs.TopicJob,
+			Key:       "example",
+			Namespace: structs.DefaultNamespace,
+			Index:     i,
+			Payload:   &structs.JobEvent{Job: &structs.Job{ID: "example", Version: i}},
+		}}})
+	}
+	node := mock.Node()
+	publisher.Publish(&structs.Events{Index: 1006, Events: []structs.Event{{
+		Topic:   structs.TopicNode,
+		Key:     node.ID,
+		Index:   1006,
+		Payload: &structs.NodeStreamEvent{Node: node},
+	}}})
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return s1.eventJournal.LastIndex() >= 1006 }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+
+	// Index 1002 has been dropped from the buffer and is read from the
+	// journal, one page at a time.
+	req := &structs.EventReplayRequest{
+		Topics: map[structs.Topic][]string{structs.TopicJob: {"example"}},
+		Index:  1002,
+		QueryOptions: structs.QueryOptions{
+			Region:    s1.Region(),
+			Namespace: structs.DefaultNamespace,
+			PerPage:   2,
+		},
+	}
+	var resp structs.EventReplayResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.Replay", req, &resp))
+	must.Len(t, 2, resp.Events)
+	must.Eq(t, 1002, resp.Events[0].Index)
+	must.Eq(t, 1003, resp.Events[1].Index)
+	must.Eq(t, "1004", resp.NextToken)
+
+	payload := resp.Events[0].Events[0].Payload.(map[string]any)
+	must.Eq(t, "example", payload["Job"].(map[string]any)["ID"])
+
+	req.NextToken = resp.NextToken
+	resp = structs.EventReplayResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.Replay", req, &resp))
+	must.Len(t, 2, resp.Events)
+	must.Eq(t, 1004, resp.Events[0].Index)
+	must.Eq(t, 1005, resp.Events[1].Index)
+	must.Eq(t, "", resp.NextToken)
+
+	// Events from the buffer are sanitized like streamed events.
+	req = &structs.EventReplayRequest{
+		Topics:       map[structs.Topic][]string{structs.TopicNode: {"*"}},
+		Index:        1006,
+		QueryOptions: structs.QueryOptions{Region: s1.Region()},
+	}
+	resp = structs.EventReplayResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.Replay", req, &resp))
+	must.Len(t, 1, resp.Events)
+	replayed := resp.Events[0].Events[0].Payload.(map[string]any)["Node"].(map[string]any)
+	must.Eq[any](t, node.ID, replayed["ID"])
+	must.NotEq[any](t, node.SecretID, replayed["SecretID"])
+
+	// An invalid filter is rejected.
+	req.Filter = "Payload.Node.ID =="
+	err = msgpackrpc.CallWithCodec(codec, "Event.Replay", req, &resp)
+	must.ErrorContains(t, err, "invalid filter expression")
+}
+
+func TestEvent_Replay_StartTimeRequiresJournal(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	req := &structs.EventReplayRequest{
+		Topics:       map[structs.Topic][]string{structs.TopicAll: {"*"}},
+		StartTime:    time.Now().Add(-time.Hour).UnixNano(),
+		QueryOptions: structs.QueryOptions{Region: s1.Region()},
+	}
+	var resp structs.EventReplayResponse
+	err := msgpackrpc.CallWithCodec(codec, "Event.Replay", req, &resp)
+	must.ErrorContains(t, err, "event journal is not enabled")
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// EventSink endpoint is used to manage the event sinks the leader delivers
+// events to. Sinks can receive events from every topic and namespace, and
+// webhook sinks may hold credentials in their headers, so all operations
+// require a management token.
+type EventSink struct {
+	srv *Server
+	ctx *RPCContext
+}
+
+func NewEventSinkEndpoint(srv *Server, ctx *RPCContext) *EventSink {
+	return &EventSink{srv: srv, ctx: ctx}
+}
+
+// List is used to retrieve all the event sinks.
+func (e *EventSink) List(args *structs.EventSinkListRequest, reply *structs.EventSinkListResponse) error {
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.List", args, args, reply); done {
+		return err
+	}
+	e.srv.MeasureRPCRate("event_sink", structs.RateMetricList, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "list"}, time.Now())
+
+	if aclObj, err := e.srv.ResolveACL(args); err != nil {
+		return err
+	} else if !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			iter, err := store.EventSinks(ws, state.SortOption(args.Reverse))
+			if err != nil {
+				return err
+			}
+
+			sinks := []*structs.EventSink{}
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				sink := raw.(*structs.EventSink)
+				if prefix := args.QueryOptions.Prefix; prefix != "" && !strings.HasPrefix(sink.ID, prefix) {
+					continue
+				}
+				sinks = append(sinks, sink)
+			}
+			reply.Sinks = sinks
+
+			// Use the last index that affected the event sinks table.
+			index, err := store.Index(state.TableEventSinks)
+			if err != nil {
+				return err
+			}
+			reply.Index = max(1, index)
+
+			e.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return e.srv.blockingRPC(&opts)
+}
+
+// GetSink returns the specific event sink requested or nil if the event sink
+// doesn't exist.
+func (e *EventSink) GetSink(args *structs.EventSinkSpecificRequest, reply *structs.SingleEventSinkResponse) error {
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.GetSink", args, args, reply); done {
+		return err
+	}
+	e.srv.MeasureRPCRate("event_sink", structs.RateMetricRead, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "get_sink"}, time.Now())
+
+	if aclObj, err := e.srv.ResolveACL(args); err != nil {
+		return err
+	} else if !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			sink, err := store.EventSinkByID(ws, args.ID)
+			if err != nil {
+				return err
+			}
+
+			reply.Sink = sink
+			if sink != nil {
+				reply.Index = sink.ModifyIndex
+			} else {
+				// Return the last index that affected the event sinks table
+				// if the requested event sink doesn't exist.
+				index, err := store.Index(state.TableEventSinks)
+				if err != nil {
+					return err
+				}
+				reply.Index = max(1, index)
+			}
+			return nil
+		}}
+	return e.srv.blockingRPC(&opts)
+}
+
+// Upsert creates or updates the given event sink. Updating a sink keeps its
+// delivery checkpoint.
+func (e *EventSink) Upsert(args *structs.EventSinkUpsertRequest, reply *structs.GenericResponse) error {
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.Upsert", args, args, reply); done {
+		return err
+	}
+	e.srv.MeasureRPCRate("event_sink", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "upsert"}, time.Now())
+
+	if aclObj, err := e.srv.ResolveACL(args); err != nil {
+		return err
+	} else if !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if !e.srv.peersCache.ServersMeetMinimumVersion(e.srv.Region(), minVersionEventSinks, true) {
+		return fmt.Errorf("all servers must be running version %v or later to upsert event sinks", minVersionEventSinks)
+	}
+
+	if args.Sink == nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify an event sink")
+	}
+	args.Sink.Canonicalize()
+	if err := args.Sink.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid event sink %q: %v", args.Sink.ID, err)
+	}
+
+	// The checkpoint is owned by the leader and can't be set by operators.
+	args.Sink.LatestIndex = 0
+
+	_, index, err := e.srv.raftApply(structs.EventSinkRegisterRequestType, args)
+	if err != nil {
+		return err
+	}
+	reply.Index = index
+	return nil
+}
+
+// Delete deletes the given event sinks.
+func (e *EventSink) Delete(args *structs.EventSinkDeleteRequest, reply *structs.GenericResponse) error {
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward("EventSink.Delete", args, args, reply); done {
+		return err
+	}
+	e.srv.MeasureRPCRate("event_sink", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_sink", "delete"}, time.Now())
+
+	if aclObj, err := e.srv.ResolveACL(args); err != nil {
+		return err
+	} else if !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if !e.srv.peersCache.ServersMeetMinimumVersion(e.srv.Region(), minVersionEventSinks, true) {
+		return fmt.Errorf("all servers must be running version %v or later to delete event sinks", minVersionEventSinks)
+	}
+
+	if len(args.IDs) == 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify at least one event sink to delete")
+	}
+	if slices.Contains(args.IDs, "") {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "event sink ID is empty")
+	}
+
+	_, index, err := e.srv.raftApply(structs.EventSinkDeregisterRequestType, args)
+	if err != nil {
+		return err
+	}
+	reply.Index = index
+	return nil
+}
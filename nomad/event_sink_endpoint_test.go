@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc/v2"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestEventSinkEndpoint_CRUD(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	// Invalid sinks are rejected.
+	invalid := mock.EventSink()
+	invalid.Webhook.Address = "ftp://example.com"
+	upsertReq := &structs.EventSinkUpsertRequest{
+		Sink:         invalid,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp)
+	must.ErrorContains(t, err, "must use the http or https scheme")
+
+	// Operators can't set the checkpoint.
+	sink := mock.EventSink()
+	sink.LatestIndex = 1234
+	upsertReq.Sink = sink
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp))
+	must.NonZero(t, upsertResp.Index)
+
+	getReq := &structs.EventSinkSpecificRequest{
+		ID:           sink.ID,
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var getResp structs.SingleEventSinkResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.GetSink", getReq, &getResp))
+	must.NotNil(t, getResp.Sink)
+	must.Eq(t, sink.Webhook.Address, getResp.Sink.Webhook.Address)
+	must.Eq(t, 0, getResp.Sink.LatestIndex)
+	must.Eq(t, upsertResp.Index, getResp.Index)
+
+	listReq := &structs.EventSinkListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var listResp structs.EventSinkListResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.List", listReq, &listResp))
+	must.Len(t, 1, listResp.Sinks)
+
+	listReq.Prefix = "nope"
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.List", listReq, &listResp))
+	must.Len(t, 0, listResp.Sinks)
+
+	deleteReq := &structs.EventSinkDeleteRequest{
+		IDs:          []string{sink.ID},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var deleteResp structs.GenericResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Delete", deleteReq, &deleteResp))
+
+	getResp = structs.SingleEventSinkResponse{}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.GetSink", getReq, &getResp))
+	must.Nil(t, getResp.Sink)
+
+	err = msgpackrpc.CallWithCodec(codec, "EventSink.Delete", deleteReq, &deleteResp)
+	must.ErrorContains(t, err, "not found")
+}
+
+func TestEventSinkEndpoint_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s, root, cleanupS := TestACLServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	operatorToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1001, "operator-write",
+		`operator { policy = "write" }`)
+
+	sink := mock.EventSink()
+	upsertReq := &structs.EventSinkUpsertRequest{
+		Sink:         sink,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.GenericResponse
+
+	// Sinks can read every topic, so even operator write isn't enough.
+	upsertReq.AuthToken = operatorToken.SecretID
+	err := msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	upsertReq.AuthToken = root.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.Upsert", upsertReq, &upsertResp))
+
+	listReq := &structs.EventSinkListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: operatorToken.SecretID,
+		},
+	}
+	var listResp structs.EventSinkListResponse
+	err = msgpackrpc.CallWithCodec(codec, "EventSink.List", listReq, &listResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	listReq.AuthToken = root.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "EventSink.List", listReq, &listResp))
+	must.Len(t, 1, listResp.Sinks)
+}
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// eventSinkShim implements the eventsink.CheckpointRaft interface required by
+// the event sink manager.
+type eventSinkShim struct {
+	s *Server
+}
+
+func (e eventSinkShim) UpdateEventSinkCheckpoints(checkpoints map[string]uint64) (uint64, error) {
+	args := &structs.EventSinkCheckpointRequest{
+		Checkpoints:  checkpoints,
+		WriteRequest: structs.WriteRequest{Region: e.s.config.Region},
+	}
+	_, index, err := e.s.raftApply(structs.EventSinkCheckpointRequestType, args)
+	return index, err
+}
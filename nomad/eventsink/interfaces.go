@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package eventsink
+
+// CheckpointRaft is a minimal interface of the Server used by the event sink
+// manager to persist delivery checkpoints. It avoids a circular reference
+// between the nomad package and the eventsink package.
+type CheckpointRaft interface {
+	// UpdateEventSinkCheckpoints records the latest index delivered to each
+	// of the given sinks and returns the raft index of the update.
+	UpdateEventSinkCheckpoints(checkpoints map[string]uint64) (uint64, error)
+}
@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package eventsink
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/stream"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+var (
+	// defaultCheckpointInterval is how often delivered indexes are written
+	// to raft. Events delivered after the last checkpoint are delivered
+	// again after a leader election.
+	defaultCheckpointInterval = 5 * time.Second
+
+	// deliveryBackoffBase and deliveryBackoffLimit bound the exponential
+	// backoff between failed deliveries of the same batch of events.
+	deliveryBackoffBase  = time.Second
+	deliveryBackoffLimit = time.Minute
+)
+
+// Manager runs on the leader and delivers events from the event broker to
+// every event sink registered in the state store. Delivery is at-least-once:
+// a batch is retried until it succeeds and the index of the last delivered
+// batch is periodically checkpointed to raft.
+type Manager struct {
+	enabled bool
+	logger  log.Logger
+
+	// raft is used to persist delivery checkpoints.
+	raft CheckpointRaft
+
+	// state is the state that is watched for event sink changes.
+	state *state.StateStore
+
+	// runners is the set of active sink runners, keyed by sink ID.
+	runners map[string]*sinkRunner
+
+	// checkpoints are the delivered indexes not yet written to raft. They
+	// are guarded by their own lock so runners can record checkpoints while
+	// the manager waits for them to stop.
+	checkpoints    map[string]uint64
+	checkpointLock sync.Mutex
+
+	checkpointInterval time.Duration
+
+	// ctx and exitFn are used to cancel the manager
+	ctx    context.Context
+	exitFn context.CancelFunc
+
+	lock sync.Mutex
+}
+
+// NewManager returns an event sink manager that is enabled on the leader.
+func NewManager(logger log.Logger, raft CheckpointRaft) *Manager {
+	ctx, exitFn := context.WithCancel(context.Background())
+
+	return &Manager{
+		logger:             logger.Named("event_sinks"),
+		raft:               raft,
+		checkpointInterval: defaultCheckpointInterval,
+		ctx:                ctx,
+		exitFn:             exitFn,
+	}
+}
+
+// SetEnabled is used to control if the manager is enabled. The manager
+// should only be enabled on the active leader. When being enabled the state
+// is passed in as it is no longer valid once a leader election has taken
+// place.
+func (m *Manager) SetEnabled(enabled bool, state *state.StateStore) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.enabled = enabled
+
+	if state != nil {
+		m.state = state
+	}
+
+	// Flushing cancels any running goroutines, so they are restarted even
+	// if the manager was already enabled.
+	m.flush()
+
+	if enabled {
+		go m.watchSinks(m.ctx)
+		go m.checkpointLoop(m.ctx)
+	}
+}
+
+// flush stops all the sink runners and clears the state of the manager.
+func (m *Manager) flush() {
+	if m.exitFn != nil {
+		m.exitFn()
+	}
+	for _, runner := range m.runners {
+		runner.stop()
+	}
+
+	m.runners = make(map[string]*sinkRunner)
+	m.checkpointLock.Lock()
+	m.checkpoints = make(map[string]uint64)
+	m.checkpointLock.Unlock()
+	m.ctx, m.exitFn = context.WithCancel(context.Background())
+}
+
+// watchSinks is the long lived go-routine that starts, restarts and stops
+// sink runners as sinks are registered, updated and deleted.
+func (m *Manager) watchSinks(ctx context.Context) {
+	index := uint64(1)
+	for {
+		resp, idx, err := m.state.BlockingQuery(m.getSinksImpl, index, ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			m.logger.Error("failed to retrieve event sinks", "error", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(deliveryBackoffBase):
+			}
+			continue
+		}
+
+		index = idx
+		m.reconcile(ctx, resp.([]*structs.EventSink))
+	}
+}
+
+// getSinksImpl retrieves all event sinks from the passed state store.
+func (m *Manager) getSinksImpl(ws memdb.WatchSet, store *state.StateStore) (any, uint64, error) {
+	iter, err := store.EventSinks(ws, state.SortDefault)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var sinks []*structs.EventSink
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		sinks = append(sinks, raw.(*structs.EventSink))
+	}
+
+	index, err := store.Index(state.TableEventSinks)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sinks, max(1, index), nil
+}
+
+// reconcile makes the set of running sink runners match the given sinks.
+// Runners are only restarted when the sink configuration changes, which
+// checkpoint updates don't do.
+func (m *Manager) reconcile(ctx context.Context, sinks []*structs.EventSink) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.enabled || ctx.Err() != nil {
+		return
+	}
+
+	seen := make(map[string]struct{}, len(sinks))
+	for _, sink := range sinks {
+		seen[sink.ID] = struct{}{}
+
+		existing, ok := m.runners[sink.ID]
+		if ok && existing.sink.ModifyIndex == sink.ModifyIndex {
+			continue
+		}
+		if ok {
+			existing.stop()
+		}
+
+		start := max(sink.LatestIndex, m.pendingCheckpoint(sink.ID))
+		if ok {
+			start = max(start, existing.delivered())
+		}
+
+		runner := newSinkRunner(ctx, m, sink.Copy(), start)
+		m.runners[sink.ID] = runner
+		go runner.run()
+	}
+
+	for id, runner := range m.runners {
+		if _, ok := seen[id]; !ok {
+			runner.stop()
+			delete(m.runners, id)
+
+			m.checkpointLock.Lock()
+			delete(m.checkpoints, id)
+			m.checkpointLock.Unlock()
+		}
+	}
+}
+
+// pendingCheckpoint returns the delivered index of the sink that hasn't been
+// written to raft yet.
+func (m *Manager) pendingCheckpoint(id string) uint64 {
+	m.checkpointLock.Lock()
+	defer m.checkpointLock.Unlock()
+	return m.checkpoints[id]
+}
+
+// checkpoint records that all events up to index have been delivered to the
+// sink. It is persisted to raft by the checkpoint loop.
+func (m *Manager) checkpoint(id string, index uint64) {
+	m.checkpointLock.Lock()
+	defer m.checkpointLock.Unlock()
+	m.checkpoints[id] = max(m.checkpoints[id], index)
+}
+
+// checkpointLoop periodically writes pending checkpoints to raft.
+func (m *Manager) checkpointLoop(ctx context.Context) {
+	ticker := time.NewTicker(m.checkpointInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.flushCheckpoints()
+		}
+	}
+}
+
+// flushCheckpoints writes the pending checkpoints to raft. On failure they
+// are kept so they can be retried on the next tick. Checkpoints for sinks
+// deleted in the meantime are ignored by the state store.
+func (m *Manager) flushCheckpoints() {
+	m.checkpointLock.Lock()
+	if len(m.checkpoints) == 0 {
+		m.checkpointLock.Unlock()
+		return
+	}
+	pending := m.checkpoints
+	m.checkpoints = make(map[string]uint64)
+	m.checkpointLock.Unlock()
+
+	if _, err := m.raft.UpdateEventSinkCheckpoints(pending); err != nil {
+		m.logger.Error("failed to checkpoint event sinks", "error", err)
+
+		m.checkpointLock.Lock()
+		for id, index := range pending {
+			m.checkpoints[id] = max(m.checkpoints[id], index)
+		}
+		m.checkpointLock.Unlock()
+	}
+}
+
+// sinkRunner subscribes to the event broker on behalf of a single sink and
+// delivers each batch of events to the sink's writer.
+type sinkRunner struct {
+	manager *Manager
+	sink    *structs.EventSink
+	logger  log.Logger
+
+	// index is the index of the last delivered batch.
+	index     uint64
+	indexLock sync.Mutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+func newSinkRunner(parent context.Context, m *Manager, sink *structs.EventSink, start uint64) *sinkRunner {
+	ctx, cancel := context.WithCancel(parent)
+	return &sinkRunner{
+		manager: m,
+		sink:    sink,
+		logger:  m.logger.With("sink_id", sink.ID, "sink_type", sink.Type),
+		index:   start,
+		ctx:     ctx,
+		cancel:  cancel,
+		doneCh:  make(chan struct{}),
+	}
+}
+
+// run delivers events to the sink until the runner is stopped, resubscribing
+// to the event broker whenever the subscription fails.
+func (r *sinkRunner) run() {
+	defer close(r.doneCh)
+	defer r.cancel()
+
+	ctx := r.ctx
+	writer, err := r.newWriter(ctx)
+	if err != nil {
+		return
+	}
+	defer writer.Close()
+
+	for attempt := uint64(0); ; attempt++ {
+		err := r.deliver(ctx, writer)
+		if ctx.Err() != nil {
+			return
+		}
+		r.logger.Warn("event sink subscription failed", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(helper.Backoff(deliveryBackoffBase, deliveryBackoffLimit, attempt)):
+		}
+	}
+}
+
+// newWriter creates the sink's writer, retrying with backoff until it
+// succeeds or ctx is canceled.
+func (r *sinkRunner) newWriter(ctx context.Context) (sinkWriter, error) {
+	for attempt := uint64(0); ; attempt++ {
+		writer, err := newSinkWriter(r.sink)
+		if err == nil {
+			return writer, nil
+		}
+		r.logger.Error("failed to create event sink writer", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(helper.Backoff(deliveryBackoffBase, deliveryBackoffLimit, attempt)):
+		}
+	}
+}
+
+// deliver subscribes to the event broker from the last delivered index and
+// writes every batch of events to the sink.
+func (r *sinkRunner) deliver(ctx context.Context, writer sinkWriter) error {
+	broker, err := r.manager.state.EventBroker()
+	if err != nil {
+		return err
+	}
+
+	index := r.delivered()
+	sub, err := broker.Subscribe(&stream.SubscribeRequest{
+		Index:      index + 1,
+		Topics:     r.sink.Topics,
+		Namespaces: []string{r.sink.Namespace},
+	})
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		events, err := sub.Next(ctx)
+		if err != nil {
+			return err
+		}
+
+		// The subscription starts at the closest index in the buffer, which
+		// may include batches that were already delivered.
+		if events.Index <= index || len(events.Events) == 0 {
+			continue
+		}
+
+		if err := r.write(ctx, writer, &events); err != nil {
+			return err
+		}
+
+		index = events.Index
+		r.indexLock.Lock()
+		r.index = index
+		r.indexLock.Unlock()
+		r.manager.checkpoint(r.sink.ID, index)
+	}
+}
+
+// write delivers a batch of events, retrying with backoff until it succeeds
+// or ctx is canceled.
+func (r *sinkRunner) write(ctx context.Context, writer sinkWriter, events *structs.Events) error {
+	for attempt := uint64(0); ; attempt++ {
+		err := writer.Write(ctx, events)
+		if err == nil {
+			return nil
+		}
+		r.logger.Warn("failed to deliver events", "index", events.Index, "attempt", attempt+1, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(helper.Backoff(deliveryBackoffBase, deliveryBackoffLimit, attempt)):
+		}
+	}
+}
+
+// delivered returns the index of the last batch delivered to the sink.
+func (r *sinkRunner) delivered() uint64 {
+	r.indexLock.Lock()
+	defer r.indexLock.Unlock()
+	return r.index
+}
+
+// stop cancels the runner and waits for it to exit so the sink's writer is
+// closed before a replacement runner opens it again.
+func (r *sinkRunner) stop() {
+	r.cancel()
+	<-r.doneCh
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package eventsink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+// testCheckpointRaft applies checkpoints directly to the state store.
+type testCheckpointRaft struct {
+	state *state.StateStore
+
+	lock  sync.Mutex
+	index uint64
+}
+
+func (r *testCheckpointRaft) UpdateEventSinkCheckpoints(checkpoints map[string]uint64) (uint64, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.index++
+	return r.index, r.state.UpdateEventSinkCheckpoints(structs.MsgTypeTestSetup, r.index, checkpoints)
+}
+
+func TestManager_DeliversAndCheckpoints(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+	broker, err := store.EventBroker()
+	must.NoError(t, err)
+
+	// The webhook fails the first delivery so it has to be retried.
+	var lock sync.Mutex
+	var requests int
+	var delivered []uint64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var events structs.Events
+		if err := json.Unmarshal(body, &events); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		delivered = append(delivered, events.Index)
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := mock.EventSink()
+	sink.Namespace = structs.AllNamespacesSentinel
+	sink.Webhook.Address = srv.URL
+	must.NoError(t, store.UpsertEventSink(structs.MsgTypeTestSetup, 1000, sink))
+
+	raft := &testCheckpointRaft{state: store, index: 2000}
+	m := NewManager(testlog.HCLogger(t), raft)
+	m.checkpointInterval = 10 * time.Millisecond
+	m.SetEnabled(true, store)
+	t.Cleanup(func() { m.SetEnabled(false, nil) })
+
+	// Wait for the runner to subscribe before publishing.
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+			return len(m.runners) == 1
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+
+	broker.Publish(&structs.Events{Index: 1100, Events: []structs.Event{{
+		Topic: structs.TopicNode, Key: "ignored", Index: 1100,
+	}}})
+	broker.Publish(&structs.Events{Index: 1101, Events: []structs.Event{{
+		Topic: structs.TopicJob, Key: "example", Namespace: "prod", Index: 1101,
+	}}})
+	broker.Publish(&structs.Events{Index: 1102, Events: []structs.Event{{
+		Topic: structs.TopicJob, Key: "example", Namespace: "default", Index: 1102,
+	}}})
+
+	must.Wait(t, wait.InitialSuccess(
+		wait.ErrorFunc(func() error {
+			out, err := store.EventSinkByID(nil, sink.ID)
+			if err != nil {
+				return err
+			}
+			if out.LatestIndex != 1102 {
+				return fmt.Errorf("expected checkpoint 1102, got %d", out.LatestIndex)
+			}
+			return nil
+		}),
+		wait.Timeout(10*time.Second),
+		wait.Gap(50*time.Millisecond),
+	))
+
+	lock.Lock()
+	defer lock.Unlock()
+	must.Eq(t, []uint64{1101, 1102}, delivered)
+}
+
+func TestManager_Reconcile(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+
+	sink := mock.EventSink()
+	must.NoError(t, store.UpsertEventSink(structs.MsgTypeTestSetup, 1000, sink))
+
+	m := NewManager(testlog.HCLogger(t), &testCheckpointRaft{state: store})
+	m.SetEnabled(true, store)
+	t.Cleanup(func() { m.SetEnabled(false, nil) })
+
+	runnerFor := func(id string) *sinkRunner {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return m.runners[id]
+	}
+
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return runnerFor(sink.ID) != nil }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	first := runnerFor(sink.ID)
+
+	// A checkpoint doesn't restart the runner.
+	must.NoError(t, store.UpdateEventSinkCheckpoints(structs.MsgTypeTestSetup, 1001,
+		map[string]uint64{sink.ID: 500}))
+	time.Sleep(100 * time.Millisecond)
+	must.Eq(t, first, runnerFor(sink.ID))
+
+	// A configuration change restarts the runner from the checkpoint.
+	update := sink.Copy()
+	update.Webhook.Address = "http://127.0.0.1:8081/events"
+	must.NoError(t, store.UpsertEventSink(structs.MsgTypeTestSetup, 1002, update))
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			r := runnerFor(sink.ID)
+			return r != nil && r != first
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	must.Eq(t, 500, runnerFor(sink.ID).delivered())
+
+	// Deleting the sink stops the runner.
+	must.NoError(t, store.DeleteEventSinks(structs.MsgTypeTestSetup, 1003, []string{sink.ID}))
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return runnerFor(sink.ID) == nil }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-msgpack/v2/codec"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// sinkWriter delivers batches of events to the destination of a sink. A
+// failed Write may be retried with the same batch, so destinations must
+// tolerate receiving a batch more than once.
+type sinkWriter interface {
+	Write(ctx context.Context, events *structs.Events) error
+	Close() error
+}
+
+// newSinkWriter returns the writer for the given sink's type.
+func newSinkWriter(sink *structs.EventSink) (sinkWriter, error) {
+	switch sink.Type {
+	case structs.EventSinkTypeWebhook:
+		return newWebhookWriter(sink.Webhook), nil
+	case structs.EventSinkTypeFile:
+		return newFileWriter(sink.File)
+	default:
+		return nil, fmt.Errorf("unknown event sink type %q", sink.Type)
+	}
+}
+
+// encodeEvents encodes a batch of events as a single line of newline
+// delimited JSON, the same format used by the event stream API.
+func encodeEvents(events *structs.Events) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, structs.JsonHandleWithExtensions)
+	if err := enc.Encode(events); err != nil {
+		return nil, fmt.Errorf("failed to encode events: %w", err)
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// webhookWriter POSTs each batch of events to an HTTP endpoint.
+type webhookWriter struct {
+	config *structs.EventSinkWebhookConfig
+	client *http.Client
+}
+
+func newWebhookWriter(config *structs.EventSinkWebhookConfig) *webhookWriter {
+	client := cleanhttp.DefaultPooledClient()
+	client.Timeout = config.Timeout
+	return &webhookWriter{
+		config: config,
+		client: client,
+	}
+}
+
+// Write sends the batch of events to the webhook. Any response status other
+// than 2xx is treated as a failed delivery.
+func (w *webhookWriter) Write(ctx context.Context, events *structs.Events) error {
+	body, err := encodeEvents(events)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.Address, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	for k, v := range w.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *webhookWriter) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+// fileWriter appends each batch of events to a local file, rotating it once
+// it would grow beyond MaxBytes. Rotated files are suffixed with .1 (most
+// recent) up to .MaxFiles (oldest).
+type fileWriter struct {
+	config *structs.EventSinkFileConfig
+	file   *os.File
+	size   int64
+}
+
+func newFileWriter(config *structs.EventSinkFileConfig) (*fileWriter, error) {
+	w := &fileWriter{config: config}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *fileWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.config.Path), 0o700); err != nil {
+		return fmt.Errorf("failed to create event sink directory: %w", err)
+	}
+
+	f, err := os.OpenFile(w.config.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open event sink file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat event sink file: %w", err)
+	}
+
+	w.file = f
+	w.size = info.Size()
+	return nil
+}
+
+// Write appends the batch of events to the active file and syncs it to disk
+// so the batch can be checkpointed.
+func (w *fileWriter) Write(_ context.Context, events *structs.Events) error {
+	line, err := encodeEvents(events)
+	if err != nil {
+		return err
+	}
+
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+
+	if w.size > 0 && w.size+int64(len(line)) > w.config.MaxBytes {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.Write(line)
+	w.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write event sink file: %w", err)
+	}
+	if err := w.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync event sink file: %w", err)
+	}
+	return nil
+}
+
+// rotate shifts the rotated files by one, dropping the oldest, and moves the
+// active file into the .1 position before opening a new active file.
+func (w *fileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("failed to close event sink file: %w", err)
+	}
+	w.file = nil
+
+	path := w.config.Path
+	oldest := fmt.Sprintf("%s.%d", path, w.config.MaxFiles)
+	if err := os.Remove(oldest); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove rotated event sink file: %w", err)
+	}
+	for i := w.config.MaxFiles - 1; i >= 1; i-- {
+		from := fmt.Sprintf("%s.%d", path, i)
+		to := fmt.Sprintf("%s.%d", path, i+1)
+		if err := os.Rename(from, to); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to rotate event sink file: %w", err)
+		}
+	}
+
+	var err error
+	if w.config.MaxFiles > 0 {
+		err = os.Rename(path, path+".1")
+	} else {
+		err = os.Remove(path)
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to rotate event sink file: %w", err)
+	}
+
+	return w.open()
+}
+
+func (w *fileWriter) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
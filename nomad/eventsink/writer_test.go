@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func testEvents(index uint64) *structs.Events {
+	return &structs.Events{
+		Index: index,
+		Events: []structs.Event{{
+			Topic:     structs.TopicJob,
+			Type:      structs.TypeJobRegistered,
+			Key:       "example",
+			Namespace: "default",
+			Index:     index,
+		}},
+	}
+}
+
+func TestWebhookWriter(t *testing.T) {
+	ci.Parallel(t)
+
+	var status int
+	var body []byte
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	writer := newWebhookWriter(&structs.EventSinkWebhookConfig{
+		Address: srv.URL,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+		Timeout: structs.DefaultEventSinkWebhookTimeout,
+	})
+	t.Cleanup(func() { writer.Close() })
+
+	status = http.StatusServiceUnavailable
+	err := writer.Write(context.Background(), testEvents(10))
+	must.ErrorContains(t, err, "unexpected response code 503")
+
+	status = http.StatusOK
+	must.NoError(t, writer.Write(context.Background(), testEvents(11)))
+	must.Eq(t, "Bearer secret", header.Get("Authorization"))
+	must.Eq(t, "application/x-ndjson", header.Get("Content-Type"))
+
+	var got structs.Events
+	must.NoError(t, json.Unmarshal(body, &got))
+	must.Eq(t, 11, got.Index)
+	must.Len(t, 1, got.Events)
+	must.Eq(t, "example", got.Events[0].Key)
+}
+
+func TestFileWriter_Rotate(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sink", "events.ndjson")
+
+	line, err := encodeEvents(testEvents(1))
+	must.NoError(t, err)
+
+	// Allow two batches per file and keep two rotated files.
+	config := &structs.EventSinkFileConfig{
+		Path:     path,
+		MaxBytes: int64(2*len(line) + 1),
+		MaxFiles: 2,
+	}
+	writer, err := newFileWriter(config)
+	must.NoError(t, err)
+	t.Cleanup(func() { writer.Close() })
+
+	for i := uint64(1); i <= 7; i++ {
+		must.NoError(t, writer.Write(context.Background(), testEvents(i)))
+	}
+
+	readIndexes := func(path string) []uint64 {
+		t.Helper()
+		content, err := os.ReadFile(path)
+		must.NoError(t, err)
+
+		var indexes []uint64
+		for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+			var events structs.Events
+			must.NoError(t, json.Unmarshal([]byte(line), &events))
+			indexes = append(indexes, events.Index)
+		}
+		return indexes
+	}
+
+	// The first batches were rotated out of the oldest file.
+	must.Eq(t, []uint64{7}, readIndexes(path))
+	must.Eq(t, []uint64{5, 6}, readIndexes(path+".1"))
+	must.Eq(t, []uint64{3, 4}, readIndexes(path+".2"))
+	must.FileNotExists(t, path+".3")
+
+	// Reopening the file continues appending to the active file.
+	must.NoError(t, writer.Close())
+	writer, err = newFileWriter(config)
+	must.NoError(t, err)
+	must.NoError(t, writer.Write(context.Background(), testEvents(8)))
+	must.Eq(t, []uint64{7, 8}, readIndexes(path))
+}
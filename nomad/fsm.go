@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/scheduler"
 	sstructs "github.com/hashicorp/nomad/scheduler/structs"
@@ -59,6 +60,7 @@ const (
 	JobSubmissionSnapshot                SnapshotType = 29
 	RootKeySnapshot                      SnapshotType = 30
 	HostVolumeSnapshot                   SnapshotType = 31
+	EventSinkConfigSnapshot              SnapshotType = 32
 
 	// TimeTableSnapshot
 	// Deprecated: Nomad no longer supports TimeTable snapshots since 1.9.2
@@ -110,6 +112,7 @@ var snapshotTypeStrings = map[SnapshotType]string{
 	JobSubmissionSnapshot:                "JobSubmission",
 	RootKeySnapshot:                      "WrappedRootKeys",
 	HostVolumeSnapshot:                   "HostVolumeSnapshot",
+	EventSinkConfigSnapshot:              "EventSinkConfig",
 	NamespaceSnapshot:                    "Namespace",
 }
 
@@ -194,6 +197,10 @@ type FSMConfig struct {
 	// EventBufferSize is the amount of messages to hold in memory
 	EventBufferSize int64
 
+	// EventJournal is the optional on-disk journal the state store's event
+	// publisher writes events to
+	EventJournal *stream.Journal
+
 	// JobTrackedVersions is the number of historic job versions that are kept.
 	JobTrackedVersions int
 }
@@ -206,6 +213,7 @@ func NewFSM(config *FSMConfig) (*nomadFSM, error) {
 		Region:             config.Region,
 		EnablePublisher:    config.EnableEventBroker,
 		EventBufferSize:    config.EventBufferSize,
+		EventJournal:       config.EventJournal,
 		JobTrackedVersions: config.JobTrackedVersions,
 	}
 	state, err := state.NewStateStore(sconfig)
@@ -397,6 +405,12 @@ func (n *nomadFSM) Apply(log *raft.Log) any {
 		return n.applyHostVolumeDelete(msgType, buf[1:], log.Index)
 	case structs.TaskGroupHostVolumeClaimDeleteRequestType:
 		return n.applyTaskGroupHostVolumeClaimDelete(buf[1:], log.Index)
+	case structs.EventSinkRegisterRequestType:
+		return n.applyEventSinkRegister(msgType, buf[1:], log.Index)
+	case structs.EventSinkDeregisterRequestType:
+		return n.applyEventSinkDeregister(msgType, buf[1:], log.Index)
+	case structs.EventSinkCheckpointRequestType:
+		return n.applyEventSinkCheckpoint(msgType, buf[1:], log.Index)
 	}
 
 	// Check enterprise only message types.
@@ -1585,6 +1599,7 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 		Region:             n.config.Region,
 		EnablePublisher:    n.config.EnableEventBroker,
 		EventBufferSize:    n.config.EventBufferSize,
+		EventJournal:       n.config.EventJournal,
 		JobTrackedVersions: n.config.JobTrackedVersions,
 	}
 	newState, err := state.NewStateStore(config)
@@ -2003,6 +2018,15 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				}
 			}
 
+		case EventSinkConfigSnapshot:
+			sink := new(structs.EventSink)
+			if err := dec.Decode(sink); err != nil {
+				return err
+			}
+			if err := restore.EventSinkRestore(sink); err != nil {
+				return err
+			}
+
 		default:
 			// Check if this is an enterprise only object being restored
 			restorer, ok := n.enterpriseRestorers[snapType]
@@ -2517,6 +2541,51 @@ func (n *nomadFSM) applyTaskGroupHostVolumeClaimDelete(buf []byte, index uint64)
 	return nil
 }
 
+func (n *nomadFSM) applyEventSinkRegister(msgType structs.MessageType, buf []byte, index uint64) any {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_event_sink_register"}, time.Now())
+
+	var req structs.EventSinkUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertEventSink(msgType, index, req.Sink); err != nil {
+		n.logger.Error("UpsertEventSink failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (n *nomadFSM) applyEventSinkDeregister(msgType structs.MessageType, buf []byte, index uint64) any {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_event_sink_deregister"}, time.Now())
+
+	var req structs.EventSinkDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteEventSinks(msgType, index, req.IDs); err != nil {
+		n.logger.Error("DeleteEventSinks failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (n *nomadFSM) applyEventSinkCheckpoint(msgType structs.MessageType, buf []byte, index uint64) any {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_event_sink_checkpoint"}, time.Now())
+
+	var req structs.EventSinkCheckpointRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpdateEventSinkCheckpoints(msgType, index, req.Checkpoints); err != nil {
+		n.logger.Error("UpdateEventSinkCheckpoints failed", "error", err)
+		return err
+	}
+	return nil
+}
+
 func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "persist"}, time.Now())
 	// Register the nodes
@@ -2646,6 +2715,10 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistEventSinks(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	return nil
 }
 
@@ -3354,6 +3427,22 @@ func (s *nomadSnapshot) persistHostVolumes(sink raft.SnapshotSink, encoder *code
 	return nil
 }
 
+func (s *nomadSnapshot) persistEventSinks(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	iter, err := s.snap.EventSinks(nil, state.SortDefault)
+	if err != nil {
+		return err
+	}
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		eventSink := raw.(*structs.EventSink)
+
+		sink.Write([]byte{byte(EventSinkConfigSnapshot)})
+		if err := encoder.Encode(eventSink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Release is a no-op, as we just need to GC the pointer
 // to the state store snapshot. There is nothing to explicitly
 // cleanup.
@@ -2341,6 +2341,26 @@ func TestFSM_SnapshotRestore_NodePools(t *testing.T) {
 	must.Eq(t, pool, out)
 }
 
+func TestFSM_SnapshotRestore_EventSinks(t *testing.T) {
+	ci.Parallel(t)
+
+	testFSM := testFSM(t)
+	testState := testFSM.State()
+	sink := mock.EventSink()
+	must.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 1000, sink))
+	must.NoError(t, testState.UpdateEventSinkCheckpoints(structs.MsgTypeTestSetup, 1001,
+		map[string]uint64{sink.ID: 999}))
+
+	testFSM2 := testSnapshotRestore(t, testFSM)
+	testState2 := testFSM2.State()
+	out, err := testState2.EventSinkByID(nil, sink.ID)
+	must.NoError(t, err)
+	must.Eq(t, 999, out.LatestIndex)
+
+	sink.LatestIndex = 999
+	must.Eq(t, sink, out)
+}
+
 func TestFSM_SnapshotRestore_NodePoolsPreTTL(t *testing.T) {
 	ci.Parallel(t)
 
@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/time/rate"
+)
+
+// jobDependencyRateLimit limits how often the leader checks the dependencies
+// of waiting jobs as the job table changes.
+const jobDependencyRateLimit = rate.Limit(1)
+
+// watchJobDependencies is a long lived function that creates the evaluation of
+// each job with dependencies once all of its dependencies have completed. It
+// is only run on the leader.
+func (s *Server) watchJobDependencies(stopCh chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	logger := s.logger.Named("job_dependencies")
+	limiter := rate.NewLimiter(jobDependencyRateLimit, 1)
+
+	index := uint64(1)
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return
+		}
+
+		resp, next, err := s.State().BlockingQuery(waitingDependentJobs, index, ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			logger.Error("failed to get jobs with dependencies", "error", err)
+			continue
+		}
+		index = next
+
+		for _, job := range resp.([]*structs.Job) {
+			if err := s.evaluateDependentJob(job); err != nil {
+				logger.Error("failed to evaluate job with dependencies",
+					"job", job.NamespacedID(), "error", err)
+			}
+		}
+	}
+}
+
+// waitingDependentJobs returns the jobs with dependencies whose current
+// version hasn't been released yet.
+func waitingDependentJobs(ws memdb.WatchSet, store *state.StateStore) (any, uint64, error) {
+	iter, err := store.Jobs(ws, state.SortDefault)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var jobs []*structs.Job
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		job := raw.(*structs.Job)
+		if job.DependsOn == nil || job.Stop {
+			continue
+		}
+
+		released, err := jobVersionReleased(store, job)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !released {
+			jobs = append(jobs, job)
+		}
+	}
+
+	index, err := store.Index("jobs")
+	if err != nil {
+		return nil, 0, err
+	}
+	return jobs, index, nil
+}
+
+// jobVersionReleased returns whether an evaluation was created for the
+// current version of the job. A dead job with allocations of its current
+// version has run already, even if the state store didn't record its release.
+func jobVersionReleased(store *state.StateStore, job *structs.Job) (bool, error) {
+	if job.DependenciesReleasedIndex >= job.JobModifyIndex {
+		return true, nil
+	}
+	if job.Status != structs.JobStatusDead {
+		return false, nil
+	}
+
+	allocs, err := store.AllocsByJob(nil, job.Namespace, job.ID, false)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(allocs, func(alloc *structs.Allocation) bool {
+		return alloc.Job != nil && alloc.Job.JobModifyIndex == job.JobModifyIndex
+	}), nil
+}
+
+// evaluateDependentJob creates the evaluation of the job if all of its
+// dependencies have completed.
+func (s *Server) evaluateDependentJob(job *structs.Job) error {
+	snap, err := s.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	complete, err := jobDependenciesComplete(&snap.StateStore, job)
+	if err != nil || !complete {
+		return err
+	}
+
+	now := time.Now().UTC().UnixNano()
+	eval := &structs.Evaluation{
+		ID:             uuid.Generate(),
+		Namespace:      job.Namespace,
+		Priority:       job.Priority,
+		Type:           job.Type,
+		TriggeredBy:    structs.EvalTriggerJobDependency,
+		JobID:          job.ID,
+		JobModifyIndex: job.JobModifyIndex,
+		Status:         structs.EvalStatusPending,
+		CreateTime:     now,
+		ModifyTime:     now,
+	}
+	req := &structs.EvalUpdateRequest{
+		Evals: []*structs.Evaluation{eval},
+	}
+	if _, _, err := s.raftApply(structs.EvalUpdateRequestType, req); err != nil {
+		return fmt.Errorf("failed to create evaluation: %w", err)
+	}
+
+	s.logger.Named("job_dependencies").Debug("dependencies of job completed",
+		"job", job.NamespacedID(), "eval_id", eval.ID)
+	return nil
+}
+
+// jobDependenciesComplete returns whether every dependency of the job has
+// completed successfully.
+func jobDependenciesComplete(snap *state.StateStore, job *structs.Job) (bool, error) {
+	if job.DependsOn == nil {
+		return true, nil
+	}
+
+	for _, id := range job.DependsOn.Jobs {
+		dep, err := snap.JobByID(nil, job.Namespace, id)
+		if err != nil {
+			return false, err
+		}
+		if dep == nil {
+			return false, nil
+		}
+
+		complete, err := jobComplete(snap, dep)
+		if err != nil || !complete {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// jobComplete returns whether the job has completed successfully. A job is
+// complete when it is dead and all of its allocations are complete, or were
+// rescheduled. A periodic or parameterized job is complete when it has
+// children and all of them are complete.
+func jobComplete(snap *state.StateStore, job *structs.Job) (bool, error) {
+	if job.Stop {
+		return false, nil
+	}
+
+	if job.IsPeriodic() || job.IsParameterized() {
+		iter, err := snap.JobsByIDPrefix(nil, job.Namespace, job.ID, state.SortDefault)
+		if err != nil {
+			return false, err
+		}
+
+		var children int
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			child := raw.(*structs.Job)
+			if child.ParentID != job.ID {
+				continue
+			}
+			children++
+
+			complete, err := jobComplete(snap, child)
+			if err != nil || !complete {
+				return false, err
+			}
+		}
+		return children > 0, nil
+	}
+
+	if job.Status != structs.JobStatusDead {
+		return false, nil
+	}
+
+	allocs, err := snap.AllocsByJob(nil, job.Namespace, job.ID, false)
+	if err != nil {
+		return false, err
+	}
+	if len(allocs) == 0 {
+		return false, nil
+	}
+	for _, alloc := range allocs {
+		if alloc.ClientStatus != structs.AllocClientStatusComplete && alloc.NextAllocation == "" {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// jobDependencyStatuses returns the statuses of the dependencies of the job,
+// including their own dependencies. Jobs already visited are not expanded
+// again.
+func jobDependencyStatuses(snap *state.StateStore, job *structs.Job, visited map[string]struct{}) ([]*structs.JobDependencyStatus, error) {
+	if job.DependsOn == nil {
+		return nil, nil
+	}
+	visited[job.ID] = struct{}{}
+
+	statuses := make([]*structs.JobDependencyStatus, 0, len(job.DependsOn.Jobs))
+	for _, id := range job.DependsOn.Jobs {
+		status := &structs.JobDependencyStatus{
+			ID:        id,
+			Namespace: job.Namespace,
+			Status:    structs.JobDependencyStatusMissing,
+		}
+		statuses = append(statuses, status)
+
+		dep, err := snap.JobByID(nil, job.Namespace, id)
+		if err != nil {
+			return nil, err
+		}
+		if dep == nil {
+			continue
+		}
+
+		status.Status = dep.Status
+		if status.Complete, err = jobComplete(snap, dep); err != nil {
+			return nil, err
+		}
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		if status.DependsOn, err = jobDependencyStatuses(snap, dep, visited); err != nil {
+			return nil, err
+		}
+	}
+	return statuses, nil
+}
+
+// jobDependents returns the IDs of the jobs that depend on the job.
+func jobDependents(snap *state.StateStore, namespace, jobID string) ([]string, error) {
+	iter, err := snap.JobsByNamespace(nil, namespace, state.SortDefault)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		job := raw.(*structs.Job)
+		if job.DependsOn != nil && slices.Contains(job.DependsOn.Jobs, jobID) {
+			dependents = append(dependents, job.ID)
+		}
+	}
+	return dependents, nil
+}
+
+// jobDependencyCycle returns the path of a dependency cycle that registering
+// the job would create, if any.
+func jobDependencyCycle(snap *state.StateStore, job *structs.Job) ([]string, error) {
+	if job.DependsOn == nil {
+		return nil, nil
+	}
+
+	visited := make(map[string]struct{})
+	var visit func(id string, path []string) ([]string, error)
+	visit = func(id string, path []string) ([]string, error) {
+		path = append(path, id)
+		if id == job.ID {
+			return path, nil
+		}
+		if _, ok := visited[id]; ok {
+			return nil, nil
+		}
+		visited[id] = struct{}{}
+
+		dep, err := snap.JobByID(nil, job.Namespace, id)
+		if err != nil || dep == nil || dep.DependsOn == nil {
+			return nil, err
+		}
+		for _, next := range dep.DependsOn.Jobs {
+			if cycle, err := visit(next, path); cycle != nil || err != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	for _, id := range job.DependsOn.Jobs {
+		if cycle, err := visit(id, []string{job.ID}); cycle != nil || err != nil {
+			return cycle, err
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/net-rpc-msgpackrpc/v2"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+// registerDependencyTestJob registers a batch job with the given dependencies
+// and returns the ID of the eval created at registration, if any.
+func registerDependencyTestJob(t *testing.T, s *Server, id string, deps ...string) string {
+	t.Helper()
+
+	codec := rpcClient(t, s)
+	job := mock.BatchJob()
+	job.ID = id
+	job.Name = id
+	if len(deps) != 0 {
+		job.DependsOn = &structs.JobDependencies{Jobs: deps}
+	}
+
+	var resp structs.JobRegisterResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Register", &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}, &resp)
+	must.NoError(t, err)
+	return resp.EvalID
+}
+
+// completeDependencyTestJob marks the evals of the job complete and adds a
+// complete allocation for it, so the job is dead and complete.
+func completeDependencyTestJob(t *testing.T, s *Server, index uint64, id string) {
+	t.Helper()
+
+	store := s.fsm.State()
+	job, err := store.JobByID(nil, structs.DefaultNamespace, id)
+	must.NoError(t, err)
+	evals, err := store.EvalsByJob(nil, structs.DefaultNamespace, id)
+	must.NoError(t, err)
+
+	for _, eval := range evals {
+		eval = eval.Copy()
+		eval.Status = structs.EvalStatusComplete
+		must.NoError(t, store.UpsertEvals(structs.MsgTypeTestSetup, index, []*structs.Evaluation{eval}))
+	}
+
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.TaskGroup = job.TaskGroups[0].Name
+	alloc.ClientStatus = structs.AllocClientStatusComplete
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, index+1, []*structs.Allocation{alloc}))
+}
+
+func TestJobDependencies_Evaluate(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	must.NotEq(t, "", registerDependencyTestJob(t, s1, "extract"))
+	must.NotEq(t, "", registerDependencyTestJob(t, s1, "transform"))
+
+	// The downstream job isn't evaluated while its dependencies run.
+	must.Eq(t, "", registerDependencyTestJob(t, s1, "load", "extract", "transform"))
+
+	completeDependencyTestJob(t, s1, 1000, "extract")
+	time.Sleep(2 * time.Second)
+	evals, err := s1.fsm.State().EvalsByJob(nil, structs.DefaultNamespace, "load")
+	must.NoError(t, err)
+	must.Len(t, 0, evals)
+
+	// Once all dependencies complete, the leader creates the eval.
+	completeDependencyTestJob(t, s1, 1010, "transform")
+	must.Wait(t, wait.InitialSuccess(
+		wait.ErrorFunc(func() error {
+			evals, err := s1.fsm.State().EvalsByJob(nil, structs.DefaultNamespace, "load")
+			if err != nil {
+				return err
+			}
+			if len(evals) != 1 {
+				return fmt.Errorf("expected 1 eval, got %d", len(evals))
+			}
+			if evals[0].TriggeredBy != structs.EvalTriggerJobDependency {
+				return fmt.Errorf("unexpected eval trigger %q", evals[0].TriggeredBy)
+			}
+			return nil
+		}),
+		wait.Timeout(10*time.Second),
+		wait.Gap(100*time.Millisecond),
+	))
+
+	// The release of the job version outlives its evals.
+	store := s1.fsm.State()
+	load, err := store.JobByID(nil, structs.DefaultNamespace, "load")
+	must.NoError(t, err)
+	must.Eq(t, load.JobModifyIndex, load.DependenciesReleasedIndex)
+
+	evals, err = store.EvalsByJob(nil, structs.DefaultNamespace, "load")
+	must.NoError(t, err)
+	must.NoError(t, store.DeleteEval(1020, []string{evals[0].ID}, nil, false))
+	time.Sleep(2 * time.Second)
+	evals, err = store.EvalsByJob(nil, structs.DefaultNamespace, "load")
+	must.NoError(t, err)
+	must.Len(t, 0, evals)
+
+	// Registering a job whose dependencies are complete creates the eval
+	// immediately.
+	must.NotEq(t, "", registerDependencyTestJob(t, s1, "report", "extract"))
+}
+
+func TestJobDependencies_waitingDependentJobs(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+
+	newJob := func(id string) *structs.Job {
+		job := mock.BatchJob()
+		job.ID = id
+		job.DependsOn = &structs.JobDependencies{Jobs: []string{"upstream"}}
+		return job
+	}
+
+	// The job is waiting until an eval is created for its version.
+	waiting := newJob("waiting")
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1000, nil, waiting))
+	released := newJob("released")
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1001, nil, released))
+	eval := mock.Eval()
+	eval.JobID = released.ID
+	eval.JobModifyIndex = 1001
+	must.NoError(t, store.UpsertEvals(structs.MsgTypeTestSetup, 1002, []*structs.Evaluation{eval}))
+
+	// A dead job that ran its version before releases were recorded.
+	ran := newJob("ran")
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1003, nil, ran))
+	ran, err := store.JobByID(nil, ran.Namespace, ran.ID)
+	must.NoError(t, err)
+	alloc := mock.Alloc()
+	alloc.Job = ran
+	alloc.JobID = ran.ID
+	alloc.ClientStatus = structs.AllocClientStatusComplete
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1004, []*structs.Allocation{alloc}))
+	eval = mock.Eval()
+	eval.JobID = ran.ID
+	eval.Status = structs.EvalStatusComplete
+	must.NoError(t, store.UpsertEvals(structs.MsgTypeTestSetup, 1005, []*structs.Evaluation{eval}))
+	must.NoError(t, store.DeleteEval(1006, []string{eval.ID}, nil, false))
+	ran, err = store.JobByID(nil, ran.Namespace, ran.ID)
+	must.NoError(t, err)
+	must.Eq(t, structs.JobStatusDead, ran.Status)
+	must.Eq(t, 0, ran.DependenciesReleasedIndex)
+
+	resp, _, err := waitingDependentJobs(nil, store)
+	must.NoError(t, err)
+	jobs := resp.([]*structs.Job)
+	must.Len(t, 1, jobs)
+	must.Eq(t, waiting.ID, jobs[0].ID)
+}
+
+func TestJobDependencies_Cycle(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	registerDependencyTestJob(t, s1, "a", "b")
+	registerDependencyTestJob(t, s1, "b", "c")
+
+	job := mock.BatchJob()
+	job.ID = "c"
+	job.DependsOn = &structs.JobDependencies{Jobs: []string{"a"}}
+	var resp structs.JobRegisterResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Register", &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}, &resp)
+	must.ErrorContains(t, err, "job dependencies form a cycle: c -> a -> b -> c")
+}
+
+func TestJobEndpoint_Dependencies(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	registerDependencyTestJob(t, s1, "extract")
+	registerDependencyTestJob(t, s1, "transform", "extract", "missing")
+	registerDependencyTestJob(t, s1, "load", "transform")
+	completeDependencyTestJob(t, s1, 1000, "extract")
+
+	req := &structs.JobSpecificRequest{
+		JobID: "load",
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: structs.DefaultNamespace,
+		},
+	}
+	var resp structs.JobDependenciesResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Dependencies", req, &resp))
+	must.Len(t, 1, resp.Dependencies)
+	must.Len(t, 0, resp.Dependents)
+
+	transform := resp.Dependencies[0]
+	must.Eq(t, "transform", transform.ID)
+	must.Eq(t, structs.JobStatusPending, transform.Status)
+	must.False(t, transform.Complete)
+	must.Len(t, 2, transform.DependsOn)
+	must.Eq(t, "extract", transform.DependsOn[0].ID)
+	must.Eq(t, structs.JobStatusDead, transform.DependsOn[0].Status)
+	must.True(t, transform.DependsOn[0].Complete)
+	must.Eq(t, structs.JobDependencyStatusMissing, transform.DependsOn[1].Status)
+
+	req.JobID = "extract"
+	var extractResp structs.JobDependenciesResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Dependencies", req, &extractResp))
+	must.Len(t, 0, extractResp.Dependencies)
+	must.Eq(t, []string{"transform"}, extractResp.Dependents)
+
+	req.JobID = "unknown"
+	err := msgpackrpc.CallWithCodec(codec, "Job.Dependencies", req, &structs.JobDependenciesResponse{})
+	must.ErrorContains(t, err, "not found")
+}
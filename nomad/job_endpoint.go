@@ -200,6 +200,19 @@ func (j *Job) doRegister(aclObj *acl.ACL, additionalAllowedPermissions []string,
 			}
 		}
 
+		// The leader reads the variable checked by canary analysis, so the
+		// submitter must be able to read it themselves.
+		if tg.Update != nil && tg.Update.Analysis != nil {
+			analysis := tg.Update.Analysis
+			if analysis.Variable != nil &&
+				!aclObj.AllowVariableOperation(args.RequestNamespace(), analysis.Variable.Path, acl.VariablesCapabilityRead, nil) {
+				return structs.ErrPermissionDenied
+			}
+			if analysis.HTTP != nil && len(j.srv.config.CanaryAnalysisHTTPAllowlist) == 0 {
+				return fmt.Errorf("task group %q: HTTP canary analysis is not enabled on the servers", tg.Name)
+			}
+		}
+
 		// Check if override is set and we do not have permissions
 		if args.PolicyOverride {
 			if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySentinelOverride) {
@@ -233,6 +246,15 @@ func (j *Job) doRegister(aclObj *acl.ACL, additionalAllowedPermissions []string,
 		return err
 	}
 
+	// Ensure that the job's dependencies don't form a cycle
+	cycle, err := jobDependencyCycle(&snap.StateStore, args.Job)
+	if err != nil {
+		return err
+	}
+	if cycle != nil {
+		return fmt.Errorf("job dependencies form a cycle: %s", strings.Join(cycle, " -> "))
+	}
+
 	// Ensure that all scaling policies have an appropriate ID
 	if err := propagateScalingPolicyIDs(existingJob, args.Job); err != nil {
 		return err
@@ -297,8 +319,17 @@ func (j *Job) doRegister(aclObj *acl.ACL, additionalAllowedPermissions []string,
 	now := time.Now().UnixNano()
 	args.Job.SubmitTime = now
 
-	// If the job is periodic or parameterized, we don't create an eval.
-	if !(args.Job.IsPeriodic() || args.Job.IsParameterized()) {
+	// If the job is periodic or parameterized, we don't create an eval. The
+	// eval of a job with dependencies that haven't completed is created by
+	// the leader once they have.
+	createEval := !(args.Job.IsPeriodic() || args.Job.IsParameterized())
+	if createEval && args.Job.DependsOn != nil {
+		createEval, err = jobDependenciesComplete(&snap.StateStore, args.Job)
+		if err != nil {
+			return err
+		}
+	}
+	if createEval {
 
 		// Initially set the eval priority to that of the job priority. If the
 		// user supplied an eval priority override, we subsequently use this.
@@ -479,6 +510,62 @@ func (j *Job) Summary(args *structs.JobSummaryRequest, reply *structs.JobSummary
 	return j.srv.blockingRPC(&opts)
 }
 
+// Dependencies is used to retrieve the dependency graph of a job: the status
+// of the jobs it depends on, recursively, and the jobs that depend on it.
+func (j *Job) Dependencies(args *structs.JobSpecificRequest, reply *structs.JobDependenciesResponse) error {
+	authErr := j.srv.Authenticate(j.ctx, args)
+	if done, err := j.srv.forward("Job.Dependencies", args, args, reply); done {
+		return err
+	}
+	j.srv.MeasureRPCRate("job", structs.RateMetricRead, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "job", "dependencies"}, time.Now())
+
+	// Check for read-job permissions
+	if aclObj, err := j.srv.ResolveACL(args); err != nil {
+		return err
+	} else if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilityReadJob) {
+		return structs.ErrPermissionDenied
+	}
+
+	// Setup the blocking query
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, state *state.StateStore) error {
+			job, err := state.JobByID(ws, args.RequestNamespace(), args.JobID)
+			if err != nil {
+				return err
+			}
+			if job == nil {
+				return structs.NewErrRPCCoded(404, fmt.Sprintf("job %q not found", args.JobID))
+			}
+
+			reply.Dependencies, err = jobDependencyStatuses(state, job, make(map[string]struct{}))
+			if err != nil {
+				return err
+			}
+			reply.Dependents, err = jobDependents(state, job.Namespace, job.ID)
+			if err != nil {
+				return err
+			}
+
+			// The graph depends on the status of other jobs, so use the
+			// last index that affected the jobs table
+			reply.Index, err = state.Index("jobs")
+			if err != nil {
+				return err
+			}
+
+			// Set the query response
+			j.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return j.srv.blockingRPC(&opts)
+}
+
 // Validate validates a job.
 //
 // Must forward to the leader, because only the leader will have a live Vault
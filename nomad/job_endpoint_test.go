@@ -1166,6 +1166,94 @@ func TestJobEndpoint_Register_ACL(t *testing.T) {
 	}
 }
 
+func TestJobEndpoint_Register_CanaryAnalysis_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, _, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	newAnalysisJob := func(analysis *structs.CanaryAnalysis) *structs.Job {
+		j := mock.Job()
+		j.TaskGroups[0].Update = structs.DefaultUpdateStrategy.Copy()
+		j.TaskGroups[0].Update.Canary = 1
+		j.TaskGroups[0].Update.Analysis = analysis
+		return j
+	}
+	variableJob := func() *structs.Job {
+		return newAnalysisJob(&structs.CanaryAnalysis{
+			Duration: time.Minute,
+			Interval: 10 * time.Second,
+			Variable: &structs.CanaryAnalysisVariable{
+				Path: "metrics/web",
+				Key:  "error_rate",
+				Max:  new(0.05),
+			},
+		})
+	}
+
+	submitJobToken := mock.CreatePolicyAndToken(t, s1.State(), 1001, "test-submit-job",
+		mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilitySubmitJob}))
+	variableReadToken := mock.CreatePolicyAndToken(t, s1.State(), 1002, "test-submit-job-variables",
+		mock.NamespacePolicyWithVariables(structs.DefaultNamespace, "",
+			[]string{acl.NamespaceCapabilitySubmitJob},
+			map[string][]string{"metrics/*": {acl.VariablesCapabilityRead}}))
+
+	cases := []struct {
+		name   string
+		job    *structs.Job
+		token  string
+		expErr string
+	}{
+		{
+			name:   "variable without read",
+			job:    variableJob(),
+			token:  submitJobToken.SecretID,
+			expErr: structs.ErrPermissionDenied.Error(),
+		},
+		{
+			name:  "variable with read",
+			job:   variableJob(),
+			token: variableReadToken.SecretID,
+		},
+		{
+			name: "http disabled",
+			job: newAnalysisJob(&structs.CanaryAnalysis{
+				Duration: time.Minute,
+				Interval: 10 * time.Second,
+				HTTP:     &structs.CanaryAnalysisHTTP{URL: "http://169.254.169.254/latest"},
+			}),
+			token:  variableReadToken.SecretID,
+			expErr: "HTTP canary analysis is not enabled",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			codec := rpcClient(t, s1)
+			req := &structs.JobRegisterRequest{
+				Job: tc.job,
+				WriteRequest: structs.WriteRequest{
+					Region:    "global",
+					Namespace: tc.job.Namespace,
+					AuthToken: tc.token,
+				},
+			}
+
+			var resp structs.JobRegisterResponse
+			err := msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+			if tc.expErr != "" {
+				must.ErrorContains(t, err, tc.expErr)
+			} else {
+				must.NoError(t, err)
+				must.NonZero(t, resp.Index)
+			}
+		})
+	}
+}
+
 func TestJobEndpoint_Register_InvalidNamespace(t *testing.T) {
 	ci.Parallel(t)
 
@@ -105,6 +105,11 @@ var minVersionNodeIntro = version.Must(version.NewVersion("1.11.0"))
 // we submit a full Job object like we used to before.
 var minVersionPlanLeanJob = version.Must(version.NewVersion("2.0.0"))
 
+// minVersionEventSinks is the Nomad version at which event sinks were
+// introduced. All servers must meet this version before sinks can be
+// registered.
+var minVersionEventSinks = version.Must(version.NewVersion("2.0.6-dev"))
+
 // monitorLeadership is used to monitor if we acquire or lose our role
 // as the leader in the Raft cluster. There is some work the leader is
 // expected to do, so we must react to changes
@@ -418,6 +423,9 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 	// Enable the volume watcher, since we are now the leader
 	s.volumeWatcher.SetEnabled(true, s.State(), s.getLeaderAcl())
 
+	// Enable the event sink manager, since we are now the leader
+	s.eventSinkManager.SetEnabled(true, s.State())
+
 	// Restore the eval broker state and blocked eval state. If these are
 	// currently paused, we do not need to do this.
 	if restoreEvals {
@@ -466,6 +474,9 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 	// Periodically publish job status metrics
 	go s.publishJobStatusMetrics(stopCh)
 
+	// Evaluate jobs with dependencies once their dependencies complete
+	go s.watchJobDependencies(stopCh)
+
 	// Populate the variable lock TTL timers, so we can start tracking renewals
 	// and expirations.
 	if err := s.restoreLockTTLTimers(); err != nil {
@@ -897,6 +908,13 @@ func (s *Server) restorePeriodicDispatcher() error {
 			continue
 		}
 
+		// We skip if the job's catch up policy drops missed launches. The
+		// excluded launches are already skipped by Next.
+		if job.Periodic.CatchUp == structs.PeriodicCatchUpSkip {
+			logger.Debug("skipping missed launches of periodic job", "job", job.NamespacedID())
+			continue
+		}
+
 		// We skip if the job doesn't allow overlap and there are already
 		// instances running
 		allowed, err := s.cronJobOverlapAllowed(job)
@@ -907,6 +925,14 @@ func (s *Server) restorePeriodicDispatcher() error {
 			continue
 		}
 
+		// Jobs that prohibit overlap can only run a single missed launch.
+		if job.Periodic.CatchUp == structs.PeriodicCatchUpRunAll && !job.Periodic.ProhibitOverlap {
+			if err := s.runMissedPeriodicLaunches(job, nextLaunch, now); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if _, err := s.periodicDispatcher.ForceEval(job.Namespace, job.ID); err != nil {
 			logger.Error("force run of periodic job failed", "job", job.NamespacedID(), "error", err)
 			return fmt.Errorf("force run of periodic job %q failed: %v", job.NamespacedID(), err)
@@ -918,6 +944,39 @@ func (s *Server) restorePeriodicDispatcher() error {
 	return nil
 }
 
+// runMissedPeriodicLaunches launches an instance of a periodic job for every
+// launch between first and now, up to PeriodicCatchUpRunAllLimit. It is used
+// by the run-all catch up policy.
+func (s *Server) runMissedPeriodicLaunches(job *structs.Job, first, now time.Time) error {
+	logger := s.logger.Named("periodic")
+
+	var launched int
+	for launch := first; !launch.IsZero() && launch.Before(now); {
+		if launched == structs.PeriodicCatchUpRunAllLimit {
+			logger.Warn("too many missed launches of periodic job, skipping the rest",
+				"job", job.NamespacedID(), "limit", structs.PeriodicCatchUpRunAllLimit, "next", launch)
+			break
+		}
+
+		if _, err := s.periodicDispatcher.ForceEvalAt(job.Namespace, job.ID, launch); err != nil {
+			logger.Error("force run of periodic job failed", "job", job.NamespacedID(), "error", err)
+			return fmt.Errorf("force run of periodic job %q failed: %v", job.NamespacedID(), err)
+		}
+		launched++
+
+		var err error
+		launch, err = job.Periodic.Next(launch)
+		if err != nil {
+			logger.Error("failed to determine next periodic launch for job", "job", job.NamespacedID(), "error", err)
+			break
+		}
+	}
+
+	logger.Debug("periodic job missed launches run during leadership establishment",
+		"job", job.NamespacedID(), "launches", launched)
+	return nil
+}
+
 // cronJobOverlapAllowed checks if the job allows for overlap and if there are already
 // instances of the job running in order to determine if a new evaluation needs to
 // be created upon periodic dispatcher restore
@@ -1453,6 +1512,9 @@ func (s *Server) revokeLeadership() error {
 	// Disable the volume watcher
 	s.volumeWatcher.SetEnabled(false, nil, "")
 
+	// Disable the event sink manager
+	s.eventSinkManager.SetEnabled(false, nil)
+
 	// Disable any enterprise systems required.
 	if err := s.revokeEnterpriseLeadership(); err != nil {
 		return err
@@ -2777,6 +2839,7 @@ func (s *Server) handleEvalBrokerStateChange(schedConfig *structs.SchedulerConfi
 	// whether using a persisted Raft configuration, or the default bootstrap
 	// config.
 	var enableBrokers, restoreEvals bool
+	var fairShare structs.FairShareConfig
 
 	// The scheduler config can only be persisted to Raft once quorum has been
 	// established. If this is a fresh cluster, we need to use the default
@@ -2784,10 +2847,16 @@ func (s *Server) handleEvalBrokerStateChange(schedConfig *structs.SchedulerConfi
 	switch schedConfig {
 	case nil:
 		enableBrokers = !s.config.DefaultSchedulerConfig.PauseEvalBroker
+		fairShare = s.config.DefaultSchedulerConfig.FairShareConfig
 	default:
 		enableBrokers = !schedConfig.PauseEvalBroker
+		fairShare = schedConfig.FairShareConfig
 	}
 
+	// Apply the fair-share queueing configuration before enabling the broker
+	// so restored evaluations are dequeued in the expected order.
+	s.evalBroker.SetFairShareConfig(fairShare)
+
 	// If the evalBroker status is changing, set the new state.
 	if enableBrokers != s.evalBroker.Enabled() {
 		s.logger.Info("eval broker status modified", "paused", !enableBrokers)
@@ -442,6 +442,108 @@ func TestLeader_PeriodicDispatcher_Restore_NoEvals(t *testing.T) {
 	}
 }
 
+func TestLeader_PeriodicDispatcher_Restore_CatchUp(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Now().Truncate(time.Second)
+	lastLaunch := now.Add(-10 * time.Minute)
+	missed := []time.Time{
+		lastLaunch.Add(1 * time.Minute),
+		lastLaunch.Add(2 * time.Minute),
+		lastLaunch.Add(3 * time.Minute),
+	}
+
+	cases := []struct {
+		name       string
+		catchUp    string
+		exclusions []*structs.PeriodicExclusion
+		expected   []time.Time
+	}{
+		{name: "default", expected: []time.Time{{}}},
+		{name: "skip", catchUp: structs.PeriodicCatchUpSkip},
+		{name: "run-once", catchUp: structs.PeriodicCatchUpRunOnce, expected: []time.Time{{}}},
+		{name: "run-all", catchUp: structs.PeriodicCatchUpRunAll, expected: missed},
+		{
+			name:    "run-all excluded",
+			catchUp: structs.PeriodicCatchUpRunAll,
+			exclusions: []*structs.PeriodicExclusion{{
+				Start: missed[1].UTC().Format(time.RFC3339),
+				End:   missed[2].UTC().Format(time.RFC3339),
+			}},
+			expected: []time.Time{missed[0], missed[2]},
+		},
+		{
+			// launches missed before the exclusion the leader is elected
+			// in are still run
+			name:    "elected during exclusion",
+			catchUp: structs.PeriodicCatchUpRunAll,
+			exclusions: []*structs.PeriodicExclusion{{
+				Start: now.Add(-time.Minute).UTC().Format(time.RFC3339),
+				End:   now.Add(time.Hour).UTC().Format(time.RFC3339),
+			}},
+			expected: missed,
+		},
+		{
+			name:    "all missed launches excluded",
+			catchUp: structs.PeriodicCatchUpRunAll,
+			exclusions: []*structs.PeriodicExclusion{{
+				Start: lastLaunch.UTC().Format(time.RFC3339),
+				End:   now.Add(time.Hour).UTC().Format(time.RFC3339),
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s1, cleanupS1 := TestServer(t, func(c *Config) {
+				c.NumSchedulers = 0
+			})
+			defer cleanupS1()
+			testutil.WaitForLeader(t, s1.RPC)
+
+			// Register the job with the dispatcher disabled and record a last
+			// launch from before the missed launches.
+			s1.periodicDispatcher.SetEnabled(false)
+			job := testPeriodicJob(append(missed, now.Add(time.Hour))...)
+			job.Periodic.CatchUp = tc.catchUp
+			job.Periodic.Exclusions = tc.exclusions
+			_, _, err := s1.raftApply(structs.JobRegisterRequestType, structs.JobRegisterRequest{
+				Job:          job,
+				WriteRequest: structs.WriteRequest{Namespace: job.Namespace},
+			})
+			must.NoError(t, err)
+			must.NoError(t, s1.fsm.State().UpsertPeriodicLaunch(1000, &structs.PeriodicLaunch{
+				ID:        job.ID,
+				Namespace: job.Namespace,
+				Launch:    lastLaunch,
+			}))
+
+			s1.periodicDispatcher.SetEnabled(true)
+			must.NoError(t, s1.restorePeriodicDispatcher())
+
+			iter, err := s1.fsm.State().JobsByIDPrefix(nil, job.Namespace,
+				job.ID+structs.PeriodicLaunchSuffix, state.SortDefault)
+			must.NoError(t, err)
+
+			var launches []time.Time
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				launch, err := s1.periodicDispatcher.LaunchTime(raw.(*structs.Job).ID)
+				must.NoError(t, err)
+				launches = append(launches, launch)
+			}
+			must.Len(t, len(tc.expected), launches)
+			for i, expected := range tc.expected {
+				// A zero expected time is a single launch at the current time.
+				if expected.IsZero() {
+					must.False(t, launches[i].Before(now))
+				} else {
+					must.Eq(t, expected.Unix(), launches[i].Unix())
+				}
+			}
+		})
+	}
+}
+
 type mockJobEvalDispatcher struct {
 	forceEvalCalled, children bool
 	evalToReturn              *structs.Evaluation
@@ -255,6 +255,24 @@ func NodePool() *structs.NodePool {
 	return pool
 }
 
+// EventSink generates a webhook event sink with all of its default values
+// set.
+func EventSink() *structs.EventSink {
+	sink := &structs.EventSink{
+		ID:   fmt.Sprintf("sink-%s", uuid.Short()),
+		Type: structs.EventSinkTypeWebhook,
+		Topics: map[structs.Topic][]string{
+			structs.TopicJob: {"*"},
+		},
+		Webhook: &structs.EventSinkWebhookConfig{
+			Address: "http://127.0.0.1:8080/events",
+			Headers: map[string]string{"Authorization": "Bearer test"},
+		},
+	}
+	sink.Canonicalize()
+	return sink
+}
+
 // ServiceRegistrations generates an array containing two unique service
 // registrations.
 func ServiceRegistrations() []*structs.ServiceRegistration {
@@ -511,6 +511,36 @@ func (op *Operator) SchedulerGetConfiguration(args *structs.GenericRequest, repl
 	return nil
 }
 
+// SchedulerGetQueues is used to retrieve the fair-share queue state of the
+// leader's eval broker.
+func (op *Operator) SchedulerGetQueues(args *structs.GenericRequest, reply *structs.SchedulerQueuesResponse) error {
+
+	authErr := op.srv.Authenticate(op.ctx, args)
+	// The eval broker only runs on the leader, so we fix the args since we
+	// are re-using a structure where we don't support all the options.
+	args.AllowStale = false
+	if done, err := op.srv.forward("Operator.SchedulerGetQueues", args, args, reply); done {
+		return err
+	}
+	op.srv.MeasureRPCRate("operator", structs.RateMetricRead, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+
+	// This action requires operator read access.
+	aclObj, err := op.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	} else if !aclObj.AllowOperatorRead() {
+		return structs.ErrPermissionDenied
+	}
+
+	reply.FairShareEnabled, reply.Queues = op.srv.evalBroker.SchedulerQueues()
+	op.srv.setQueryMeta(&reply.QueryMeta)
+
+	return nil
+}
+
 func (op *Operator) forwardStreamingRPC(region string, method string, args any, in io.ReadWriteCloser) error {
 	server, err := op.srv.findRegionServer(region)
 	if err != nil {
@@ -586,6 +586,53 @@ func TestOperator_SchedulerSetConfiguration(t *testing.T) {
 	require.False(t, s1.blockedEvals.Enabled())
 }
 
+func TestOperator_SchedulerGetQueues(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, root, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Enable fair-share queueing
+	setArg := structs.SchedulerSetConfigRequest{
+		Config: structs.SchedulerConfiguration{
+			FairShareConfig: structs.FairShareConfig{
+				Enabled: true,
+				Weights: map[string]int{"default": 5},
+			},
+		},
+	}
+	setArg.Region = s1.config.Region
+	setArg.AuthToken = root.SecretID
+	var setReply structs.SchedulerSetConfigurationResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.SchedulerSetConfiguration", &setArg, &setReply))
+
+	s1.evalBroker.Enqueue(mock.Eval())
+
+	arg := structs.GenericRequest{
+		QueryOptions: structs.QueryOptions{
+			Region: s1.config.Region,
+		},
+	}
+	var reply structs.SchedulerQueuesResponse
+
+	// Try with no token and expect permission denied
+	err := msgpackrpc.CallWithCodec(codec, "Operator.SchedulerGetQueues", &arg, &reply)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	// Try with root token, should succeed
+	arg.AuthToken = root.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.SchedulerGetQueues", &arg, &reply))
+	must.True(t, reply.FairShareEnabled)
+	must.SliceContainsFunc(t, reply.Queues, "default",
+		func(q *structs.SchedulerQueue, ns string) bool {
+			return q.Namespace == ns && q.Weight == 5 && q.Ready+q.Unacked > 0
+		})
+}
+
 func TestOperator_SchedulerGetConfiguration_ACL(t *testing.T) {
 	ci.Parallel(t)
 
@@ -281,6 +281,13 @@ func (p *PeriodicDispatch) removeLocked(jobID structs.NamespacedID) error {
 // ForceEval causes the periodic job to be evaluated immediately and returns the
 // subsequent eval.
 func (p *PeriodicDispatch) ForceEval(namespace, jobID string) (*structs.Evaluation, error) {
+	return p.ForceEvalAt(namespace, jobID, time.Now())
+}
+
+// ForceEvalAt causes the periodic job to be evaluated immediately as if it was
+// launched at the passed time and returns the subsequent eval. It is used to
+// run launches that were missed while there was no leader.
+func (p *PeriodicDispatch) ForceEvalAt(namespace, jobID string, launch time.Time) (*structs.Evaluation, error) {
 	p.l.Lock()
 
 	// Do nothing if not enabled
@@ -300,7 +307,7 @@ func (p *PeriodicDispatch) ForceEval(namespace, jobID string) (*structs.Evaluati
 	}
 
 	p.l.Unlock()
-	return p.createEval(job, time.Now().In(job.Periodic.GetLocation()))
+	return p.createEval(job, launch.In(job.Periodic.GetLocation()))
 }
 
 // shouldRun returns whether the long lived run function should run.
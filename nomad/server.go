@@ -45,10 +45,12 @@ import (
 	"github.com/hashicorp/nomad/nomad/auth"
 	"github.com/hashicorp/nomad/nomad/deploymentwatcher"
 	"github.com/hashicorp/nomad/nomad/drainer"
+	"github.com/hashicorp/nomad/nomad/eventsink"
 	"github.com/hashicorp/nomad/nomad/lock"
 	"github.com/hashicorp/nomad/nomad/peers"
 	"github.com/hashicorp/nomad/nomad/reporting"
 	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/nomad/structs/config"
 	"github.com/hashicorp/nomad/nomad/volumewatcher"
@@ -75,6 +77,7 @@ const (
 
 	raftState         = "raft/"
 	serfSnapshot      = "serf/snapshot"
+	eventJournalPath  = "event_journal/journal.db"
 	snapshotsRetained = 2
 
 	// serverRPCCache controls how long we keep an idle connection open to a server
@@ -235,6 +238,14 @@ type Server struct {
 	// volumeWatcher is used to release volume claims
 	volumeWatcher *volumewatcher.Watcher
 
+	// eventSinkManager is used to deliver events to the registered event
+	// sinks.
+	eventSinkManager *eventsink.Manager
+
+	// eventJournal is the optional on-disk journal of published events. It
+	// outlives the state stores replaced on snapshot restore.
+	eventJournal *stream.Journal
+
 	// volumeControllerFutures is a map of plugin IDs to pending controller RPCs. If
 	// no RPC is pending for a given plugin, this may be nil.
 	volumeControllerFutures map[string]context.Context
@@ -482,6 +493,14 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigFunc
 		Encrypter:      s.encrypter,
 	})
 
+	// Open the event journal before the FSM is created so it receives the
+	// events published while raft logs are replayed
+	if err := s.setupEventJournal(); err != nil {
+		s.Shutdown()
+		s.logger.Error("failed to setup event journal", "error", err)
+		return nil, fmt.Errorf("Failed to setup event journal: %v", err)
+	}
+
 	// Initialize the Raft server
 	if err := s.setupRaft(); err != nil {
 		s.Shutdown()
@@ -530,6 +549,9 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigFunc
 	// Setup the node drainer.
 	s.setupNodeDrainer()
 
+	// Setup the event sink manager.
+	s.setupEventSinkManager()
+
 	// Setup the enterprise state
 	if err := s.setupEnterprise(config); err != nil {
 		return nil, err
@@ -789,6 +811,13 @@ func (s *Server) Shutdown() error {
 		s.fsm.Close()
 	}
 
+	// Close the event journal once nothing can publish to it
+	if s.eventJournal != nil {
+		if err := s.eventJournal.Close(); err != nil {
+			s.logger.Warn("error closing event journal", "error", err)
+		}
+	}
+
 	// Stop being able to set Configuration Entries
 	s.consulConfigEntries.Stop()
 
@@ -1161,10 +1190,17 @@ func (s *Server) setupDeploymentWatcher() error {
 		apply: s.raftApply,
 	}
 
+	variableShim := &deploymentWatcherVariableShim{
+		state:     s.State,
+		encrypter: s.encrypter,
+	}
+
 	// Create the deployment watcher
 	s.deploymentWatcher = deploymentwatcher.NewDeploymentsWatcher(
 		s.logger,
 		raftShim,
+		variableShim,
+		s.config.CanaryAnalysisHTTPAllowlist,
 		NewDeploymentEndpoint(s, nil),
 		NewJobEndpoints(s, nil),
 		s.config.DeploymentQueryRateLimit,
@@ -1182,6 +1218,34 @@ func (s *Server) setupVolumeWatcher() error {
 	return nil
 }
 
+// setupEventJournal opens the on-disk event journal if it is enabled.
+func (s *Server) setupEventJournal() error {
+	if !s.config.EnableEventBroker || !s.config.EnableEventJournal {
+		return nil
+	}
+	if s.config.DataDir == "" {
+		s.logger.Warn("event journal requires a data directory and is disabled")
+		return nil
+	}
+
+	journal, err := stream.NewJournal(stream.JournalConfig{
+		Path:      filepath.Join(s.config.DataDir, eventJournalPath),
+		Retention: s.config.EventJournalRetention,
+		Logger:    s.logger,
+	})
+	if err != nil {
+		return err
+	}
+	s.eventJournal = journal
+	return nil
+}
+
+// setupEventSinkManager creates an event sink manager which will be enabled
+// when a server becomes a leader.
+func (s *Server) setupEventSinkManager() {
+	s.eventSinkManager = eventsink.NewManager(s.logger, eventSinkShim{s})
+}
+
 // setupNodeDrainer creates a node drainer which will be enabled when a server
 // becomes a leader.
 func (s *Server) setupNodeDrainer() {
@@ -1279,9 +1343,9 @@ func (s *Server) setupStreamingEndpoints(server *rpc.Server) {
 	agentEndpoint := NewAgentEndpoint(s)
 	agentEndpoint.register()
 
-	// Event is a streaming-only endpoint so we don't want to register it as a
-	// normal RPC
-	eventEndpoint := NewEventEndpoint(s)
+	// Event takes a RPC context but also has a streaming RPC that needs to
+	// be registered
+	eventEndpoint := NewEventEndpoint(s, nil)
 	eventEndpoint.register()
 
 	// Operator takes a RPC context but also has a streaming RPC that needs to
@@ -1306,6 +1370,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) {
 	_ = server.Register(NewFileSystemEndpoint(s))
 	_ = server.Register(NewAgentEndpoint(s))
 	_ = server.Register(NewOperatorEndpoint(s, ctx))
+	_ = server.Register(NewEventEndpoint(s, ctx))
 
 	// All other endpoints include the connection context and don't need to be
 	// registered as streaming endpoints
@@ -1317,6 +1382,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) {
 	_ = server.Register(NewCSIPluginEndpoint(s, ctx))
 	_ = server.Register(NewDeploymentEndpoint(s, ctx))
 	_ = server.Register(NewEvalEndpoint(s, ctx))
+	_ = server.Register(NewEventSinkEndpoint(s, ctx))
 	_ = server.Register(NewJobEndpoints(s, ctx))
 	_ = server.Register(NewKeyringEndpoint(s, ctx, s.encrypter))
 	_ = server.Register(NewNamespaceEndpoint(s, ctx))
@@ -1363,6 +1429,7 @@ func (s *Server) setupRaft() error {
 		Region:             s.Region(),
 		EnableEventBroker:  s.config.EnableEventBroker,
 		EventBufferSize:    s.config.EventBufferSize,
+		EventJournal:       s.eventJournal,
 		JobTrackedVersions: s.config.JobTrackedVersions,
 	}
 
@@ -1230,6 +1230,38 @@ func TestServiceRegistration_GetService(t *testing.T) {
 			},
 			name: "ACLs enabled using node secret",
 		},
+		{
+			serverFn: func(t *testing.T) (*Server, *structs.ACLToken, func()) {
+				return TestACLServer(t, nil)
+			},
+			testFn: func(t *testing.T, s *Server, _ *structs.ACLToken) {
+				codec := rpcClient(t, s)
+				testutil.WaitForKeyring(t, s.RPC, "global")
+
+				services := mock.ServiceRegistrations()
+				must.NoError(t, s.fsm.State().UpsertServiceRegistrations(
+					structs.MsgTypeTestSetup, 10, services))
+
+				node := mock.Node()
+				must.NoError(t, s.State().UpsertNode(structs.MsgTypeTestSetup, 20, node))
+
+				// The node secret grants no access to the services of a
+				// namespace; clients read them with workload identities.
+				serviceRegReq := &structs.ServiceRegistrationByNameRequest{
+					ServiceName: services[0].ServiceName,
+					QueryOptions: structs.QueryOptions{
+						Namespace: services[0].Namespace,
+						Region:    s.Region(),
+						AuthToken: node.SecretID,
+					},
+				}
+				var serviceRegResp structs.ServiceRegistrationByNameResponse
+				err := msgpackrpc.CallWithCodec(
+					codec, structs.ServiceRegistrationGetServiceRPCMethod, serviceRegReq, &serviceRegResp)
+				must.EqError(t, err, structs.ErrPermissionDenied.Error())
+			},
+			name: "ACLs enabled get service using node secret",
+		},
 		{
 			serverFn: func(t *testing.T) (*Server, *structs.ACLToken, func()) {
 				return TestACLServer(t, nil)
@@ -4,6 +4,8 @@
 package state
 
 import (
+	"fmt"
+
 	memdb "github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -59,17 +61,107 @@ func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
 	}
 
 	var events []structs.Event
+	changedAllocs := allocsFromChanges(changes)
 	for _, change := range changes.Changes {
 		if event, ok := eventFromChange(change); ok {
 			event.Type = eventType
 			event.Index = changes.Index
 			events = append(events, event)
 		}
+		if event, ok := preemptionEventFromChange(tx, changedAllocs, change); ok {
+			event.Index = changes.Index
+			events = append(events, event)
+		}
 	}
 
 	return &structs.Events{Index: changes.Index, Events: events}
 }
 
+// allocsFromChanges indexes the allocations written by a set of changes. Events
+// are generated before the transaction commits, so allocations placed in the
+// same transaction are not yet visible to the read transaction.
+func allocsFromChanges(changes Changes) map[string]*structs.Allocation {
+	allocs := make(map[string]*structs.Allocation)
+	for _, change := range changes.Changes {
+		if change.Table != "allocs" || change.Deleted() {
+			continue
+		}
+		if alloc, ok := change.After.(*structs.Allocation); ok {
+			allocs[alloc.ID] = alloc
+		}
+	}
+	return allocs
+}
+
+// preemptionEventFromChange returns a Preemption event if the change marks an
+// allocation as preempted for the first time.
+func preemptionEventFromChange(tx ReadTxn, changedAllocs map[string]*structs.Allocation, change memdb.Change) (structs.Event, bool) {
+	if change.Table != "allocs" || change.Deleted() {
+		return structs.Event{}, false
+	}
+
+	after, ok := change.After.(*structs.Allocation)
+	if !ok || after.DesiredStatus != structs.AllocDesiredStatusEvict || after.PreemptedByAllocation == "" {
+		return structs.Event{}, false
+	}
+	if before, ok := change.Before.(*structs.Allocation); ok &&
+		before.DesiredStatus == structs.AllocDesiredStatusEvict {
+		return structs.Event{}, false
+	}
+
+	payload := &structs.PreemptionEvent{
+		PreemptedByAllocation: after.PreemptedByAllocation,
+		Reason:                after.DesiredDescription,
+	}
+	if after.Job != nil {
+		payload.JobPriority = after.Job.Priority
+	}
+
+	preempting := changedAllocs[after.PreemptedByAllocation]
+	if preempting == nil {
+		if raw, err := tx.First("allocs", "id", after.PreemptedByAllocation); err == nil && raw != nil {
+			preempting = raw.(*structs.Allocation)
+		}
+	}
+	filterKeys := []string{after.JobID}
+	if preempting != nil {
+		// the event is published in the namespace of the preempted
+		// allocation, so the preempting job is only identified if it is in
+		// the same namespace
+		sameNamespace := preempting.Namespace == after.Namespace
+		if sameNamespace {
+			payload.PreemptedByJobID = preempting.JobID
+			payload.PreemptedByNamespace = preempting.Namespace
+			filterKeys = append(filterKeys, preempting.JobID)
+		}
+		if preempting.Job != nil {
+			payload.PreemptedByJobPriority = preempting.Job.Priority
+			if sameNamespace {
+				payload.Reason = fmt.Sprintf(
+					"Preempted by allocation %s of job %q with priority %d, higher than priority %d",
+					preempting.ID, preempting.JobID, payload.PreemptedByJobPriority, payload.JobPriority)
+			} else {
+				payload.Reason = fmt.Sprintf(
+					"Preempted by allocation %s with priority %d, higher than priority %d",
+					preempting.ID, payload.PreemptedByJobPriority, payload.JobPriority)
+			}
+		}
+	}
+
+	alloc := after.Sanitize()
+	alloc.Job = nil
+	payload.Allocation = alloc
+
+	return structs.Event{
+		Topic:      structs.TopicPreemption,
+		Type:       structs.TypeAllocationPreempted,
+		Key:        after.ID,
+		FilterKeys: filterKeys,
+		Namespace:  after.Namespace,
+		Payload:    payload,
+	}, true
+}
+
 func eventFromChange(change memdb.Change) (structs.Event, bool) {
 	if change.Deleted() {
 		switch change.Table {
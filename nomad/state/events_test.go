@@ -545,6 +545,136 @@ func TestEventsFromChanges_ApplyPlanResultsRequestType(t *testing.T) {
 	}
 }
 
+func TestEventsFromChanges_ApplyPlanResultsRequestType_Preemption(t *testing.T) {
+	ci.Parallel(t)
+	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer s.StopEventBroker()
+
+	lowJob := mock.Job()
+	lowJob.Priority = 20
+	highJob := mock.Job()
+	highJob.Priority = 80
+	must.NoError(t, s.UpsertJob(structs.MsgTypeTestSetup, 8, nil, lowJob))
+	must.NoError(t, s.UpsertJob(structs.MsgTypeTestSetup, 9, nil, highJob))
+
+	existing := mock.Alloc()
+	existing.JobID = lowJob.ID
+	existing.Job = lowJob
+	must.NoError(t, s.UpsertAllocs(structs.MsgTypeTestSetup, 10, []*structs.Allocation{existing}))
+
+	eval := mock.Eval()
+	eval.JobID = highJob.ID
+	must.NoError(t, s.UpsertEvals(structs.MsgTypeTestSetup, 11, []*structs.Evaluation{eval}))
+
+	placed := mock.Alloc()
+	placed.JobID = highJob.ID
+	placed.Job = nil
+
+	req := &structs.ApplyPlanResultsRequest{
+		AllocsUpdated: []*structs.Allocation{placed},
+		AllocsPreempted: []*structs.AllocationDiff{{
+			ID:                    existing.ID,
+			PreemptedByAllocation: placed.ID,
+		}},
+		Job:    highJob,
+		EvalID: eval.ID,
+	}
+	must.NoError(t, s.UpsertPlanResults(structs.ApplyPlanResultsRequestType, 100, req))
+
+	events := WaitForEvents(t, s, 100, 1, 1*time.Second)
+
+	var preemptions []structs.Event
+	for _, e := range events {
+		if e.Topic == structs.TopicPreemption {
+			preemptions = append(preemptions, e)
+		}
+	}
+	must.Len(t, 1, preemptions)
+
+	event := preemptions[0]
+	must.Eq(t, structs.TypeAllocationPreempted, event.Type)
+	must.Eq(t, existing.ID, event.Key)
+	must.Eq(t, existing.Namespace, event.Namespace)
+	must.SliceContains(t, event.FilterKeys, highJob.ID)
+
+	payload, ok := event.Payload.(*structs.PreemptionEvent)
+	must.True(t, ok)
+	must.Eq(t, placed.ID, payload.PreemptedByAllocation)
+	must.Eq(t, highJob.ID, payload.PreemptedByJobID)
+	must.Eq(t, 80, payload.PreemptedByJobPriority)
+	must.Eq(t, 20, payload.JobPriority)
+	must.Nil(t, payload.Allocation.Job)
+	must.StrContains(t, payload.Reason, "priority 80")
+}
+
+func TestEventsFromChanges_ApplyPlanResultsRequestType_PreemptionOtherNamespace(t *testing.T) {
+	ci.Parallel(t)
+	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer s.StopEventBroker()
+
+	ns := mock.Namespace()
+	must.NoError(t, s.UpsertNamespaces(7, []*structs.Namespace{ns}))
+
+	lowJob := mock.Job()
+	lowJob.Priority = 20
+	highJob := mock.Job()
+	highJob.Namespace = ns.Name
+	highJob.Priority = 80
+	must.NoError(t, s.UpsertJob(structs.MsgTypeTestSetup, 8, nil, lowJob))
+	must.NoError(t, s.UpsertJob(structs.MsgTypeTestSetup, 9, nil, highJob))
+
+	existing := mock.Alloc()
+	existing.JobID = lowJob.ID
+	existing.Job = lowJob
+	must.NoError(t, s.UpsertAllocs(structs.MsgTypeTestSetup, 10, []*structs.Allocation{existing}))
+
+	eval := mock.Eval()
+	eval.Namespace = ns.Name
+	eval.JobID = highJob.ID
+	must.NoError(t, s.UpsertEvals(structs.MsgTypeTestSetup, 11, []*structs.Evaluation{eval}))
+
+	placed := mock.Alloc()
+	placed.Namespace = ns.Name
+	placed.JobID = highJob.ID
+	placed.Job = nil
+
+	req := &structs.ApplyPlanResultsRequest{
+		AllocsUpdated: []*structs.Allocation{placed},
+		AllocsPreempted: []*structs.AllocationDiff{{
+			ID:                    existing.ID,
+			PreemptedByAllocation: placed.ID,
+		}},
+		Job:    highJob,
+		EvalID: eval.ID,
+	}
+	must.NoError(t, s.UpsertPlanResults(structs.ApplyPlanResultsRequestType, 100, req))
+
+	events := WaitForEvents(t, s, 100, 1, 1*time.Second)
+
+	var preemptions []structs.Event
+	for _, e := range events {
+		if e.Topic == structs.TopicPreemption {
+			preemptions = append(preemptions, e)
+		}
+	}
+	must.Len(t, 1, preemptions)
+
+	// the preempting job is not disclosed to readers of the preempted
+	// allocation's namespace
+	event := preemptions[0]
+	must.Eq(t, existing.Namespace, event.Namespace)
+	must.Eq(t, []string{lowJob.ID}, event.FilterKeys)
+
+	payload, ok := event.Payload.(*structs.PreemptionEvent)
+	must.True(t, ok)
+	must.Eq(t, placed.ID, payload.PreemptedByAllocation)
+	must.Eq(t, "", payload.PreemptedByJobID)
+	must.Eq(t, "", payload.PreemptedByNamespace)
+	must.Eq(t, 80, payload.PreemptedByJobPriority)
+	must.StrNotContains(t, payload.Reason, highJob.ID)
+	must.StrContains(t, payload.Reason, "priority 80")
+}
+
 func TestEventFromChange_AllocationTimeoutFields(t *testing.T) {
 	ci.Parallel(t)
 	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
@@ -31,6 +31,7 @@ const (
 	TableCSIVolumes               = "csi_volumes"
 	TableCSIPlugins               = "csi_plugins"
 	TableTaskGroupHostVolumeClaim = "task_volume"
+	TableEventSinks               = "event_sinks"
 )
 
 const (
@@ -104,6 +105,7 @@ func init() {
 		bindingRulesTableSchema,
 		hostVolumeTableSchema,
 		taskGroupHostVolumeClaimSchema,
+		eventSinkTableSchema,
 	}...)
 }
 
@@ -208,6 +210,24 @@ func nodePoolTableSchema() *memdb.TableSchema {
 	}
 }
 
+// eventSinkTableSchema returns the MemDB schema for the event sinks table.
+// This table is used to store the event sinks the leader delivers events to.
+func eventSinkTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableEventSinks,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "ID",
+				},
+			},
+		},
+	}
+}
+
 // jobTableSchema returns the MemDB schema for the jobs table.
 // This table is used to store all the jobs that have been submitted.
 func jobTableSchema() *memdb.TableSchema {
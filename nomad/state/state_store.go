@@ -87,6 +87,9 @@ type StateStoreConfig struct {
 	// EventBufferSize configures the amount of events to hold in memory
 	EventBufferSize int64
 
+	// EventJournal is the optional on-disk journal events are written to
+	EventJournal *stream.Journal
+
 	// JobTrackedVersions is the number of historic job versions that are kept.
 	JobTrackedVersions int
 }
@@ -147,6 +150,7 @@ func NewStateStore(config *StateStoreConfig) (*StateStore, error) {
 		broker, err := stream.NewEventBroker(ctx, stream.EventBrokerCfg{
 			EventBufferSize: config.EventBufferSize,
 			Logger:          config.Logger,
+			Journal:         config.EventJournal,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("creating state store event broker %w", err)
@@ -3557,6 +3561,39 @@ func (s *StateStore) nestedUpsertEval(txn *txn, index uint64, eval *structs.Eval
 	if err := txn.Insert("index", &IndexEntry{"evals", index}); err != nil {
 		return fmt.Errorf("index update failed: %v", err)
 	}
+
+	return s.setJobDependenciesReleased(index, txn, eval)
+}
+
+// setJobDependenciesReleased records on a job with dependencies that an
+// evaluation was created for its current version, so the leader doesn't
+// evaluate it again once the evaluation is garbage collected.
+func (s *StateStore) setJobDependenciesReleased(index uint64, txn *txn, eval *structs.Evaluation) error {
+	existing, err := txn.First("jobs", "id", eval.Namespace, eval.JobID)
+	if err != nil {
+		return fmt.Errorf("job lookup failed: %v", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	job := existing.(*structs.Job)
+	if job.DependsOn == nil ||
+		eval.JobModifyIndex < job.JobModifyIndex ||
+		job.DependenciesReleasedIndex >= job.JobModifyIndex {
+		return nil
+	}
+
+	updated := job.Copy()
+	updated.DependenciesReleasedIndex = job.JobModifyIndex
+	updated.ModifyIndex = index
+
+	if err := txn.Insert("jobs", updated); err != nil {
+		return fmt.Errorf("job insert failed: %v", err)
+	}
+	if err := txn.Insert("index", &IndexEntry{"jobs", index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"fmt"
+
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// EventSinks returns an iterator over all event sinks.
+func (s *StateStore) EventSinks(ws memdb.WatchSet, sort SortOption) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	var iter memdb.ResultIterator
+	var err error
+
+	switch sort {
+	case SortReverse:
+		iter, err = txn.GetReverse(TableEventSinks, indexID)
+	default:
+		iter, err = txn.Get(TableEventSinks, indexID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("event sinks lookup failed: %w", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// EventSinkByID returns the event sink that matches the given ID or nil if
+// there is no match.
+func (s *StateStore) EventSinkByID(ws memdb.WatchSet, id string) (*structs.EventSink, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, existing, err := txn.FirstWatch(TableEventSinks, indexID, id)
+	if err != nil {
+		return nil, fmt.Errorf("event sink lookup failed: %w", err)
+	}
+	ws.Add(watchCh)
+
+	if existing == nil {
+		return nil, nil
+	}
+	return existing.(*structs.EventSink), nil
+}
+
+// UpsertEventSink inserts or updates the given event sink. Updating a sink
+// keeps its delivery checkpoint so events are not redelivered.
+func (s *StateStore) UpsertEventSink(msgType structs.MessageType, index uint64, sink *structs.EventSink) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	existing, err := txn.First(TableEventSinks, indexID, sink.ID)
+	if err != nil {
+		return fmt.Errorf("event sink lookup failed: %w", err)
+	}
+
+	if existing != nil {
+		exist := existing.(*structs.EventSink)
+		sink.CreateIndex = exist.CreateIndex
+		sink.LatestIndex = exist.LatestIndex
+	} else {
+		sink.CreateIndex = index
+	}
+	sink.ModifyIndex = index
+
+	if err := txn.Insert(TableEventSinks, sink); err != nil {
+		return fmt.Errorf("event sink insert failed: %w", err)
+	}
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
+
+// DeleteEventSinks removes the given set of event sinks.
+func (s *StateStore) DeleteEventSinks(msgType structs.MessageType, index uint64, ids []string) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, id := range ids {
+		existing, err := txn.First(TableEventSinks, indexID, id)
+		if err != nil {
+			return fmt.Errorf("event sink lookup failed: %w", err)
+		}
+		if existing == nil {
+			return fmt.Errorf("event sink %s not found", id)
+		}
+		if err := txn.Delete(TableEventSinks, existing); err != nil {
+			return fmt.Errorf("event sink deletion failed: %w", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
+
+// UpdateEventSinkCheckpoints records the latest index delivered to each of
+// the given event sinks. Checkpoints only ever move forward, and sinks that
+// have since been deleted are ignored. The ModifyIndex of the sinks is left
+// untouched so checkpoints are not mistaken for configuration changes.
+func (s *StateStore) UpdateEventSinkCheckpoints(msgType structs.MessageType, index uint64, checkpoints map[string]uint64) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for id, latest := range checkpoints {
+		existing, err := txn.First(TableEventSinks, indexID, id)
+		if err != nil {
+			return fmt.Errorf("event sink lookup failed: %w", err)
+		}
+		if existing == nil {
+			continue
+		}
+
+		sink := existing.(*structs.EventSink)
+		if latest <= sink.LatestIndex {
+			continue
+		}
+
+		sink = sink.Copy()
+		sink.LatestIndex = latest
+		if err := txn.Insert(TableEventSinks, sink); err != nil {
+			return fmt.Errorf("event sink insert failed: %w", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_EventSinks_CRUD(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	sink1 := mock.EventSink()
+	sink2 := mock.EventSink()
+
+	must.NoError(t, store.UpsertEventSink(structs.MsgTypeTestSetup, 1000, sink1))
+	must.NoError(t, store.UpsertEventSink(structs.MsgTypeTestSetup, 1001, sink2))
+
+	iter, err := store.EventSinks(nil, SortDefault)
+	must.NoError(t, err)
+	var got []*structs.EventSink
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		got = append(got, raw.(*structs.EventSink))
+	}
+	must.Len(t, 2, got)
+
+	index, err := store.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 1001, index)
+
+	// Checkpoints only move forward, don't change the ModifyIndex, and ignore
+	// unknown sinks.
+	must.NoError(t, store.UpdateEventSinkCheckpoints(structs.MsgTypeTestSetup, 1002,
+		map[string]uint64{sink1.ID: 900, "unknown": 10}))
+	must.NoError(t, store.UpdateEventSinkCheckpoints(structs.MsgTypeTestSetup, 1003,
+		map[string]uint64{sink1.ID: 800}))
+
+	out, err := store.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.Eq(t, 900, out.LatestIndex)
+	must.Eq(t, 1000, out.CreateIndex)
+	must.Eq(t, 1000, out.ModifyIndex)
+
+	index, err = store.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 1003, index)
+
+	// Updating the sink keeps its checkpoint.
+	update := sink1.Copy()
+	update.Webhook.Address = "https://example.com/events"
+	update.LatestIndex = 0
+	must.NoError(t, store.UpsertEventSink(structs.MsgTypeTestSetup, 1004, update))
+
+	out, err = store.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.Eq(t, "https://example.com/events", out.Webhook.Address)
+	must.Eq(t, 900, out.LatestIndex)
+	must.Eq(t, 1000, out.CreateIndex)
+	must.Eq(t, 1004, out.ModifyIndex)
+
+	// Deleting an unknown sink fails without deleting the others.
+	err = store.DeleteEventSinks(structs.MsgTypeTestSetup, 1005, []string{sink1.ID, "unknown"})
+	must.ErrorContains(t, err, "not found")
+
+	out, err = store.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+
+	must.NoError(t, store.DeleteEventSinks(structs.MsgTypeTestSetup, 1006, []string{sink1.ID, sink2.ID}))
+	out, err = store.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.Nil(t, out)
+
+	index, err = store.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 1006, index)
+}
@@ -283,3 +283,11 @@ func (r *StateRestore) HostVolumeRestore(vol *structs.HostVolume) error {
 	}
 	return nil
 }
+
+// EventSinkRestore restores a single event sink into the event_sinks table
+func (r *StateRestore) EventSinkRestore(sink *structs.EventSink) error {
+	if err := r.txn.Insert(TableEventSinks, sink); err != nil {
+		return fmt.Errorf("event sink insert failed: %w", err)
+	}
+	return nil
+}
@@ -23,6 +23,10 @@ const (
 type EventBrokerCfg struct {
 	EventBufferSize int64
 	Logger          hclog.Logger
+
+	// Journal, if set, persists published events to disk so subscribers
+	// can resume from indexes that are no longer in the buffer.
+	Journal *Journal
 }
 
 type EventBroker struct {
@@ -40,6 +44,9 @@ type EventBroker struct {
 
 	aclCh chan structs.Event
 
+	// journal is the optional on-disk event journal.
+	journal *Journal
+
 	logger hclog.Logger
 }
 
@@ -63,6 +70,7 @@ func NewEventBroker(ctx context.Context, cfg EventBrokerCfg) (*EventBroker, erro
 		eventBuf:  buffer,
 		publishCh: make(chan *structs.Events, 64),
 		aclCh:     make(chan structs.Event, 10),
+		journal:   cfg.Journal,
 		subscriptions: &subscriptions{
 			byToken: make(map[string]map[*SubscribeRequest]*Subscription),
 		},
@@ -106,9 +114,17 @@ func (e *EventBroker) Publish(events *structs.Events) {
 // set and the index is no longer in the buffer or not yet in the buffer an error
 // will be returned.
 //
+// If the broker has a journal and the requested index is older than the
+// buffer, the subscription first replays events from the journal.
+//
 // When a caller is finished with the subscription it must call Subscription.Unsubscribe
 // to free ACL tracking resources.
 func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
+	evaluator, err := newFilterEvaluator(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -139,7 +155,17 @@ func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
 		return nil, err
 	}
 
-	sub := newSubscription(req, start, e.subscriptions.unsubscribeFn(req))
+	sub := newSubscription(req, start, evaluator, e.subscriptions.unsubscribeFn(req))
+
+	// Replay from the journal if the requested index has been dropped from
+	// the buffer, or the buffer is empty after a restart.
+	if e.journal != nil && req.Index != 0 && req.Index <= e.journal.LastIndex() {
+		if first := e.eventBuf.Head().Events.Index; first == 0 || req.Index < first {
+			sub.journal = e.journal
+			sub.journalIndex = req.Index
+			sub.buffer = e.eventBuf
+		}
+	}
 
 	e.subscriptions.add(req, sub)
 	return sub, nil
@@ -158,6 +184,9 @@ func (e *EventBroker) handleUpdates(ctx context.Context) {
 			return
 		case update := <-e.publishCh:
 			e.eventBuf.Append(update)
+			if e.journal != nil {
+				e.journal.enqueue(update)
+			}
 		}
 	}
 }
@@ -122,6 +122,61 @@ func TestEventBroker_ShutdownClosesSubscriptions(t *testing.T) {
 	sub2.Unsubscribe()
 }
 
+func TestEventBroker_Subscribe_Filter(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	publisher, err := NewEventBroker(ctx, EventBrokerCfg{EventBufferSize: 100})
+	must.NoError(t, err)
+
+	_, err = publisher.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{structs.TopicAll: {"*"}},
+		Filter: "Payload.Allocation.ClientStatus ==",
+	})
+	must.ErrorIs(t, err, ErrInvalidFilter)
+
+	sub, err := publisher.Subscribe(&SubscribeRequest{
+		Topics:     map[structs.Topic][]string{structs.TopicAll: {"*"}},
+		Namespaces: []string{"prod", "dev"},
+		Filter:     `Namespace == "prod" and Payload.Allocation.ClientStatus == "failed"`,
+	})
+	must.NoError(t, err)
+	eventCh := consumeSubscription(ctx, sub)
+
+	allocEvent := func(id, namespace, status string) structs.Event {
+		return structs.Event{
+			Topic:     structs.TopicAllocation,
+			Key:       id,
+			Namespace: namespace,
+			Index:     1,
+			Payload: &structs.AllocationEvent{
+				Allocation: &structs.Allocation{ID: id, Namespace: namespace, ClientStatus: status},
+			},
+		}
+	}
+
+	publisher.Publish(&structs.Events{Index: 1, Events: []structs.Event{
+		allocEvent("a", "prod", structs.AllocClientStatusRunning),
+		allocEvent("b", "dev", structs.AllocClientStatusFailed),
+		allocEvent("c", "prod", structs.AllocClientStatusFailed),
+		{
+			// Events without the selected fields are excluded.
+			Topic:     structs.TopicJob,
+			Key:       "example",
+			Namespace: "prod",
+			Index:     1,
+			Payload:   &structs.JobEvent{Job: &structs.Job{ID: "example"}},
+		},
+	}})
+
+	result := nextResult(t, eventCh)
+	must.NoError(t, result.Err)
+	must.Len(t, 1, result.Events)
+	must.Eq(t, "c", result.Events[0].Key)
+}
+
 // TestEventBroker_EmptyReqToken_DistinctSubscriptions tests subscription
 // hanlding behavior when ACLs are disabled (request Token is empty).
 // Subscriptions are mapped by their request token.  when that token is empty,
@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/go-msgpack/v2/codec"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// DefaultJournalRetention is how long events are kept in the journal if
+	// no retention is configured.
+	DefaultJournalRetention = 24 * time.Hour
+
+	// journalQueueSize is the number of published batches of events that
+	// can be waiting to be written to the journal. Batches published while
+	// the queue is full are dropped from the journal.
+	journalQueueSize = 1024
+
+	// journalPruneInterval is how often events older than the retention
+	// window are removed from the journal.
+	journalPruneInterval = time.Minute
+)
+
+var (
+	// journalEventsBucket maps the big-endian raft index of each batch of
+	// events to its JSON encoding.
+	journalEventsBucket = []byte("events")
+
+	// journalTimesBucket maps the big-endian write time and raft index of
+	// each batch of events to nothing. It is used to prune the journal and
+	// to find the first index written after a point in time.
+	journalTimesBucket = []byte("times")
+
+	// journalDecodeHandle decodes the payload of journaled events into the
+	// same shape as the JSON that live subscribers receive.
+	journalDecodeHandle = func() *codec.JsonHandle {
+		h := &codec.JsonHandle{HTMLCharsAsIs: true}
+		h.MapType = reflect.TypeFor[map[string]any]()
+		return h
+	}()
+)
+
+// JournalConfig is used to configure an event journal.
+type JournalConfig struct {
+	// Path is the path of the journal's database file.
+	Path string
+
+	// Retention is how long events are kept in the journal.
+	Retention time.Duration
+
+	Logger hclog.Logger
+}
+
+// Journal is an on-disk log of the events published by the event broker.
+// Unlike the broker's in-memory buffer, which is lost on restart and only
+// holds a fixed number of events, the journal keeps every event published
+// within its retention window so subscribers can resume from older indexes.
+//
+// Events are written asynchronously by a single goroutine so the broker never
+// blocks on disk. Journaled payloads are decoded as generic maps rather than
+// their original types.
+type Journal struct {
+	db        *bbolt.DB
+	retention time.Duration
+	logger    hclog.Logger
+
+	queueCh chan *structs.Events
+
+	// lastIndex is the index of the last batch of events written.
+	lastIndex atomic.Uint64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// NewJournal opens or creates the journal at the configured path and starts
+// the goroutine that writes and prunes it. Close must be called to release
+// the database.
+func NewJournal(cfg JournalConfig) (*Journal, error) {
+	if cfg.Logger == nil {
+		cfg.Logger = hclog.NewNullLogger()
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultJournalRetention
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create event journal directory: %w", err)
+	}
+	db, err := bbolt.Open(cfg.Path, 0o600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event journal: %w", err)
+	}
+
+	var lastIndex uint64
+	err = db.Update(func(tx *bbolt.Tx) error {
+		events, err := tx.CreateBucketIfNotExists(journalEventsBucket)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucketIfNotExists(journalTimesBucket); err != nil {
+			return err
+		}
+		if k, _ := events.Cursor().Last(); k != nil {
+			lastIndex = binary.BigEndian.Uint64(k)
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize event journal: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &Journal{
+		db:        db,
+		retention: cfg.Retention,
+		logger:    cfg.Logger.Named("event_journal"),
+		queueCh:   make(chan *structs.Events, journalQueueSize),
+		ctx:       ctx,
+		cancel:    cancel,
+		doneCh:    make(chan struct{}),
+	}
+	j.lastIndex.Store(lastIndex)
+
+	go j.run()
+	return j, nil
+}
+
+// Close stops writing to the journal and closes its database. Batches of
+// events still queued are written first.
+func (j *Journal) Close() error {
+	j.cancel()
+	<-j.doneCh
+	return j.db.Close()
+}
+
+// LastIndex returns the index of the last batch of events written to the
+// journal.
+func (j *Journal) LastIndex() uint64 {
+	return j.lastIndex.Load()
+}
+
+// enqueue queues a batch of published events to be written to the journal.
+// It never blocks; if the writer has fallen too far behind the batch is
+// dropped and the journal will have a gap.
+func (j *Journal) enqueue(events *structs.Events) {
+	select {
+	case j.queueCh <- events:
+	default:
+		metrics.IncrCounter([]string{"nomad", "event_journal", "dropped"}, 1)
+		j.logger.Warn("event journal queue is full, dropping events", "index", events.Index)
+	}
+}
+
+func (j *Journal) run() {
+	defer close(j.doneCh)
+
+	ticker := time.NewTicker(journalPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.ctx.Done():
+			j.write(j.drain(nil))
+			return
+		case events := <-j.queueCh:
+			j.write(j.drain([]*structs.Events{events}))
+		case <-ticker.C:
+			if err := j.prune(time.Now().Add(-j.retention)); err != nil {
+				j.logger.Error("failed to prune event journal", "error", err)
+			}
+		}
+	}
+}
+
+// drain appends every queued batch of events to batches without blocking so
+// they can be written in a single transaction.
+func (j *Journal) drain(batches []*structs.Events) []*structs.Events {
+	for {
+		select {
+		case events := <-j.queueCh:
+			batches = append(batches, events)
+		default:
+			return batches
+		}
+	}
+}
+
+// write appends batches of events to the journal. Batches at or below the
+// last written index, such as those republished while raft logs are replayed
+// on startup, are ignored.
+func (j *Journal) write(batches []*structs.Events) {
+	if len(batches) == 0 {
+		return
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event_journal", "write"}, time.Now())
+
+	now := time.Now()
+	lastIndex := j.LastIndex()
+	err := j.db.Update(func(tx *bbolt.Tx) error {
+		events := tx.Bucket(journalEventsBucket)
+		times := tx.Bucket(journalTimesBucket)
+
+		for _, batch := range batches {
+			if batch.Index <= lastIndex {
+				continue
+			}
+
+			buf, err := encodeJournalEvents(batch)
+			if err != nil {
+				return fmt.Errorf("failed to encode events at index %d: %w", batch.Index, err)
+			}
+			if err := events.Put(journalIndexKey(batch.Index), buf); err != nil {
+				return err
+			}
+			if err := times.Put(journalTimeKey(now, batch.Index), nil); err != nil {
+				return err
+			}
+			lastIndex = batch.Index
+		}
+		return nil
+	})
+	if err != nil {
+		j.logger.Error("failed to write events to journal", "error", err)
+		return
+	}
+	j.lastIndex.Store(lastIndex)
+}
+
+// prune removes every batch of events written before cutoff.
+func (j *Journal) prune(cutoff time.Time) error {
+	return j.db.Update(func(tx *bbolt.Tx) error {
+		events := tx.Bucket(journalEventsBucket)
+		c := tx.Bucket(journalTimesBucket).Cursor()
+
+		end := journalTimeKey(cutoff, 0)
+		for k, _ := c.First(); k != nil && bytes.Compare(k, end) < 0; k, _ = c.First() {
+			if err := events.Delete(k[8:]); err != nil {
+				return err
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Read returns up to limit batches of events with an index greater than or
+// equal to index, in index order. If index has already been pruned the
+// oldest batches in the journal are returned.
+func (j *Journal) Read(index uint64, limit int) ([]*structs.Events, error) {
+	var result []*structs.Events
+	err := j.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(journalEventsBucket).Cursor()
+		for k, v := c.Seek(journalIndexKey(index)); k != nil && len(result) < limit; k, v = c.Next() {
+			events, err := decodeJournalEvents(v)
+			if err != nil {
+				return fmt.Errorf("failed to decode events at index %d: %w",
+					binary.BigEndian.Uint64(k), err)
+			}
+			result = append(result, events)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// IndexAtTime returns the index of the first batch of events written to the
+// journal at or after t. If no events were written since t, the index after
+// the last written batch is returned.
+func (j *Journal) IndexAtTime(t time.Time) (uint64, error) {
+	index := j.LastIndex() + 1
+	err := j.db.View(func(tx *bbolt.Tx) error {
+		k, _ := tx.Bucket(journalTimesBucket).Cursor().Seek(journalTimeKey(t, 0))
+		if k != nil {
+			index = binary.BigEndian.Uint64(k[8:])
+		}
+		return nil
+	})
+	return index, err
+}
+
+// JournalEvents returns a copy of events with their payloads in the same
+// shape as events read from the journal. It is used to return events from the
+// journal and the buffer together, and applies the same JSON encoding
+// extensions that live subscribers receive, such as sanitizing nodes.
+func JournalEvents(events *structs.Events) (*structs.Events, error) {
+	buf, err := encodeJournalEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJournalEvents(buf)
+}
+
+func encodeJournalEvents(events *structs.Events) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := codec.NewEncoder(&buf, structs.JsonHandleWithExtensions).Encode(events); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeJournalEvents(buf []byte) (*structs.Events, error) {
+	var events structs.Events
+	if err := codec.NewDecoderBytes(buf, journalDecodeHandle).Decode(&events); err != nil {
+		return nil, err
+	}
+	return &events, nil
+}
+
+func journalIndexKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, index)
+}
+
+func journalTimeKey(t time.Time, index uint64) []byte {
+	key := binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+	return binary.BigEndian.AppendUint64(key, index)
+}
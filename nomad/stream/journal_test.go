@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package stream
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+func testJournal(t *testing.T, path string) *Journal {
+	t.Helper()
+	j, err := NewJournal(JournalConfig{Path: path})
+	must.NoError(t, err)
+	return j
+}
+
+func waitForJournalIndex(t *testing.T, j *Journal, index uint64) {
+	t.Helper()
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return j.LastIndex() >= index }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+}
+
+func testJournalEvents(index uint64) *structs.Events {
+	return &structs.Events{Index: index, Events: []structs.Event{{
+		Topic:     structs.TopicJob,
+		Key:       "example",
+		Namespace: "default",
+		Index:     index,
+		Payload:   &structs.JobEvent{Job: &structs.Job{ID: "example", Version: index}},
+	}}}
+}
+
+func TestJournal_WriteRead(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "journal.db")
+	j := testJournal(t, path)
+
+	for i := uint64(10); i <= 15; i++ {
+		j.enqueue(testJournalEvents(i))
+	}
+	// Batches at or below the last written index are ignored.
+	j.enqueue(testJournalEvents(12))
+	waitForJournalIndex(t, j, 15)
+
+	batches, err := j.Read(12, 2)
+	must.NoError(t, err)
+	must.Len(t, 2, batches)
+	must.Eq(t, 12, batches[0].Index)
+	must.Eq(t, 13, batches[1].Index)
+
+	// Payloads are decoded into the JSON shape of the original type.
+	event := batches[0].Events[0]
+	must.Eq(t, structs.TopicJob, event.Topic)
+	must.Eq(t, "default", event.Namespace)
+	payload := event.Payload.(map[string]any)
+	must.Eq(t, "example", payload["Job"].(map[string]any)["ID"])
+
+	// Reading from a pruned index starts at the oldest batch.
+	batches, err = j.Read(1, 100)
+	must.NoError(t, err)
+	must.Len(t, 6, batches)
+
+	// The journal survives a restart.
+	must.NoError(t, j.Close())
+	j = testJournal(t, path)
+	defer j.Close()
+	must.Eq(t, 15, j.LastIndex())
+}
+
+func TestJournal_PruneAndIndexAtTime(t *testing.T) {
+	ci.Parallel(t)
+
+	j := testJournal(t, filepath.Join(t.TempDir(), "journal.db"))
+	defer j.Close()
+
+	j.enqueue(testJournalEvents(10))
+	waitForJournalIndex(t, j, 10)
+	cutoff := time.Now()
+	j.enqueue(testJournalEvents(20))
+	waitForJournalIndex(t, j, 20)
+
+	index, err := j.IndexAtTime(cutoff)
+	must.NoError(t, err)
+	must.Eq(t, 20, index)
+
+	index, err = j.IndexAtTime(time.Now().Add(time.Hour))
+	must.NoError(t, err)
+	must.Eq(t, 21, index)
+
+	must.NoError(t, j.prune(cutoff))
+	batches, err := j.Read(0, 100)
+	must.NoError(t, err)
+	must.Len(t, 1, batches)
+	must.Eq(t, 20, batches[0].Index)
+}
+
+func TestEventBroker_Subscribe_Journal(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	j := testJournal(t, filepath.Join(t.TempDir(), "journal.db"))
+	defer j.Close()
+
+	publisher, err := NewEventBroker(ctx, EventBrokerCfg{EventBufferSize: 2, Journal: j})
+	must.NoError(t, err)
+
+	for i := uint64(1); i <= 5; i++ {
+		publisher.Publish(testJournalEvents(i))
+	}
+	waitForJournalIndex(t, j, 5)
+
+	// Index 2 is no longer in the buffer, so it is replayed from the journal
+	// before the subscription continues from the buffer.
+	sub, err := publisher.Subscribe(&SubscribeRequest{
+		Index:      2,
+		Topics:     map[structs.Topic][]string{structs.TopicJob: {"*"}},
+		Namespaces: []string{"default"},
+	})
+	must.NoError(t, err)
+	eventCh := consumeSubscription(ctx, sub)
+
+	publisher.Publish(testJournalEvents(6))
+
+	for i := uint64(2); i <= 6; i++ {
+		result := nextResult(t, eventCh)
+		must.NoError(t, result.Err)
+		must.Len(t, 1, result.Events)
+		must.Eq(t, i, result.Events[0].Index)
+	}
+	assertNoResult(t, eventCh)
+}
@@ -6,9 +6,11 @@ package stream
 import (
 	"context"
 	"errors"
+	"fmt"
 	"slices"
 	"sync/atomic"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -27,6 +29,10 @@ const (
 // closed. The client should Unsubscribe, then re-Subscribe.
 var ErrSubscriptionClosed = errors.New("subscription closed by server, client should resubscribe")
 
+// ErrInvalidFilter is returned by Subscribe when the request's filter
+// expression can't be parsed.
+var ErrInvalidFilter = errors.New("invalid filter expression")
+
 type Subscription struct {
 	// state must be accessed atomically 0 means open, 1 means closed with reload
 	state atomic.Uint32
@@ -37,6 +43,19 @@ type Subscription struct {
 	// is mutated by calls to Next.
 	currentItem *bufferItem
 
+	// evaluator is the compiled filter expression of the request, or nil if
+	// the request has no filter.
+	evaluator *bexpr.Evaluator
+
+	// journal is set while the subscription is replaying events from the
+	// event journal, starting at journalIndex. Once the journal has been
+	// read the subscription continues from the buffer and skips any events
+	// below journalIndex.
+	journal        *Journal
+	journalIndex   uint64
+	journalBatches []*structs.Events
+	buffer         *eventBuffer
+
 	// forceClosed is closed when forceClose is called. It is used by
 	// EventBroker to cancel Next().
 	forceClosed chan struct{}
@@ -70,13 +89,21 @@ type SubscribeRequest struct {
 	// topic/key/namespace matching. Returning false excludes the event
 	// from the subscription. It must be safe to call concurrently.
 	FilterFn func(event structs.Event) bool
+
+	// Filter is an optional go-bexpr expression evaluated against each
+	// event that passes topic/key/namespace matching and FilterFn, such as
+	// `Namespace == "prod" and Payload.Allocation.ClientStatus == "failed"`.
+	// Events the expression doesn't apply to, for example because a
+	// selector doesn't exist in their payload, are excluded.
+	Filter string
 }
 
-func newSubscription(req *SubscribeRequest, item *bufferItem, unsub func()) *Subscription {
+func newSubscription(req *SubscribeRequest, item *bufferItem, evaluator *bexpr.Evaluator, unsub func()) *Subscription {
 	return &Subscription{
 		forceClosed: make(chan struct{}),
 		req:         req,
 		currentItem: item,
+		evaluator:   evaluator,
 		unsub:       unsub,
 	}
 }
@@ -86,6 +113,15 @@ func (s *Subscription) Next(ctx context.Context) (structs.Events, error) {
 		return structs.Events{}, ErrSubscriptionClosed
 	}
 
+	if s.journal != nil {
+		events, err := s.nextJournal()
+		if err != nil {
+			return structs.Events{}, err
+		} else if events != nil {
+			return *events, nil
+		}
+	}
+
 	for {
 		next, err := s.currentItem.Next(ctx, s.forceClosed)
 		switch {
@@ -95,8 +131,11 @@ func (s *Subscription) Next(ctx context.Context) (structs.Events, error) {
 			return structs.Events{}, err
 		}
 		s.currentItem = next
+		if next.Events.Index < s.journalIndex {
+			continue
+		}
 
-		events := filter(s.req, next.Events.Events)
+		events := s.evaluate(filter(s.req, next.Events.Events))
 		if len(events) == 0 {
 			continue
 		}
@@ -109,14 +148,26 @@ func (s *Subscription) NextNoBlock() ([]structs.Event, error) {
 		return nil, ErrSubscriptionClosed
 	}
 
+	if s.journal != nil {
+		events, err := s.nextJournal()
+		if err != nil {
+			return nil, err
+		} else if events != nil {
+			return events.Events, nil
+		}
+	}
+
 	for {
 		next := s.currentItem.NextNoBlock()
 		if next == nil {
 			return nil, nil
 		}
 		s.currentItem = next
+		if next.Events.Index < s.journalIndex {
+			continue
+		}
 
-		events := filter(s.req, next.Events.Events)
+		events := s.evaluate(filter(s.req, next.Events.Events))
 		if len(events) == 0 {
 			continue
 		}
@@ -124,10 +175,92 @@ func (s *Subscription) NextNoBlock() ([]structs.Event, error) {
 	}
 }
 
+// journalReadLimit is the number of batches of events read from the journal
+// at once while replaying.
+const journalReadLimit = 64
+
+// nextJournal returns the next batch of events replayed from the journal that
+// match the subscription. It returns nil once the journal has been read, at
+// which point the subscription continues from the buffer.
+func (s *Subscription) nextJournal() (*structs.Events, error) {
+	for s.journal != nil {
+		if s.state.Load() == subscriptionStateClosed {
+			return nil, ErrSubscriptionClosed
+		}
+
+		if len(s.journalBatches) == 0 {
+			batches, err := s.journal.Read(s.journalIndex, journalReadLimit)
+			if err != nil {
+				return nil, err
+			}
+			if len(batches) == 0 {
+				s.resumeFromBuffer()
+				return nil, nil
+			}
+			s.journalBatches = batches
+		}
+
+		batch := s.journalBatches[0]
+		s.journalBatches = s.journalBatches[1:]
+		s.journalIndex = batch.Index + 1
+
+		events := s.evaluate(filter(s.req, batch.Events))
+		if len(events) > 0 {
+			return &structs.Events{Index: batch.Index, Events: events}, nil
+		}
+	}
+	return nil, nil
+}
+
+// resumeFromBuffer switches a subscription that has finished replaying the
+// journal to the closest item in the buffer. Events written to the journal
+// after it was read are still in the buffer unless the journal fell behind
+// by more than the buffer size.
+func (s *Subscription) resumeFromBuffer() {
+	item, _ := s.buffer.StartAtClosest(s.journalIndex)
+
+	start := newBufferItem(&structs.Events{Index: s.journalIndex})
+	start.link.next.Store(item)
+	close(start.link.nextCh)
+
+	s.currentItem = start
+	s.journal = nil
+	s.buffer = nil
+}
+
 func (s *Subscription) Unsubscribe() {
 	s.unsub()
 }
 
+// newFilterEvaluator compiles the filter expression of a subscription. It
+// returns nil if the expression is empty.
+func newFilterEvaluator(expr string) (*bexpr.Evaluator, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	evaluator, err := bexpr.CreateEvaluator(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+	return evaluator, nil
+}
+
+// evaluate returns the events that match the subscription's filter
+// expression. Events are filtered in place.
+func (s *Subscription) evaluate(events []structs.Event) []structs.Event {
+	if s.evaluator == nil || len(events) == 0 {
+		return events
+	}
+
+	result := events[:0]
+	for _, event := range events {
+		if ok, err := s.evaluator.Evaluate(event); ok && err == nil {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
 // filter events to only those that match a subscriptions topic/keys/namespace
 func filter(req *SubscribeRequest, events []structs.Event) []structs.Event {
 	if len(events) == 0 {
@@ -136,10 +269,14 @@ func filter(req *SubscribeRequest, events []structs.Event) []structs.Event {
 
 	allTopicKeys := req.Topics[structs.TopicAll]
 
+	// The wildcard namespace is only used by internal subscribers, such as
+	// event sinks, which are not restricted by an ACL token.
+	allNamespaces := slices.Contains(req.Namespaces, structs.AllNamespacesSentinel)
+
 	var result []structs.Event
 
 	for _, event := range events {
-		if event.Namespace != "" && !slices.Contains(req.Namespaces, event.Namespace) {
+		if event.Namespace != "" && !allNamespaces && !slices.Contains(req.Namespaces, event.Namespace) {
 			continue
 		}
 
@@ -159,6 +159,24 @@ func TestFilter_Namespace(t *testing.T) {
 	require.Equal(t, 2, cap(actual))
 }
 
+func TestFilter_Namespace_Wildcard(t *testing.T) {
+	ci.Parallel(t)
+
+	event1 := structs.Event{Topic: "Test", Key: "One", Namespace: "foo"}
+	event2 := structs.Event{Topic: "Test", Key: "Two", Namespace: "bar"}
+	event3 := structs.Event{Topic: "Test", Key: "Three"}
+	events := []structs.Event{event1, event2, event3}
+
+	req := &SubscribeRequest{
+		Topics: map[structs.Topic][]string{
+			"*": {"*"},
+		},
+		Namespaces: []string{structs.AllNamespacesSentinel},
+	}
+	actual := filter(req, events)
+	require.Equal(t, events, actual)
+}
+
 func TestFilter_FilterKeys(t *testing.T) {
 	ci.Parallel(t)
 
@@ -199,6 +199,26 @@ func (a *Allocation) Sanitize() *Allocation {
 	return clean
 }
 
+// DefaultIdentityToken returns the signed default workload identity of the
+// first task of the allocation that has one. The client uses it to make
+// requests on behalf of the allocation as a whole, with the permissions of
+// its job. It returns an empty string if no task has a signed identity.
+func (a *Allocation) DefaultIdentityToken() string {
+	if a == nil || a.Job == nil {
+		return ""
+	}
+	tg := a.Job.LookupTaskGroup(a.TaskGroup)
+	if tg == nil {
+		return ""
+	}
+	for _, task := range tg.Tasks {
+		if token := a.SignedIdentities[task.Name]; token != "" {
+			return token
+		}
+	}
+	return ""
+}
+
 // GetNamespace implements the NamespaceGetter interface, required for
 // pagination and filtering namespaces in endpoints that support glob namespace
 // requests using tokens with limited access.
@@ -668,6 +668,27 @@ func TestAllocation_Index(t *testing.T) {
 		t.Fatalf("Got %d and %d", a1.Index(), a2.Index())
 	}
 }
+func TestAllocation_DefaultIdentityToken(t *testing.T) {
+	ci.Parallel(t)
+
+	a := &Allocation{
+		TaskGroup: "cache",
+		Job: &Job{
+			TaskGroups: []*TaskGroup{{
+				Name:  "cache",
+				Tasks: []*Task{{Name: "init"}, {Name: "redis"}},
+			}},
+		},
+	}
+	must.Eq(t, "", a.DefaultIdentityToken())
+
+	a.SignedIdentities = map[string]string{"redis": "redis-identity"}
+	must.Eq(t, "redis-identity", a.DefaultIdentityToken())
+
+	a.SignedIdentities["init"] = "init-identity"
+	must.Eq(t, "init-identity", a.DefaultIdentityToken())
+}
+
 func TestAllocation_Terminated(t *testing.T) {
 	ci.Parallel(t)
 	type desiredState struct {
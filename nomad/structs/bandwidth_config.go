@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// BandwidthConfig is the rate limit of the traffic of an allocation in a
+// bridge or CNI network, in megabits per second. A zero rate is unlimited.
+type BandwidthConfig struct {
+	// IngressMBits is the rate limit of the traffic received by the
+	// allocation.
+	IngressMBits int
+
+	// EgressMBits is the rate limit of the traffic sent by the allocation.
+	EgressMBits int
+}
+
+func (b *BandwidthConfig) Copy() *BandwidthConfig {
+	if b == nil {
+		return nil
+	}
+	nb := *b
+	return &nb
+}
+
+func (b *BandwidthConfig) Equal(o *BandwidthConfig) bool {
+	if b == nil || o == nil {
+		return b == o
+	}
+	return *b == *o
+}
+
+// Validate validates the bandwidth of a network in the given mode.
+func (b *BandwidthConfig) Validate(mode string) error {
+	if b == nil {
+		return nil
+	}
+
+	var mErr multierror.Error
+	if mode != "bridge" && !strings.HasPrefix(mode, "cni/") {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("bandwidth requires the bridge or a cni network mode, not %q", mode))
+	}
+	if b.IngressMBits < 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("bandwidth ingress_mbits must not be negative"))
+	}
+	if b.EgressMBits < 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("bandwidth egress_mbits must not be negative"))
+	}
+	return mErr.ErrorOrNil()
+}
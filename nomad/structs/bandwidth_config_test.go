@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestBandwidthConfig_Equal(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Equal[*BandwidthConfig](t, nil, nil)
+	must.NotEqual[*BandwidthConfig](t, nil, new(BandwidthConfig))
+
+	must.StructEqual(t, &BandwidthConfig{
+		IngressMBits: 100,
+		EgressMBits:  50,
+	}, []must.Tweak[*BandwidthConfig]{{
+		Field: "IngressMBits",
+		Apply: func(b *BandwidthConfig) { b.IngressMBits = 200 },
+	}, {
+		Field: "EgressMBits",
+		Apply: func(b *BandwidthConfig) { b.EgressMBits = 0 },
+	}})
+}
+
+func TestBandwidthConfig_Copy(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Nil(t, (*BandwidthConfig)(nil).Copy())
+
+	b := &BandwidthConfig{IngressMBits: 100, EgressMBits: 50}
+	c := b.Copy()
+	must.Eq(t, b, c)
+
+	c.IngressMBits = 200
+	must.Eq(t, 100, b.IngressMBits)
+}
+
+func TestBandwidthConfig_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name      string
+		bandwidth *BandwidthConfig
+		mode      string
+		expErr    string
+	}{
+		{
+			name: "nil",
+			mode: "host",
+		},
+		{
+			name:      "bridge",
+			bandwidth: &BandwidthConfig{IngressMBits: 100, EgressMBits: 50},
+			mode:      "bridge",
+		},
+		{
+			name:      "cni",
+			bandwidth: &BandwidthConfig{EgressMBits: 50},
+			mode:      "cni/mynet",
+		},
+		{
+			name:      "host mode",
+			bandwidth: &BandwidthConfig{IngressMBits: 100},
+			mode:      "host",
+			expErr:    `bandwidth requires the bridge or a cni network mode, not "host"`,
+		},
+		{
+			name:      "negative rates",
+			bandwidth: &BandwidthConfig{IngressMBits: -1, EgressMBits: -1},
+			mode:      "bridge",
+			expErr:    "2 errors occurred",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bandwidth.Validate(tc.mode)
+			if tc.expErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 // The CheckMode of a Nomad check is either Healthiness or Readiness.
@@ -93,6 +94,19 @@ func NomadCheckID(allocID, group string, c *ServiceCheck) CheckID {
 	hashString(sum, c.Path)
 	hashString(sum, c.Method)
 	hashString(sum, strconv.FormatBool(c.TLSSkipVerify))
+
+	// Only include the fields of grpc and script checks for those types, to
+	// maintain ID stability of existing tcp and http checks
+	switch c.Type {
+	case ServiceCheckGRPC:
+		hashString(sum, c.GRPCService)
+		hashString(sum, strconv.FormatBool(c.GRPCUseTLS))
+		hashString(sum, c.TLSServerName)
+	case ServiceCheckScript:
+		hashString(sum, c.Command)
+		hashString(sum, strings.Join(c.Args, " "))
+	}
+
 	h := sum.Sum(nil)
 	return CheckID(fmt.Sprintf("%x", h))
 }
@@ -6,6 +6,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"path/filepath"
 	"slices"
 	"time"
 
@@ -72,6 +73,35 @@ type ArtifactConfig struct {
 	// variable names to inherit from the Nomad Client and set in the artifact
 	// download sandbox process.
 	SetEnvironmentVariables *string `hcl:"set_environment_variables"`
+
+	// CacheEnabled turns on the node level artifact cache, which shares
+	// downloaded artifacts between allocations. Only artifacts pinned by a
+	// checksum or an OCI digest are cached, and entries are never shared
+	// between namespaces. Cached files are reflinked into task directories
+	// where supported, and otherwise copied. Defaults to false.
+	CacheEnabled *bool `hcl:"cache_enabled"`
+
+	// CacheDir is the directory in which cached artifacts are stored. It
+	// should be on the same filesystem as the alloc dir so cached files can
+	// be reflinked into the task directories. Defaults to
+	// <data_dir>/artifact_cache.
+	CacheDir *string `hcl:"cache_dir"`
+
+	// CacheSizeLimit is the maximum size of the artifact cache. The least
+	// recently used artifacts are evicted once it is exceeded. Defaults to
+	// 10GB.
+	CacheSizeLimit *string `hcl:"cache_size_limit"`
+
+	// OCIAuthConfig is the path to a docker config file with the credentials
+	// used to pull artifacts from OCI registries, when the artifact does not
+	// set them itself. Credential helpers configured in the file are
+	// supported. As with the auth.config of the docker driver, the docker
+	// config of the user running the client is never used by default, as its
+	// credentials would be available to every job on the node. Defaults to
+	// "", in which case only the credentials set on artifacts are used. Jobs
+	// set them from Nomad variables with a secret block, interpolated into
+	// the username and password options of the artifact.
+	OCIAuthConfig *string `hcl:"oci_auth_config"`
 }
 
 func (a *ArtifactConfig) Copy() *ArtifactConfig {
@@ -91,6 +121,10 @@ func (a *ArtifactConfig) Copy() *ArtifactConfig {
 		DisableFilesystemIsolation:    pointer.Copy(a.DisableFilesystemIsolation),
 		FilesystemIsolationExtraPaths: slices.Clone(a.FilesystemIsolationExtraPaths),
 		SetEnvironmentVariables:       pointer.Copy(a.SetEnvironmentVariables),
+		CacheEnabled:                  pointer.Copy(a.CacheEnabled),
+		CacheDir:                      pointer.Copy(a.CacheDir),
+		CacheSizeLimit:                pointer.Copy(a.CacheSizeLimit),
+		OCIAuthConfig:                 pointer.Copy(a.OCIAuthConfig),
 	}
 }
 
@@ -113,6 +147,10 @@ func (a *ArtifactConfig) Merge(o *ArtifactConfig) *ArtifactConfig {
 			DisableArtifactInspection:   pointer.Merge(a.DisableArtifactInspection, o.DisableArtifactInspection),
 			DisableFilesystemIsolation:  pointer.Merge(a.DisableFilesystemIsolation, o.DisableFilesystemIsolation),
 			SetEnvironmentVariables:     pointer.Merge(a.SetEnvironmentVariables, o.SetEnvironmentVariables),
+			CacheEnabled:                pointer.Merge(a.CacheEnabled, o.CacheEnabled),
+			CacheDir:                    pointer.Merge(a.CacheDir, o.CacheDir),
+			CacheSizeLimit:              pointer.Merge(a.CacheSizeLimit, o.CacheSizeLimit),
+			OCIAuthConfig:               pointer.Merge(a.OCIAuthConfig, o.OCIAuthConfig),
 		}
 
 		if o.FilesystemIsolationExtraPaths != nil {
@@ -154,6 +192,14 @@ func (a *ArtifactConfig) Equal(o *ArtifactConfig) bool {
 		return false
 	case !pointer.Eq(a.SetEnvironmentVariables, o.SetEnvironmentVariables):
 		return false
+	case !pointer.Eq(a.CacheEnabled, o.CacheEnabled):
+		return false
+	case !pointer.Eq(a.CacheDir, o.CacheDir):
+		return false
+	case !pointer.Eq(a.CacheSizeLimit, o.CacheSizeLimit):
+		return false
+	case !pointer.Eq(a.OCIAuthConfig, o.OCIAuthConfig):
+		return false
 	}
 	return true
 }
@@ -251,6 +297,35 @@ func (a *ArtifactConfig) Validate() error {
 		return fmt.Errorf("set_environment_variables must be set")
 	}
 
+	if a.CacheEnabled == nil {
+		return fmt.Errorf("cache_enabled must be set")
+	}
+
+	if a.CacheDir == nil {
+		return fmt.Errorf("cache_dir must be set")
+	}
+	if v := *a.CacheDir; v != "" && !filepath.IsAbs(v) {
+		return fmt.Errorf("cache_dir must be an absolute path but found %q", v)
+	}
+
+	if a.CacheSizeLimit == nil {
+		return fmt.Errorf("cache_size_limit must be set")
+	}
+	if v, err := humanize.ParseBytes(*a.CacheSizeLimit); err != nil {
+		return fmt.Errorf("cache_size_limit is not a valid size: %w", err)
+	} else if v > math.MaxInt64 {
+		return fmt.Errorf("cache_size_limit must be < %d but found %d", int64(math.MaxInt64), v)
+	} else if v == 0 {
+		return fmt.Errorf("cache_size_limit must be > 0")
+	}
+
+	if a.OCIAuthConfig == nil {
+		return fmt.Errorf("oci_auth_config must be set")
+	}
+	if v := *a.OCIAuthConfig; v != "" && !filepath.IsAbs(v) {
+		return fmt.Errorf("oci_auth_config must be an absolute path but found %q", v)
+	}
+
 	return nil
 }
 
@@ -300,5 +375,17 @@ func DefaultArtifactConfig() *ArtifactConfig {
 
 		// No environment variables are inherited from Client by default.
 		SetEnvironmentVariables: new(""),
+
+		// The artifact cache is opt-in.
+		CacheEnabled: new(false),
+
+		// Empty means <data_dir>/artifact_cache.
+		CacheDir: new(""),
+
+		// Maximum size of the artifact cache before artifacts are evicted.
+		CacheSizeLimit: new("10GB"),
+
+		// Empty means only credentials set on artifacts are used.
+		OCIAuthConfig: new(""),
 	}
 }
@@ -63,6 +63,10 @@ func TestArtifactConfig_Merge(t *testing.T) {
 					"d:r:/tmp/stash",
 				},
 				SetEnvironmentVariables: new(""),
+				CacheEnabled:            new(false),
+				CacheDir:                new(""),
+				CacheSizeLimit:          new("10GB"),
+				OCIAuthConfig:           new(""),
 			},
 			other: &ArtifactConfig{
 				HTTPReadTimeout:             new("5m"),
@@ -79,6 +83,10 @@ func TestArtifactConfig_Merge(t *testing.T) {
 					"f:rx:/opt/bin/runme",
 				},
 				SetEnvironmentVariables: new("FOO,BAR"),
+				CacheEnabled:            new(true),
+				CacheDir:                new("/opt/nomad/artifact_cache"),
+				CacheSizeLimit:          new("1GB"),
+				OCIAuthConfig:           new("/etc/docker/auth.json"),
 			},
 			expected: &ArtifactConfig{
 				HTTPReadTimeout:             new("5m"),
@@ -95,6 +103,10 @@ func TestArtifactConfig_Merge(t *testing.T) {
 					"f:rx:/opt/bin/runme",
 				},
 				SetEnvironmentVariables: new("FOO,BAR"),
+				CacheEnabled:            new(true),
+				CacheDir:                new("/opt/nomad/artifact_cache"),
+				CacheSizeLimit:          new("1GB"),
+				OCIAuthConfig:           new("/etc/docker/auth.json"),
 			},
 		},
 		{
@@ -498,6 +510,57 @@ func TestArtifactConfig_Validate(t *testing.T) {
 			},
 			expErr: "set_environment_variables must be set",
 		},
+		{
+			name: "cache enabled not set",
+			config: func(a *ArtifactConfig) {
+				a.CacheEnabled = nil
+			},
+			expErr: "cache_enabled must be set",
+		},
+		{
+			name: "cache dir is relative",
+			config: func(a *ArtifactConfig) {
+				a.CacheDir = new("artifact_cache")
+			},
+			expErr: "cache_dir must be an absolute path",
+		},
+		{
+			name: "cache size limit is invalid",
+			config: func(a *ArtifactConfig) {
+				a.CacheSizeLimit = new("lots")
+			},
+			expErr: "cache_size_limit is not a valid size",
+		},
+		{
+			name: "cache size limit is zero",
+			config: func(a *ArtifactConfig) {
+				a.CacheSizeLimit = new("0")
+			},
+			expErr: "cache_size_limit must be > 0",
+		},
+		{
+			name: "cache enabled",
+			config: func(a *ArtifactConfig) {
+				a.CacheEnabled = new(true)
+				a.CacheDir = new("/var/lib/nomad/artifact_cache")
+				a.CacheSizeLimit = new("1GB")
+			},
+			expErr: "",
+		},
+		{
+			name: "oci auth config not set",
+			config: func(a *ArtifactConfig) {
+				a.OCIAuthConfig = nil
+			},
+			expErr: "oci_auth_config must be set",
+		},
+		{
+			name: "oci auth config is relative",
+			config: func(a *ArtifactConfig) {
+				a.OCIAuthConfig = new("docker.json")
+			},
+			expErr: "oci_auth_config must be an absolute path",
+		},
 	}
 
 	for _, tc := range testCases {
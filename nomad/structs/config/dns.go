@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import "github.com/hashicorp/nomad/helper/pointer"
+
+// DNSConfig configures the DNS interface of a client, which answers queries
+// for Nomad service registrations. It only answers for the namespaces of the
+// allocations running on the client.
+type DNSConfig struct {
+	// Enabled configures whether the client runs the DNS interface.
+	Enabled *bool `hcl:"enabled"`
+
+	// Address is the IP address the DNS interface listens on.
+	Address *string `hcl:"address"`
+
+	// Port is the port the DNS interface listens on, over both UDP and TCP.
+	Port *int `hcl:"port"`
+
+	// Domain is the domain of the service names the DNS interface answers
+	// queries for.
+	Domain *string `hcl:"domain"`
+
+	// TTL is the time to live of the records of the answers.
+	TTL *string `hcl:"ttl"`
+}
+
+func (d *DNSConfig) Copy() *DNSConfig {
+	if d == nil {
+		return nil
+	}
+
+	return &DNSConfig{
+		Enabled: pointer.Copy(d.Enabled),
+		Address: pointer.Copy(d.Address),
+		Port:    pointer.Copy(d.Port),
+		Domain:  pointer.Copy(d.Domain),
+		TTL:     pointer.Copy(d.TTL),
+	}
+}
+
+func (d *DNSConfig) Merge(o *DNSConfig) *DNSConfig {
+	switch {
+	case d == nil:
+		return o.Copy()
+	case o == nil:
+		return d.Copy()
+	default:
+		nd := d.Copy()
+		if o.Enabled != nil {
+			nd.Enabled = pointer.Copy(o.Enabled)
+		}
+		if o.Address != nil {
+			nd.Address = pointer.Copy(o.Address)
+		}
+		if o.Port != nil {
+			nd.Port = pointer.Copy(o.Port)
+		}
+		if o.Domain != nil {
+			nd.Domain = pointer.Copy(o.Domain)
+		}
+		if o.TTL != nil {
+			nd.TTL = pointer.Copy(o.TTL)
+		}
+		return nd
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestDNSConfig_Copy(t *testing.T) {
+	ci.Parallel(t)
+
+	var nilConfig *DNSConfig
+	must.Nil(t, nilConfig.Copy())
+
+	a := &DNSConfig{
+		Enabled: new(true),
+		Address: new("127.0.0.1"),
+		Port:    new(8600),
+		Domain:  new("nomad"),
+		TTL:     new("5s"),
+	}
+	b := a.Copy()
+	must.Eq(t, a, b)
+
+	// The copy must not share pointers with the original.
+	*b.Port = 53
+	must.Eq(t, 8600, *a.Port)
+}
+
+func TestDNSConfig_Merge(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name     string
+		a, b     *DNSConfig
+		expected *DNSConfig
+	}{
+		{
+			name:     "both nil",
+			expected: nil,
+		},
+		{
+			name:     "nil base",
+			b:        &DNSConfig{Enabled: new(true)},
+			expected: &DNSConfig{Enabled: new(true)},
+		},
+		{
+			name:     "nil other",
+			a:        &DNSConfig{Enabled: new(true)},
+			expected: &DNSConfig{Enabled: new(true)},
+		},
+		{
+			name: "merge",
+			a: &DNSConfig{
+				Enabled: new(true),
+				Port:    new(8600),
+				Domain:  new("nomad"),
+			},
+			b: &DNSConfig{
+				Enabled: new(false),
+				Address: new("0.0.0.0"),
+				TTL:     new("10s"),
+			},
+			expected: &DNSConfig{
+				Enabled: new(false),
+				Address: new("0.0.0.0"),
+				Port:    new(8600),
+				Domain:  new("nomad"),
+				TTL:     new("10s"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			must.Eq(t, tc.expected, tc.a.Merge(tc.b))
+		})
+	}
+}
@@ -28,16 +28,20 @@ const (
 
 	// DeploymentStatusDescriptions are the various descriptions of the states a
 	// deployment can be in.
-	DeploymentStatusDescriptionRunning               = "Deployment is running"
-	DeploymentStatusDescriptionRunningNeedsPromotion = "Deployment is running but requires manual promotion"
-	DeploymentStatusDescriptionRunningAutoPromotion  = "Deployment is running pending automatic promotion"
-	DeploymentStatusDescriptionPaused                = "Deployment is paused"
-	DeploymentStatusDescriptionSuccessful            = "Deployment completed successfully"
-	DeploymentStatusDescriptionStoppedJob            = "Cancelled because job is stopped"
-	DeploymentStatusDescriptionNewerJob              = "Cancelled due to newer version of job"
-	DeploymentStatusDescriptionFailedAllocations     = "Failed due to unhealthy allocations"
-	DeploymentStatusDescriptionProgressDeadline      = "Failed due to progress deadline"
-	DeploymentStatusDescriptionFailedByUser          = "Deployment marked as failed"
+	DeploymentStatusDescriptionRunning                = "Deployment is running"
+	DeploymentStatusDescriptionRunningNeedsPromotion  = "Deployment is running but requires manual promotion"
+	DeploymentStatusDescriptionRunningAutoPromotion   = "Deployment is running pending automatic promotion"
+	DeploymentStatusDescriptionPaused                 = "Deployment is paused"
+	DeploymentStatusDescriptionSuccessful             = "Deployment completed successfully"
+	DeploymentStatusDescriptionStoppedJob             = "Cancelled because job is stopped"
+	DeploymentStatusDescriptionNewerJob               = "Cancelled due to newer version of job"
+	DeploymentStatusDescriptionFailedAllocations      = "Failed due to unhealthy allocations"
+	DeploymentStatusDescriptionProgressDeadline       = "Failed due to progress deadline"
+	DeploymentStatusDescriptionFailedByUser           = "Deployment marked as failed"
+	DeploymentStatusDescriptionRunningAnalysis        = "Deployment is running canary analysis"
+	DeploymentStatusDescriptionAnalysisPassed         = "Deployment canary analysis passed"
+	DeploymentStatusDescriptionAnalysisNeedsPromotion = "Deployment canary analysis passed but requires manual promotion"
+	DeploymentStatusDescriptionFailedAnalysis         = "Failed due to canary analysis"
 
 	// used only in multiregion deployments
 	DeploymentStatusDescriptionFailedByPeer   = "Failed because of an error in peer region"
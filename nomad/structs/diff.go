@@ -5,6 +5,7 @@ package structs
 
 import (
 	"fmt"
+	"maps"
 	"net"
 	"reflect"
 	"sort"
@@ -75,7 +76,8 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 	diff := &JobDiff{Type: DiffTypeNone}
 	var oldPrimitiveFlat, newPrimitiveFlat map[string]string
 	filter := []string{"ID", "Status", "StatusDescription", "Version", "Stable", "CreateIndex",
-		"ModifyIndex", "JobModifyIndex", "Update", "SubmitTime", "NomadTokenID", "VaultToken"}
+		"ModifyIndex", "JobModifyIndex", "Update", "SubmitTime", "NomadTokenID", "VaultToken",
+		"DependenciesReleasedIndex"}
 
 	if j == nil && other == nil {
 		return diff, nil
@@ -146,6 +148,16 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 		diff.Objects = append(diff.Objects, cDiff)
 	}
 
+	// Gang diff
+	if gDiff := gangDiff(j.Gang, other.Gang, contextual); gDiff != nil {
+		diff.Objects = append(diff.Objects, gDiff)
+	}
+
+	// DependsOn diff
+	if dDiff := jobDependenciesDiff(j.DependsOn, other.DependsOn, contextual); dDiff != nil {
+		diff.Objects = append(diff.Objects, dDiff)
+	}
+
 	// Multiregion diff
 	if mrDiff := multiregionDiff(j.Multiregion, other.Multiregion, contextual); mrDiff != nil {
 		diff.Objects = append(diff.Objects, mrDiff)
@@ -319,7 +331,7 @@ func (tg *TaskGroup) Diff(other *TaskGroup, contextual bool) (*TaskGroupDiff, er
 
 	// Update diff
 	// COMPAT: Remove "Stagger" in 0.7.0.
-	if uDiff := primitiveObjectDiff(tg.Update, other.Update, []string{"Stagger"}, "Update", contextual); uDiff != nil {
+	if uDiff := updateStrategyDiff(tg.Update, other.Update, contextual); uDiff != nil {
 		diff.Objects = append(diff.Objects, uDiff)
 	}
 
@@ -506,7 +518,7 @@ func (t *Task) Diff(other *Task, contextual bool) (*TaskDiff, error) {
 	}
 
 	// LogConfig diff
-	lDiff := primitiveObjectDiff(t.LogConfig, other.LogConfig, nil, "LogConfig", contextual)
+	lDiff := logConfigDiff(t.LogConfig, other.LogConfig, contextual)
 	if lDiff != nil {
 		diff.Objects = append(diff.Objects, lDiff)
 	}
@@ -1771,7 +1783,7 @@ func sidecarTaskDiff(old, new *SidecarTask, contextual bool) *ObjectDiff {
 	}
 
 	// LogConfig diff
-	lDiff := primitiveObjectDiff(old.LogConfig, new.LogConfig, nil, "LogConfig", contextual)
+	lDiff := logConfigDiff(old.LogConfig, new.LogConfig, contextual)
 	if lDiff != nil {
 		diff.Objects = append(diff.Objects, lDiff)
 	}
@@ -2278,6 +2290,55 @@ func parameterizedJobDiff(old, new *ParameterizedJobConfig, contextual bool) *Ob
 	return diff
 }
 
+// gangDiff returns the diff of two gang configurations. If contextual diff is
+// enabled, all fields will be returned, even if no diff occurred.
+func gangDiff(old, new *GangConfig, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Gang"}
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		old = &GangConfig{}
+		diff.Type = DiffTypeAdded
+	} else if new == nil {
+		new = &GangConfig{}
+		diff.Type = DiffTypeDeleted
+	} else {
+		diff.Type = DiffTypeEdited
+	}
+
+	if groupsDiff := stringSetDiff(old.Groups, new.Groups, "Groups", contextual); groupsDiff != nil {
+		diff.Objects = append(diff.Objects, groupsDiff)
+	}
+
+	return diff
+}
+
+// jobDependenciesDiff returns the diff of two job dependency configurations.
+// If contextual diff is enabled, all fields will be returned, even if no diff
+// occurred.
+func jobDependenciesDiff(old, new *JobDependencies, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "DependsOn"}
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		old = &JobDependencies{}
+		diff.Type = DiffTypeAdded
+	} else if new == nil {
+		new = &JobDependencies{}
+		diff.Type = DiffTypeDeleted
+	} else {
+		diff.Type = DiffTypeEdited
+	}
+
+	if jobsDiff := stringSetDiff(old.Jobs, new.Jobs, "Jobs", contextual); jobsDiff != nil {
+		diff.Objects = append(diff.Objects, jobsDiff)
+	}
+
+	return diff
+}
+
 func multiregionDiff(old, new *Multiregion, contextual bool) *ObjectDiff {
 
 	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Multiregion"}
@@ -2715,6 +2776,14 @@ func (n *NetworkResource) Diff(other *NetworkResource, contextual bool) *ObjectD
 		diff.Objects = append(diff.Objects, cniDiff)
 	}
 
+	if bandwidthDiff := n.Bandwidth.Diff(other.Bandwidth, contextual); bandwidthDiff != nil {
+		diff.Objects = append(diff.Objects, bandwidthDiff)
+	}
+
+	if egressDiff := n.Egress.Diff(other.Egress, contextual); egressDiff != nil {
+		diff.Objects = append(diff.Objects, egressDiff)
+	}
+
 	return diff
 }
 
@@ -2758,6 +2827,58 @@ func (d *DNSConfig) Diff(other *DNSConfig, contextual bool) *ObjectDiff {
 	return diff
 }
 
+// Diff returns a diff of two BandwidthConfig structs
+func (b *BandwidthConfig) Diff(other *BandwidthConfig, contextual bool) *ObjectDiff {
+	if b.Equal(other) {
+		return nil
+	}
+
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Bandwidth"}
+	var oldPrimitiveFlat, newPrimitiveFlat map[string]string
+	if b == nil {
+		diff.Type = DiffTypeAdded
+		newPrimitiveFlat = flatmap.Flatten(other, nil, true)
+	} else if other == nil {
+		diff.Type = DiffTypeDeleted
+		oldPrimitiveFlat = flatmap.Flatten(b, nil, true)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldPrimitiveFlat = flatmap.Flatten(b, nil, true)
+		newPrimitiveFlat = flatmap.Flatten(other, nil, true)
+	}
+
+	// Diff the primitive fields.
+	diff.Fields = fieldDiffs(oldPrimitiveFlat, newPrimitiveFlat, contextual)
+
+	return diff
+}
+
+// Diff returns a diff of two EgressConfig structs
+func (e *EgressConfig) Diff(other *EgressConfig, contextual bool) *ObjectDiff {
+	if e.Equal(other) {
+		return nil
+	}
+
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Egress"}
+	var oldPrimitiveFlat, newPrimitiveFlat map[string]string
+	if e == nil {
+		diff.Type = DiffTypeAdded
+		newPrimitiveFlat = flatmap.Flatten(other, nil, true)
+	} else if other == nil {
+		diff.Type = DiffTypeDeleted
+		oldPrimitiveFlat = flatmap.Flatten(e, nil, true)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldPrimitiveFlat = flatmap.Flatten(e, nil, true)
+		newPrimitiveFlat = flatmap.Flatten(other, nil, true)
+	}
+
+	// Diff the primitive fields.
+	diff.Fields = fieldDiffs(oldPrimitiveFlat, newPrimitiveFlat, contextual)
+
+	return diff
+}
+
 // Diff returns a diff of two CNIConfig structs
 func (d *CNIConfig) Diff(other *CNIConfig, contextual bool) *ObjectDiff {
 	if d == nil {
@@ -2773,6 +2894,59 @@ func (d *CNIConfig) Diff(other *CNIConfig, contextual bool) *ObjectDiff {
 	return primitiveObjectDiff(d.Args, other.Args, nil, "CNIConfig", contextual)
 }
 
+// updateStrategyDiff returns the diff of two update strategies, including
+// their canary analysis.
+func updateStrategyDiff(old, new *UpdateStrategy, contextual bool) *ObjectDiff {
+	diff := primitiveObjectDiff(old, new, []string{"Stagger"}, "Update", contextual)
+
+	var oldAnalysis, newAnalysis *CanaryAnalysis
+	if old != nil {
+		oldAnalysis = old.Analysis
+	}
+	if new != nil {
+		newAnalysis = new.Analysis
+	}
+	aDiff := canaryAnalysisDiff(oldAnalysis, newAnalysis, contextual)
+	if aDiff == nil {
+		return diff
+	}
+
+	if diff == nil {
+		diff = &ObjectDiff{Type: DiffTypeEdited, Name: "Update"}
+	}
+	diff.Objects = append(diff.Objects, aDiff)
+	return diff
+}
+
+func canaryAnalysisDiff(old, new *CanaryAnalysis, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Analysis"}
+	var oldAnalysisFlat, newAnalysisFlat map[string]string
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		diff.Type = DiffTypeAdded
+		newAnalysisFlat = flatmap.Flatten(new, nil, false)
+	} else if new == nil {
+		diff.Type = DiffTypeDeleted
+		oldAnalysisFlat = flatmap.Flatten(old, nil, false)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldAnalysisFlat = flatmap.Flatten(old, nil, false)
+		newAnalysisFlat = flatmap.Flatten(new, nil, false)
+	}
+
+	// Unset blocks and thresholds are left out of the diff.
+	for _, flat := range []map[string]string{oldAnalysisFlat, newAnalysisFlat} {
+		maps.DeleteFunc(flat, func(_, v string) bool { return v == "nil" })
+	}
+
+	// Diff the primitive fields.
+	diff.Fields = fieldDiffs(oldAnalysisFlat, newAnalysisFlat, contextual)
+
+	return diff
+}
+
 func disconectStrategyDiffs(old, new *DisconnectStrategy, contextual bool) *ObjectDiff {
 	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Disconnect"}
 	var oldDisconnectFlat, newDisconnectFlat map[string]string
@@ -3034,6 +3208,87 @@ func idSliceDiffs(old, new []*WorkloadIdentity, contextual bool) []*ObjectDiff {
 	return diffs
 }
 
+// logConfigDiff returns the diff of two LogConfig objects, including their
+// sinks. If contextual diff is enabled, all fields will be returned, even if
+// no diff occurred.
+func logConfigDiff(old, new *LogConfig, contextual bool) *ObjectDiff {
+	diff := primitiveObjectDiff(old, new, nil, "LogConfig", contextual)
+
+	var oldSinks, newSinks []*LogSink
+	if old != nil {
+		oldSinks = old.Sinks
+	}
+	if new != nil {
+		newSinks = new.Sinks
+	}
+	sDiffs := logSinkDiffs(oldSinks, newSinks, contextual)
+	if len(sDiffs) == 0 {
+		return diff
+	}
+
+	if diff == nil {
+		diff = &ObjectDiff{Type: DiffTypeEdited, Name: "LogConfig"}
+	}
+	diff.Objects = append(diff.Objects, sDiffs...)
+	return diff
+}
+
+// logSinkDiffs diffs a set of log sinks, keyed by their name.
+func logSinkDiffs(old, new []*LogSink, contextual bool) []*ObjectDiff {
+	oldMap := make(map[string]*LogSink, len(old))
+	newMap := make(map[string]*LogSink, len(new))
+
+	for _, o := range old {
+		oldMap[o.Name] = o
+	}
+	for _, n := range new {
+		newMap[n.Name] = n
+	}
+
+	var diffs []*ObjectDiff
+	for name, oldSink := range oldMap {
+		// Diff the same, deleted, and edited
+		if diff := logSinkDiff(oldSink, newMap[name], contextual); diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	for name, newSink := range newMap {
+		// diff the added
+		if _, exists := oldMap[name]; !exists {
+			if diff := logSinkDiff(nil, newSink, contextual); diff != nil {
+				diffs = append(diffs, diff)
+			}
+		}
+	}
+	sort.Sort(ObjectDiffs(diffs))
+	return diffs
+}
+
+func logSinkDiff(old, new *LogSink, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Sink"}
+	var oldFlat, newFlat map[string]string
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		diff.Type = DiffTypeAdded
+		newFlat = flatmap.Flatten(new, nil, false)
+	} else if new == nil {
+		diff.Type = DiffTypeDeleted
+		oldFlat = flatmap.Flatten(old, nil, false)
+	} else {
+		diff.Type = DiffTypeEdited
+		oldFlat = flatmap.Flatten(old, nil, false)
+		newFlat = flatmap.Flatten(new, nil, false)
+	}
+
+	// Diff the fields, including the headers.
+	diff.Fields = fieldDiffs(oldFlat, newFlat, contextual)
+
+	return diff
+}
+
 func weightsDiff(oldWeights *ServiceWeights, newWeights *ServiceWeights, contextual bool) *ObjectDiff {
 	if reflect.DeepEqual(oldWeights, newWeights) {
 		return nil
@@ -3352,6 +3607,16 @@ func periodicDiff(old, new *PeriodicConfig, contextual bool) *ObjectDiff {
 		diff.Objects = append(diff.Objects, setDiff)
 	}
 
+	// Exclusions diff
+	if exDiff := primitiveObjectSetDiff(
+		interfaceSlice(old.Exclusions),
+		interfaceSlice(new.Exclusions),
+		nil,
+		"Exclusion",
+		contextual); exDiff != nil {
+		diff.Objects = append(diff.Objects, exDiff...)
+	}
+
 	sort.Sort(FieldDiffs(diff.Fields))
 	return diff
 }
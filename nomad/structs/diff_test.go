@@ -746,6 +746,75 @@ func TestJobDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Periodic catch up and exclusions edited
+			Old: &Job{
+				Periodic: &PeriodicConfig{
+					Spec: "@daily",
+					Exclusions: []*PeriodicExclusion{
+						{Start: "2025-12-24", End: "2025-12-27"},
+					},
+				},
+			},
+			New: &Job{
+				Periodic: &PeriodicConfig{
+					Spec:    "@daily",
+					CatchUp: PeriodicCatchUpRunAll,
+					Exclusions: []*PeriodicExclusion{
+						{Cron: "* * * * 0,6"},
+					},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Periodic",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "CatchUp",
+								Old:  "",
+								New:  "run-all",
+							},
+						},
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Exclusion",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Cron",
+										Old:  "",
+										New:  "* * * * 0,6",
+									},
+								},
+							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "Exclusion",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeDeleted,
+										Name: "End",
+										Old:  "2025-12-27",
+										New:  "",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Start",
+										Old:  "2025-12-24",
+										New:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			// Periodic single to multiple times
 			Old: &Job{
@@ -1026,6 +1095,12 @@ func TestJobDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "Periodic",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "CatchUp",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Enabled",
@@ -1299,6 +1374,74 @@ func TestJobDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Gang edited
+			Old: &Job{
+				Gang: &GangConfig{
+					Groups: []string{"foo"},
+				},
+			},
+			New: &Job{
+				Gang: &GangConfig{
+					Groups: []string{"foo", "bar"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Gang",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Groups",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Groups",
+										Old:  "",
+										New:  "bar",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			// DependsOn added
+			Old: &Job{},
+			New: &Job{
+				DependsOn: &JobDependencies{
+					Jobs: []string{"extract"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeAdded,
+						Name: "DependsOn",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Jobs",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Jobs",
+										Old:  "",
+										New:  "extract",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			// Parameterized Job deleted
 			Old: &Job{
@@ -2967,6 +3110,81 @@ func TestTaskGroupDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			TestCase: "Update strategy analysis added",
+			Old: &TaskGroup{
+				Update: &UpdateStrategy{
+					Canary: 1,
+				},
+			},
+			New: &TaskGroup{
+				Update: &UpdateStrategy{
+					Canary: 1,
+					Analysis: &CanaryAnalysis{
+						Duration: 1 * time.Minute,
+						Interval: 10 * time.Second,
+						Variable: &CanaryAnalysisVariable{
+							Path: "metrics",
+							Key:  "errors",
+							Max:  new(0.5),
+						},
+					},
+				},
+			},
+			Expected: &TaskGroupDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Update",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Analysis",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Duration",
+										Old:  "",
+										New:  "60000000000",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "FailureLimit",
+										Old:  "",
+										New:  "0",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Interval",
+										Old:  "",
+										New:  "10000000000",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Variable.Key",
+										Old:  "",
+										New:  "errors",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Variable.Max",
+										Old:  "",
+										New:  "0.5",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Variable.Path",
+										Old:  "",
+										New:  "metrics",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			TestCase: "Disconnect strategy deleted",
 			Old: &TaskGroup{
@@ -3187,6 +3405,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeAdded,
 						Name: "EphemeralDisk",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Checkpoint",
+								Old:  "",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "Migrate",
@@ -3227,6 +3451,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeDeleted,
 						Name: "EphemeralDisk",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeDeleted,
+								Name: "Checkpoint",
+								Old:  "false",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "Migrate",
@@ -3321,6 +3551,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "EphemeralDisk",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "Checkpoint",
+								Old:  "false",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Migrate",
@@ -5192,6 +5428,81 @@ func TestTaskGroupDiff(t *testing.T) {
 				},
 			},
 		},
+		{TestCase: "TaskGroup Bandwidth edited",
+			Contextual: false,
+			Old: &TaskGroup{
+				Networks: Networks{
+					{
+						Bandwidth: &BandwidthConfig{
+							IngressMBits: 100,
+						},
+					},
+				},
+			},
+			New: &TaskGroup{
+				Networks: Networks{
+					{
+						Bandwidth: &BandwidthConfig{
+							IngressMBits: 100,
+							EgressMBits:  50,
+						},
+					},
+				},
+			},
+			Expected: &TaskGroupDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeAdded,
+						Name: "Network",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Bandwidth",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "EgressMBits",
+										Old:  "",
+										New:  "50",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "IngressMBits",
+										Old:  "",
+										New:  "100",
+									},
+								},
+							},
+						},
+					},
+					{
+						Type: DiffTypeDeleted,
+						Name: "Network",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeDeleted,
+								Name: "Bandwidth",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeDeleted,
+										Name: "EgressMBits",
+										Old:  "0",
+										New:  "",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "IngressMBits",
+										Old:  "100",
+										New:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{TestCase: "Editing Networks deletes and re-adds",
 			Contextual: false,
 			Old: &TaskGroup{
@@ -5758,6 +6069,12 @@ func TestTaskDiff(t *testing.T) {
 								Old:  "",
 								New:  "true",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "MaxAge",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "MaxFileSizeMB",
@@ -5798,6 +6115,12 @@ func TestTaskDiff(t *testing.T) {
 								Old:  "true",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "MaxAge",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "MaxFileSizeMB",
@@ -5885,12 +6208,24 @@ func TestTaskDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "LogConfig",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "Compress",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Disabled",
 								Old:  "false",
 								New:  "true",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "MaxAge",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "MaxFileSizeMB",
@@ -5908,6 +6243,82 @@ func TestTaskDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "LogConfig sinks edited",
+			Old: &Task{
+				LogConfig: &LogConfig{
+					MaxFiles:      1,
+					MaxFileSizeMB: 10,
+					Sinks: []*LogSink{
+						{Name: "journal", Type: LogSinkTypeJournald, BufferSize: 1024},
+						{Name: "http", Type: LogSinkTypeHTTP, Address: "https://a", BufferSize: 1024},
+					},
+				},
+			},
+			New: &Task{
+				LogConfig: &LogConfig{
+					MaxFiles:      1,
+					MaxFileSizeMB: 10,
+					Sinks: []*LogSink{
+						{Name: "http", Type: LogSinkTypeHTTP, Address: "https://b", BufferSize: 1024,
+							Headers: map[string]string{"Authorization": "Bearer x"}},
+					},
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "LogConfig",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Sink",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeEdited,
+										Name: "Address",
+										Old:  "https://a",
+										New:  "https://b",
+									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Headers[Authorization]",
+										Old:  "",
+										New:  "Bearer x",
+									},
+								},
+							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "Sink",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeDeleted,
+										Name: "BufferSize",
+										Old:  "1024",
+										New:  "",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Name",
+										Old:  "journal",
+										New:  "",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Type",
+										Old:  "journald",
+										New:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			Name: "Artifacts edited",
 			Old: &Task{
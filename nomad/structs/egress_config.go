@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// EgressConfig is the egress policy of an allocation in a bridge or CNI
+// network. Traffic leaving the network namespace of the allocation is denied
+// unless it is a reply to an inbound connection, is sent to the loopback
+// interface, or is allowed by a rule. DNS resolvers outside the allocation
+// must be allowed explicitly.
+type EgressConfig struct {
+	Allow []*EgressRule
+}
+
+// EgressRule allows the egress traffic of an allocation to either a CIDR,
+// optionally restricted to ports, or to the registrations of a Nomad service
+// in the namespace of the allocation.
+type EgressRule struct {
+	// CIDR is the destination of the traffic, as a CIDR or a single IP.
+	CIDR string
+
+	// Service is the name of a Nomad service. The traffic is allowed to the
+	// address and port of each of its registrations, which the client keeps
+	// up to date as the service moves.
+	Service string
+
+	// Ports restricts the traffic to a CIDR to ports or port ranges, such as
+	// "443" or "8000-8100". All ports are allowed if empty.
+	Ports []string
+}
+
+func (e *EgressConfig) Copy() *EgressConfig {
+	if e == nil {
+		return nil
+	}
+	ne := &EgressConfig{}
+	if e.Allow != nil {
+		ne.Allow = make([]*EgressRule, len(e.Allow))
+		for i, rule := range e.Allow {
+			ne.Allow[i] = rule.Copy()
+		}
+	}
+	return ne
+}
+
+func (e *EgressConfig) Equal(o *EgressConfig) bool {
+	if e == nil || o == nil {
+		return e == o
+	}
+	return slices.EqualFunc(e.Allow, o.Allow, (*EgressRule).Equal)
+}
+
+// Services returns the sorted set of the Nomad services the policy allows
+// traffic to.
+func (e *EgressConfig) Services() []string {
+	if e == nil {
+		return nil
+	}
+	var services []string
+	for _, rule := range e.Allow {
+		if rule.Service != "" {
+			services = append(services, rule.Service)
+		}
+	}
+	slices.Sort(services)
+	return slices.Compact(services)
+}
+
+// Validate validates the egress policy of a network in the given mode.
+func (e *EgressConfig) Validate(mode string) error {
+	if e == nil {
+		return nil
+	}
+
+	var mErr multierror.Error
+	if mode != "bridge" && !strings.HasPrefix(mode, "cni/") {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("egress requires the bridge or a cni network mode, not %q", mode))
+	}
+	for i, rule := range e.Allow {
+		if err := rule.Validate(); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("egress allow rule %d: %w", i+1, err))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+func (r *EgressRule) Copy() *EgressRule {
+	if r == nil {
+		return nil
+	}
+	nr := *r
+	nr.Ports = slices.Clone(r.Ports)
+	return &nr
+}
+
+func (r *EgressRule) Equal(o *EgressRule) bool {
+	if r == nil || o == nil {
+		return r == o
+	}
+	return r.CIDR == o.CIDR &&
+		r.Service == o.Service &&
+		slices.Equal(r.Ports, o.Ports)
+}
+
+// Validate validates the rule, which must set either a CIDR or a service.
+func (r *EgressRule) Validate() error {
+	if r == nil {
+		return errors.New("rule must not be empty")
+	}
+
+	switch {
+	case r.CIDR == "" && r.Service == "":
+		return errors.New("either cidr or service must be set")
+	case r.CIDR != "" && r.Service != "":
+		return errors.New("only one of cidr and service may be set")
+	case r.Service != "":
+		if len(r.Ports) > 0 {
+			return errors.New("ports may not be set with service")
+		}
+		return nil
+	}
+
+	var mErr multierror.Error
+	if _, err := r.Prefix(); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+	for _, port := range r.Ports {
+		if _, _, err := ParseEgressPortRange(port); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Prefix returns the CIDR of the rule as a prefix. A single IP is returned as
+// a prefix of the length of its address.
+func (r *EgressRule) Prefix() (netip.Prefix, error) {
+	if !strings.Contains(r.CIDR, "/") {
+		addr, err := netip.ParseAddr(r.CIDR)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid cidr %q: %w", r.CIDR, err)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(r.CIDR)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid cidr %q: %w", r.CIDR, err)
+	}
+	return prefix.Masked(), nil
+}
+
+// ParseEgressPortRange parses a port, such as "443", or a port range, such as
+// "8000-8100", of an egress rule.
+func ParseEgressPortRange(s string) (uint16, uint16, error) {
+	lo, hi, isRange := strings.Cut(s, "-")
+	if !isRange {
+		hi = lo
+	}
+
+	from, err := strconv.ParseUint(strings.TrimSpace(lo), 10, 16)
+	if err != nil || from == 0 {
+		return 0, 0, fmt.Errorf("invalid port %q", s)
+	}
+	to, err := strconv.ParseUint(strings.TrimSpace(hi), 10, 16)
+	if err != nil || to == 0 {
+		return 0, 0, fmt.Errorf("invalid port %q", s)
+	}
+	if from > to {
+		return 0, 0, fmt.Errorf("invalid port range %q: start is greater than end", s)
+	}
+	return uint16(from), uint16(to), nil
+}
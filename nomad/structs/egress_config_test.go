@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestEgressConfig_Equal(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Equal[*EgressConfig](t, nil, nil)
+	must.NotEqual[*EgressConfig](t, nil, new(EgressConfig))
+
+	must.StructEqual(t, &EgressConfig{
+		Allow: []*EgressRule{
+			{CIDR: "10.0.0.0/8", Ports: []string{"443"}},
+			{Service: "db"},
+		},
+	}, []must.Tweak[*EgressConfig]{{
+		Field: "Allow",
+		Apply: func(e *EgressConfig) { e.Allow[0].Ports = []string{"80"} },
+	}})
+}
+
+func TestEgressConfig_Copy(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Nil(t, (*EgressConfig)(nil).Copy())
+
+	e := &EgressConfig{
+		Allow: []*EgressRule{
+			{CIDR: "10.0.0.0/8", Ports: []string{"443"}},
+		},
+	}
+	c := e.Copy()
+	must.Eq(t, e, c)
+
+	c.Allow[0].Ports[0] = "80"
+	must.Eq(t, "443", e.Allow[0].Ports[0])
+}
+
+func TestEgressConfig_Services(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Nil(t, (*EgressConfig)(nil).Services())
+
+	e := &EgressConfig{
+		Allow: []*EgressRule{
+			{Service: "db"},
+			{CIDR: "10.0.0.0/8"},
+			{Service: "cache"},
+			{Service: "db"},
+		},
+	}
+	must.Eq(t, []string{"cache", "db"}, e.Services())
+}
+
+func TestEgressConfig_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		egress *EgressConfig
+		mode   string
+		expErr string
+	}{
+		{
+			name: "nil",
+			mode: "host",
+		},
+		{
+			name: "valid",
+			egress: &EgressConfig{
+				Allow: []*EgressRule{
+					{CIDR: "10.0.0.0/8", Ports: []string{"443", "8000-8100"}},
+					{CIDR: "2001:db8::1"},
+					{Service: "db"},
+				},
+			},
+			mode: "cni/mynet",
+		},
+		{
+			name:   "host mode",
+			egress: &EgressConfig{},
+			mode:   "host",
+			expErr: `egress requires the bridge or a cni network mode, not "host"`,
+		},
+		{
+			name:   "empty rule",
+			egress: &EgressConfig{Allow: []*EgressRule{{}}},
+			mode:   "bridge",
+			expErr: "egress allow rule 1: either cidr or service must be set",
+		},
+		{
+			name: "cidr and service",
+			egress: &EgressConfig{Allow: []*EgressRule{
+				{CIDR: "10.0.0.0/8", Service: "db"},
+			}},
+			mode:   "bridge",
+			expErr: "only one of cidr and service may be set",
+		},
+		{
+			name: "service with ports",
+			egress: &EgressConfig{Allow: []*EgressRule{
+				{Service: "db", Ports: []string{"5432"}},
+			}},
+			mode:   "bridge",
+			expErr: "ports may not be set with service",
+		},
+		{
+			name: "invalid cidr",
+			egress: &EgressConfig{Allow: []*EgressRule{
+				{CIDR: "10.0.0.0/33"},
+			}},
+			mode:   "bridge",
+			expErr: `invalid cidr "10.0.0.0/33"`,
+		},
+		{
+			name: "invalid port range",
+			egress: &EgressConfig{Allow: []*EgressRule{
+				{CIDR: "10.0.0.0/8", Ports: []string{"8100-8000"}},
+			}},
+			mode:   "bridge",
+			expErr: `invalid port range "8100-8000"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.egress.Validate(tc.mode)
+			if tc.expErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParseEgressPortRange(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		input    string
+		from, to uint16
+		expErr   bool
+	}{
+		{input: "443", from: 443, to: 443},
+		{input: "8000-8100", from: 8000, to: 8100},
+		{input: "0", expErr: true},
+		{input: "65536", expErr: true},
+		{input: "http", expErr: true},
+		{input: "80-", expErr: true},
+		{input: "90-80", expErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			from, to, err := ParseEgressPortRange(tc.input)
+			if tc.expErr {
+				must.Error(t, err)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.from, from)
+			must.Eq(t, tc.to, to)
+		})
+	}
+}
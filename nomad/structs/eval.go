@@ -31,6 +31,7 @@ const (
 	EvalTriggerMaxDisconnectTimeout = "max-disconnect-timeout"
 	EvalTriggerReconnect            = "reconnect"
 	EvalTriggerAllocReschedule      = "alloc-reschedule"
+	EvalTriggerJobDependency        = "job-dependency"
 
 	EvalStatusBlocked   = "blocked"
 	EvalStatusPending   = "pending"
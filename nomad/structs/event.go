@@ -11,6 +11,30 @@ type EventStreamRequest struct {
 	QueryOptions
 }
 
+// EventReplayRequest is used to read a page of past events from a server's
+// event journal and in-memory buffer.
+type EventReplayRequest struct {
+	Topics map[Topic][]string
+
+	// Index is the index to replay events from.
+	Index uint64
+
+	// StartTime, if set, replays events from the first index written to the
+	// event journal at or after this time, in nanoseconds since the epoch.
+	// It takes precedence over Index.
+	StartTime int64
+
+	QueryOptions
+}
+
+// EventReplayResponse is used to return a page of past events. NextToken is
+// set to the index to continue from if there are more events.
+type EventReplayResponse struct {
+	Events []*Events
+
+	QueryMeta
+}
+
 type EventStreamWrapper struct {
 	Error *RpcError
 	Event *EventJson
@@ -35,6 +59,7 @@ const (
 	TopicCSIVolume      Topic = "CSIVolume"
 	TopicCSIPlugin      Topic = "CSIPlugin"
 	TopicOperator       Topic = "Operator"
+	TopicPreemption     Topic = "Preemption"
 	TopicAll            Topic = "*"
 	TopicVariable       Topic = "Variable"
 
@@ -51,6 +76,7 @@ const (
 	TypeAllocationCreated             = "AllocationCreated"
 	TypeAllocationUpdated             = "AllocationUpdated"
 	TypeAllocationUpdateDesiredStatus = "AllocationUpdateDesiredStatus"
+	TypeAllocationPreempted           = "AllocationPreempted"
 	TypeEvalUpdated                   = "EvaluationUpdated"
 	TypeJobRegistered                 = "JobRegistered"
 	TypeJobDeregistered               = "JobDeregistered"
@@ -137,6 +163,32 @@ type EvaluationEvent struct {
 	Evaluation *Evaluation
 }
 
+// PreemptionEvent holds an Allocation that was newly marked for preemption,
+// along with the allocation that preempted it. The Allocs embedded Job has
+// been removed to reduce size.
+type PreemptionEvent struct {
+	Allocation *Allocation
+
+	// JobPriority is the priority of the job of the preempted allocation.
+	JobPriority int
+
+	// PreemptedByAllocation is the ID of the allocation that was placed in
+	// place of the preempted allocation.
+	PreemptedByAllocation string
+
+	// PreemptedByJobID, PreemptedByNamespace and PreemptedByJobPriority
+	// identify the job of the preempting allocation. The job ID and
+	// namespace are only set if the preempting allocation is in the same
+	// namespace as the preempted one.
+	PreemptedByJobID       string
+	PreemptedByNamespace   string
+	PreemptedByJobPriority int
+
+	// Reason is a human-readable explanation of why the allocation was
+	// preempted.
+	Reason string
+}
+
 // AllocationEvent holds a newly updated Allocation. The
 // Allocs embedded Job has been removed to reduce size.
 type AllocationEvent struct {
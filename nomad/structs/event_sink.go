@@ -0,0 +1,284 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// EventSinkTypeWebhook is an event sink that POSTs batches of events to
+	// an HTTP endpoint.
+	EventSinkTypeWebhook = "webhook"
+
+	// EventSinkTypeFile is an event sink that appends batches of events to a
+	// local NDJSON file on the leader, rotating it when it grows too large.
+	EventSinkTypeFile = "file"
+
+	// DefaultEventSinkWebhookTimeout is the default timeout for a single
+	// webhook delivery attempt.
+	DefaultEventSinkWebhookTimeout = 10 * time.Second
+
+	// DefaultEventSinkFileMaxBytes is the default size at which a file sink
+	// is rotated.
+	DefaultEventSinkFileMaxBytes = 100 * 1024 * 1024
+
+	// DefaultEventSinkFileMaxFiles is the default number of rotated files
+	// kept by a file sink in addition to the active one.
+	DefaultEventSinkFileMaxFiles = 5
+)
+
+var (
+	// validEventSinkID is the rule used to validate an event sink ID.
+	validEventSinkID = regexp.MustCompile("^[a-zA-Z0-9-_]{1,128}$")
+)
+
+// EventSink is a durable, server-side consumer of the event stream. The
+// leader delivers every event matching Topics and Namespace to the sink and
+// records the index of the last delivered batch in LatestIndex so delivery
+// resumes from there after a leader election.
+type EventSink struct {
+	// ID is the unique, operator provided name of the sink.
+	ID string
+
+	// Type is the kind of sink, one of EventSinkTypeWebhook or
+	// EventSinkTypeFile.
+	Type string
+
+	// Topics is the set of topics and keys the sink subscribes to, using the
+	// same semantics as the event stream API.
+	Topics map[Topic][]string
+
+	// Namespace restricts the sink to events in a single namespace. The
+	// wildcard "*" delivers events from all namespaces.
+	Namespace string
+
+	// Webhook is the configuration for webhook sinks.
+	Webhook *EventSinkWebhookConfig
+
+	// File is the configuration for file sinks.
+	File *EventSinkFileConfig
+
+	// LatestIndex is the raft index of the last batch of events that was
+	// successfully delivered to the sink.
+	LatestIndex uint64
+
+	// Raft indexes.
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// EventSinkWebhookConfig configures the delivery of events to an HTTP
+// endpoint.
+type EventSinkWebhookConfig struct {
+	// Address is the URL events are POSTed to.
+	Address string
+
+	// Headers are additional HTTP headers sent with every request.
+	Headers map[string]string
+
+	// Timeout is the timeout for a single delivery attempt.
+	Timeout time.Duration
+}
+
+// EventSinkFileConfig configures the delivery of events to a local file.
+type EventSinkFileConfig struct {
+	// Path is the absolute path of the active file on the leader.
+	Path string
+
+	// MaxBytes is the size at which the active file is rotated.
+	MaxBytes int64
+
+	// MaxFiles is the number of rotated files to keep.
+	MaxFiles int
+}
+
+// GetID implements the IDGetter interface required for pagination.
+func (e *EventSink) GetID() string {
+	return e.ID
+}
+
+// Canonicalize sets default values for the event sink.
+func (e *EventSink) Canonicalize() {
+	if e == nil {
+		return
+	}
+
+	if len(e.Topics) == 0 {
+		e.Topics = map[Topic][]string{TopicAll: {string(TopicAll)}}
+	}
+	if e.Namespace == "" {
+		e.Namespace = AllNamespacesSentinel
+	}
+
+	if e.Webhook != nil && e.Webhook.Timeout == 0 {
+		e.Webhook.Timeout = DefaultEventSinkWebhookTimeout
+	}
+	if e.File != nil {
+		if e.File.MaxBytes == 0 {
+			e.File.MaxBytes = DefaultEventSinkFileMaxBytes
+		}
+		if e.File.MaxFiles == 0 {
+			e.File.MaxFiles = DefaultEventSinkFileMaxFiles
+		}
+	}
+}
+
+// Validate returns an error if the event sink is invalid.
+func (e *EventSink) Validate() error {
+	var mErr *multierror.Error
+
+	if !validEventSinkID.MatchString(e.ID) {
+		mErr = multierror.Append(mErr, fmt.Errorf("invalid ID %q, must match regex %s", e.ID, validEventSinkID))
+	}
+
+	if len(e.Topics) == 0 {
+		mErr = multierror.Append(mErr, errors.New("must specify at least one topic"))
+	}
+	for topic, keys := range e.Topics {
+		if len(keys) == 0 {
+			mErr = multierror.Append(mErr, fmt.Errorf("topic %q must specify at least one key", topic))
+		}
+	}
+
+	switch e.Type {
+	case EventSinkTypeWebhook:
+		if e.File != nil {
+			mErr = multierror.Append(mErr, errors.New("file block is not allowed for webhook sinks"))
+		}
+		if e.Webhook == nil {
+			mErr = multierror.Append(mErr, errors.New("webhook sinks require a webhook block"))
+		} else if err := e.Webhook.Validate(); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("invalid webhook: %w", err))
+		}
+	case EventSinkTypeFile:
+		if e.Webhook != nil {
+			mErr = multierror.Append(mErr, errors.New("webhook block is not allowed for file sinks"))
+		}
+		if e.File == nil {
+			mErr = multierror.Append(mErr, errors.New("file sinks require a file block"))
+		} else if err := e.File.Validate(); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("invalid file: %w", err))
+		}
+	default:
+		mErr = multierror.Append(mErr, fmt.Errorf("invalid type %q, must be one of %q or %q",
+			e.Type, EventSinkTypeWebhook, EventSinkTypeFile))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Validate returns an error if the webhook configuration is invalid.
+func (w *EventSinkWebhookConfig) Validate() error {
+	u, err := url.Parse(w.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("address %q must use the http or https scheme", w.Address)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("address %q must include a host", w.Address)
+	}
+	if w.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	return nil
+}
+
+// Validate returns an error if the file configuration is invalid.
+func (f *EventSinkFileConfig) Validate() error {
+	var mErr *multierror.Error
+	if !filepath.IsAbs(f.Path) {
+		mErr = multierror.Append(mErr, fmt.Errorf("path %q must be absolute", f.Path))
+	}
+	if f.MaxBytes < 0 {
+		mErr = multierror.Append(mErr, errors.New("max_bytes must not be negative"))
+	}
+	if f.MaxFiles < 0 {
+		mErr = multierror.Append(mErr, errors.New("max_files must not be negative"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Copy returns a deep copy of the event sink.
+func (e *EventSink) Copy() *EventSink {
+	if e == nil {
+		return nil
+	}
+
+	ec := new(EventSink)
+	*ec = *e
+
+	if e.Topics != nil {
+		ec.Topics = make(map[Topic][]string, len(e.Topics))
+		for topic, keys := range e.Topics {
+			ec.Topics[topic] = slices.Clone(keys)
+		}
+	}
+	if e.Webhook != nil {
+		wc := *e.Webhook
+		wc.Headers = maps.Clone(e.Webhook.Headers)
+		ec.Webhook = &wc
+	}
+	if e.File != nil {
+		fc := *e.File
+		ec.File = &fc
+	}
+
+	return ec
+}
+
+// EventSinkListRequest is used to list event sinks.
+type EventSinkListRequest struct {
+	QueryOptions
+}
+
+// EventSinkListResponse is the response to an event sinks list request.
+type EventSinkListResponse struct {
+	Sinks []*EventSink
+	QueryMeta
+}
+
+// EventSinkSpecificRequest is used to make a request for a specific event
+// sink.
+type EventSinkSpecificRequest struct {
+	ID string
+	QueryOptions
+}
+
+// SingleEventSinkResponse is the response to a specific event sink request.
+type SingleEventSinkResponse struct {
+	Sink *EventSink
+	QueryMeta
+}
+
+// EventSinkUpsertRequest is used to make a request to insert or update an
+// event sink.
+type EventSinkUpsertRequest struct {
+	Sink *EventSink
+	WriteRequest
+}
+
+// EventSinkDeleteRequest is used to make a request to delete event sinks.
+type EventSinkDeleteRequest struct {
+	IDs []string
+	WriteRequest
+}
+
+// EventSinkCheckpointRequest is used by the leader to record the latest index
+// successfully delivered to each event sink.
+type EventSinkCheckpointRequest struct {
+	Checkpoints map[string]uint64
+	WriteRequest
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestEventSink_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name      string
+		sink      *EventSink
+		expectErr string
+	}{
+		{
+			name: "valid webhook",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    EventSinkTypeWebhook,
+				Webhook: &EventSinkWebhookConfig{Address: "https://example.com/events"},
+			},
+		},
+		{
+			name: "valid file",
+			sink: &EventSink{
+				ID:   "archive",
+				Type: EventSinkTypeFile,
+				File: &EventSinkFileConfig{Path: "/var/log/nomad/events.ndjson"},
+			},
+		},
+		{
+			name: "invalid id",
+			sink: &EventSink{
+				ID:      "not valid",
+				Type:    EventSinkTypeWebhook,
+				Webhook: &EventSinkWebhookConfig{Address: "https://example.com/events"},
+			},
+			expectErr: "invalid ID",
+		},
+		{
+			name:      "invalid type",
+			sink:      &EventSink{ID: "audit", Type: "kafka"},
+			expectErr: `invalid type "kafka"`,
+		},
+		{
+			name:      "missing webhook block",
+			sink:      &EventSink{ID: "audit", Type: EventSinkTypeWebhook},
+			expectErr: "webhook sinks require a webhook block",
+		},
+		{
+			name: "mismatched block",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    EventSinkTypeFile,
+				File:    &EventSinkFileConfig{Path: "/tmp/events"},
+				Webhook: &EventSinkWebhookConfig{Address: "https://example.com/events"},
+			},
+			expectErr: "webhook block is not allowed for file sinks",
+		},
+		{
+			name: "invalid webhook address",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    EventSinkTypeWebhook,
+				Webhook: &EventSinkWebhookConfig{Address: "example.com/events"},
+			},
+			expectErr: "must use the http or https scheme",
+		},
+		{
+			name: "relative file path",
+			sink: &EventSink{
+				ID:   "archive",
+				Type: EventSinkTypeFile,
+				File: &EventSinkFileConfig{Path: "events.ndjson"},
+			},
+			expectErr: "must be absolute",
+		},
+		{
+			name: "topic without keys",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    EventSinkTypeWebhook,
+				Topics:  map[Topic][]string{TopicJob: {}},
+				Webhook: &EventSinkWebhookConfig{Address: "https://example.com/events"},
+			},
+			expectErr: `topic "Job" must specify at least one key`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.sink.Canonicalize()
+			err := tc.sink.Validate()
+			if tc.expectErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestEventSink_Canonicalize(t *testing.T) {
+	ci.Parallel(t)
+
+	sink := &EventSink{
+		ID:   "archive",
+		Type: EventSinkTypeFile,
+		File: &EventSinkFileConfig{Path: "/tmp/events"},
+	}
+	sink.Canonicalize()
+
+	must.Eq(t, map[Topic][]string{TopicAll: {"*"}}, sink.Topics)
+	must.Eq(t, AllNamespacesSentinel, sink.Namespace)
+	must.Eq(t, DefaultEventSinkFileMaxBytes, sink.File.MaxBytes)
+	must.Eq(t, DefaultEventSinkFileMaxFiles, sink.File.MaxFiles)
+}
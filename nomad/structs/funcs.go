@@ -294,6 +294,92 @@ func ScoreFitSpread(node *Node, util *ComparableResources) float64 {
 	return score
 }
 
+// ScoreFitTetris computes a multi-dimensional best fit score. Score is in
+// [0, 18]
+//
+// Unlike ScoreFitBinPack, which only considers CPU and memory, the score is
+// derived from the vector of free capacity across CPU, memory, disk and, when
+// the node fingerprints any, device instances. Nodes whose remaining capacity
+// is closest to zero in every dimension score highest, which avoids leaving
+// nodes with plenty of one resource but none of another.
+func ScoreFitTetris(node *Node, util *ComparableResources) float64 {
+	free := computeFreeVector(node, util)
+
+	var sumSquares float64
+	for _, f := range free {
+		sumSquares += f * f
+	}
+
+	// The norm of the free vector is in [0, sqrt(len(free))], so normalize it
+	// into [0, 1] before inverting it into a score.
+	norm := math.Sqrt(sumSquares / float64(len(free)))
+	return boundFitScore(18.0 * (1 - norm))
+}
+
+// ScoreFitLeastAllocated computes a fit score that favors the nodes with the
+// smallest share of their capacity allocated. Score is in [0, 18]
+//
+// The score is the average free percentage across CPU, memory, disk and
+// device instances, so a single heavily allocated dimension does not dominate
+// the result the way it does in ScoreFitSpread.
+func ScoreFitLeastAllocated(node *Node, util *ComparableResources) float64 {
+	free := computeFreeVector(node, util)
+
+	var sum float64
+	for _, f := range free {
+		sum += f
+	}
+	return boundFitScore(18.0 * sum / float64(len(free)))
+}
+
+// computeFreeVector returns the free percentage of each resource dimension of
+// the node, bounded to [0, 1]. CPU and memory are always included, while disk
+// and devices are only included when the node has any capacity for them.
+func computeFreeVector(node *Node, util *ComparableResources) []float64 {
+	freePctCpu, freePctRam := computeFreePercentage(node, util)
+	free := []float64{freePctCpu, freePctRam}
+
+	res := node.NodeResources.Comparable()
+	nodeDisk := float64(res.Shared.DiskMB)
+	if reserved := node.ReservedResources.Comparable(); reserved != nil {
+		nodeDisk -= float64(reserved.Shared.DiskMB)
+	}
+	if nodeDisk > 0 {
+		free = append(free, 1-(float64(util.Shared.DiskMB)/nodeDisk))
+	}
+
+	var nodeDevices int
+	for _, d := range node.NodeResources.Devices {
+		for _, instance := range d.Instances {
+			if instance.Healthy {
+				nodeDevices++
+			}
+		}
+	}
+	if nodeDevices > 0 {
+		var usedDevices int
+		for _, d := range util.Flattened.Devices {
+			usedDevices += len(d.DeviceIDs)
+		}
+		free = append(free, 1-(float64(usedDevices)/float64(nodeDevices)))
+	}
+
+	for i, f := range free {
+		free[i] = math.Min(math.Max(f, 0), 1)
+	}
+	return free
+}
+
+// boundFitScore bounds a fit score to [0, 18], just in case.
+func boundFitScore(score float64) float64 {
+	if score > 18.0 {
+		return 18.0
+	} else if score < 0 {
+		return 0
+	}
+	return score
+}
+
 func CopySliceConstraints(s []*Constraint) []*Constraint {
 	l := len(s)
 	if l == 0 {
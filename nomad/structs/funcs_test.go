@@ -6,6 +6,7 @@ package structs
 import (
 	"encoding/base64"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -718,6 +719,116 @@ func TestScoreFitBinPack(t *testing.T) {
 	}
 }
 
+func TestScoreFitTetris(t *testing.T) {
+	ci.Parallel(t)
+
+	node := &Node{}
+	node.NodeResources = &NodeResources{
+		Processors: NodeProcessorResources{
+			Topology: &numalib.Topology{
+				Distances: numalib.SLIT{[]numalib.Cost{10}},
+				Cores: []numalib.Core{{
+					ID:        0,
+					Grade:     numalib.Performance,
+					BaseSpeed: 4096,
+				}},
+			},
+		},
+		Memory: NodeMemoryResources{
+			MemoryMB: 8192,
+		},
+		Disk: NodeDiskResources{
+			DiskMB: 10000,
+		},
+		Devices: []*NodeDeviceResource{
+			{
+				Vendor: "nvidia",
+				Type:   "gpu",
+				Name:   "1080ti",
+				Instances: []*NodeDevice{
+					{ID: "gpu-0", Healthy: true},
+					{ID: "gpu-1", Healthy: true},
+					{ID: "gpu-2", Healthy: false},
+				},
+			},
+		},
+	}
+	node.NodeResources.Processors.Topology.SetNodes(idset.From[hw.NodeID]([]hw.NodeID{0}))
+	node.NodeResources.Compatibility()
+
+	gpus := func(ids ...string) []*AllocatedDeviceResource {
+		return []*AllocatedDeviceResource{{
+			Vendor:    "nvidia",
+			Type:      "gpu",
+			Name:      "1080ti",
+			DeviceIDs: ids,
+		}}
+	}
+
+	cases := []struct {
+		name                string
+		util                *ComparableResources
+		tetrisScore         float64
+		leastAllocatedScore float64
+	}{
+		{
+			name: "completely filled node",
+			util: &ComparableResources{
+				Flattened: AllocatedTaskResources{
+					Cpu:     AllocatedCpuResources{CpuShares: 4096},
+					Memory:  AllocatedMemoryResources{MemoryMB: 8192},
+					Devices: gpus("gpu-0", "gpu-1"),
+				},
+				Shared: AllocatedSharedResources{DiskMB: 10000},
+			},
+			tetrisScore:         18,
+			leastAllocatedScore: 0,
+		},
+		{
+			name:                "unutilized node",
+			util:                &ComparableResources{},
+			tetrisScore:         0,
+			leastAllocatedScore: 18,
+		},
+		{
+			name: "half utilized in every dimension",
+			util: &ComparableResources{
+				Flattened: AllocatedTaskResources{
+					Cpu:     AllocatedCpuResources{CpuShares: 2048},
+					Memory:  AllocatedMemoryResources{MemoryMB: 4096},
+					Devices: gpus("gpu-0"),
+				},
+				Shared: AllocatedSharedResources{DiskMB: 5000},
+			},
+			tetrisScore:         9,
+			leastAllocatedScore: 9,
+		},
+		{
+			name: "filled in cpu and memory only",
+			util: &ComparableResources{
+				Flattened: AllocatedTaskResources{
+					Cpu:    AllocatedCpuResources{CpuShares: 4096},
+					Memory: AllocatedMemoryResources{MemoryMB: 8192},
+				},
+			},
+			tetrisScore:         18 * (1 - math.Sqrt(0.5)),
+			leastAllocatedScore: 9,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.InDelta(t, c.tetrisScore, ScoreFitTetris(node, c.util), 0.001, "tetris score")
+			require.InDelta(t, c.leastAllocatedScore, ScoreFitLeastAllocated(node, c.util), 0.001, "least allocated score")
+		})
+	}
+
+	// Compared to the CPU and memory only binpack score, the tetris score
+	// penalizes nodes that would be left with unbalanced free capacity.
+	binPackOnly := ScoreFitBinPack(node, cases[3].util)
+	must.Greater(t, ScoreFitTetris(node, cases[3].util), binPackOnly)
+}
+
 func TestAllocsFit_MaxNodeAllocs(t *testing.T) {
 	ci.Parallel(t)
 	baseAlloc := &Allocation{
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	// LogSinkTypeSyslog ships logs as RFC5424 messages to a syslog server
+	// over TCP, UDP or a unix socket.
+	LogSinkTypeSyslog = "syslog"
+
+	// LogSinkTypeJournald ships logs to a local socket speaking the native
+	// protocol of journald.
+	LogSinkTypeJournald = "journald"
+
+	// LogSinkTypeHTTP ships logs as batches of newline delimited JSON to an
+	// HTTP endpoint.
+	LogSinkTypeHTTP = "http"
+
+	// DefaultLogSinkBufferSize is the default number of log lines a sink
+	// buffers before dropping new lines.
+	DefaultLogSinkBufferSize = 1024
+
+	// maxLogSinkBufferSize is the maximum number of log lines a sink may
+	// buffer, bounding the memory of the logmon process.
+	maxLogSinkBufferSize = 65536
+
+	// LogSinkJournaldSocket is the socket of journald, which is the only
+	// socket journald sinks may write to.
+	LogSinkJournaldSocket = "/run/systemd/journal/socket"
+)
+
+// LogSinkSyslogSockets are the sockets of the local syslog daemon on the
+// supported platforms, which are the only unix sockets syslog sinks may write
+// to. Logs are shipped by the logmon process, which runs as the client user,
+// so sinks can't be allowed to connect to arbitrary sockets of the node.
+var LogSinkSyslogSockets = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// LogSink ships the stdout and stderr of a task to an external destination,
+// in addition to the log files of the task.
+type LogSink struct {
+	// Name is the unique name of the sink in the task.
+	Name string
+
+	// Type is one of LogSinkTypeSyslog, LogSinkTypeJournald, or
+	// LogSinkTypeHTTP.
+	Type string
+
+	// Address is the destination of the logs. It is a tcp://, udp:// or
+	// unix:// URL for syslog, the path of the socket for journald, and the
+	// http:// or https:// URL for http. It defaults to the journald socket.
+	// Unix sockets are limited to LogSinkJournaldSocket and
+	// LogSinkSyslogSockets.
+	Address string
+
+	// Headers are the headers of the requests of the http sink.
+	Headers map[string]string
+
+	// BufferSize is the number of log lines the sink buffers while the
+	// destination is unavailable or slow. New lines are dropped when the
+	// buffer is full, so the task is never blocked by the sink.
+	BufferSize int
+}
+
+func (s *LogSink) Copy() *LogSink {
+	if s == nil {
+		return nil
+	}
+	ns := *s
+	ns.Headers = maps.Clone(s.Headers)
+	return &ns
+}
+
+func (s *LogSink) Equal(o *LogSink) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+	return s.Name == o.Name &&
+		s.Type == o.Type &&
+		s.Address == o.Address &&
+		maps.Equal(s.Headers, o.Headers) &&
+		s.BufferSize == o.BufferSize
+}
+
+// Canonicalize sets the default buffer size.
+func (s *LogSink) Canonicalize() {
+	if s.BufferSize == 0 {
+		s.BufferSize = DefaultLogSinkBufferSize
+	}
+}
+
+func (s *LogSink) Validate() error {
+	var mErr multierror.Error
+	if s.Name == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("sink name must be set"))
+	}
+	if s.BufferSize < 0 || s.BufferSize > maxLogSinkBufferSize {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("buffer_size must be between 0 and %d", maxLogSinkBufferSize))
+	}
+	if len(s.Headers) > 0 && s.Type != LogSinkTypeHTTP {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("headers are only supported by the %s sink", LogSinkTypeHTTP))
+	}
+
+	switch s.Type {
+	case LogSinkTypeSyslog:
+		u, err := url.Parse(s.Address)
+		if err != nil || !slices.Contains([]string{"tcp", "udp", "unix"}, u.Scheme) {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("syslog address %q must be a tcp://, udp:// or unix:// URL", s.Address))
+		} else if u.Scheme == "unix" && !slices.Contains(LogSinkSyslogSockets, u.Path) {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("syslog socket %q must be one of %q", u.Path, LogSinkSyslogSockets))
+		}
+	case LogSinkTypeJournald:
+		if path := strings.TrimPrefix(s.Address, "unix://"); path != "" && path != LogSinkJournaldSocket {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("journald socket %q must be %q", path, LogSinkJournaldSocket))
+		}
+	case LogSinkTypeHTTP:
+		u, err := url.Parse(s.Address)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("http address %q must be a http:// or https:// URL", s.Address))
+		}
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("sink type must be one of %q, %q, or %q, not %q",
+			LogSinkTypeSyslog, LogSinkTypeJournald, LogSinkTypeHTTP, s.Type))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// validateLogSinks validates the sinks of a task and that their names are
+// unique.
+func validateLogSinks(sinks []*LogSink) error {
+	var mErr multierror.Error
+	names := make(map[string]struct{}, len(sinks))
+	for _, sink := range sinks {
+		if err := sink.Validate(); err != nil {
+			mErr.Errors = append(mErr.Errors, multierror.Prefix(err, fmt.Sprintf("sink %q:", sink.Name)))
+		}
+		if _, ok := names[sink.Name]; ok {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("sink %q is defined more than once", sink.Name))
+		}
+		names[sink.Name] = struct{}{}
+	}
+	return mErr.ErrorOrNil()
+}
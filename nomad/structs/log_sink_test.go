@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestLogSink_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name   string
+		sink   *LogSink
+		expErr string
+	}{
+		{
+			name: "syslog tcp",
+			sink: &LogSink{Name: "s", Type: LogSinkTypeSyslog, Address: "tcp://10.0.0.1:514"},
+		},
+		{
+			name: "syslog unix",
+			sink: &LogSink{Name: "s", Type: LogSinkTypeSyslog, Address: "unix:///dev/log"},
+		},
+		{
+			name:   "syslog bad scheme",
+			sink:   &LogSink{Name: "s", Type: LogSinkTypeSyslog, Address: "http://10.0.0.1:514"},
+			expErr: "must be a tcp://, udp:// or unix:// URL",
+		},
+		{
+			name:   "syslog unix other socket",
+			sink:   &LogSink{Name: "s", Type: LogSinkTypeSyslog, Address: "unix:///var/run/docker.sock"},
+			expErr: `syslog socket "/var/run/docker.sock" must be one of`,
+		},
+		{
+			name: "journald default socket",
+			sink: &LogSink{Name: "s", Type: LogSinkTypeJournald},
+		},
+		{
+			name: "journald explicit socket",
+			sink: &LogSink{Name: "s", Type: LogSinkTypeJournald, Address: "unix:///run/systemd/journal/socket"},
+		},
+		{
+			name:   "journald other socket",
+			sink:   &LogSink{Name: "s", Type: LogSinkTypeJournald, Address: "/var/run/docker.sock"},
+			expErr: `journald socket "/var/run/docker.sock" must be`,
+		},
+		{
+			name: "http with headers",
+			sink: &LogSink{Name: "s", Type: LogSinkTypeHTTP, Address: "https://logs.example.com/ingest",
+				Headers: map[string]string{"Authorization": "Bearer x"}},
+		},
+		{
+			name:   "http bad address",
+			sink:   &LogSink{Name: "s", Type: LogSinkTypeHTTP, Address: "logs.example.com"},
+			expErr: "must be a http:// or https:// URL",
+		},
+		{
+			name:   "headers without http",
+			sink:   &LogSink{Name: "s", Type: LogSinkTypeJournald, Headers: map[string]string{"a": "b"}},
+			expErr: "headers are only supported by the http sink",
+		},
+		{
+			name:   "missing name",
+			sink:   &LogSink{Type: LogSinkTypeJournald},
+			expErr: "sink name must be set",
+		},
+		{
+			name:   "unknown type",
+			sink:   &LogSink{Name: "s", Type: "kafka"},
+			expErr: `not "kafka"`,
+		},
+		{
+			name:   "buffer size",
+			sink:   &LogSink{Name: "s", Type: LogSinkTypeJournald, BufferSize: -1},
+			expErr: "buffer_size must be between 0 and 65536",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sink.Validate()
+			if tc.expErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestLogConfig_Validate_Sinks(t *testing.T) {
+	ci.Parallel(t)
+
+	disk := &EphemeralDisk{SizeMB: 300}
+
+	l := DefaultLogConfig()
+	l.Sinks = []*LogSink{
+		{Name: "a", Type: LogSinkTypeJournald},
+		{Name: "a", Type: LogSinkTypeJournald},
+	}
+	must.ErrorContains(t, l.Validate(disk), `sink "a" is defined more than once`)
+
+	l.Sinks = l.Sinks[:1]
+	must.NoError(t, l.Validate(disk))
+
+	l.Disabled = true
+	must.ErrorContains(t, l.Validate(disk), "sinks require log collection to be enabled")
+}
+
+func TestLogConfig_Sinks_CopyEqual(t *testing.T) {
+	ci.Parallel(t)
+
+	a := DefaultLogConfig()
+	a.Sinks = []*LogSink{{
+		Name:       "http",
+		Type:       LogSinkTypeHTTP,
+		Address:    "https://logs.example.com",
+		Headers:    map[string]string{"Authorization": "Bearer x"},
+		BufferSize: 10,
+	}}
+
+	b := a.Copy()
+	must.True(t, a.Equal(b))
+
+	b.Sinks[0].Headers["Authorization"] = "Bearer y"
+	must.Eq(t, "Bearer x", a.Sinks[0].Headers["Authorization"])
+	must.False(t, a.Equal(b))
+
+	b = a.Copy()
+	b.Sinks = nil
+	must.False(t, a.Equal(b))
+}
@@ -3,6 +3,8 @@
 
 package structs
 
+import "fmt"
+
 // NamespaceVaultConfiguration stores configuration about permissions to Vault
 // clusters for a namespace, for use with Nomad Enterprise.
 type NamespaceVaultConfiguration struct {
@@ -25,6 +27,42 @@ type NamespaceVaultConfiguration struct {
 	Denied []string
 }
 
+// NamespacePreemptionConfiguration stores configuration about the preemption
+// performed by the scheduler on behalf of the jobs in a namespace.
+type NamespacePreemptionConfiguration struct {
+	// MaxPreemptionsPerEval is the maximum number of lower priority
+	// allocations that may be preempted while processing a single evaluation
+	// of a job in this namespace. Zero means there is no limit.
+	MaxPreemptionsPerEval int
+}
+
+// GetMaxPreemptionsPerEval returns the preemption limit of the namespace, or
+// zero if the namespace does not limit preemption.
+func (n *NamespacePreemptionConfiguration) GetMaxPreemptionsPerEval() int {
+	if n == nil {
+		return 0
+	}
+	return n.MaxPreemptionsPerEval
+}
+
+func (n *NamespacePreemptionConfiguration) Validate() error {
+	if n == nil {
+		return nil
+	}
+	if n.MaxPreemptionsPerEval < 0 {
+		return fmt.Errorf("max_preemptions_per_eval must not be negative, got %d", n.MaxPreemptionsPerEval)
+	}
+	return nil
+}
+
+func (n *NamespacePreemptionConfiguration) Copy() *NamespacePreemptionConfiguration {
+	if n == nil {
+		return nil
+	}
+	nn := *n
+	return &nn
+}
+
 // NamespaceConsulConfiguration stores configuration about permissions to Consul
 // clusters for a namespace, for use with Nomad Enterprise.
 type NamespaceConsulConfiguration struct {
@@ -395,15 +395,21 @@ func (idx *NetworkIndex) AddReservedPorts(ports AllocatedPorts) (collide bool, r
 		if port.Value < 0 || port.Value >= MaxValidPort {
 			return true, []string{fmt.Sprintf("invalid port %d", port.Value)}
 		}
-		used := idx.getUsedPortsFor(port.HostIP)
-		if used.Check(uint(port.Value)) {
-			if !port.IgnoreCollision {
-				collide = true
-				reason := fmt.Sprintf("port %d already in use", port.Value)
-				reasons = append(reasons, reason)
+		ips := []string{port.HostIP}
+		if port.DualStackHostIP != "" {
+			ips = append(ips, port.DualStackHostIP)
+		}
+		for _, ip := range ips {
+			used := idx.getUsedPortsFor(ip)
+			if used.Check(uint(port.Value)) {
+				if !port.IgnoreCollision {
+					collide = true
+					reason := fmt.Sprintf("port %d already in use", port.Value)
+					reasons = append(reasons, reason)
+				}
+			} else {
+				used.Set(uint(port.Value))
 			}
-		} else {
-			used.Set(uint(port.Value))
 		}
 	}
 
@@ -502,6 +508,7 @@ func (idx *NetworkIndex) AssignPorts(ask *NetworkResource) (AllocatedPorts, erro
 				To:              port.To,
 				HostIP:          addr.Address,
 				IgnoreCollision: port.IgnoreCollision,
+				DualStackHostIP: idx.dualStackAddress(port.HostNetwork, addr, port.Value, port.IgnoreCollision),
 			}
 			break
 		}
@@ -549,6 +556,7 @@ func (idx *NetworkIndex) AssignPorts(ask *NetworkResource) (AllocatedPorts, erro
 			if allocPort.To == -1 {
 				allocPort.To = allocPort.Value
 			}
+			allocPort.DualStackHostIP = idx.dualStackAddress(port.HostNetwork, addr, allocPort.Value, false)
 			break
 		}
 
@@ -566,6 +574,26 @@ func (idx *NetworkIndex) AssignPorts(ask *NetworkResource) (AllocatedPorts, erro
 	return offer, nil
 }
 
+// dualStackAddress returns the first address of the host network of the other
+// IP family than addr on which the port is available, so that dual-stack host
+// networks forward the port on both families. The port is only forwarded on
+// addr if it is not available on any address of the other family.
+func (idx *NetworkIndex) dualStackAddress(hostNetwork string, addr NodeNetworkAddress, port int, ignoreCollision bool) string {
+	for _, other := range idx.HostNetworks[hostNetwork] {
+		if other.Family == "" || addr.Family == "" || other.Family == addr.Family {
+			continue
+		}
+		if !ignoreCollision {
+			used := idx.UsedPorts[other.Address]
+			if used != nil && used.Check(uint(port)) {
+				continue
+			}
+		}
+		return other.Address
+	}
+	return ""
+}
+
 // AssignTaskNetwork is used to offer network resources given a
 // task.resources.network ask.  If the ask cannot be satisfied, returns nil
 //
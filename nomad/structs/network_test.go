@@ -530,6 +530,80 @@ func TestNetworkIndex_AssignPorts_TwoIp(t *testing.T) {
 
 }
 
+func TestNetworkIndex_AssignPorts_DualStack(t *testing.T) {
+	ci.Parallel(t)
+
+	n := &Node{
+		NodeResources: &NodeResources{
+			NodeNetworks: []*NodeNetworkResource{
+				{
+					Mode:   "host",
+					Device: "eth0",
+					Speed:  1000,
+					Addresses: []NodeNetworkAddress{
+						{
+							Alias:   "default",
+							Address: "192.168.0.100",
+							Family:  NodeNetworkAF_IPv4,
+						},
+						{
+							Alias:   "default",
+							Address: "2001:db8::100",
+							Family:  NodeNetworkAF_IPv6,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	idx := NewNetworkIndex()
+	idx.SetNode(n)
+	idx.AddReservedPorts(AllocatedPorts{
+		{Label: "used", Value: 7001, HostIP: "2001:db8::100"},
+	})
+
+	offer, err := idx.AssignPorts(&NetworkResource{
+		ReservedPorts: []Port{
+			{Label: "static", Value: 7000, HostNetwork: "default"},
+			{Label: "single", Value: 7001, HostNetwork: "default"},
+		},
+		DynamicPorts: []Port{
+			{Label: "dynamic", HostNetwork: "default"},
+		},
+	})
+	must.NoError(t, err)
+
+	// ports are assigned on both families when available
+	static, ok := offer.Get("static")
+	must.True(t, ok)
+	must.Eq(t, "192.168.0.100", static.HostIP)
+	must.Eq(t, "2001:db8::100", static.DualStackHostIP)
+
+	dynamic, ok := offer.Get("dynamic")
+	must.True(t, ok)
+	must.Eq(t, "192.168.0.100", dynamic.HostIP)
+	must.Eq(t, "2001:db8::100", dynamic.DualStackHostIP)
+
+	// ports in use on the other family are only assigned on one
+	single, ok := offer.Get("single")
+	must.True(t, ok)
+	must.Eq(t, "192.168.0.100", single.HostIP)
+	must.Eq(t, "", single.DualStackHostIP)
+
+	// reserving the offer reserves the ports on both families
+	collide, _ := idx.AddReservedPorts(offer)
+	must.False(t, collide)
+	must.True(t, idx.UsedPorts["2001:db8::100"].Check(7000))
+	must.True(t, idx.UsedPorts["2001:db8::100"].Check(uint(dynamic.Value)))
+
+	collide, reasons := idx.AddReservedPorts(AllocatedPorts{
+		{Label: "other", Value: 7000, HostIP: "2001:db8::100"},
+	})
+	must.True(t, collide)
+	must.Eq(t, []string{"port 7000 already in use"}, reasons)
+}
+
 // TestNetworkIndex_IgnorePortCollision tests Port.IgnoreCollision.
 func TestNetworkIndex_IgnorePortCollision(t *testing.T) {
 	ci.Parallel(t)
@@ -6,6 +6,7 @@ package structs
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/netip"
 	"time"
 
@@ -210,6 +211,16 @@ const (
 	// allocations as evenly as possible over the available hardware.
 	SchedulerAlgorithmSpread SchedulerAlgorithm = "spread"
 
+	// SchedulerAlgorithmTetris indicates that the scheduler should perform a
+	// multi-dimensional best fit, considering CPU, memory, disk and device
+	// utilization together to reduce fragmentation on mixed-size fleets.
+	SchedulerAlgorithmTetris SchedulerAlgorithm = "tetris"
+
+	// SchedulerAlgorithmLeastAllocatedByClass indicates that the scheduler
+	// should balance placements across node classes, preferring the least
+	// allocated nodes within each class.
+	SchedulerAlgorithmLeastAllocatedByClass SchedulerAlgorithm = "least-allocated-by-class"
+
 	// DefaultNodeLimitForFeasibilityChecks is the default value of
 	// NodeLimitForFeasibilityChecks if not specified
 	DefaultNodeLimitForFeasibilityChecks = 100
@@ -244,6 +255,14 @@ type SchedulerConfiguration struct {
 	// deterministic application of spread and/or affinity.
 	NodeLimitForFeasibilityChecks uint `hcl:"node_limit_for_feasibility_checks"`
 
+	// FairShareConfig configures whether the eval broker dequeues
+	// evaluations using weighted fair-share queues across namespaces.
+	FairShareConfig FairShareConfig `hcl:"fair_share_config"`
+
+	// DisableImageLocality disables the scoring boost of nodes which already
+	// hold the images of a task group.
+	DisableImageLocality bool `hcl:"disable_image_locality"`
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -255,6 +274,7 @@ func (s *SchedulerConfiguration) Copy() *SchedulerConfiguration {
 	}
 
 	ns := *s
+	ns.FairShareConfig.Weights = maps.Clone(s.FairShareConfig.Weights)
 	return &ns
 }
 
@@ -273,6 +293,12 @@ func (s *SchedulerConfiguration) GetNodeLimitForFeasibilityChecks() uint {
 	return s.NodeLimitForFeasibilityChecks
 }
 
+// ImageLocalityEnabled returns whether the scheduler boosts the score of nodes
+// which already hold the images of a task group.
+func (s *SchedulerConfiguration) ImageLocalityEnabled() bool {
+	return s == nil || !s.DisableImageLocality
+}
+
 // WithNodePool returns a new SchedulerConfiguration with the node pool
 // scheduler configuration applied.
 func (s *SchedulerConfiguration) WithNodePool(pool *NodePool) *SchedulerConfiguration {
@@ -305,11 +331,16 @@ func (s *SchedulerConfiguration) Validate() error {
 	}
 
 	switch s.SchedulerAlgorithm {
-	case "", SchedulerAlgorithmBinpack, SchedulerAlgorithmSpread:
+	case "", SchedulerAlgorithmBinpack, SchedulerAlgorithmSpread,
+		SchedulerAlgorithmTetris, SchedulerAlgorithmLeastAllocatedByClass:
 	default:
 		return fmt.Errorf("invalid scheduler algorithm: %v", s.SchedulerAlgorithm)
 	}
 
+	if err := s.FairShareConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid fair share config: %v", err)
+	}
+
 	return nil
 }
 
@@ -346,6 +377,76 @@ type PreemptionConfig struct {
 	ServiceSchedulerEnabled bool `hcl:"service_scheduler_enabled"`
 }
 
+// FairShareConfig configures weighted fair-share queueing in the eval broker.
+// When enabled, the broker tracks how many evaluations it recently handed out
+// for each namespace and dequeues from the namespace whose usage is furthest
+// below its share. Evaluations within a namespace are still dequeued by
+// priority and then FIFO.
+type FairShareConfig struct {
+	// Enabled specifies whether fair-share queueing is enabled.
+	Enabled bool `hcl:"enabled"`
+
+	// Weights is the relative share of each namespace. Namespaces without a
+	// weight get a weight of DefaultFairShareWeight.
+	Weights map[string]int `hcl:"weights"`
+}
+
+// DefaultFairShareWeight is the weight of a namespace that has no weight in
+// the FairShareConfig.
+const DefaultFairShareWeight = 1
+
+// WeightFor returns the fair-share weight of the namespace.
+func (f *FairShareConfig) WeightFor(namespace string) int {
+	if w, ok := f.Weights[namespace]; ok {
+		return w
+	}
+	return DefaultFairShareWeight
+}
+
+func (f *FairShareConfig) Validate() error {
+	for ns, w := range f.Weights {
+		if w <= 0 {
+			return fmt.Errorf("weight for namespace %q must be positive, got %d", ns, w)
+		}
+	}
+	return nil
+}
+
+// SchedulerQueue is the fair-share queue state of a namespace in the eval
+// broker.
+type SchedulerQueue struct {
+	// Namespace is the namespace the queue belongs to.
+	Namespace string
+
+	// Weight is the relative share of the namespace.
+	Weight int
+
+	// Usage is the exponentially decayed number of evaluations recently
+	// dequeued for the namespace.
+	Usage float64
+
+	// Ready is the number of evaluations ready to be dequeued.
+	Ready int
+
+	// Unacked is the number of evaluations dequeued but not yet
+	// acknowledged.
+	Unacked int
+}
+
+// SchedulerQueuesResponse is the response object for the eval broker's
+// fair-share queue state.
+type SchedulerQueuesResponse struct {
+	// FairShareEnabled specifies whether the broker dequeues using the
+	// fair-share queues.
+	FairShareEnabled bool
+
+	// Queues is the queue state of each namespace with recent activity,
+	// sorted by namespace.
+	Queues []*SchedulerQueue
+
+	QueryMeta
+}
+
 // SchedulerSetConfigRequest is used by the Operator endpoint to update the
 // current Scheduler configuration of the cluster.
 type SchedulerSetConfigRequest struct {
@@ -44,6 +44,28 @@ func TestSchedulerConfiguration_GetNodeLimitForFeasibilityChecks(t *testing.T) {
 	}
 }
 
+func TestSchedulerConfiguration_Validate_FairShare(t *testing.T) {
+	ci.Parallel(t)
+
+	config := &SchedulerConfiguration{
+		FairShareConfig: FairShareConfig{
+			Enabled: true,
+			Weights: map[string]int{"default": 2},
+		},
+	}
+	must.NoError(t, config.Validate())
+	must.Eq(t, 2, config.FairShareConfig.WeightFor("default"))
+	must.Eq(t, DefaultFairShareWeight, config.FairShareConfig.WeightFor("other"))
+
+	// Copies must not share the weights
+	configCopy := config.Copy()
+	configCopy.FairShareConfig.Weights["default"] = 4
+	must.Eq(t, 2, config.FairShareConfig.Weights["default"])
+
+	config.FairShareConfig.Weights["other"] = 0
+	must.ErrorContains(t, config.Validate(), `weight for namespace "other" must be positive`)
+}
+
 func TestSchedulerConfiguration_WithNodePool(t *testing.T) {
 	ci.Parallel(t)
 
@@ -87,10 +87,21 @@ type ServiceRegistration struct {
 	// on cluster network topology.
 	Address string
 
+	// DualStackAddress is the IP address of the other IP family than Address
+	// of this service registration, if the allocation is dual-stack. It uses
+	// the same port as Address.
+	DualStackAddress string
+
 	// Port is the port number on which this service registration is bound. It
 	// is determined by a combination of factors on the client.
 	Port int
 
+	// CheckStatus is the aggregate status of the healthiness checks of this
+	// service, as observed by the client running it. It is failure if any
+	// check is failing, pending if any check has not yet completed, and
+	// success otherwise. It is empty if the service has no checks.
+	CheckStatus CheckStatus
+
 	CreateIndex uint64
 	ModifyIndex uint64
 }
@@ -139,15 +150,27 @@ func (s *ServiceRegistration) Equal(o *ServiceRegistration) bool {
 	if s.Address != o.Address {
 		return false
 	}
+	if s.DualStackAddress != o.DualStackAddress {
+		return false
+	}
 	if s.Port != o.Port {
 		return false
 	}
+	if s.CheckStatus != o.CheckStatus {
+		return false
+	}
 	if !helper.SliceSetEq(s.Tags, o.Tags) {
 		return false
 	}
 	return true
 }
 
+// Healthy returns whether the service is passing its checks. Services without
+// checks are always healthy.
+func (s *ServiceRegistration) Healthy() bool {
+	return s.CheckStatus == "" || s.CheckStatus == CheckSuccess
+}
+
 // Validate ensures the upserted service registration contains valid
 // information and routing capabilities. Objects should never fail here as
 // Nomad controls the entire registration process; but it's possible
@@ -156,6 +179,9 @@ func (s *ServiceRegistration) Validate() error {
 	if ipaddr.IsAny(s.Address) {
 		return fmt.Errorf("invalid service registration address")
 	}
+	if s.DualStackAddress != "" && ipaddr.IsAny(s.DualStackAddress) {
+		return fmt.Errorf("invalid service registration dual-stack address")
+	}
 	return nil
 }
 
@@ -194,6 +220,7 @@ func (s *ServiceRegistration) HashWith(key string) string {
 	sum.Write([]byte(s.ID))
 	sum.Write([]byte(s.Namespace))
 	sum.Write([]byte(s.Address))
+	sum.Write([]byte(s.DualStackAddress))
 	sum.Write([]byte(s.ServiceName))
 	for _, tag := range s.Tags {
 		sum.Write([]byte(tag))
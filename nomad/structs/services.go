@@ -349,7 +349,7 @@ func (sc *ServiceCheck) validateCommon(allowableTypes []string) error {
 
 // validate a Service's ServiceCheck in the context of the Nomad provider.
 func (sc *ServiceCheck) validateNomad() error {
-	allowable := []string{ServiceCheckTCP, ServiceCheckHTTP}
+	allowable := []string{ServiceCheckTCP, ServiceCheckHTTP, ServiceCheckGRPC, ServiceCheckScript}
 	if err := sc.validateCommon(allowable); err != nil {
 		return err
 	}
@@ -400,9 +400,9 @@ func (sc *ServiceCheck) validateNomad() error {
 		return errors.New("failures_before_warning may only be set for Consul service checks")
 	}
 
-	// tls_server_name is consul only
-	if sc.TLSServerName != "" {
-		return errors.New("tls_server_name may only be set for Consul service checks")
+	// tls_server_name is only used by grpc checks in nomad
+	if sc.TLSServerName != "" && sc.Type != ServiceCheckGRPC {
+		return errors.New("tls_server_name may only be set for Consul service checks or Nomad grpc checks")
 	}
 
 	return nil
@@ -254,8 +254,28 @@ func TestServiceCheck_validateNomad(t *testing.T) {
 		sc   *ServiceCheck
 		exp  string
 	}{
-		{name: "grpc", sc: &ServiceCheck{Type: ServiceCheckGRPC}, exp: `invalid check type ("grpc"), must be one of tcp, http`},
-		{name: "script", sc: &ServiceCheck{Type: ServiceCheckScript}, exp: `invalid check type ("script"), must be one of tcp, http`},
+		{name: "docker", sc: &ServiceCheck{Type: "docker"}, exp: `invalid check type ("docker"), must be one of tcp, http, grpc, script`},
+		{
+			name: "grpc",
+			sc: &ServiceCheck{
+				Type:          ServiceCheckGRPC,
+				Interval:      3 * time.Second,
+				Timeout:       1 * time.Second,
+				GRPCService:   "health",
+				GRPCUseTLS:    true,
+				TLSServerName: "foo",
+			},
+		},
+		{
+			name: "script",
+			sc: &ServiceCheck{
+				Type:     ServiceCheckScript,
+				Interval: 3 * time.Second,
+				Timeout:  1 * time.Second,
+				Command:  "/bin/check",
+			},
+		},
+		{name: "script without command", sc: &ServiceCheck{Type: ServiceCheckScript}, exp: `script type must have a valid script path`},
 		{
 			name: "expose",
 			sc: &ServiceCheck{
@@ -391,7 +411,7 @@ func TestServiceCheck_validateNomad(t *testing.T) {
 				Path:          "/health",
 				TLSServerName: "foo",
 			},
-			exp: `tls_server_name may only be set for Consul service checks`,
+			exp: `tls_server_name may only be set for Consul service checks or Nomad grpc checks`,
 		},
 	}
 
@@ -2246,7 +2266,7 @@ func TestService_validateNomadService(t *testing.T) {
 			},
 			inputErr: &multierror.Error{},
 			expectedOutputErrors: []error{
-				errors.New(`invalid check type (""), must be one of tcp, http`),
+				errors.New(`invalid check type (""), must be one of tcp, http, grpc, script`),
 			},
 			name: "bad nomad check",
 		},
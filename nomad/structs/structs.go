@@ -20,6 +20,8 @@ import (
 	"maps"
 	"math"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"reflect"
 	"regexp"
@@ -135,6 +137,9 @@ const (
 	HostVolumeRegisterRequestType             MessageType = 75
 	HostVolumeDeleteRequestType               MessageType = 76
 	TaskGroupHostVolumeClaimDeleteRequestType MessageType = 77
+	EventSinkRegisterRequestType              MessageType = 78
+	EventSinkDeregisterRequestType            MessageType = 79
+	EventSinkCheckpointRequestType            MessageType = 80
 
 	// NOTE: MessageTypes are shared between CE and ENT. If you need to add a
 	// new type, check that ENT is not already using that value.
@@ -1541,6 +1546,16 @@ type JobSummaryResponse struct {
 	QueryMeta
 }
 
+// JobDependenciesResponse is used to return the dependency graph of a job
+type JobDependenciesResponse struct {
+	// Dependencies are the statuses of the jobs the job depends on.
+	Dependencies []*JobDependencyStatus
+
+	// Dependents are the IDs of the jobs that depend on the job.
+	Dependents []string
+	QueryMeta
+}
+
 // JobScaleStatusResponse is used to return the scale status for a job
 type JobScaleStatusResponse struct {
 	JobScaleStatus *JobScaleStatus
@@ -2757,6 +2772,10 @@ type AllocatedPortMapping struct {
 	To              int
 	HostIP          string
 	IgnoreCollision bool
+
+	// DualStackHostIP is the address of the other IP family of the host
+	// network the port is also assigned on, if the host network is dual-stack
+	DualStackHostIP string
 }
 
 func (m *AllocatedPortMapping) Copy() *AllocatedPortMapping {
@@ -2766,6 +2785,7 @@ func (m *AllocatedPortMapping) Copy() *AllocatedPortMapping {
 		To:              m.To,
 		HostIP:          m.HostIP,
 		IgnoreCollision: m.IgnoreCollision,
+		DualStackHostIP: m.DualStackHostIP,
 	}
 }
 
@@ -2784,6 +2804,8 @@ func (m *AllocatedPortMapping) Equal(o *AllocatedPortMapping) bool {
 		return false
 	case m.IgnoreCollision != o.IgnoreCollision:
 		return false
+	case m.DualStackHostIP != o.DualStackHostIP:
+		return false
 	}
 	return true
 }
@@ -2880,16 +2902,18 @@ type NetworkResource struct {
 	// msgpack omit empty fields during serialization
 	_struct bool `codec:",omitempty"` // nolint: structcheck
 
-	Mode          string     // Mode of the network
-	Device        string     // Name of the device
-	CIDR          string     // CIDR block of addresses
-	IP            string     // Host IP address
-	Hostname      string     `json:",omitempty"` // Hostname of the network namespace
-	MBits         int        // Throughput
-	DNS           *DNSConfig // DNS Configuration
-	ReservedPorts []Port     // Host Reserved ports
-	DynamicPorts  []Port     // Host Dynamically assigned ports
-	CNI           *CNIConfig // CNIConfig Configuration
+	Mode          string           // Mode of the network
+	Device        string           // Name of the device
+	CIDR          string           // CIDR block of addresses
+	IP            string           // Host IP address
+	Hostname      string           `json:",omitempty"` // Hostname of the network namespace
+	MBits         int              // Throughput
+	DNS           *DNSConfig       // DNS Configuration
+	ReservedPorts []Port           // Host Reserved ports
+	DynamicPorts  []Port           // Host Dynamically assigned ports
+	CNI           *CNIConfig       // CNIConfig Configuration
+	Bandwidth     *BandwidthConfig // Bandwidth rate limits
+	Egress        *EgressConfig    // Egress policy
 }
 
 func (n *NetworkResource) Hash() uint32 {
@@ -2904,6 +2928,10 @@ func (n *NetworkResource) Hash() uint32 {
 		data = fmt.Appendf(data, "d%d%s%d%d", i, port.Label, port.Value, port.To)
 	}
 
+	if n.Bandwidth != nil {
+		data = fmt.Appendf(data, "b%d%d", n.Bandwidth.IngressMBits, n.Bandwidth.EgressMBits)
+	}
+
 	return crc32.ChecksumIEEE(data)
 }
 
@@ -2941,6 +2969,8 @@ func (n *NetworkResource) Copy() *NetworkResource {
 	newR := new(NetworkResource)
 	*newR = *n
 	newR.DNS = n.DNS.Copy()
+	newR.Bandwidth = n.Bandwidth.Copy()
+	newR.Egress = n.Egress.Copy()
 	if n.ReservedPorts != nil {
 		newR.ReservedPorts = make([]Port, len(n.ReservedPorts))
 		copy(newR.ReservedPorts, n.ReservedPorts)
@@ -4469,6 +4499,14 @@ type Job struct {
 	// for dispatching.
 	ParameterizedJob *ParameterizedJobConfig
 
+	// Gang is used to require that the placements of a set of task groups
+	// either all succeed together or are not made at all.
+	Gang *GangConfig
+
+	// DependsOn is used to defer evaluating a batch job until the jobs it
+	// depends on have completed successfully.
+	DependsOn *JobDependencies
+
 	// Dispatched is used to identify if the Job has been dispatched from a
 	// parameterized job.
 	Dispatched bool
@@ -4516,6 +4554,12 @@ type Job struct {
 	// UnixNano in UTC
 	SubmitTime int64
 
+	// DependenciesReleasedIndex is the JobModifyIndex of the last version of
+	// the job an evaluation was created for. It is set by the state store and
+	// prevents the leader from evaluating a job with dependencies twice once
+	// its evaluations are garbage collected.
+	DependenciesReleasedIndex uint64
+
 	// Raft Indexes
 	CreateIndex uint64
 	// ModifyIndex is the index at which any state of the job last changed
@@ -4728,6 +4772,8 @@ func (j *Job) Copy() *Job {
 	}
 
 	nj.Periodic = j.Periodic.Copy()
+	nj.Gang = j.Gang.Copy()
+	nj.DependsOn = j.DependsOn.Copy()
 	nj.Meta = maps.Clone(j.Meta)
 	nj.ParameterizedJob = j.ParameterizedJob.Copy()
 	return nj
@@ -4882,6 +4928,34 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if j.Gang != nil {
+		if j.Type != JobTypeService && j.Type != JobTypeBatch {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf(
+				"Gang scheduling can only be used with %q or %q scheduler", JobTypeService, JobTypeBatch,
+			))
+		}
+
+		if err := j.Gang.Validate(j); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	}
+
+	if j.DependsOn != nil {
+		if j.Type != JobTypeBatch && j.Type != JobTypeSysBatch {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf(
+				"Dependencies can only be used with %q or %q scheduler", JobTypeBatch, JobTypeSysBatch,
+			))
+		}
+		if j.IsPeriodic() || j.IsParameterized() {
+			mErr.Errors = append(mErr.Errors, errors.New(
+				"Dependencies can't be used with periodic or parameterized jobs"))
+		}
+
+		if err := j.DependsOn.Validate(j); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -5278,6 +5352,7 @@ func (j *Job) SpecChanged(new *Job) bool {
 	c.ModifyIndex = j.ModifyIndex
 	c.JobModifyIndex = j.JobModifyIndex
 	c.SubmitTime = j.SubmitTime
+	c.DependenciesReleasedIndex = j.DependenciesReleasedIndex
 
 	// cgbaker: FINISH: probably need some consideration of scaling policy ID here
 
@@ -5449,6 +5524,10 @@ type UpdateStrategy struct {
 	// Canary is the number of canaries to deploy when a change to the task
 	// group is detected.
 	Canary int
+
+	// Analysis is an optional analysis run once all canaries are healthy. The
+	// deployment is promoted if the analysis passes and failed otherwise.
+	Analysis *CanaryAnalysis
 }
 
 func (u *UpdateStrategy) Copy() *UpdateStrategy {
@@ -5458,6 +5537,7 @@ func (u *UpdateStrategy) Copy() *UpdateStrategy {
 
 	c := new(UpdateStrategy)
 	*c = *u
+	c.Analysis = u.Analysis.Copy()
 	return c
 }
 
@@ -5500,6 +5580,14 @@ func (u *UpdateStrategy) Validate() error {
 	if u.Stagger <= 0 {
 		_ = multierror.Append(&mErr, fmt.Errorf("Stagger must be greater than zero: %v", u.Stagger))
 	}
+	if u.Analysis != nil {
+		if u.Canary == 0 {
+			_ = multierror.Append(&mErr, fmt.Errorf("Analysis requires a Canary count greater than zero"))
+		}
+		if err := u.Analysis.Validate(); err != nil {
+			_ = multierror.Append(&mErr, multierror.Prefix(err, "Analysis:"))
+		}
+	}
 
 	return mErr.ErrorOrNil()
 }
@@ -5518,6 +5606,191 @@ func (u *UpdateStrategy) IsEmpty() bool {
 	return u.MaxParallel == 0
 }
 
+const (
+	// CanaryAnalysisHTTPMethodDefault is the method used by HTTP canary
+	// analysis queries when none is given.
+	CanaryAnalysisHTTPMethodDefault = http.MethodGet
+)
+
+// CanaryAnalysis is an analysis run against the canaries of a deployment
+// before they are promoted. Either an HTTP query or a variable threshold is
+// checked every Interval for Duration, and the analysis fails once more than
+// FailureLimit checks have failed.
+type CanaryAnalysis struct {
+	// Duration is how long the analysis runs for.
+	Duration time.Duration
+
+	// Interval is the time between two checks of the analysis.
+	Interval time.Duration
+
+	// FailureLimit is the number of failed checks tolerated before the
+	// analysis fails.
+	FailureLimit int
+
+	// HTTP is the HTTP query checked by the analysis.
+	HTTP *CanaryAnalysisHTTP
+
+	// Variable is the variable threshold checked by the analysis.
+	Variable *CanaryAnalysisVariable
+}
+
+func (a *CanaryAnalysis) Copy() *CanaryAnalysis {
+	if a == nil {
+		return nil
+	}
+
+	c := new(CanaryAnalysis)
+	*c = *a
+	c.HTTP = a.HTTP.Copy()
+	c.Variable = a.Variable.Copy()
+	return c
+}
+
+func (a *CanaryAnalysis) Validate() error {
+	var mErr multierror.Error
+	if a.Duration <= 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Duration must be greater than zero: %v", a.Duration))
+	}
+	if a.Interval <= 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Interval must be greater than zero: %v", a.Interval))
+	} else if a.Interval > a.Duration {
+		_ = multierror.Append(&mErr, fmt.Errorf("Interval must not be greater than duration: %v > %v", a.Interval, a.Duration))
+	}
+	if a.FailureLimit < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Failure limit can not be less than zero: %d < 0", a.FailureLimit))
+	}
+
+	switch {
+	case a.HTTP == nil && a.Variable == nil:
+		_ = multierror.Append(&mErr, fmt.Errorf("Must specify either an http or a variable block"))
+	case a.HTTP != nil && a.Variable != nil:
+		_ = multierror.Append(&mErr, fmt.Errorf("Can not specify both an http and a variable block"))
+	case a.HTTP != nil:
+		if err := a.HTTP.Validate(); err != nil {
+			_ = multierror.Append(&mErr, err)
+		}
+	default:
+		if err := a.Variable.Validate(); err != nil {
+			_ = multierror.Append(&mErr, err)
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// CanaryAnalysisHTTP is an HTTP query checked by a canary analysis. A check
+// passes when the query returns a 2xx status code.
+type CanaryAnalysisHTTP struct {
+	// URL is the URL queried, including any query parameters.
+	URL string
+
+	// Method is the HTTP method of the query.
+	Method string
+
+	// Header is the set of headers sent with the query.
+	Header map[string]string
+
+	// Timeout is the timeout of a single query. It defaults to the interval
+	// of the analysis.
+	Timeout time.Duration
+}
+
+func (h *CanaryAnalysisHTTP) Copy() *CanaryAnalysisHTTP {
+	if h == nil {
+		return nil
+	}
+
+	c := new(CanaryAnalysisHTTP)
+	*c = *h
+	c.Header = maps.Clone(h.Header)
+	return c
+}
+
+func (h *CanaryAnalysisHTTP) Validate() error {
+	var mErr multierror.Error
+	if u, err := url.Parse(h.URL); err != nil {
+		_ = multierror.Append(&mErr, fmt.Errorf("Invalid http url %q: %v", h.URL, err))
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		_ = multierror.Append(&mErr, fmt.Errorf("Http url must use the http or https scheme: %q", h.URL))
+	}
+
+	switch h.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPost:
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Invalid http method given: %q", h.Method))
+	}
+	if h.Timeout < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Http timeout can not be less than zero: %v", h.Timeout))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// CanaryAnalysisVariable is a threshold on the value of a Nomad variable item
+// checked by a canary analysis. A check passes when the item is a number
+// within the Min and Max bounds.
+type CanaryAnalysisVariable struct {
+	// Path is the path of the variable, in the namespace of the job.
+	Path string
+
+	// Key is the item of the variable holding the value.
+	Key string
+
+	// Min and Max are the inclusive bounds of the value.
+	Min *float64
+	Max *float64
+}
+
+func (v *CanaryAnalysisVariable) Copy() *CanaryAnalysisVariable {
+	if v == nil {
+		return nil
+	}
+
+	c := new(CanaryAnalysisVariable)
+	*c = *v
+	c.Min = pointer.Copy(v.Min)
+	c.Max = pointer.Copy(v.Max)
+	return c
+}
+
+func (v *CanaryAnalysisVariable) Validate() error {
+	var mErr multierror.Error
+	if v.Path == "" {
+		_ = multierror.Append(&mErr, fmt.Errorf("Variable path must be set"))
+	}
+	if v.Key == "" {
+		_ = multierror.Append(&mErr, fmt.Errorf("Variable key must be set"))
+	}
+	if v.Min == nil && v.Max == nil {
+		_ = multierror.Append(&mErr, fmt.Errorf("Variable threshold requires a min or a max"))
+	}
+	if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+		_ = multierror.Append(&mErr, fmt.Errorf("Variable min must not be greater than max: %v > %v", *v.Min, *v.Max))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Check returns an error if the value of the variable item is outside of the
+// threshold.
+func (v *CanaryAnalysisVariable) Check(items map[string]string) error {
+	raw, ok := items[v.Key]
+	if !ok {
+		return fmt.Errorf("variable %q has no key %q", v.Path, v.Key)
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil {
+		return fmt.Errorf("variable %q key %q is not a number: %q", v.Path, v.Key, raw)
+	}
+	if v.Min != nil && value < *v.Min {
+		return fmt.Errorf("variable %q key %q is below the minimum: %v < %v", v.Path, v.Key, value, *v.Min)
+	}
+	if v.Max != nil && value > *v.Max {
+		return fmt.Errorf("variable %q key %q is above the maximum: %v > %v", v.Path, v.Key, value, *v.Max)
+	}
+	return nil
+}
+
 // Rolling returns if a rolling strategy should be used.
 // TODO(alexdadgar): Remove once no longer used by the scheduler.
 func (u *UpdateStrategy) Rolling() bool {
@@ -5604,6 +5877,10 @@ type Namespace struct {
 	VaultConfiguration  *NamespaceVaultConfiguration
 	ConsulConfiguration *NamespaceConsulConfiguration
 
+	// PreemptionConfiguration is the namespace configuration for limiting
+	// the preemption performed on behalf of jobs in the namespace.
+	PreemptionConfiguration *NamespacePreemptionConfiguration
+
 	// Meta is the set of metadata key/value pairs that attached to the namespace
 	Meta map[string]string
 
@@ -5694,6 +5971,10 @@ func (n *Namespace) Validate() error {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid consul configuration: %v", e))
 	}
 
+	if err := n.PreemptionConfiguration.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid preemption configuration: %v", err))
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -5753,6 +6034,10 @@ func (n *Namespace) SetHash() []byte {
 		}
 	}
 
+	if n.PreemptionConfiguration != nil {
+		_, _ = hash.Write([]byte(strconv.Itoa(n.PreemptionConfiguration.MaxPreemptionsPerEval)))
+	}
+
 	// sort keys to ensure hash stability when meta is stored later
 	var keys []string
 	for k := range n.Meta {
@@ -5804,6 +6089,7 @@ func (n *Namespace) Copy() *Namespace {
 		nc.Allowed = slices.Clone(n.ConsulConfiguration.Allowed)
 		nc.Denied = slices.Clone(n.ConsulConfiguration.Denied)
 	}
+	nc.PreemptionConfiguration = n.PreemptionConfiguration.Copy()
 
 	if n.Meta != nil {
 		nc.Meta = make(map[string]string, len(n.Meta))
@@ -5869,6 +6155,38 @@ const (
 	PeriodicSpecTest = "_internal_test"
 )
 
+const (
+	// PeriodicCatchUpSkip drops launches that were missed while there was no
+	// leader to run them.
+	PeriodicCatchUpSkip = "skip"
+
+	// PeriodicCatchUpRunOnce launches a single instance of the job if any
+	// launches were missed while there was no leader. It is the default.
+	PeriodicCatchUpRunOnce = "run-once"
+
+	// PeriodicCatchUpRunAll launches an instance of the job for every launch
+	// that was missed while there was no leader, up to
+	// PeriodicCatchUpRunAllLimit.
+	PeriodicCatchUpRunAll = "run-all"
+
+	// PeriodicCatchUpRunAllLimit is the maximum number of missed launches
+	// that are run by the run-all catch up policy.
+	PeriodicCatchUpRunAllLimit = 100
+
+	// periodicExclusionSkipLimit is the maximum number of consecutive
+	// excluded launch times Next skips before giving up.
+	periodicExclusionSkipLimit = 10000
+)
+
+// periodicExclusionTimeFormats are the formats accepted for the start and end
+// of an exclusion window, other than RFC3339. They are interpreted in the time
+// zone of the periodic job.
+var periodicExclusionTimeFormats = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
 // Periodic defines the interval a job should be run at.
 type PeriodicConfig struct {
 	// Enabled determines if the job should be run periodically.
@@ -5895,16 +6213,124 @@ type PeriodicConfig struct {
 	// Reference: https://www.iana.org/time-zones
 	TimeZone string
 
+	// CatchUp is the policy for launches that were missed while there was no
+	// leader. An empty value is treated as PeriodicCatchUpRunOnce.
+	CatchUp string
+
+	// Exclusions suppress any launch that falls within them.
+	Exclusions []*PeriodicExclusion
+
 	// location is the time zone to evaluate the launch time against
 	location *time.Location
 }
 
+// PeriodicExclusion is a window during which a periodic job is not launched.
+// It is either a range of time, from Start up to but excluding End, or a cron
+// expression matching the launch times to skip.
+type PeriodicExclusion struct {
+	// Start and End are either RFC3339 timestamps or dates and times in the
+	// job's time zone, such as "2025-12-24" or "2025-12-24 18:00".
+	Start string
+	End   string
+
+	// Cron is a cron expression matching the launch times to skip.
+	Cron string
+}
+
+func (e *PeriodicExclusion) Copy() *PeriodicExclusion {
+	if e == nil {
+		return nil
+	}
+	ne := new(PeriodicExclusion)
+	*ne = *e
+	return ne
+}
+
+// Validate checks that the exclusion is either a valid time range or a valid
+// cron expression.
+func (e *PeriodicExclusion) Validate() error {
+	if e.Cron != "" {
+		if e.Start != "" || e.End != "" {
+			return fmt.Errorf("exclusion can't specify both cron and start/end")
+		}
+		if _, err := cronexpr.Parse(e.Cron); err != nil {
+			return fmt.Errorf("invalid exclusion cron %q: %v", e.Cron, err)
+		}
+		return nil
+	}
+
+	if e.Start == "" || e.End == "" {
+		return fmt.Errorf("exclusion must specify either cron or both start and end")
+	}
+	start, end, err := e.window(time.UTC)
+	if err != nil {
+		return err
+	}
+	if !start.Before(end) {
+		return fmt.Errorf("exclusion start %q must be before end %q", e.Start, e.End)
+	}
+	return nil
+}
+
+// window returns the start and end of a time range exclusion, parsing dates
+// without a time zone in loc.
+func (e *PeriodicExclusion) window(loc *time.Location) (time.Time, time.Time, error) {
+	start, err := parsePeriodicExclusionTime(e.Start, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := parsePeriodicExclusionTime(e.End, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+func parsePeriodicExclusionTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, format := range periodicExclusionTimeFormats {
+		if t, err := time.ParseInLocation(format, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid exclusion time %q", value)
+}
+
+// excludedUntil returns whether t is excluded and, if so, the time after which
+// the next launch should be searched for.
+func (e *PeriodicExclusion) excludedUntil(t time.Time, loc *time.Location) (bool, time.Time) {
+	if e.Cron != "" {
+		// Launch times have a resolution of a second, so t is matched by the
+		// expression if it is the next match from the second before it.
+		match, err := CronParseNext(t.Add(-time.Second), e.Cron)
+		if err != nil || !match.Equal(t) {
+			return false, time.Time{}
+		}
+		return true, t
+	}
+
+	start, end, err := e.window(loc)
+	if err != nil || t.Before(start) || !t.Before(end) {
+		return false, time.Time{}
+	}
+	return true, end.Add(-time.Nanosecond).In(t.Location())
+}
+
 func (p *PeriodicConfig) Copy() *PeriodicConfig {
 	if p == nil {
 		return nil
 	}
 	np := new(PeriodicConfig)
 	*np = *p
+	np.Specs = slices.Clone(p.Specs)
+	if p.Exclusions != nil {
+		np.Exclusions = make([]*PeriodicExclusion, len(p.Exclusions))
+		for i, e := range p.Exclusions {
+			np.Exclusions[i] = e.Copy()
+		}
+	}
 	return np
 }
 
@@ -5949,6 +6375,18 @@ func (p *PeriodicConfig) Validate() error {
 		_ = multierror.Append(&mErr, fmt.Errorf("Unknown periodic specification type %q", p.SpecType))
 	}
 
+	switch p.CatchUp {
+	case "", PeriodicCatchUpSkip, PeriodicCatchUpRunOnce, PeriodicCatchUpRunAll:
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Unknown catch up policy %q", p.CatchUp))
+	}
+
+	for i, e := range p.Exclusions {
+		if err := e.Validate(); err != nil {
+			_ = multierror.Append(&mErr, fmt.Errorf("Exclusion %d: %v", i, err))
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -5979,10 +6417,46 @@ func CronParseNext(fromTime time.Time, spec string) (t time.Time, err error) {
 }
 
 // Next returns the closest time instant matching the spec that is after the
-// passed time. If no matching instance exists, the zero value of time.Time is
-// returned. The `time.Location` of the returned value matches that of the
-// passed time.
+// passed time and not excluded. If no matching instance exists, the zero value
+// of time.Time is returned. The `time.Location` of the returned value matches
+// that of the passed time.
 func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
+	next, err := p.nextSpec(fromTime)
+	for i := 0; err == nil && !next.IsZero(); i++ {
+		excluded, until := p.excludedUntil(next)
+		if !excluded {
+			return next, nil
+		}
+		if i == periodicExclusionSkipLimit {
+			return time.Time{}, fmt.Errorf("no launch within %d launches that isn't excluded", periodicExclusionSkipLimit)
+		}
+		next, err = p.nextSpec(until)
+	}
+	return next, err
+}
+
+// Excluded returns whether a launch at t is suppressed by an exclusion.
+func (p *PeriodicConfig) Excluded(t time.Time) bool {
+	excluded, _ := p.excludedUntil(t)
+	return excluded
+}
+
+// excludedUntil returns whether t is excluded and, if so, the latest time
+// after which the next launch may occur.
+func (p *PeriodicConfig) excludedUntil(t time.Time) (bool, time.Time) {
+	var excluded bool
+	var until time.Time
+	for _, e := range p.Exclusions {
+		if ok, u := e.excludedUntil(t, p.GetLocation()); ok && (!excluded || u.After(until)) {
+			excluded, until = true, u
+		}
+	}
+	return excluded, until
+}
+
+// nextSpec returns the closest time instant matching the spec that is after
+// the passed time, ignoring exclusions.
+func (p *PeriodicConfig) nextSpec(fromTime time.Time) (time.Time, error) {
 	switch p.SpecType {
 	case PeriodicSpecCron:
 		// Single spec parsing
@@ -6115,6 +6589,123 @@ func (d *ParameterizedJobConfig) Copy() *ParameterizedJobConfig {
 	return nd
 }
 
+// GangConfig is used to configure all-or-nothing placement for the task
+// groups of a job. When any placement of a group in the gang can not be made,
+// none of the placements of the gang are submitted and the evaluation is
+// blocked until all of them fit. The groups outside of the gang are placed
+// independently, as with any other job.
+type GangConfig struct {
+	// Groups is the set of task groups that must be placed together. If
+	// empty, every task group of the job is part of the gang.
+	Groups []string
+}
+
+func (g *GangConfig) Validate(job *Job) error {
+	var mErr multierror.Error
+	seen := make(map[string]struct{}, len(g.Groups))
+	for _, name := range g.Groups {
+		if _, ok := seen[name]; ok {
+			_ = multierror.Append(&mErr, fmt.Errorf("Gang group %q is listed more than once", name))
+			continue
+		}
+		seen[name] = struct{}{}
+
+		if job.LookupTaskGroup(name) == nil {
+			_ = multierror.Append(&mErr, fmt.Errorf("Gang group %q does not exist in job", name))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Includes returns whether the task group is part of the gang.
+func (g *GangConfig) Includes(group string) bool {
+	if g == nil {
+		return false
+	}
+	if len(g.Groups) == 0 {
+		return true
+	}
+	return slices.Contains(g.Groups, group)
+}
+
+func (g *GangConfig) Copy() *GangConfig {
+	if g == nil {
+		return nil
+	}
+	ng := new(GangConfig)
+	*ng = *g
+	ng.Groups = slices.Clone(g.Groups)
+	return ng
+}
+
+const (
+	// JobDependencyStatusMissing is the status of a job dependency that
+	// doesn't exist.
+	JobDependencyStatusMissing = "missing"
+)
+
+// JobDependencies is used to defer evaluating a batch job until the jobs it
+// depends on have completed successfully. The job is registered as usual but
+// the leader only creates its evaluation once every dependency is dead with
+// all of its allocations complete.
+type JobDependencies struct {
+	// Jobs are the IDs of the jobs in the same namespace that must complete
+	// before the job is evaluated. A periodic or parameterized job completes
+	// once all of its children have.
+	Jobs []string
+}
+
+func (d *JobDependencies) Validate(job *Job) error {
+	var mErr multierror.Error
+	if len(d.Jobs) == 0 {
+		_ = multierror.Append(&mErr, errors.New("Dependencies must list at least one job"))
+	}
+
+	seen := make(map[string]struct{}, len(d.Jobs))
+	for _, id := range d.Jobs {
+		if _, ok := seen[id]; ok {
+			_ = multierror.Append(&mErr, fmt.Errorf("Dependency %q is listed more than once", id))
+			continue
+		}
+		seen[id] = struct{}{}
+
+		switch id {
+		case "":
+			_ = multierror.Append(&mErr, errors.New("Dependency job ID can't be empty"))
+		case job.ID:
+			_ = multierror.Append(&mErr, errors.New("Job can't depend on itself"))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+func (d *JobDependencies) Copy() *JobDependencies {
+	if d == nil {
+		return nil
+	}
+	nd := new(JobDependencies)
+	*nd = *d
+	nd.Jobs = slices.Clone(d.Jobs)
+	return nd
+}
+
+// JobDependencyStatus is the status of a job that another job depends on.
+type JobDependencyStatus struct {
+	ID        string
+	Namespace string
+
+	// Status is the status of the job, or JobDependencyStatusMissing if it
+	// doesn't exist.
+	Status string
+
+	// Complete is whether the job has completed successfully, which
+	// satisfies the dependency.
+	Complete bool
+
+	// DependsOn are the statuses of the job's own dependencies.
+	DependsOn []*JobDependencyStatus
+}
+
 // DispatchedID returns an ID appropriate for a job dispatched against a
 // particular parameterized job
 func DispatchedID(templateID, idPrefixTemplate string, t time.Time) string {
@@ -7389,6 +7980,14 @@ func (tg *TaskGroup) validateNetworks() error {
 			}
 		}
 
+		if err := net.Bandwidth.Validate(net.Mode); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+
+		if err := net.Egress.Validate(net.Mode); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+
 		// Validate the hostname field to be a valid DNS name. If the parameter
 		// looks like it includes an interpolation value, we skip this. It
 		// would be nice to validate additional parameters, but this isn't the
@@ -7818,8 +8417,24 @@ type LogConfig struct {
 	MaxFiles      int
 	MaxFileSizeMB int
 	Disabled      bool
+
+	// MaxAge is the age after which rotated log files are deleted, even if
+	// MaxFiles hasn't been reached. Zero keeps them until they're rotated out.
+	MaxAge time.Duration
+
+	// Compress is the compression applied to rotated log files, one of
+	// LogCompressionGzip or LogCompressionZstd. Empty keeps them plain.
+	Compress string
+
+	// Sinks ship the logs of the task to external destinations
+	Sinks []*LogSink
 }
 
+const (
+	LogCompressionGzip = "gzip"
+	LogCompressionZstd = "zstd"
+)
+
 func (l *LogConfig) Equal(o *LogConfig) bool {
 	if l == nil || o == nil {
 		return l == o
@@ -7837,6 +8452,18 @@ func (l *LogConfig) Equal(o *LogConfig) bool {
 		return false
 	}
 
+	if l.MaxAge != o.MaxAge {
+		return false
+	}
+
+	if l.Compress != o.Compress {
+		return false
+	}
+
+	if !slices.EqualFunc(l.Sinks, o.Sinks, (*LogSink).Equal) {
+		return false
+	}
+
 	return true
 }
 
@@ -7848,6 +8475,9 @@ func (l *LogConfig) Copy() *LogConfig {
 		MaxFiles:      l.MaxFiles,
 		MaxFileSizeMB: l.MaxFileSizeMB,
 		Disabled:      l.Disabled,
+		MaxAge:        l.MaxAge,
+		Compress:      l.Compress,
+		Sinks:         helper.CopySlice(l.Sinks),
 	}
 }
 
@@ -7879,6 +8509,21 @@ func (l *LogConfig) Validate(disk *EphemeralDisk) error {
 					logUsage, disk.SizeMB))
 		}
 	}
+	if l.MaxAge < 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("max_age must not be negative; got %v", l.MaxAge))
+	}
+	switch l.Compress {
+	case "", LogCompressionGzip, LogCompressionZstd:
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("compress must be %q or %q; got %q",
+			LogCompressionGzip, LogCompressionZstd, l.Compress))
+	}
+	if err := validateLogSinks(l.Sinks); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+	if len(l.Sinks) > 0 && l.Disabled {
+		mErr.Errors = append(mErr.Errors, errors.New("sinks require log collection to be enabled"))
+	}
 	return mErr.ErrorOrNil()
 }
 
@@ -8151,6 +8796,12 @@ func (t *Task) Canonicalize(job *Job, tg *TaskGroup) {
 		t.RestartPolicy = tg.RestartPolicy
 	}
 
+	if t.LogConfig != nil {
+		for _, sink := range t.LogConfig.Sinks {
+			sink.Canonicalize()
+		}
+	}
+
 	// Set the default timeout if it is not specified.
 	if t.KillTimeout == 0 {
 		t.KillTimeout = DefaultKillTimeout
@@ -9349,6 +10000,14 @@ const (
 	// configured to ignore the shutdown delay value set for the tas.
 	TaskSkippingShutdownDelay = "Skipping shutdown delay"
 
+	// TaskCheckpointed indicates that the task was checkpointed before being
+	// killed, so that it is restored once its allocation is migrated.
+	TaskCheckpointed = "Checkpointed"
+
+	// TaskRestoredFromCheckpoint indicates that the task was restored from
+	// the checkpoint of the previous allocation.
+	TaskRestoredFromCheckpoint = "Restored from checkpoint"
+
 	// TaskRunning indicates a task is running due to a schedule or schedule
 	// override. (Enterprise)
 	TaskRunning = "Running"
@@ -9565,6 +10224,10 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		desc = "Main tasks in the group died"
 	case TaskClientReconnected:
 		desc = "Client reconnected"
+	case TaskCheckpointed:
+		desc = "Task checkpointed for migration"
+	case TaskRestoredFromCheckpoint:
+		desc = "Task restored from checkpoint"
 	default:
 		desc = e.Message
 	}
@@ -10371,6 +11034,12 @@ type EphemeralDisk struct {
 	// Migrate determines if Nomad client should migrate the allocation dir for
 	// sticky allocations
 	Migrate bool
+
+	// Checkpoint determines if Nomad client should checkpoint the running
+	// tasks of migrating allocations, so that they are restored from the
+	// checkpoint on the new node. It requires Migrate and a driver with the
+	// checkpoint capability.
+	Checkpoint bool
 }
 
 // DefaultEphemeralDisk returns a EphemeralDisk with default configurations
@@ -10391,6 +11060,8 @@ func (d *EphemeralDisk) Equal(o *EphemeralDisk) bool {
 		return false
 	case d.Migrate != o.Migrate:
 		return false
+	case d.Checkpoint != o.Checkpoint:
+		return false
 	}
 	return true
 }
@@ -10400,6 +11071,9 @@ func (d *EphemeralDisk) Validate() error {
 	if d.SizeMB < 10 {
 		return fmt.Errorf("minimum DiskMB value is 10; got %d", d.SizeMB)
 	}
+	if d.Checkpoint && !d.Migrate {
+		return fmt.Errorf("checkpoint requires migrate to be enabled")
+	}
 	return nil
 }
 
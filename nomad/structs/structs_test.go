@@ -62,6 +62,16 @@ func TestNamespace_Validate(t *testing.T) {
 			},
 			Expected: "description longer than",
 		},
+		{
+			Test: "negative preemption limit",
+			Namespace: &Namespace{
+				Name: "foo",
+				PreemptionConfiguration: &NamespacePreemptionConfiguration{
+					MaxPreemptionsPerEval: -1,
+				},
+			},
+			Expected: "invalid preemption configuration",
+		},
 		{
 			Test: "valid",
 			Namespace: &Namespace{
@@ -112,6 +122,9 @@ func TestNamespace_SetHash(t *testing.T) {
 			Default: "default",
 			Allowed: []string{"default"},
 		},
+		PreemptionConfiguration: &NamespacePreemptionConfiguration{
+			MaxPreemptionsPerEval: 2,
+		},
 		Meta: map[string]string{
 			"a": "b",
 			"c": "d",
@@ -179,6 +192,13 @@ func TestNamespace_SetHash(t *testing.T) {
 	must.NotNil(t, ns.Hash)
 	must.Eq(t, out8, ns.Hash)
 	must.NotEq(t, out7, out8)
+
+	ns.PreemptionConfiguration.MaxPreemptionsPerEval = 5
+	out9 := ns.SetHash()
+	must.NotNil(t, out9)
+	must.NotNil(t, ns.Hash)
+	must.Eq(t, out9, ns.Hash)
+	must.NotEq(t, out8, out9)
 }
 
 func TestNamespace_Copy(t *testing.T) {
@@ -204,6 +224,9 @@ func TestNamespace_Copy(t *testing.T) {
 			Default: "default",
 			Allowed: []string{"default"},
 		},
+		PreemptionConfiguration: &NamespacePreemptionConfiguration{
+			MaxPreemptionsPerEval: 2,
+		},
 		Meta: map[string]string{
 			"a": "b",
 			"c": "d",
@@ -226,6 +249,7 @@ func TestNamespace_Copy(t *testing.T) {
 	nsCopy.ConsulConfiguration.Default = "infra"
 	nsCopy.ConsulConfiguration.Allowed = []string{}
 	nsCopy.ConsulConfiguration.Denied = []string{"dev"}
+	nsCopy.PreemptionConfiguration.MaxPreemptionsPerEval = 10
 	nsCopy.Meta["a"] = "z"
 	must.NotEq(t, ns, nsCopy)
 
@@ -491,6 +515,62 @@ func TestJob_ValidateScaling(t *testing.T) {
 	)
 }
 
+func TestJob_ValidateGang(t *testing.T) {
+	ci.Parallel(t)
+
+	job := testJob()
+	job.Gang = &GangConfig{}
+	must.NoError(t, job.Validate())
+	must.True(t, job.Gang.Includes(job.TaskGroups[0].Name))
+
+	job.Gang.Groups = []string{job.TaskGroups[0].Name}
+	must.NoError(t, job.Validate())
+	must.False(t, job.Gang.Includes("other"))
+
+	job.Gang.Groups = []string{job.TaskGroups[0].Name, job.TaskGroups[0].Name, "missing"}
+	err := job.Validate()
+	requireErrors(t, err,
+		"is listed more than once",
+		`Gang group "missing" does not exist in job`,
+	)
+
+	job.Gang.Groups = nil
+	job.Type = JobTypeSystem
+	err = job.Validate()
+	must.ErrorContains(t, err, "Gang scheduling can only be used with")
+
+	var nilGang *GangConfig
+	must.False(t, nilGang.Includes(job.TaskGroups[0].Name))
+}
+
+func TestJob_ValidateDependsOn(t *testing.T) {
+	ci.Parallel(t)
+
+	job := testJob()
+	job.Type = JobTypeBatch
+	job.Periodic = nil
+	job.DependsOn = &JobDependencies{Jobs: []string{"extract", "transform"}}
+	must.NoError(t, job.Validate())
+
+	job.DependsOn.Jobs = []string{"extract", "extract", "", job.ID}
+	err := job.Validate()
+	requireErrors(t, err,
+		`Dependency "extract" is listed more than once`,
+		"Dependency job ID can't be empty",
+		"Job can't depend on itself",
+	)
+
+	job.DependsOn.Jobs = nil
+	job.Type = JobTypeService
+	job.Periodic = &PeriodicConfig{Enabled: true, SpecType: PeriodicSpecCron, Spec: "@daily"}
+	err = job.Validate()
+	requireErrors(t, err,
+		"Dependencies must list at least one job",
+		"Dependencies can only be used with",
+		"Dependencies can't be used with periodic or parameterized jobs",
+	)
+}
+
 func TestJob_ValidateNullChar(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3431,6 +3511,18 @@ func TestLogConfig_Equals(t *testing.T) {
 		require.False(t, a.Equal(b))
 	})
 
+	t.Run("max age", func(t *testing.T) {
+		a := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200, MaxAge: time.Hour}
+		b := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200}
+		require.False(t, a.Equal(b))
+	})
+
+	t.Run("compress", func(t *testing.T) {
+		a := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200, Compress: LogCompressionGzip}
+		b := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200, Compress: LogCompressionZstd}
+		require.False(t, a.Equal(b))
+	})
+
 	t.Run("same", func(t *testing.T) {
 		a := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200}
 		b := &LogConfig{MaxFiles: 1, MaxFileSizeMB: 200}
@@ -3438,6 +3530,22 @@ func TestLogConfig_Equals(t *testing.T) {
 	})
 }
 
+func TestLogConfig_Validate_Rotation(t *testing.T) {
+	ci.Parallel(t)
+
+	l := DefaultLogConfig()
+	l.MaxAge = 24 * time.Hour
+	l.Compress = LogCompressionZstd
+	must.NoError(t, l.Validate(nil))
+
+	l.MaxAge = -time.Second
+	must.ErrorContains(t, l.Validate(nil), "max_age must not be negative")
+
+	l.MaxAge = 0
+	l.Compress = "lz4"
+	must.ErrorContains(t, l.Validate(nil), `compress must be "gzip" or "zstd"; got "lz4"`)
+}
+
 func TestTask_Validate_CSIPluginConfig(t *testing.T) {
 	ci.Parallel(t)
 
@@ -4132,6 +4240,50 @@ func TestUpdateStrategy_Validate(t *testing.T) {
 	)
 }
 
+func TestUpdateStrategy_ValidateAnalysis(t *testing.T) {
+	ci.Parallel(t)
+
+	u := DefaultUpdateStrategy.Copy()
+	u.Analysis = &CanaryAnalysis{
+		Duration:     time.Minute,
+		Interval:     2 * time.Minute,
+		FailureLimit: -1,
+		HTTP:         &CanaryAnalysisHTTP{URL: "ftp://example.com", Method: "DELETE"},
+		Variable:     &CanaryAnalysisVariable{Path: "metrics"},
+	}
+	requireErrors(t, u.Validate(),
+		"Analysis requires a Canary count greater than zero",
+		"Interval must not be greater than duration",
+		"Failure limit can not be less than zero",
+		"Can not specify both an http and a variable block",
+	)
+
+	u.Canary = 1
+	u.Analysis.FailureLimit = 0
+	u.Analysis.Interval = 10 * time.Second
+	u.Analysis.Variable = nil
+	requireErrors(t, u.Validate(),
+		"Http url must use the http or https scheme",
+		"Invalid http method given",
+	)
+
+	u.Analysis.HTTP = nil
+	u.Analysis.Variable = &CanaryAnalysisVariable{Path: "metrics", Min: new(2.0), Max: new(1.0)}
+	requireErrors(t, u.Validate(),
+		"Variable key must be set",
+		"Variable min must not be greater than max",
+	)
+
+	u.Analysis.Variable.Key = "error_rate"
+	u.Analysis.Variable.Min = nil
+	must.NoError(t, u.Validate())
+
+	must.NoError(t, u.Analysis.Variable.Check(map[string]string{"error_rate": " 0.5 "}))
+	must.ErrorContains(t, u.Analysis.Variable.Check(map[string]string{"error_rate": "1.5"}), "above the maximum")
+	must.ErrorContains(t, u.Analysis.Variable.Check(map[string]string{"error_rate": "high"}), "is not a number")
+	must.ErrorContains(t, u.Analysis.Variable.Check(nil), "has no key")
+}
+
 func TestResource_NetIndex(t *testing.T) {
 	ci.Parallel(t)
 
@@ -4927,6 +5079,82 @@ func TestPeriodicConfig_DST(t *testing.T) {
 	require.Equal(e2, n2.UTC())
 }
 
+func TestPeriodicConfig_Exclusions(t *testing.T) {
+	ci.Parallel(t)
+
+	p := &PeriodicConfig{
+		Enabled:  true,
+		SpecType: PeriodicSpecCron,
+		Spec:     "0 2 * * *",
+		TimeZone: "America/New_York",
+		CatchUp:  PeriodicCatchUpRunAll,
+		Exclusions: []*PeriodicExclusion{
+			{Start: "2025-12-24", End: "2025-12-27"},
+			{Cron: "* * * * 0,6"},
+		},
+	}
+	p.Canonicalize()
+	must.NoError(t, p.Validate())
+
+	// Wed Dec 24 to Fri Dec 26 are excluded by the range and the weekend by
+	// the cron expression, so the next launch is on Monday.
+	from := time.Date(2025, time.December, 23, 3, 0, 0, 0, p.location)
+	next, err := p.Next(from)
+	must.NoError(t, err)
+	must.Eq(t, time.Date(2025, time.December, 29, 2, 0, 0, 0, p.location), next)
+
+	must.True(t, p.Excluded(time.Date(2025, time.December, 24, 2, 0, 0, 0, p.location)))
+	must.True(t, p.Excluded(time.Date(2025, time.December, 27, 2, 0, 0, 0, p.location)))
+	must.False(t, p.Excluded(time.Date(2025, time.December, 23, 2, 0, 0, 0, p.location)))
+
+	// An exclusion that covers every launch is an error rather than a loop.
+	p.Exclusions = []*PeriodicExclusion{{Cron: "* * * * *"}}
+	_, err = p.Next(from)
+	must.ErrorContains(t, err, "isn't excluded")
+
+	// Copies don't share exclusions.
+	c := p.Copy()
+	c.Exclusions[0].Cron = "0 0 * * *"
+	must.Eq(t, "* * * * *", p.Exclusions[0].Cron)
+}
+
+func TestPeriodicConfig_ValidateExclusions(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name      string
+		catchUp   string
+		exclusion *PeriodicExclusion
+		errorMsg  string
+	}{
+		{name: "rfc3339", exclusion: &PeriodicExclusion{Start: "2025-12-24T00:00:00Z", End: "2025-12-24T12:00:00+01:00"}},
+		{name: "catch up", catchUp: "later", exclusion: &PeriodicExclusion{Cron: "@daily"}, errorMsg: "Unknown catch up policy"},
+		{name: "both", exclusion: &PeriodicExclusion{Start: "2025-12-24", Cron: "@daily"}, errorMsg: "both cron and start/end"},
+		{name: "no end", exclusion: &PeriodicExclusion{Start: "2025-12-24"}, errorMsg: "either cron or both start and end"},
+		{name: "bad time", exclusion: &PeriodicExclusion{Start: "24/12/2025", End: "2025-12-27"}, errorMsg: "invalid exclusion time"},
+		{name: "reversed", exclusion: &PeriodicExclusion{Start: "2025-12-27", End: "2025-12-24"}, errorMsg: "must be before end"},
+		{name: "bad cron", exclusion: &PeriodicExclusion{Cron: "* *"}, errorMsg: "invalid exclusion cron"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &PeriodicConfig{
+				Enabled:    true,
+				SpecType:   PeriodicSpecCron,
+				Spec:       "@hourly",
+				CatchUp:    tc.catchUp,
+				Exclusions: []*PeriodicExclusion{tc.exclusion},
+			}
+			p.Canonicalize()
+			err := p.Validate()
+			if tc.errorMsg == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
 func TestTaskLifecycleConfig_Validate(t *testing.T) {
 	ci.Parallel(t)
 
@@ -7320,9 +7548,10 @@ func TestEphemeralDisk_Equal(t *testing.T) {
 	must.NotEqual[*EphemeralDisk](t, nil, new(EphemeralDisk))
 
 	must.StructEqual(t, &EphemeralDisk{
-		Sticky:  true,
-		SizeMB:  42,
-		Migrate: true,
+		Sticky:     true,
+		SizeMB:     42,
+		Migrate:    true,
+		Checkpoint: true,
 	}, []must.Tweak[*EphemeralDisk]{{
 		Field: "Sticky",
 		Apply: func(e *EphemeralDisk) { e.Sticky = false },
@@ -7332,9 +7561,22 @@ func TestEphemeralDisk_Equal(t *testing.T) {
 	}, {
 		Field: "Migrate",
 		Apply: func(e *EphemeralDisk) { e.Migrate = false },
+	}, {
+		Field: "Checkpoint",
+		Apply: func(e *EphemeralDisk) { e.Checkpoint = false },
 	}})
 }
 
+func TestEphemeralDisk_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	must.NoError(t, DefaultEphemeralDisk().Validate())
+	must.NoError(t, (&EphemeralDisk{SizeMB: 10, Migrate: true, Checkpoint: true}).Validate())
+	must.EqError(t, (&EphemeralDisk{SizeMB: 5}).Validate(), "minimum DiskMB value is 10; got 5")
+	must.EqError(t, (&EphemeralDisk{SizeMB: 10, Checkpoint: true}).Validate(),
+		"checkpoint requires migrate to be enabled")
+}
+
 func TestDNSConfig_Equal(t *testing.T) {
 	ci.Parallel(t)
 
@@ -96,6 +96,7 @@ func (d *driverPluginClient) Capabilities() (*Capabilities, error) {
 		caps.MountConfigs = MountConfigSupport(resp.Capabilities.MountConfigs)
 		caps.DisableLogCollection = resp.Capabilities.DisableLogCollection
 		caps.DynamicWorkloadUsers = resp.Capabilities.DynamicWorkloadUsers
+		caps.Checkpoint = resp.Capabilities.Checkpoint
 	}
 
 	return caps, nil
@@ -180,19 +181,7 @@ func (d *driverPluginClient) StartTask(c *TaskConfig) (*TaskHandle, *DriverNetwo
 		return nil, nil, grpcutils.HandleGrpcErr(err, d.doneCtx)
 	}
 
-	var net *DriverNetwork
-	if resp.NetworkOverride != nil {
-		net = &DriverNetwork{
-			PortMap:       map[string]int{},
-			IP:            resp.NetworkOverride.Addr,
-			AutoAdvertise: resp.NetworkOverride.AutoAdvertise,
-		}
-		for k, v := range resp.NetworkOverride.PortMap {
-			net.PortMap[k] = int(v)
-		}
-	}
-
-	return taskHandleFromProto(resp.Handle), net, nil
+	return taskHandleFromProto(resp.Handle), networkOverrideFromProto(resp.NetworkOverride), nil
 }
 
 // WaitTask returns a channel that will have an ExitResult pushed to it once when the task
@@ -535,6 +524,35 @@ func (d *driverPluginClient) DestroyNetwork(allocID string, spec *NetworkIsolati
 	return nil
 }
 
+func (d *driverPluginClient) CheckpointTask(taskID string, opts *CheckpointOptions) error {
+	req := &proto.CheckpointTaskRequest{
+		TaskId:       taskID,
+		ImageDir:     opts.ImageDir,
+		LeaveRunning: opts.LeaveRunning,
+	}
+
+	_, err := d.client.CheckpointTask(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
+
+func (d *driverPluginClient) RestoreTask(c *TaskConfig, imageDir string) (*TaskHandle, *DriverNetwork, error) {
+	req := &proto.RestoreTaskRequest{
+		Task:     taskConfigToProto(c),
+		ImageDir: imageDir,
+	}
+
+	resp, err := d.client.RestoreTask(d.doneCtx, req)
+	if err != nil {
+		return nil, nil, grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return taskHandleFromProto(resp.Handle), networkOverrideFromProto(resp.NetworkOverride), nil
+}
+
 func (d *driverPluginClient) Shutdown(ctx context.Context) error {
 	ctx, cancel := joincontext.Join(d.DoneCtx, ctx)
 	defer cancel()
@@ -114,6 +114,37 @@ type DriverNetworkManager interface {
 	DestroyNetwork(allocID string, spec *NetworkIsolationSpec) error
 }
 
+// DriverCheckpointer is the interface which exposes functions for checkpointing
+// a running task to disk and restoring it from the checkpoint image, possibly on
+// another node. Drivers implementing it must set the Checkpoint capability.
+type DriverCheckpointer interface {
+	// CheckpointTask writes the state of the running task to a checkpoint
+	// image in the directory of the options.
+	CheckpointTask(taskID string, opts *CheckpointOptions) error
+
+	// RestoreTask starts the task from the checkpoint image in imageDir, in
+	// place of StartTask.
+	RestoreTask(cfg *TaskConfig, imageDir string) (*TaskHandle, *DriverNetwork, error)
+}
+
+// DriverImagePrePuller is an optional interface for drivers which can pull
+// images ahead of placement. It is only supported by internal drivers.
+type DriverImagePrePuller interface {
+	// PrePullImages sets the images to pull, which are listed in the node
+	// metadata. Images which are no longer set may be garbage collected.
+	PrePullImages(images []string)
+}
+
+// CheckpointOptions are the options of DriverCheckpointer.CheckpointTask.
+type CheckpointOptions struct {
+	// ImageDir is the directory the checkpoint image is written to.
+	ImageDir string
+
+	// LeaveRunning is set if the task keeps running after it has been
+	// checkpointed. Otherwise the task is stopped.
+	LeaveRunning bool
+}
+
 // DriverSignalTaskNotSupported can be embedded by drivers which don't support
 // the SignalTask RPC. This satisfies the SignalTask func requirement for the
 // DriverPlugin interface.
@@ -193,6 +224,10 @@ type Capabilities struct {
 	// The allocation of a unique, not-in-use UID/GID is managed by Nomad client
 	// ensuring no overlap.
 	DynamicWorkloadUsers bool
+
+	// Checkpoint indicates the driver implements DriverCheckpointer and can
+	// migrate running tasks between nodes.
+	Checkpoint bool
 }
 
 func (c *Capabilities) HasNetIsolationMode(m NetIsolationMode) bool {
@@ -1925,7 +1925,10 @@ type DriverCapabilities struct {
 	DisableLogCollection bool `protobuf:"varint,8,opt,name=disable_log_collection,json=disableLogCollection,proto3" json:"disable_log_collection,omitempty"`
 	// dynamic_workload_users indicates the task is capable of using UID/GID
 	// assigned from the Nomad client as user credentials for the task.
-	DynamicWorkloadUsers bool     `protobuf:"varint,9,opt,name=dynamic_workload_users,json=dynamicWorkloadUsers,proto3" json:"dynamic_workload_users,omitempty"`
+	DynamicWorkloadUsers bool `protobuf:"varint,9,opt,name=dynamic_workload_users,json=dynamicWorkloadUsers,proto3" json:"dynamic_workload_users,omitempty"`
+	// checkpoint indicates that the driver implements the CheckpointTask and
+	// RestoreTask RPCs.
+	Checkpoint           bool     `protobuf:"varint,10,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2012,6 +2015,13 @@ func (m *DriverCapabilities) GetDynamicWorkloadUsers() bool {
 	return false
 }
 
+func (m *DriverCapabilities) GetCheckpoint() bool {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return false
+}
+
 type NetworkIsolationSpec struct {
 	Mode                 NetworkIsolationSpec_NetworkIsolationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode" json:"mode,omitempty"`
 	Path                 string                                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -3818,6 +3828,196 @@ func (m *ShutdownResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShutdownResponse proto.InternalMessageInfo
 
+type CheckpointTaskRequest struct {
+	// TaskId is the ID of the target task
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// ImageDir is the directory the checkpoint image is written to
+	ImageDir string `protobuf:"bytes,2,opt,name=image_dir,json=imageDir,proto3" json:"image_dir,omitempty"`
+	// LeaveRunning is set if the task should keep running after it has been
+	// checkpointed, otherwise it is stopped
+	LeaveRunning         bool     `protobuf:"varint,3,opt,name=leave_running,json=leaveRunning,proto3" json:"leave_running,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskRequest) Reset()         { *m = CheckpointTaskRequest{} }
+func (m *CheckpointTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskRequest) ProtoMessage()    {}
+func (*CheckpointTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{61}
+}
+
+func (m *CheckpointTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskRequest.Unmarshal(m, b)
+}
+func (m *CheckpointTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskRequest.Merge(m, src)
+}
+func (m *CheckpointTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskRequest.Size(m)
+}
+func (m *CheckpointTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskRequest proto.InternalMessageInfo
+
+func (m *CheckpointTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *CheckpointTaskRequest) GetImageDir() string {
+	if m != nil {
+		return m.ImageDir
+	}
+	return ""
+}
+
+func (m *CheckpointTaskRequest) GetLeaveRunning() bool {
+	if m != nil {
+		return m.LeaveRunning
+	}
+	return false
+}
+
+type CheckpointTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskResponse) Reset()         { *m = CheckpointTaskResponse{} }
+func (m *CheckpointTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskResponse) ProtoMessage()    {}
+func (*CheckpointTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{62}
+}
+
+func (m *CheckpointTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskResponse.Unmarshal(m, b)
+}
+func (m *CheckpointTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskResponse.Merge(m, src)
+}
+func (m *CheckpointTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskResponse.Size(m)
+}
+func (m *CheckpointTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskResponse proto.InternalMessageInfo
+
+type RestoreTaskRequest struct {
+	// Task configuration to restore
+	Task *TaskConfig `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// ImageDir is the directory holding the checkpoint image
+	ImageDir             string   `protobuf:"bytes,2,opt,name=image_dir,json=imageDir,proto3" json:"image_dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTaskRequest) Reset()         { *m = RestoreTaskRequest{} }
+func (m *RestoreTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTaskRequest) ProtoMessage()    {}
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{63}
+}
+
+func (m *RestoreTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTaskRequest.Unmarshal(m, b)
+}
+func (m *RestoreTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTaskRequest.Merge(m, src)
+}
+func (m *RestoreTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTaskRequest.Size(m)
+}
+func (m *RestoreTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTaskRequest proto.InternalMessageInfo
+
+func (m *RestoreTaskRequest) GetTask() *TaskConfig {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *RestoreTaskRequest) GetImageDir() string {
+	if m != nil {
+		return m.ImageDir
+	}
+	return ""
+}
+
+type RestoreTaskResponse struct {
+	// Handle is opaque to the client, but must be stored in order to recover
+	// the task.
+	Handle *TaskHandle `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	// NetworkOverride is set if the driver sets network settings and the service ip/port
+	// needs to be set differently.
+	NetworkOverride      *NetworkOverride `protobuf:"bytes,2,opt,name=network_override,json=networkOverride,proto3" json:"network_override,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RestoreTaskResponse) Reset()         { *m = RestoreTaskResponse{} }
+func (m *RestoreTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTaskResponse) ProtoMessage()    {}
+func (*RestoreTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{64}
+}
+
+func (m *RestoreTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTaskResponse.Unmarshal(m, b)
+}
+func (m *RestoreTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTaskResponse.Merge(m, src)
+}
+func (m *RestoreTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTaskResponse.Size(m)
+}
+func (m *RestoreTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTaskResponse proto.InternalMessageInfo
+
+func (m *RestoreTaskResponse) GetHandle() *TaskHandle {
+	if m != nil {
+		return m.Handle
+	}
+	return nil
+}
+
+func (m *RestoreTaskResponse) GetNetworkOverride() *NetworkOverride {
+	if m != nil {
+		return m.NetworkOverride
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.TaskState", TaskState_name, TaskState_value)
 	proto.RegisterEnum("hashicorp.nomad.plugins.drivers.proto.FingerprintResponse_HealthState", FingerprintResponse_HealthState_name, FingerprintResponse_HealthState_value)
@@ -3898,6 +4098,10 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent.AnnotationsEntry")
 	proto.RegisterType((*ShutdownRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.ShutdownRequest")
 	proto.RegisterType((*ShutdownResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.ShutdownResponse")
+	proto.RegisterType((*CheckpointTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskRequest")
+	proto.RegisterType((*CheckpointTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskResponse")
+	proto.RegisterType((*RestoreTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.RestoreTaskRequest")
+	proto.RegisterType((*RestoreTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.RestoreTaskResponse")
 }
 
 func init() {
@@ -3905,259 +4109,267 @@ func init() {
 }
 
 var fileDescriptor_4a8f45747846a74d = []byte{
-	// 4029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x73, 0x23, 0x49,
-	0x56, 0x77, 0xe9, 0x9f, 0xa5, 0x27, 0x59, 0x2e, 0xa7, 0xed, 0x1e, 0xb5, 0x66, 0x61, 0x7a, 0x6a,
-	0x63, 0x08, 0xb3, 0x3b, 0xa3, 0x9e, 0xf5, 0xc0, 0xf4, 0x74, 0x6f, 0xcf, 0xf6, 0x68, 0x64, 0x75,
-	0x5b, 0xdd, 0xb6, 0x6c, 0x52, 0x32, 0xbd, 0x4d, 0xc3, 0x14, 0xe5, 0xaa, 0x6c, 0xb9, 0xda, 0x52,
-	0x55, 0x75, 0x65, 0xc9, 0x6d, 0x2f, 0x41, 0x40, 0x2c, 0x11, 0xc4, 0x12, 0x01, 0x01, 0x07, 0x86,
-	0xbd, 0x70, 0x22, 0x82, 0x13, 0x5f, 0x80, 0x58, 0x82, 0x13, 0x07, 0x8e, 0xf0, 0x01, 0x38, 0xc0,
-	0x8d, 0x2b, 0x9f, 0x80, 0x8d, 0xfc, 0x53, 0xa5, 0x2a, 0x49, 0xbd, 0x5d, 0x92, 0xfb, 0x24, 0xbd,
-	0x97, 0x99, 0xbf, 0x7c, 0xf5, 0xf2, 0xe5, 0x7b, 0x2f, 0x33, 0x1f, 0x68, 0xde, 0x70, 0x3c, 0xb0,
-	0x1d, 0x7a, 0xdb, 0xf2, 0xed, 0x0b, 0xe2, 0xd3, 0xdb, 0x9e, 0xef, 0x06, 0xae, 0xa4, 0x1a, 0x9c,
-	0x40, 0x1f, 0x9d, 0x19, 0xf4, 0xcc, 0x36, 0x5d, 0xdf, 0x6b, 0x38, 0xee, 0xc8, 0xb0, 0x1a, 0x72,
-	0x4c, 0x43, 0x8e, 0x11, 0xdd, 0xea, 0xbf, 0x3e, 0x70, 0xdd, 0xc1, 0x90, 0x08, 0x84, 0xd3, 0xf1,
-	0x8b, 0xdb, 0xd6, 0xd8, 0x37, 0x02, 0xdb, 0x75, 0x64, 0xfb, 0x07, 0xd3, 0xed, 0x81, 0x3d, 0x22,
-	0x34, 0x30, 0x46, 0x9e, 0xec, 0xf0, 0x51, 0x28, 0x0b, 0x3d, 0x33, 0x7c, 0x62, 0xdd, 0x3e, 0x33,
-	0x87, 0xd4, 0x23, 0x26, 0xfb, 0xd5, 0xd9, 0x1f, 0xd9, 0xed, 0xe3, 0xa9, 0x6e, 0x34, 0xf0, 0xc7,
-	0x66, 0x10, 0x4a, 0x6e, 0x04, 0x81, 0x6f, 0x9f, 0x8e, 0x03, 0x22, 0x7a, 0x6b, 0x6b, 0x50, 0xee,
-	0x38, 0x76, 0x80, 0xc9, 0xab, 0x31, 0xa1, 0x81, 0x56, 0x85, 0x8a, 0x20, 0xa9, 0xe7, 0x3a, 0x94,
-	0x68, 0x37, 0xe1, 0xbd, 0xbe, 0x41, 0xcf, 0x5b, 0xae, 0xf3, 0xc2, 0x1e, 0xf4, 0xcc, 0x33, 0x32,
-	0x32, 0xc2, 0xae, 0xbf, 0x0f, 0xb5, 0xd9, 0x26, 0x31, 0x0c, 0x7d, 0x05, 0x39, 0x26, 0x51, 0x4d,
-	0xb9, 0xa5, 0xec, 0x94, 0x77, 0x3f, 0x6e, 0xbc, 0x49, 0x43, 0x42, 0xc4, 0x86, 0xfc, 0x92, 0x46,
-	0xcf, 0x23, 0x26, 0xe6, 0x23, 0xb5, 0x6d, 0xd8, 0x6c, 0x19, 0x9e, 0x71, 0x6a, 0x0f, 0xed, 0xc0,
-	0x26, 0x34, 0x9c, 0x74, 0x0c, 0x5b, 0x49, 0xb6, 0x9c, 0xf0, 0x0f, 0xa0, 0x62, 0xc6, 0xf8, 0x72,
-	0xe2, 0xbb, 0x8d, 0x54, 0x4b, 0xd3, 0xd8, 0xe3, 0x54, 0x02, 0x38, 0x01, 0xa7, 0x6d, 0x01, 0x7a,
-	0x68, 0x3b, 0x03, 0xe2, 0x7b, 0xbe, 0xed, 0x44, 0xca, 0xfa, 0x8f, 0x2c, 0x6c, 0x26, 0xd8, 0x52,
-	0x98, 0x97, 0x00, 0x91, 0x9a, 0x99, 0x28, 0xd9, 0x9d, 0xf2, 0xee, 0xe3, 0x94, 0xa2, 0xcc, 0xc1,
-	0x6b, 0x34, 0x23, 0xb0, 0xb6, 0x13, 0xf8, 0x57, 0x38, 0x86, 0x8e, 0xbe, 0x81, 0xc2, 0x19, 0x31,
-	0x86, 0xc1, 0x59, 0x2d, 0x73, 0x4b, 0xd9, 0xa9, 0xee, 0x3e, 0xbc, 0xc6, 0x3c, 0xfb, 0x1c, 0xa8,
-	0x17, 0x18, 0x01, 0xc1, 0x12, 0x15, 0x7d, 0x02, 0x48, 0xfc, 0xd3, 0x2d, 0x42, 0x4d, 0xdf, 0xf6,
-	0x98, 0xc5, 0xd6, 0xb2, 0xb7, 0x94, 0x9d, 0x12, 0xde, 0x10, 0x2d, 0x7b, 0x93, 0x06, 0xa4, 0x42,
-	0x96, 0xf8, 0x7e, 0x2d, 0xc7, 0xdb, 0xd9, 0xdf, 0xba, 0x07, 0xeb, 0x53, 0xf2, 0xb3, 0x4e, 0xe7,
-	0xe4, 0x8a, 0xaf, 0x51, 0x09, 0xb3, 0xbf, 0xe8, 0x11, 0xe4, 0x2f, 0x8c, 0xe1, 0x98, 0xf0, 0x8f,
-	0x28, 0xef, 0xfe, 0xe0, 0x6d, 0x06, 0x23, 0x6d, 0x7a, 0xa2, 0x19, 0x2c, 0xc6, 0xdf, 0xcb, 0x7c,
-	0xa1, 0x68, 0x77, 0xa1, 0x1c, 0xfb, 0x12, 0x54, 0x05, 0x38, 0xe9, 0xee, 0xb5, 0xfb, 0xed, 0x56,
-	0xbf, 0xbd, 0xa7, 0xae, 0xa0, 0x35, 0x28, 0x9d, 0x74, 0xf7, 0xdb, 0xcd, 0x83, 0xfe, 0xfe, 0x33,
-	0x55, 0x41, 0x65, 0x58, 0x0d, 0x89, 0x8c, 0x76, 0x09, 0x08, 0x13, 0xd3, 0xbd, 0x20, 0x3e, 0x33,
-	0x6d, 0xb9, 0xce, 0xe8, 0x3d, 0x58, 0x0d, 0x0c, 0x7a, 0xae, 0xdb, 0x96, 0x94, 0xb9, 0xc0, 0xc8,
-	0x8e, 0x85, 0x3a, 0x50, 0x38, 0x33, 0x1c, 0x6b, 0xf8, 0x76, 0xb9, 0x93, 0xca, 0x67, 0xe0, 0xfb,
-	0x7c, 0x20, 0x96, 0x00, 0xcc, 0xde, 0x13, 0x33, 0xcb, 0xfd, 0xf7, 0x0c, 0xd4, 0x5e, 0x60, 0xf8,
-	0x41, 0x5c, 0x9c, 0x36, 0xe4, 0xd8, 0xfc, 0xd2, 0xc6, 0x17, 0x99, 0x53, 0xec, 0x55, 0xcc, 0x87,
-	0x6b, 0xff, 0x97, 0x81, 0x8d, 0x18, 0xb6, 0xb4, 0xdd, 0xa7, 0x50, 0xf0, 0x09, 0x1d, 0x0f, 0x03,
-	0x0e, 0x5f, 0xdd, 0x7d, 0x90, 0x12, 0x7e, 0x06, 0xa9, 0x81, 0x39, 0x0c, 0x96, 0x70, 0x68, 0x07,
-	0x54, 0x31, 0x42, 0x27, 0xbe, 0xef, 0xfa, 0xfa, 0x88, 0x0e, 0xb8, 0xd6, 0x4a, 0xb8, 0x2a, 0xf8,
-	0x6d, 0xc6, 0x3e, 0xa4, 0x83, 0x98, 0x56, 0xb3, 0xd7, 0xd4, 0x2a, 0x32, 0x40, 0x75, 0x48, 0xf0,
-	0xda, 0xf5, 0xcf, 0x75, 0xa6, 0x5a, 0xdf, 0xb6, 0x08, 0xb7, 0xcd, 0xf2, 0xee, 0xe7, 0x29, 0x41,
-	0xbb, 0x62, 0xf8, 0x91, 0x1c, 0x8d, 0xd7, 0x9d, 0x24, 0x43, 0xfb, 0x3e, 0x14, 0xc4, 0x97, 0x32,
-	0x4b, 0xea, 0x9d, 0xb4, 0x5a, 0xed, 0x5e, 0x4f, 0x5d, 0x41, 0x25, 0xc8, 0xe3, 0x76, 0x1f, 0x33,
-	0x0b, 0x2b, 0x41, 0xfe, 0x61, 0xb3, 0xdf, 0x3c, 0x50, 0x33, 0xda, 0xf7, 0x60, 0xfd, 0xa9, 0x61,
-	0x07, 0x69, 0x8c, 0x4b, 0x73, 0x41, 0x9d, 0xf4, 0x95, 0xab, 0xd3, 0x49, 0xac, 0x4e, 0x7a, 0xd5,
-	0xb4, 0x2f, 0xb9, 0x4f, 0x8f, 0xaf, 0x87, 0xdc, 0xa9, 0x99, 0x68, 0xa7, 0x6a, 0xaf, 0x61, 0xbd,
-	0x17, 0xb8, 0x5e, 0x2a, 0xcb, 0xff, 0x0c, 0x56, 0x59, 0x78, 0x72, 0xc7, 0x81, 0x34, 0xfd, 0x9b,
-	0x0d, 0x11, 0xbe, 0x1a, 0x61, 0xf8, 0x6a, 0xec, 0xc9, 0xf0, 0x86, 0xc3, 0x9e, 0xe8, 0x06, 0x14,
-	0xa8, 0x3d, 0x70, 0x8c, 0xa1, 0xf4, 0x1f, 0x92, 0xd2, 0x10, 0x33, 0xf2, 0x70, 0x62, 0x69, 0xf8,
-	0x2d, 0x40, 0x7b, 0x84, 0x06, 0xbe, 0x7b, 0x95, 0x4a, 0x9e, 0x2d, 0xc8, 0xbf, 0x70, 0x7d, 0x53,
-	0x6c, 0xc4, 0x22, 0x16, 0x04, 0xdb, 0x54, 0x09, 0x10, 0x89, 0xfd, 0x09, 0xa0, 0x8e, 0xc3, 0xa2,
-	0x4c, 0xba, 0x85, 0xf8, 0x9b, 0x0c, 0x6c, 0x26, 0xfa, 0xcb, 0xc5, 0x58, 0x7e, 0x1f, 0x32, 0xc7,
-	0x34, 0xa6, 0x62, 0x1f, 0xa2, 0x23, 0x28, 0x88, 0x1e, 0x52, 0x93, 0x77, 0x16, 0x00, 0x12, 0x81,
-	0x4b, 0xc2, 0x49, 0x98, 0xb9, 0x46, 0x9f, 0x7d, 0xb7, 0x46, 0xff, 0x1a, 0xd4, 0xf0, 0x3b, 0xe8,
-	0x5b, 0xd7, 0xe6, 0x31, 0x6c, 0x9a, 0xee, 0x70, 0x48, 0x4c, 0x66, 0x0d, 0xba, 0xed, 0x04, 0xc4,
-	0xbf, 0x30, 0x86, 0x6f, 0xb7, 0x1b, 0x34, 0x19, 0xd5, 0x91, 0x83, 0xb4, 0xe7, 0xb0, 0x11, 0x9b,
-	0x58, 0x2e, 0xc4, 0x43, 0xc8, 0x53, 0xc6, 0x90, 0x2b, 0xf1, 0xe9, 0x82, 0x2b, 0x41, 0xb1, 0x18,
-	0xae, 0x6d, 0x0a, 0xf0, 0xf6, 0x05, 0x71, 0xa2, 0xcf, 0xd2, 0xf6, 0x60, 0xa3, 0xc7, 0xcd, 0x34,
-	0x95, 0x1d, 0x4e, 0x4c, 0x3c, 0x93, 0x30, 0xf1, 0x2d, 0x40, 0x71, 0x14, 0x69, 0x88, 0x57, 0xb0,
-	0xde, 0xbe, 0x24, 0x66, 0x2a, 0xe4, 0x1a, 0xac, 0x9a, 0xee, 0x68, 0x64, 0x38, 0x56, 0x2d, 0x73,
-	0x2b, 0xbb, 0x53, 0xc2, 0x21, 0x19, 0xdf, 0x8b, 0xd9, 0xb4, 0x7b, 0x51, 0xfb, 0x2b, 0x05, 0xd4,
-	0xc9, 0xdc, 0x52, 0x91, 0x4c, 0xfa, 0xc0, 0x62, 0x40, 0x6c, 0xee, 0x0a, 0x96, 0x94, 0xe4, 0x87,
-	0xee, 0x42, 0xf0, 0x89, 0xef, 0xc7, 0xdc, 0x51, 0xf6, 0x9a, 0xee, 0x48, 0xdb, 0x87, 0xef, 0x84,
-	0xe2, 0xf4, 0x02, 0x9f, 0x18, 0x23, 0xdb, 0x19, 0x74, 0x8e, 0x8e, 0x3c, 0x22, 0x04, 0x47, 0x08,
-	0x72, 0x96, 0x11, 0x18, 0x52, 0x30, 0xfe, 0x9f, 0x6d, 0x7a, 0x73, 0xe8, 0xd2, 0x68, 0xd3, 0x73,
-	0x42, 0xfb, 0xf7, 0x2c, 0xd4, 0x66, 0xa0, 0x42, 0xf5, 0x3e, 0x87, 0x3c, 0x25, 0xc1, 0xd8, 0x93,
-	0xa6, 0xd2, 0x4e, 0x2d, 0xf0, 0x7c, 0xbc, 0x46, 0x8f, 0x81, 0x61, 0x81, 0x89, 0x06, 0x50, 0x0c,
-	0x82, 0x2b, 0x9d, 0xda, 0x3f, 0x09, 0x13, 0x82, 0x83, 0xeb, 0xe2, 0xf7, 0x89, 0x3f, 0xb2, 0x1d,
-	0x63, 0xd8, 0xb3, 0x7f, 0x42, 0xf0, 0x6a, 0x10, 0x5c, 0xb1, 0x3f, 0xe8, 0x19, 0x33, 0x78, 0xcb,
-	0x76, 0xa4, 0xda, 0x5b, 0xcb, 0xce, 0x12, 0x53, 0x30, 0x16, 0x88, 0xf5, 0x03, 0xc8, 0xf3, 0x6f,
-	0x5a, 0xc6, 0x10, 0x55, 0xc8, 0x06, 0xc1, 0x15, 0x17, 0xaa, 0x88, 0xd9, 0xdf, 0xfa, 0x7d, 0xa8,
-	0xc4, 0xbf, 0x80, 0x19, 0xd2, 0x19, 0xb1, 0x07, 0x67, 0xc2, 0xc0, 0xf2, 0x58, 0x52, 0x6c, 0x25,
-	0x5f, 0xdb, 0x96, 0x4c, 0x62, 0xf3, 0x58, 0x10, 0xda, 0x3f, 0x67, 0xe0, 0xe6, 0x1c, 0xcd, 0x48,
-	0x63, 0x7d, 0x9e, 0x30, 0xd6, 0x77, 0xa4, 0x85, 0xd0, 0xe2, 0x9f, 0x27, 0x2c, 0xfe, 0x1d, 0x82,
-	0xb3, 0x6d, 0x73, 0x03, 0x0a, 0xe4, 0xd2, 0x0e, 0x88, 0x25, 0x55, 0x25, 0xa9, 0xd8, 0x76, 0xca,
-	0x5d, 0x77, 0x3b, 0x1d, 0xc2, 0x56, 0xcb, 0x27, 0x46, 0x40, 0xa4, 0x2b, 0x0f, 0xed, 0xff, 0x26,
-	0x14, 0x8d, 0xe1, 0xd0, 0x35, 0x27, 0xcb, 0xba, 0xca, 0xe9, 0x8e, 0x85, 0xea, 0x50, 0x3c, 0x73,
-	0x69, 0xe0, 0x18, 0x23, 0x22, 0x9d, 0x57, 0x44, 0x6b, 0xdf, 0x2a, 0xb0, 0x3d, 0x85, 0x27, 0x57,
-	0xe1, 0x14, 0xaa, 0x36, 0x75, 0x87, 0xfc, 0x03, 0xf5, 0xd8, 0x99, 0xef, 0x87, 0x8b, 0x85, 0x9a,
-	0x4e, 0x88, 0xc1, 0x8f, 0x80, 0x6b, 0x76, 0x9c, 0xe4, 0x16, 0xc7, 0x27, 0xb7, 0xe4, 0x4e, 0x0f,
-	0x49, 0xed, 0xef, 0x14, 0xd8, 0x96, 0x11, 0x3e, 0xfd, 0x87, 0xce, 0x8a, 0x9c, 0x79, 0xd7, 0x22,
-	0x6b, 0x35, 0xb8, 0x31, 0x2d, 0x97, 0xf4, 0xf9, 0xff, 0x9d, 0x07, 0x34, 0x7b, 0xde, 0x44, 0x1f,
-	0x42, 0x85, 0x12, 0xc7, 0xd2, 0x45, 0xbc, 0x10, 0xa1, 0xac, 0x88, 0xcb, 0x8c, 0x27, 0x02, 0x07,
-	0x65, 0x2e, 0x90, 0x5c, 0x4a, 0x69, 0x8b, 0x98, 0xff, 0x47, 0x67, 0x50, 0x79, 0x41, 0xf5, 0x68,
-	0x6e, 0x6e, 0x50, 0xd5, 0xd4, 0x6e, 0x6d, 0x56, 0x8e, 0xc6, 0xc3, 0x5e, 0xf4, 0x5d, 0xb8, 0xfc,
-	0x82, 0x46, 0x04, 0xfa, 0x99, 0x02, 0xef, 0x85, 0x69, 0xc5, 0x44, 0x7d, 0x23, 0xd7, 0x22, 0xb4,
-	0x96, 0xbb, 0x95, 0xdd, 0xa9, 0xee, 0x1e, 0x5f, 0x43, 0x7f, 0x33, 0xcc, 0x43, 0xd7, 0x22, 0x78,
-	0xdb, 0x99, 0xc3, 0xa5, 0xa8, 0x01, 0x9b, 0xa3, 0x31, 0x0d, 0x74, 0x61, 0x05, 0xba, 0xec, 0x54,
-	0xcb, 0x73, 0xbd, 0x6c, 0xb0, 0xa6, 0x84, 0xad, 0xa2, 0x73, 0x58, 0x1b, 0xb9, 0x63, 0x27, 0xd0,
-	0x4d, 0x7e, 0xfe, 0xa1, 0xb5, 0xc2, 0x42, 0x47, 0xe5, 0x39, 0x5a, 0x3a, 0x64, 0x70, 0xe2, 0x34,
-	0x45, 0x71, 0x65, 0x14, 0xa3, 0xd0, 0x6f, 0xc1, 0x0d, 0xcb, 0xa6, 0xc6, 0xe9, 0x90, 0xe8, 0x43,
-	0x77, 0xa0, 0x4f, 0x72, 0x98, 0x5a, 0x91, 0xcb, 0xb7, 0x25, 0x5b, 0x0f, 0xdc, 0x41, 0x2b, 0x6a,
-	0xe3, 0xa3, 0xae, 0x1c, 0x63, 0x64, 0x9b, 0x3a, 0x13, 0x79, 0xe8, 0x1a, 0x96, 0x3e, 0xa6, 0xc4,
-	0xa7, 0xb5, 0x92, 0x1c, 0x25, 0x5a, 0x9f, 0xca, 0xc6, 0x13, 0xd6, 0xa6, 0xdd, 0x83, 0x72, 0x6c,
-	0xbd, 0x50, 0x11, 0x72, 0xdd, 0xa3, 0x6e, 0x5b, 0x5d, 0x41, 0x00, 0x85, 0xd6, 0x3e, 0x3e, 0x3a,
-	0xea, 0x8b, 0xe3, 0x47, 0xe7, 0xb0, 0xf9, 0xa8, 0xad, 0x66, 0x18, 0xfb, 0xa4, 0xfb, 0xbb, 0xed,
-	0xce, 0x81, 0x9a, 0xd5, 0xda, 0x50, 0x89, 0x7f, 0x05, 0x42, 0x50, 0x3d, 0xe9, 0x3e, 0xe9, 0x1e,
-	0x3d, 0xed, 0xea, 0x87, 0x47, 0x27, 0xdd, 0x3e, 0x3b, 0xc4, 0x54, 0x01, 0x9a, 0xdd, 0x67, 0x13,
-	0x7a, 0x0d, 0x4a, 0xdd, 0xa3, 0x90, 0x54, 0xea, 0x19, 0x55, 0x79, 0x9c, 0x2b, 0xae, 0xaa, 0x45,
-	0x5c, 0xf1, 0xc9, 0xc8, 0x0d, 0x88, 0xce, 0x42, 0x04, 0xd5, 0xfe, 0x2d, 0x0b, 0x5b, 0xf3, 0x16,
-	0x19, 0x59, 0x90, 0x63, 0x06, 0x23, 0x8f, 0x96, 0xef, 0xde, 0x5e, 0x38, 0x3a, 0xdb, 0x27, 0x9e,
-	0x21, 0x63, 0x49, 0x09, 0xf3, 0xff, 0x48, 0x87, 0xc2, 0xd0, 0x38, 0x25, 0x43, 0x5a, 0xcb, 0xf2,
-	0xeb, 0x98, 0x47, 0xd7, 0x99, 0xfb, 0x80, 0x23, 0x89, 0xbb, 0x18, 0x09, 0x8b, 0xfa, 0x50, 0x66,
-	0xde, 0x92, 0x0a, 0x75, 0x4a, 0x07, 0xbe, 0x9b, 0x72, 0x96, 0xfd, 0xc9, 0x48, 0x1c, 0x87, 0xa9,
-	0xdf, 0x85, 0x72, 0x6c, 0xb2, 0x39, 0x17, 0x27, 0x5b, 0xf1, 0x8b, 0x93, 0x52, 0xfc, 0x16, 0xe4,
-	0xc1, 0xec, 0x1a, 0x30, 0x1d, 0x31, 0x23, 0xd9, 0x3f, 0xea, 0xf5, 0xc5, 0x11, 0xf5, 0x11, 0x3e,
-	0x3a, 0x39, 0x56, 0x15, 0xc6, 0xec, 0x37, 0x7b, 0x4f, 0xd4, 0x4c, 0x64, 0x43, 0x59, 0xad, 0x05,
-	0xe5, 0x98, 0x5c, 0x89, 0xf0, 0xa0, 0x24, 0xc3, 0x03, 0x73, 0xd0, 0x86, 0x65, 0xf9, 0x84, 0x52,
-	0x29, 0x47, 0x48, 0x6a, 0xcf, 0xa1, 0xb4, 0xd7, 0xed, 0x49, 0x88, 0x1a, 0xac, 0x52, 0xe2, 0xb3,
-	0xef, 0xe6, 0x97, 0x62, 0x25, 0x1c, 0x92, 0x0c, 0x9c, 0x12, 0xc3, 0x37, 0xcf, 0x08, 0x95, 0x49,
-	0x45, 0x44, 0xb3, 0x51, 0x2e, 0xbf, 0x5c, 0x12, 0x6b, 0x57, 0xc2, 0x21, 0xa9, 0xfd, 0x7f, 0x11,
-	0x60, 0x72, 0xad, 0x81, 0xaa, 0x90, 0x89, 0x9c, 0x7d, 0xc6, 0xb6, 0x98, 0x1d, 0xc4, 0x82, 0x19,
-	0xff, 0x8f, 0x76, 0x61, 0x7b, 0x44, 0x07, 0x9e, 0x61, 0x9e, 0xeb, 0xf2, 0x36, 0x42, 0xf8, 0x04,
-	0xee, 0x38, 0x2b, 0x78, 0x53, 0x36, 0xca, 0x2d, 0x2f, 0x70, 0x0f, 0x20, 0x4b, 0x9c, 0x0b, 0xee,
-	0xe4, 0xca, 0xbb, 0xf7, 0x16, 0xbe, 0x6e, 0x69, 0xb4, 0x9d, 0x0b, 0x61, 0x2b, 0x0c, 0x06, 0xe9,
-	0x00, 0x16, 0xb9, 0xb0, 0x4d, 0xa2, 0x33, 0xd0, 0x3c, 0x07, 0xfd, 0x6a, 0x71, 0xd0, 0x3d, 0x8e,
-	0x11, 0x41, 0x97, 0xac, 0x90, 0x46, 0x5d, 0x28, 0xf9, 0x84, 0xba, 0x63, 0xdf, 0x24, 0xc2, 0xd3,
-	0xa5, 0x3f, 0x11, 0xe1, 0x70, 0x1c, 0x9e, 0x40, 0xa0, 0x3d, 0x28, 0x70, 0x07, 0x47, 0x6b, 0xab,
-	0x5c, 0xd8, 0x8f, 0x53, 0x82, 0x71, 0xef, 0x82, 0xe5, 0x58, 0xf4, 0x08, 0x56, 0x85, 0x88, 0xb4,
-	0x56, 0xe4, 0x30, 0x9f, 0xa4, 0xf5, 0xbe, 0x7c, 0x14, 0x0e, 0x47, 0xb3, 0x55, 0x65, 0x8e, 0x91,
-	0xfb, 0xc5, 0x12, 0xe6, 0xff, 0xd1, 0xfb, 0x50, 0x12, 0xc1, 0xde, 0xb2, 0xfd, 0x1a, 0x08, 0xe3,
-	0xe4, 0x8c, 0x3d, 0xdb, 0x47, 0x1f, 0x40, 0x59, 0x24, 0x75, 0x3a, 0xf7, 0x0a, 0x65, 0xde, 0x0c,
-	0x82, 0x75, 0xcc, 0x7c, 0x83, 0xe8, 0x40, 0x7c, 0x5f, 0x74, 0xa8, 0x44, 0x1d, 0x88, 0xef, 0xf3,
-	0x0e, 0xbf, 0x01, 0xeb, 0x3c, 0x15, 0x1e, 0xf8, 0xee, 0xd8, 0xd3, 0xb9, 0x4d, 0xad, 0xf1, 0x4e,
-	0x6b, 0x8c, 0xfd, 0x88, 0x71, 0xbb, 0xcc, 0xb8, 0x6e, 0x42, 0xf1, 0xa5, 0x7b, 0x2a, 0x3a, 0x54,
-	0xc5, 0x3e, 0x78, 0xe9, 0x9e, 0x86, 0x4d, 0x51, 0x3a, 0xb2, 0x9e, 0x4c, 0x47, 0x5e, 0xc1, 0x8d,
-	0xd9, 0xb8, 0xca, 0xd3, 0x12, 0xf5, 0xfa, 0x69, 0xc9, 0x96, 0x33, 0xcf, 0x0f, 0x7f, 0x0d, 0x59,
-	0xcb, 0xa1, 0xb5, 0x8d, 0x85, 0x8c, 0x23, 0xda, 0xc7, 0x98, 0x0d, 0x46, 0xdb, 0x50, 0x60, 0x1f,
-	0x6b, 0x5b, 0x35, 0x24, 0x5c, 0xcf, 0x4b, 0xf7, 0xb4, 0x63, 0xa1, 0xef, 0x40, 0x89, 0x7d, 0x3f,
-	0xf5, 0x0c, 0x93, 0xd4, 0x36, 0x79, 0xcb, 0x84, 0xc1, 0x16, 0xca, 0x71, 0x2d, 0x22, 0x54, 0xb4,
-	0x25, 0x16, 0x8a, 0x31, 0xb8, 0x8e, 0xde, 0x83, 0x55, 0xde, 0x68, 0x5b, 0xb5, 0x6d, 0x71, 0xe2,
-	0x60, 0x64, 0xc7, 0x42, 0x1a, 0xac, 0x79, 0x86, 0x4f, 0x9c, 0x40, 0x97, 0x33, 0xde, 0xe0, 0xcd,
-	0x65, 0xc1, 0x7c, 0xcc, 0xe6, 0xad, 0x7f, 0x0e, 0xc5, 0x70, 0x33, 0x2c, 0xe2, 0x26, 0xeb, 0xf7,
-	0xa1, 0x9a, 0xdc, 0x4a, 0x0b, 0x39, 0xd9, 0x7f, 0xcc, 0x40, 0x29, 0xda, 0x34, 0xc8, 0x81, 0x4d,
-	0xbe, 0xa8, 0x2c, 0x35, 0xd5, 0x27, 0x7b, 0x50, 0x24, 0xc4, 0x5f, 0xa6, 0x54, 0x73, 0x33, 0x44,
-	0x90, 0x27, 0x73, 0xb9, 0x21, 0x51, 0x84, 0x3c, 0x99, 0xef, 0x1b, 0x58, 0x1f, 0xda, 0xce, 0xf8,
-	0x32, 0x36, 0x97, 0xc8, 0x64, 0x7f, 0x3b, 0xe5, 0x5c, 0x07, 0x6c, 0xf4, 0x64, 0x8e, 0xea, 0x30,
-	0x41, 0xa3, 0x7d, 0xc8, 0x7b, 0xae, 0x1f, 0x84, 0x31, 0x33, 0x6d, 0x34, 0x3b, 0x76, 0xfd, 0xe0,
-	0xd0, 0xf0, 0x3c, 0x76, 0x58, 0x13, 0x00, 0xda, 0xb7, 0x19, 0xb8, 0x31, 0xff, 0xc3, 0x50, 0x17,
-	0xb2, 0xa6, 0x37, 0x96, 0x4a, 0xba, 0xbf, 0xa8, 0x92, 0x5a, 0xde, 0x78, 0x22, 0x3f, 0x03, 0x42,
-	0x4f, 0xa1, 0x30, 0x22, 0x23, 0xd7, 0xbf, 0x92, 0xba, 0x78, 0xb0, 0x28, 0xe4, 0x21, 0x1f, 0x3d,
-	0x41, 0x95, 0x70, 0x08, 0x43, 0x51, 0x6e, 0x26, 0x2a, 0xdd, 0xf6, 0x82, 0xd7, 0x69, 0x21, 0x24,
-	0x8e, 0x70, 0xb4, 0xcf, 0x61, 0x7b, 0xee, 0xa7, 0xa0, 0x5f, 0x03, 0x30, 0xbd, 0xb1, 0xce, 0x9f,
-	0x3b, 0x84, 0x05, 0x65, 0x71, 0xc9, 0xf4, 0xc6, 0x3d, 0xce, 0xd0, 0x9e, 0x43, 0xed, 0x4d, 0xf2,
-	0xb2, 0x3d, 0x26, 0x24, 0xd6, 0x47, 0xa7, 0x5c, 0x07, 0x59, 0x5c, 0x14, 0x8c, 0xc3, 0x53, 0xb6,
-	0x95, 0xc2, 0x46, 0xe3, 0x92, 0x75, 0xc8, 0xf2, 0x0e, 0x65, 0xd9, 0xc1, 0xb8, 0x3c, 0x3c, 0xd5,
-	0x7e, 0x9e, 0x81, 0xf5, 0x29, 0x91, 0xd9, 0x91, 0x55, 0x38, 0xe0, 0xf0, 0x32, 0x40, 0x50, 0xcc,
-	0x1b, 0x9b, 0xb6, 0x15, 0x5e, 0x23, 0xf3, 0xff, 0x3c, 0x0e, 0x7b, 0xf2, 0x8a, 0x37, 0x63, 0x7b,
-	0x6c, 0xfb, 0x8c, 0x4e, 0xed, 0x80, 0xf2, 0xa4, 0x28, 0x8f, 0x05, 0x81, 0x9e, 0x41, 0xd5, 0x27,
-	0x3c, 0xfe, 0x5b, 0xba, 0xb0, 0xb2, 0xfc, 0x42, 0x56, 0x26, 0x25, 0x64, 0xc6, 0x86, 0xd7, 0x42,
-	0x24, 0x46, 0x51, 0xf4, 0x14, 0xd6, 0xc2, 0x64, 0x5a, 0x20, 0x17, 0x96, 0x46, 0xae, 0x48, 0x20,
-	0x0e, 0xac, 0xdd, 0x85, 0x72, 0xac, 0x91, 0x7d, 0x18, 0xcf, 0xfe, 0xa4, 0x4e, 0x04, 0x91, 0xf4,
-	0x16, 0x79, 0xe9, 0x2d, 0xb4, 0x53, 0x28, 0xc7, 0xf6, 0xc5, 0x22, 0x43, 0x99, 0x3e, 0x03, 0x97,
-	0xeb, 0x33, 0x8f, 0x33, 0x81, 0xcb, 0xfc, 0x24, 0xcb, 0xbc, 0x74, 0xdb, 0x93, 0xef, 0x6c, 0x05,
-	0x46, 0x76, 0x3c, 0xed, 0x17, 0x19, 0xa8, 0x26, 0xb7, 0x74, 0x68, 0x47, 0x1e, 0xf1, 0x6d, 0xd7,
-	0x8a, 0xd9, 0xd1, 0x31, 0x67, 0x30, 0x5b, 0x61, 0xcd, 0xaf, 0xc6, 0x6e, 0x60, 0x84, 0xb6, 0x62,
-	0x7a, 0xe3, 0xdf, 0x61, 0xf4, 0x94, 0x0d, 0x66, 0xa7, 0x6c, 0x10, 0x7d, 0x0c, 0x48, 0x9a, 0xd2,
-	0xd0, 0x1e, 0xd9, 0x81, 0x7e, 0x7a, 0x15, 0x10, 0xb1, 0xc6, 0x59, 0xac, 0x8a, 0x96, 0x03, 0xd6,
-	0xf0, 0x35, 0xe3, 0x33, 0xc3, 0x73, 0xdd, 0x91, 0x4e, 0x4d, 0xd7, 0x27, 0xba, 0x61, 0xbd, 0xe4,
-	0xa7, 0xb5, 0x2c, 0x2e, 0xbb, 0xee, 0xa8, 0xc7, 0x78, 0x4d, 0xeb, 0x25, 0x0b, 0xc4, 0xa6, 0x37,
-	0xa6, 0x24, 0xd0, 0xd9, 0x0f, 0xcf, 0x5d, 0x4a, 0x18, 0x04, 0xab, 0xe5, 0x8d, 0x29, 0xfa, 0x2e,
-	0xac, 0x85, 0x1d, 0x78, 0x2c, 0x96, 0x49, 0x40, 0x45, 0x76, 0xe1, 0x3c, 0xa4, 0x41, 0xe5, 0x98,
-	0xf8, 0x26, 0x71, 0x82, 0xbe, 0x6d, 0x9e, 0x53, 0x7e, 0xec, 0x52, 0x70, 0x82, 0x27, 0x4f, 0x2d,
-	0xe1, 0x6c, 0x23, 0x32, 0xa2, 0xda, 0x7f, 0x2a, 0x90, 0xe7, 0x29, 0x0b, 0x53, 0x0a, 0x0f, 0xf7,
-	0x3c, 0x1b, 0x90, 0xa9, 0x2e, 0x63, 0xf0, 0x5c, 0xe0, 0x7d, 0x28, 0x71, 0xe5, 0xc7, 0x4e, 0x18,
-	0x3c, 0x0f, 0xe6, 0x8d, 0x75, 0x28, 0xfa, 0xc4, 0xb0, 0x5c, 0x67, 0x18, 0xde, 0x82, 0x45, 0x34,
-	0xfa, 0x4d, 0x50, 0x3d, 0xdf, 0xf5, 0x8c, 0xc1, 0xe4, 0xe0, 0x2c, 0x97, 0x6f, 0x3d, 0xc6, 0xe7,
-	0x29, 0xfa, 0x77, 0x61, 0x8d, 0x12, 0xe1, 0xd9, 0x85, 0x91, 0xe4, 0xc5, 0x67, 0x4a, 0x26, 0x3f,
-	0x11, 0xa0, 0x0f, 0xa1, 0xe2, 0x8b, 0xbb, 0x0e, 0x11, 0x4d, 0x85, 0xb6, 0xca, 0x92, 0xc7, 0x02,
-	0xaa, 0xf6, 0x0a, 0x0a, 0x22, 0xb6, 0x5d, 0xe3, 0x93, 0x3e, 0x01, 0x24, 0x74, 0xcd, 0x6c, 0x68,
-	0x64, 0x53, 0x2a, 0x13, 0x71, 0xfe, 0xfe, 0x2b, 0x5a, 0x8e, 0x27, 0x0d, 0xda, 0x7f, 0x29, 0x22,
-	0x25, 0x17, 0xef, 0x70, 0x2c, 0x77, 0x67, 0x1b, 0x8b, 0x9d, 0x7e, 0xc5, 0x85, 0x5f, 0x48, 0xa2,
-	0x0e, 0x14, 0x64, 0xe6, 0x9d, 0x59, 0xf6, 0x19, 0x53, 0x02, 0x84, 0xd7, 0xff, 0x44, 0x5e, 0x7e,
-	0x2c, 0x7a, 0xfd, 0x4f, 0xc4, 0xf5, 0x3f, 0x61, 0x1a, 0x95, 0x67, 0x02, 0x01, 0x97, 0xe3, 0x47,
-	0x82, 0xb2, 0x15, 0xbd, 0xb1, 0x10, 0xed, 0x7f, 0x95, 0xc8, 0x35, 0x86, 0x6f, 0x21, 0xe8, 0x1b,
-	0x28, 0x32, 0x2f, 0xa3, 0x8f, 0x0c, 0x4f, 0xbe, 0xf5, 0xb7, 0x96, 0x7b, 0x66, 0x09, 0x03, 0xa7,
-	0xc8, 0xe8, 0x57, 0x3d, 0x41, 0x31, 0x17, 0xcb, 0x4e, 0x53, 0xa1, 0x8b, 0x65, 0xff, 0xd1, 0x47,
-	0x50, 0x35, 0xc6, 0x81, 0xab, 0x1b, 0xd6, 0x05, 0xf1, 0x03, 0x9b, 0x12, 0x69, 0x6e, 0x6b, 0x8c,
-	0xdb, 0x0c, 0x99, 0xf5, 0x7b, 0x50, 0x89, 0x63, 0xbe, 0x2d, 0xb5, 0xc9, 0xc7, 0x53, 0x9b, 0x3f,
-	0x04, 0x98, 0xdc, 0x2b, 0x32, 0x1b, 0x21, 0x97, 0x76, 0xa0, 0x9b, 0xe1, 0xf1, 0x3d, 0x8f, 0x8b,
-	0x8c, 0xd1, 0x62, 0xf6, 0x9a, 0x7c, 0xf4, 0xc8, 0x87, 0x8f, 0x1e, 0xcc, 0x81, 0xb0, 0x3d, 0x7f,
-	0x6e, 0x0f, 0x87, 0xd1, 0x5d, 0x67, 0xc9, 0x75, 0x47, 0x4f, 0x38, 0x43, 0xfb, 0xd7, 0x8c, 0xb0,
-	0x15, 0xf1, 0x7c, 0x95, 0xea, 0xf8, 0xf6, 0xae, 0x96, 0xfa, 0x2e, 0x00, 0x0d, 0x0c, 0x9f, 0xe5,
-	0x69, 0x46, 0x78, 0xdb, 0x5a, 0x9f, 0x79, 0x35, 0xe9, 0x87, 0x05, 0x38, 0xb8, 0x24, 0x7b, 0x37,
-	0x03, 0xf4, 0x25, 0x54, 0x4c, 0x77, 0xe4, 0x0d, 0x89, 0x1c, 0x9c, 0x7f, 0xeb, 0xe0, 0x72, 0xd4,
-	0xbf, 0x19, 0xc4, 0xee, 0x78, 0x0b, 0xd7, 0xbd, 0xe3, 0xfd, 0x85, 0x22, 0x5e, 0xe1, 0xe2, 0x8f,
-	0x80, 0x68, 0x30, 0xa7, 0xf6, 0xe4, 0xd1, 0x92, 0x2f, 0x8a, 0xbf, 0xaa, 0xf0, 0xa4, 0xfe, 0x65,
-	0x9a, 0xba, 0x8e, 0x37, 0x67, 0xce, 0xff, 0x92, 0x85, 0x52, 0xf4, 0x00, 0x37, 0xb3, 0xf6, 0x5f,
-	0x40, 0x29, 0xaa, 0x7e, 0x92, 0x0e, 0xe2, 0x57, 0x2e, 0x4f, 0xd4, 0x19, 0xbd, 0x00, 0x64, 0x0c,
-	0x06, 0x51, 0x46, 0xac, 0x8f, 0xa9, 0x31, 0x08, 0x9f, 0x3f, 0xbf, 0x58, 0x40, 0x0f, 0x61, 0x08,
-	0x3d, 0x61, 0xe3, 0xb1, 0x6a, 0x0c, 0x06, 0x09, 0x0e, 0xfa, 0x23, 0xd8, 0x4e, 0xce, 0xa1, 0x9f,
-	0x5e, 0xe9, 0x9e, 0x6d, 0xc9, 0x6b, 0x82, 0xfd, 0x45, 0xdf, 0x20, 0x1b, 0x09, 0xf8, 0xaf, 0xaf,
-	0x8e, 0x6d, 0x4b, 0xe8, 0x1c, 0xf9, 0x33, 0x0d, 0xf5, 0x3f, 0x81, 0xf7, 0xde, 0xd0, 0x7d, 0xce,
-	0x1a, 0x74, 0x93, 0xb5, 0x35, 0xcb, 0x2b, 0x21, 0xb6, 0x7a, 0xff, 0xa0, 0x88, 0xa7, 0xd2, 0xa4,
-	0x4e, 0x9a, 0xf1, 0x54, 0xfe, 0x76, 0xca, 0x79, 0x5a, 0xc7, 0x27, 0x02, 0x9e, 0x67, 0xef, 0x8f,
-	0xa7, 0xb2, 0xf7, 0xb4, 0x39, 0x9b, 0x48, 0x82, 0x05, 0x90, 0x44, 0xd0, 0xfe, 0x29, 0x0b, 0xc5,
-	0x10, 0x9d, 0x1f, 0xf2, 0xaf, 0x68, 0x40, 0x46, 0x7a, 0x74, 0x03, 0xa9, 0x60, 0x10, 0x2c, 0x1e,
-	0x74, 0xdf, 0x87, 0xd2, 0x98, 0x12, 0x5f, 0x34, 0x67, 0x78, 0x73, 0x91, 0x31, 0x78, 0xe3, 0x07,
-	0x50, 0x0e, 0xdc, 0xc0, 0x18, 0xea, 0x01, 0x4f, 0x29, 0xb2, 0x62, 0x34, 0x67, 0xf1, 0x84, 0x02,
-	0x7d, 0x1f, 0x36, 0x82, 0x33, 0xdf, 0x0d, 0x82, 0x21, 0x4b, 0x67, 0x79, 0x72, 0x25, 0x72, 0xa1,
-	0x1c, 0x56, 0xa3, 0x06, 0x91, 0x74, 0x51, 0xe6, 0xbd, 0x27, 0x9d, 0x99, 0xe9, 0x72, 0x27, 0x92,
-	0xc3, 0x6b, 0x11, 0x97, 0x99, 0x36, 0x0b, 0x9e, 0x9e, 0x48, 0x5a, 0xb8, 0xaf, 0x50, 0x70, 0x48,
-	0x22, 0x1d, 0xd6, 0x47, 0xc4, 0xa0, 0x63, 0x9f, 0x58, 0xfa, 0x0b, 0x9b, 0x0c, 0x2d, 0x71, 0x37,
-	0x53, 0x4d, 0x7d, 0x22, 0x09, 0xd5, 0xd2, 0x78, 0xc8, 0x47, 0xe3, 0x6a, 0x08, 0x27, 0x68, 0x96,
-	0x39, 0x88, 0x7f, 0x68, 0x1d, 0xca, 0xbd, 0x67, 0xbd, 0x7e, 0xfb, 0x50, 0x3f, 0x3c, 0xda, 0x6b,
-	0xcb, 0xf2, 0xa9, 0x5e, 0x1b, 0x0b, 0x52, 0x61, 0xed, 0xfd, 0xa3, 0x7e, 0xf3, 0x40, 0xef, 0x77,
-	0x5a, 0x4f, 0x7a, 0x6a, 0x06, 0x6d, 0xc3, 0x46, 0x7f, 0x1f, 0x1f, 0xf5, 0xfb, 0x07, 0xed, 0x3d,
-	0xfd, 0xb8, 0x8d, 0x3b, 0x47, 0x7b, 0x3d, 0x35, 0x8b, 0x10, 0x54, 0x27, 0xec, 0x7e, 0xe7, 0xb0,
-	0xad, 0xe6, 0x50, 0x19, 0x56, 0x8f, 0xdb, 0xb8, 0xd5, 0xee, 0xf6, 0xd5, 0xbc, 0xf6, 0xf3, 0x2c,
-	0x94, 0x63, 0xab, 0xc8, 0x0c, 0xd9, 0xa7, 0xe2, 0xe8, 0x93, 0xc3, 0xec, 0x2f, 0x7f, 0xee, 0x35,
-	0xcc, 0x33, 0xb1, 0x3a, 0x39, 0x2c, 0x08, 0x7e, 0xdc, 0x31, 0x2e, 0x63, 0xfb, 0x3c, 0x87, 0x8b,
-	0x23, 0xe3, 0x52, 0x80, 0x7c, 0x08, 0x95, 0x73, 0xe2, 0x3b, 0x64, 0x28, 0xdb, 0xc5, 0x8a, 0x94,
-	0x05, 0x4f, 0x74, 0xd9, 0x01, 0x55, 0x76, 0x99, 0xc0, 0x88, 0xe5, 0xa8, 0x0a, 0xfe, 0x61, 0x08,
-	0xb6, 0x05, 0x79, 0xd1, 0xbc, 0x2a, 0xe6, 0xe7, 0x04, 0x0b, 0x53, 0xf4, 0xb5, 0xe1, 0xf1, 0x34,
-	0x33, 0x87, 0xf9, 0x7f, 0x74, 0x3a, 0xbb, 0x3e, 0x05, 0xbe, 0x3e, 0x77, 0x17, 0x37, 0xe7, 0x37,
-	0x2d, 0xd1, 0x59, 0xb4, 0x44, 0xab, 0x90, 0xc5, 0x61, 0xcd, 0x51, 0xab, 0xd9, 0xda, 0x67, 0xcb,
-	0xb2, 0x06, 0xa5, 0xc3, 0xe6, 0x8f, 0xf5, 0x93, 0x9e, 0xb8, 0xf8, 0x57, 0xa1, 0xf2, 0xa4, 0x8d,
-	0xbb, 0xed, 0x03, 0xc9, 0xc9, 0xa2, 0x2d, 0x50, 0x25, 0x67, 0xd2, 0x2f, 0xc7, 0x10, 0xc4, 0xdf,
-	0x3c, 0x2a, 0x42, 0xae, 0xf7, 0xb4, 0x79, 0xac, 0x16, 0xb4, 0xff, 0xc9, 0xc0, 0xba, 0x08, 0x0b,
-	0x51, 0x75, 0xc4, 0x9b, 0x5f, 0x87, 0xe3, 0x17, 0x5d, 0x99, 0xe4, 0x45, 0x57, 0x98, 0x84, 0xf2,
-	0xa8, 0x9e, 0x9d, 0x24, 0xa1, 0xfc, 0xf2, 0x27, 0xe1, 0xf1, 0x73, 0x8b, 0x78, 0xfc, 0x1a, 0xac,
-	0x8e, 0x08, 0x8d, 0xd6, 0xad, 0x84, 0x43, 0x12, 0xd9, 0x50, 0x36, 0x1c, 0xc7, 0x0d, 0x0c, 0x71,
-	0x7b, 0x5c, 0x58, 0x28, 0x18, 0x4e, 0x7d, 0x71, 0xa3, 0x39, 0x41, 0x12, 0x8e, 0x39, 0x8e, 0x5d,
-	0xff, 0x11, 0xa8, 0xd3, 0x1d, 0x16, 0x0a, 0x87, 0x1b, 0xb0, 0xde, 0x3b, 0x1b, 0x07, 0x96, 0xfb,
-	0xda, 0x09, 0x0b, 0x4f, 0x10, 0xa8, 0x13, 0x96, 0x78, 0x3c, 0xfc, 0xde, 0x0f, 0x26, 0x41, 0x93,
-	0xb0, 0xed, 0x23, 0x5f, 0x6c, 0xd4, 0x15, 0x46, 0xe0, 0x93, 0x6e, 0xb7, 0xd3, 0x7d, 0xa4, 0x2a,
-	0x08, 0xa0, 0xd0, 0xfe, 0x71, 0xa7, 0xdf, 0xde, 0x53, 0x33, 0xbb, 0x7f, 0xbb, 0x09, 0x05, 0xf1,
-	0x2d, 0xe8, 0x15, 0xe4, 0x3a, 0x8e, 0x1d, 0xa0, 0xb4, 0x4e, 0x35, 0x56, 0x18, 0x5c, 0xff, 0x6c,
-	0xa1, 0x31, 0xf2, 0xad, 0x73, 0x05, 0x7d, 0x2b, 0x73, 0x94, 0x78, 0x95, 0x30, 0xfa, 0xd1, 0xc2,
-	0xb9, 0x7e, 0xa2, 0xf2, 0xb8, 0xfe, 0x60, 0xe9, 0xf1, 0x91, 0x5c, 0x7f, 0xa1, 0x40, 0x25, 0xf1,
-	0xfe, 0x9a, 0xf6, 0x5e, 0x7f, 0x4e, 0x51, 0x72, 0xfd, 0x87, 0x4b, 0x8d, 0x8d, 0x64, 0xf9, 0x99,
-	0x02, 0xe5, 0x58, 0x39, 0x2e, 0xba, 0xbb, 0x4c, 0x09, 0xaf, 0x90, 0xe4, 0xde, 0xf2, 0xd5, 0xbf,
-	0xda, 0xca, 0xa7, 0x0a, 0xfa, 0x73, 0x05, 0xca, 0xb1, 0x32, 0xd4, 0xd4, 0xa2, 0xcc, 0x16, 0xcd,
-	0xa6, 0x16, 0x65, 0x5e, 0xd5, 0xeb, 0x0a, 0xfa, 0x53, 0x05, 0x4a, 0x51, 0x49, 0x29, 0xba, 0xb3,
-	0x78, 0x11, 0xaa, 0x10, 0xe2, 0x8b, 0x65, 0xab, 0x57, 0xb5, 0x15, 0xf4, 0xc7, 0x50, 0x0c, 0xeb,
-	0x2f, 0x51, 0xda, 0xb8, 0x3a, 0x55, 0xdc, 0x59, 0xbf, 0xb3, 0xf0, 0xb8, 0xf8, 0xf4, 0x61, 0x51,
-	0x64, 0xea, 0xe9, 0xa7, 0xca, 0x37, 0xeb, 0x77, 0x16, 0x1e, 0x17, 0x4d, 0xcf, 0x2c, 0x21, 0x56,
-	0x3b, 0x99, 0xda, 0x12, 0x66, 0x8b, 0x36, 0x53, 0x5b, 0xc2, 0xbc, 0x52, 0x4d, 0x21, 0x48, 0xac,
-	0xfa, 0x32, 0xb5, 0x20, 0xb3, 0x15, 0x9e, 0xa9, 0x05, 0x99, 0x53, 0xec, 0xa9, 0xad, 0xa0, 0x9f,
-	0x2a, 0xf1, 0x13, 0xcb, 0x9d, 0x85, 0x8b, 0x0c, 0x17, 0x34, 0xc9, 0x99, 0x32, 0x47, 0xbe, 0x41,
-	0x7f, 0x2a, 0xef, 0x57, 0x44, 0x8d, 0x22, 0x5a, 0x04, 0x2c, 0x51, 0xd6, 0x58, 0xff, 0x7c, 0xb9,
-	0x30, 0xc8, 0x85, 0x60, 0xa6, 0x29, 0x23, 0x53, 0x7a, 0xd3, 0x4c, 0x46, 0xb7, 0xf4, 0xa6, 0x39,
-	0x15, 0x02, 0xb5, 0x15, 0xf4, 0x67, 0x0a, 0xc0, 0xa4, 0x98, 0x32, 0xb5, 0x0e, 0x66, 0xaa, 0x38,
-	0xeb, 0x77, 0x97, 0x18, 0x19, 0xdf, 0x9f, 0x61, 0xb1, 0x57, 0x6a, 0x25, 0x4c, 0x15, 0x7b, 0xa6,
-	0x56, 0xc2, 0x74, 0xa1, 0xa6, 0xb6, 0x82, 0xfe, 0x5e, 0x81, 0x8d, 0x99, 0x62, 0x33, 0xf4, 0xe0,
-	0x9a, 0xf5, 0x86, 0xf5, 0xaf, 0x96, 0x07, 0x08, 0x45, 0xdb, 0x51, 0x3e, 0x55, 0xd0, 0x5f, 0x2a,
-	0xb0, 0x96, 0x2c, 0xc2, 0x49, 0x1d, 0x24, 0xe7, 0x94, 0xad, 0xd5, 0xef, 0x2f, 0x37, 0x38, 0xd2,
-	0xd6, 0x5f, 0x2b, 0x50, 0x4d, 0xd6, 0x63, 0xa1, 0xfb, 0x8b, 0x79, 0xa5, 0x29, 0x81, 0xbe, 0x5c,
-	0x72, 0x74, 0x28, 0xd1, 0xd7, 0xab, 0xbf, 0x97, 0x17, 0x69, 0x6d, 0x81, 0xff, 0x7c, 0xf6, 0xcb,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x41, 0x82, 0x3a, 0x6a, 0x36, 0x00, 0x00,
+	// 4145 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4f, 0x73, 0x1b, 0x47,
+	0x76, 0xd7, 0xe0, 0x1f, 0x81, 0x07, 0x10, 0x04, 0x9b, 0xa4, 0x0c, 0xc3, 0x9b, 0xb5, 0x76, 0x5c,
+	0x4e, 0x29, 0xbb, 0x36, 0xec, 0xa5, 0x13, 0xcb, 0xd2, 0x4a, 0x2b, 0xd3, 0x20, 0x24, 0x42, 0x22,
+	0x41, 0xa6, 0x01, 0x46, 0xab, 0x28, 0xf1, 0x64, 0x88, 0x69, 0x81, 0x23, 0x02, 0x33, 0xa3, 0xe9,
+	0x01, 0x45, 0x6e, 0x2a, 0x95, 0xad, 0x4d, 0x55, 0x6a, 0x53, 0x95, 0x54, 0x72, 0x71, 0xf6, 0x92,
+	0x53, 0xaa, 0x72, 0xca, 0x21, 0xd7, 0xd4, 0xa6, 0x72, 0xda, 0x43, 0x8e, 0xc9, 0x07, 0xc8, 0x25,
+	0xb7, 0x5c, 0xf3, 0x09, 0x92, 0x7a, 0xdd, 0x3d, 0x83, 0x19, 0x82, 0xb2, 0x06, 0xa0, 0x72, 0x02,
+	0xde, 0xeb, 0x7e, 0xbf, 0x7e, 0xf3, 0xfa, 0xf5, 0xeb, 0xd7, 0xdd, 0x0f, 0x74, 0x6f, 0x34, 0x19,
+	0xda, 0x0e, 0xff, 0xc4, 0xf2, 0xed, 0x53, 0xe6, 0xf3, 0x4f, 0x3c, 0xdf, 0x0d, 0x5c, 0x45, 0x35,
+	0x05, 0x41, 0x3e, 0x3c, 0x36, 0xf9, 0xb1, 0x3d, 0x70, 0x7d, 0xaf, 0xe9, 0xb8, 0x63, 0xd3, 0x6a,
+	0x2a, 0x99, 0xa6, 0x92, 0x91, 0xdd, 0x1a, 0xdf, 0x1d, 0xba, 0xee, 0x70, 0xc4, 0x24, 0xc2, 0xd1,
+	0xe4, 0xf9, 0x27, 0xd6, 0xc4, 0x37, 0x03, 0xdb, 0x75, 0x54, 0xfb, 0xfb, 0x17, 0xdb, 0x03, 0x7b,
+	0xcc, 0x78, 0x60, 0x8e, 0x3d, 0xd5, 0xe1, 0xc3, 0x50, 0x17, 0x7e, 0x6c, 0xfa, 0xcc, 0xfa, 0xe4,
+	0x78, 0x30, 0xe2, 0x1e, 0x1b, 0xe0, 0xaf, 0x81, 0x7f, 0x54, 0xb7, 0x8f, 0x2e, 0x74, 0xe3, 0x81,
+	0x3f, 0x19, 0x04, 0xa1, 0xe6, 0x66, 0x10, 0xf8, 0xf6, 0xd1, 0x24, 0x60, 0xb2, 0xb7, 0xbe, 0x0c,
+	0xe5, 0x8e, 0x63, 0x07, 0x94, 0xbd, 0x9c, 0x30, 0x1e, 0xe8, 0x55, 0xa8, 0x48, 0x92, 0x7b, 0xae,
+	0xc3, 0x99, 0xfe, 0x2e, 0xbc, 0xd3, 0x37, 0xf9, 0x49, 0xcb, 0x75, 0x9e, 0xdb, 0xc3, 0xde, 0xe0,
+	0x98, 0x8d, 0xcd, 0xb0, 0xeb, 0x1f, 0x40, 0x7d, 0xb6, 0x49, 0x8a, 0x91, 0x2f, 0x21, 0x87, 0x1a,
+	0xd5, 0xb5, 0x1b, 0xda, 0xcd, 0xf2, 0xe6, 0x47, 0xcd, 0xd7, 0x59, 0x48, 0xaa, 0xd8, 0x54, 0x5f,
+	0xd2, 0xec, 0x79, 0x6c, 0x40, 0x85, 0xa4, 0xbe, 0x01, 0x6b, 0x2d, 0xd3, 0x33, 0x8f, 0xec, 0x91,
+	0x1d, 0xd8, 0x8c, 0x87, 0x83, 0x4e, 0x60, 0x3d, 0xc9, 0x56, 0x03, 0xfe, 0x21, 0x54, 0x06, 0x31,
+	0xbe, 0x1a, 0xf8, 0x76, 0x33, 0xd5, 0xd4, 0x34, 0xb7, 0x05, 0x95, 0x00, 0x4e, 0xc0, 0xe9, 0xeb,
+	0x40, 0x1e, 0xd8, 0xce, 0x90, 0xf9, 0x9e, 0x6f, 0x3b, 0x91, 0xb1, 0xfe, 0x3d, 0x0b, 0x6b, 0x09,
+	0xb6, 0x52, 0xe6, 0x05, 0x40, 0x64, 0x66, 0x54, 0x25, 0x7b, 0xb3, 0xbc, 0xf9, 0x28, 0xa5, 0x2a,
+	0x97, 0xe0, 0x35, 0xb7, 0x22, 0xb0, 0xb6, 0x13, 0xf8, 0xe7, 0x34, 0x86, 0x4e, 0xbe, 0x86, 0xc2,
+	0x31, 0x33, 0x47, 0xc1, 0x71, 0x3d, 0x73, 0x43, 0xbb, 0x59, 0xdd, 0x7c, 0x70, 0x85, 0x71, 0x76,
+	0x04, 0x50, 0x2f, 0x30, 0x03, 0x46, 0x15, 0x2a, 0xf9, 0x18, 0x88, 0xfc, 0x67, 0x58, 0x8c, 0x0f,
+	0x7c, 0xdb, 0x43, 0x8f, 0xad, 0x67, 0x6f, 0x68, 0x37, 0x4b, 0x74, 0x55, 0xb6, 0x6c, 0x4f, 0x1b,
+	0x48, 0x0d, 0xb2, 0xcc, 0xf7, 0xeb, 0x39, 0xd1, 0x8e, 0x7f, 0x1b, 0x1e, 0xac, 0x5c, 0xd0, 0x1f,
+	0x3b, 0x9d, 0xb0, 0x73, 0x31, 0x47, 0x25, 0x8a, 0x7f, 0xc9, 0x43, 0xc8, 0x9f, 0x9a, 0xa3, 0x09,
+	0x13, 0x1f, 0x51, 0xde, 0xfc, 0xe1, 0x9b, 0x1c, 0x46, 0xf9, 0xf4, 0xd4, 0x32, 0x54, 0xca, 0xdf,
+	0xc9, 0x7c, 0xa1, 0xe9, 0xb7, 0xa1, 0x1c, 0xfb, 0x12, 0x52, 0x05, 0x38, 0xec, 0x6e, 0xb7, 0xfb,
+	0xed, 0x56, 0xbf, 0xbd, 0x5d, 0xbb, 0x46, 0x96, 0xa1, 0x74, 0xd8, 0xdd, 0x69, 0x6f, 0xed, 0xf6,
+	0x77, 0x9e, 0xd6, 0x34, 0x52, 0x86, 0xa5, 0x90, 0xc8, 0xe8, 0x67, 0x40, 0x28, 0x1b, 0xb8, 0xa7,
+	0xcc, 0x47, 0xd7, 0x56, 0xf3, 0x4c, 0xde, 0x81, 0xa5, 0xc0, 0xe4, 0x27, 0x86, 0x6d, 0x29, 0x9d,
+	0x0b, 0x48, 0x76, 0x2c, 0xd2, 0x81, 0xc2, 0xb1, 0xe9, 0x58, 0xa3, 0x37, 0xeb, 0x9d, 0x34, 0x3e,
+	0x82, 0xef, 0x08, 0x41, 0xaa, 0x00, 0xd0, 0xdf, 0x13, 0x23, 0xab, 0xf5, 0xf7, 0x14, 0x6a, 0xbd,
+	0xc0, 0xf4, 0x83, 0xb8, 0x3a, 0x6d, 0xc8, 0xe1, 0xf8, 0x75, 0x6d, 0xee, 0x31, 0xe5, 0x5a, 0xa5,
+	0x42, 0x5c, 0xff, 0x9f, 0x0c, 0xac, 0xc6, 0xb0, 0x95, 0xef, 0x3e, 0x81, 0x82, 0xcf, 0xf8, 0x64,
+	0x14, 0x08, 0xf8, 0xea, 0xe6, 0xfd, 0x94, 0xf0, 0x33, 0x48, 0x4d, 0x2a, 0x60, 0xa8, 0x82, 0x23,
+	0x37, 0xa1, 0x26, 0x25, 0x0c, 0xe6, 0xfb, 0xae, 0x6f, 0x8c, 0xf9, 0x50, 0x58, 0xad, 0x44, 0xab,
+	0x92, 0xdf, 0x46, 0xf6, 0x1e, 0x1f, 0xc6, 0xac, 0x9a, 0xbd, 0xa2, 0x55, 0x89, 0x09, 0x35, 0x87,
+	0x05, 0xaf, 0x5c, 0xff, 0xc4, 0x40, 0xd3, 0xfa, 0xb6, 0xc5, 0x84, 0x6f, 0x96, 0x37, 0x3f, 0x4f,
+	0x09, 0xda, 0x95, 0xe2, 0xfb, 0x4a, 0x9a, 0xae, 0x38, 0x49, 0x86, 0xfe, 0x03, 0x28, 0xc8, 0x2f,
+	0x45, 0x4f, 0xea, 0x1d, 0xb6, 0x5a, 0xed, 0x5e, 0xaf, 0x76, 0x8d, 0x94, 0x20, 0x4f, 0xdb, 0x7d,
+	0x8a, 0x1e, 0x56, 0x82, 0xfc, 0x83, 0xad, 0xfe, 0xd6, 0x6e, 0x2d, 0xa3, 0x7f, 0x1f, 0x56, 0x9e,
+	0x98, 0x76, 0x90, 0xc6, 0xb9, 0x74, 0x17, 0x6a, 0xd3, 0xbe, 0x6a, 0x76, 0x3a, 0x89, 0xd9, 0x49,
+	0x6f, 0x9a, 0xf6, 0x99, 0x1d, 0x5c, 0x98, 0x0f, 0xb5, 0x52, 0x33, 0xd1, 0x4a, 0xd5, 0x5f, 0xc1,
+	0x4a, 0x2f, 0x70, 0xbd, 0x54, 0x9e, 0xff, 0x19, 0x2c, 0xe1, 0xf6, 0xe4, 0x4e, 0x02, 0xe5, 0xfa,
+	0xef, 0x36, 0xe5, 0xf6, 0xd5, 0x0c, 0xb7, 0xaf, 0xe6, 0xb6, 0xda, 0xde, 0x68, 0xd8, 0x93, 0x5c,
+	0x87, 0x02, 0xb7, 0x87, 0x8e, 0x39, 0x52, 0xf1, 0x43, 0x51, 0x3a, 0x81, 0xda, 0x74, 0x60, 0xe5,
+	0xf8, 0x2d, 0x20, 0xdb, 0x8c, 0x07, 0xbe, 0x7b, 0x9e, 0x4a, 0x9f, 0x75, 0xc8, 0x3f, 0x77, 0xfd,
+	0x81, 0x5c, 0x88, 0x45, 0x2a, 0x09, 0x5c, 0x54, 0x09, 0x10, 0x85, 0xfd, 0x31, 0x90, 0x8e, 0x83,
+	0xbb, 0x4c, 0xba, 0x89, 0xf8, 0x9b, 0x0c, 0xac, 0x25, 0xfa, 0xab, 0xc9, 0x58, 0x7c, 0x1d, 0x62,
+	0x60, 0x9a, 0x70, 0xb9, 0x0e, 0xc9, 0x3e, 0x14, 0x64, 0x0f, 0x65, 0xc9, 0x5b, 0x73, 0x00, 0xc9,
+	0x8d, 0x4b, 0xc1, 0x29, 0x98, 0x4b, 0x9d, 0x3e, 0xfb, 0x76, 0x9d, 0xfe, 0x15, 0xd4, 0xc2, 0xef,
+	0xe0, 0x6f, 0x9c, 0x9b, 0x47, 0xb0, 0x36, 0x70, 0x47, 0x23, 0x36, 0x40, 0x6f, 0x30, 0x6c, 0x27,
+	0x60, 0xfe, 0xa9, 0x39, 0x7a, 0xb3, 0xdf, 0x90, 0xa9, 0x54, 0x47, 0x09, 0xe9, 0xcf, 0x60, 0x35,
+	0x36, 0xb0, 0x9a, 0x88, 0x07, 0x90, 0xe7, 0xc8, 0x50, 0x33, 0xf1, 0xe9, 0x9c, 0x33, 0xc1, 0xa9,
+	0x14, 0xd7, 0xd7, 0x24, 0x78, 0xfb, 0x94, 0x39, 0xd1, 0x67, 0xe9, 0xdb, 0xb0, 0xda, 0x13, 0x6e,
+	0x9a, 0xca, 0x0f, 0xa7, 0x2e, 0x9e, 0x49, 0xb8, 0xf8, 0x3a, 0x90, 0x38, 0x8a, 0x72, 0xc4, 0x73,
+	0x58, 0x69, 0x9f, 0xb1, 0x41, 0x2a, 0xe4, 0x3a, 0x2c, 0x0d, 0xdc, 0xf1, 0xd8, 0x74, 0xac, 0x7a,
+	0xe6, 0x46, 0xf6, 0x66, 0x89, 0x86, 0x64, 0x7c, 0x2d, 0x66, 0xd3, 0xae, 0x45, 0xfd, 0xaf, 0x34,
+	0xa8, 0x4d, 0xc7, 0x56, 0x86, 0x44, 0xed, 0x03, 0x0b, 0x81, 0x70, 0xec, 0x0a, 0x55, 0x94, 0xe2,
+	0x87, 0xe1, 0x42, 0xf2, 0x99, 0xef, 0xc7, 0xc2, 0x51, 0xf6, 0x8a, 0xe1, 0x48, 0xdf, 0x81, 0xef,
+	0x84, 0xea, 0xf4, 0x02, 0x9f, 0x99, 0x63, 0xdb, 0x19, 0x76, 0xf6, 0xf7, 0x3d, 0x26, 0x15, 0x27,
+	0x04, 0x72, 0x96, 0x19, 0x98, 0x4a, 0x31, 0xf1, 0x1f, 0x17, 0xfd, 0x60, 0xe4, 0xf2, 0x68, 0xd1,
+	0x0b, 0x42, 0xff, 0xb7, 0x2c, 0xd4, 0x67, 0xa0, 0x42, 0xf3, 0x3e, 0x83, 0x3c, 0x67, 0xc1, 0xc4,
+	0x53, 0xae, 0xd2, 0x4e, 0xad, 0xf0, 0xe5, 0x78, 0xcd, 0x1e, 0x82, 0x51, 0x89, 0x49, 0x86, 0x50,
+	0x0c, 0x82, 0x73, 0x83, 0xdb, 0x3f, 0x0d, 0x13, 0x82, 0xdd, 0xab, 0xe2, 0xf7, 0x99, 0x3f, 0xb6,
+	0x1d, 0x73, 0xd4, 0xb3, 0x7f, 0xca, 0xe8, 0x52, 0x10, 0x9c, 0xe3, 0x1f, 0xf2, 0x14, 0x1d, 0xde,
+	0xb2, 0x1d, 0x65, 0xf6, 0xd6, 0xa2, 0xa3, 0xc4, 0x0c, 0x4c, 0x25, 0x62, 0x63, 0x17, 0xf2, 0xe2,
+	0x9b, 0x16, 0x71, 0xc4, 0x1a, 0x64, 0x83, 0xe0, 0x5c, 0x28, 0x55, 0xa4, 0xf8, 0xb7, 0x71, 0x17,
+	0x2a, 0xf1, 0x2f, 0x40, 0x47, 0x3a, 0x66, 0xf6, 0xf0, 0x58, 0x3a, 0x58, 0x9e, 0x2a, 0x0a, 0x67,
+	0xf2, 0x95, 0x6d, 0xa9, 0x24, 0x36, 0x4f, 0x25, 0xa1, 0xff, 0x73, 0x06, 0xde, 0xbd, 0xc4, 0x32,
+	0xca, 0x59, 0x9f, 0x25, 0x9c, 0xf5, 0x2d, 0x59, 0x21, 0xf4, 0xf8, 0x67, 0x09, 0x8f, 0x7f, 0x8b,
+	0xe0, 0xb8, 0x6c, 0xae, 0x43, 0x81, 0x9d, 0xd9, 0x01, 0xb3, 0x94, 0xa9, 0x14, 0x15, 0x5b, 0x4e,
+	0xb9, 0xab, 0x2e, 0xa7, 0x3d, 0x58, 0x6f, 0xf9, 0xcc, 0x0c, 0x98, 0x0a, 0xe5, 0xa1, 0xff, 0xbf,
+	0x0b, 0x45, 0x73, 0x34, 0x72, 0x07, 0xd3, 0x69, 0x5d, 0x12, 0x74, 0xc7, 0x22, 0x0d, 0x28, 0x1e,
+	0xbb, 0x3c, 0x70, 0xcc, 0x31, 0x53, 0xc1, 0x2b, 0xa2, 0xf5, 0x6f, 0x34, 0xd8, 0xb8, 0x80, 0xa7,
+	0x66, 0xe1, 0x08, 0xaa, 0x36, 0x77, 0x47, 0xe2, 0x03, 0x8d, 0xd8, 0x99, 0xef, 0x47, 0xf3, 0x6d,
+	0x35, 0x9d, 0x10, 0x43, 0x1c, 0x01, 0x97, 0xed, 0x38, 0x29, 0x3c, 0x4e, 0x0c, 0x6e, 0xa9, 0x95,
+	0x1e, 0x92, 0xfa, 0xdf, 0x6a, 0xb0, 0xa1, 0x76, 0xf8, 0xf4, 0x1f, 0x3a, 0xab, 0x72, 0xe6, 0x6d,
+	0xab, 0xac, 0xd7, 0xe1, 0xfa, 0x45, 0xbd, 0x54, 0xcc, 0xff, 0x59, 0x01, 0xc8, 0xec, 0x79, 0x93,
+	0x7c, 0x0f, 0x2a, 0x9c, 0x39, 0x96, 0x21, 0xf7, 0x0b, 0xb9, 0x95, 0x15, 0x69, 0x19, 0x79, 0x72,
+	0xe3, 0xe0, 0x18, 0x02, 0xd9, 0x99, 0xd2, 0xb6, 0x48, 0xc5, 0x7f, 0x72, 0x0c, 0x95, 0xe7, 0xdc,
+	0x88, 0xc6, 0x16, 0x0e, 0x55, 0x4d, 0x1d, 0xd6, 0x66, 0xf5, 0x68, 0x3e, 0xe8, 0x45, 0xdf, 0x45,
+	0xcb, 0xcf, 0x79, 0x44, 0x90, 0x5f, 0x68, 0xf0, 0x4e, 0x98, 0x56, 0x4c, 0xcd, 0x37, 0x76, 0x2d,
+	0xc6, 0xeb, 0xb9, 0x1b, 0xd9, 0x9b, 0xd5, 0xcd, 0x83, 0x2b, 0xd8, 0x6f, 0x86, 0xb9, 0xe7, 0x5a,
+	0x8c, 0x6e, 0x38, 0x97, 0x70, 0x39, 0x69, 0xc2, 0xda, 0x78, 0xc2, 0x03, 0x43, 0x7a, 0x81, 0xa1,
+	0x3a, 0xd5, 0xf3, 0xc2, 0x2e, 0xab, 0xd8, 0x94, 0xf0, 0x55, 0x72, 0x02, 0xcb, 0x63, 0x77, 0xe2,
+	0x04, 0xc6, 0x40, 0x9c, 0x7f, 0x78, 0xbd, 0x30, 0xd7, 0x51, 0xf9, 0x12, 0x2b, 0xed, 0x21, 0x9c,
+	0x3c, 0x4d, 0x71, 0x5a, 0x19, 0xc7, 0x28, 0xf2, 0xdb, 0x70, 0xdd, 0xb2, 0xb9, 0x79, 0x34, 0x62,
+	0xc6, 0xc8, 0x1d, 0x1a, 0xd3, 0x1c, 0xa6, 0x5e, 0x14, 0xfa, 0xad, 0xab, 0xd6, 0x5d, 0x77, 0xd8,
+	0x8a, 0xda, 0x84, 0xd4, 0xb9, 0x63, 0x8e, 0xed, 0x81, 0x81, 0x2a, 0x8f, 0x5c, 0xd3, 0x32, 0x26,
+	0x9c, 0xf9, 0xbc, 0x5e, 0x52, 0x52, 0xb2, 0xf5, 0x89, 0x6a, 0x3c, 0xc4, 0x36, 0xf2, 0x5d, 0x80,
+	0xc1, 0x31, 0x1b, 0x9c, 0x78, 0xae, 0xed, 0x04, 0x75, 0x10, 0x3d, 0x63, 0x1c, 0xfd, 0x0e, 0x94,
+	0x63, 0xf3, 0x49, 0x8a, 0x90, 0xeb, 0xee, 0x77, 0xdb, 0xb5, 0x6b, 0x04, 0xa0, 0xd0, 0xda, 0xa1,
+	0xfb, 0xfb, 0x7d, 0x79, 0x3c, 0xe9, 0xec, 0x6d, 0x3d, 0x6c, 0xd7, 0x32, 0xc8, 0x3e, 0xec, 0xfe,
+	0x5e, 0xbb, 0xb3, 0x5b, 0xcb, 0xea, 0x6d, 0xa8, 0xc4, 0xbf, 0x92, 0x10, 0xa8, 0x1e, 0x76, 0x1f,
+	0x77, 0xf7, 0x9f, 0x74, 0x8d, 0xbd, 0xfd, 0xc3, 0x6e, 0x1f, 0x0f, 0x39, 0x55, 0x80, 0xad, 0xee,
+	0xd3, 0x29, 0xbd, 0x0c, 0xa5, 0xee, 0x7e, 0x48, 0x6a, 0x8d, 0x4c, 0x4d, 0x7b, 0x94, 0x2b, 0x2e,
+	0xd5, 0x8a, 0xb4, 0xe2, 0xb3, 0xb1, 0x1b, 0x30, 0x03, 0xb7, 0x10, 0xae, 0xff, 0x3a, 0x0b, 0xeb,
+	0x97, 0x39, 0x01, 0xb1, 0x20, 0x87, 0x0e, 0xa5, 0x8e, 0x9e, 0x6f, 0xdf, 0x9f, 0x04, 0x3a, 0xae,
+	0x23, 0xcf, 0x54, 0x7b, 0x4d, 0x89, 0x8a, 0xff, 0xc4, 0x80, 0xc2, 0xc8, 0x3c, 0x62, 0x23, 0x5e,
+	0xcf, 0x8a, 0xeb, 0x9a, 0x87, 0x57, 0x19, 0x7b, 0x57, 0x20, 0xc9, 0xbb, 0x1a, 0x05, 0x4b, 0xfa,
+	0x50, 0xc6, 0x68, 0xca, 0xa5, 0x39, 0x55, 0x80, 0xdf, 0x4c, 0x39, 0xca, 0xce, 0x54, 0x92, 0xc6,
+	0x61, 0x1a, 0xb7, 0xa1, 0x1c, 0x1b, 0xec, 0x92, 0x8b, 0x95, 0xf5, 0xf8, 0xc5, 0x4a, 0x29, 0x7e,
+	0x4b, 0x72, 0x1f, 0xd6, 0x2f, 0xb3, 0x11, 0x3a, 0xc9, 0xce, 0x7e, 0xaf, 0x2f, 0x8f, 0xb0, 0x0f,
+	0xe9, 0xfe, 0xe1, 0x41, 0x4d, 0x43, 0x66, 0x7f, 0xab, 0xf7, 0xb8, 0x96, 0x89, 0x7c, 0x28, 0xab,
+	0xb7, 0xa0, 0x1c, 0xd3, 0x2b, 0xb1, 0x7d, 0x68, 0xc9, 0xed, 0x03, 0x03, 0xb8, 0x69, 0x59, 0x3e,
+	0xe3, 0x5c, 0xe9, 0x11, 0x92, 0xfa, 0x33, 0x28, 0x6d, 0x77, 0x7b, 0x0a, 0xa2, 0x0e, 0x4b, 0x9c,
+	0xf9, 0xf8, 0xdd, 0xe2, 0xd2, 0xac, 0x44, 0x43, 0x12, 0xc1, 0x39, 0x33, 0xfd, 0xc1, 0x31, 0xe3,
+	0x2a, 0xe9, 0x88, 0x68, 0x94, 0x72, 0xc5, 0xe5, 0x93, 0x9c, 0xbb, 0x12, 0x0d, 0x49, 0xfd, 0x7f,
+	0x8b, 0x00, 0xd3, 0x6b, 0x0f, 0x52, 0x85, 0x4c, 0xb4, 0x19, 0x64, 0x6c, 0x0b, 0xfd, 0x20, 0xb6,
+	0xd9, 0x89, 0xff, 0x64, 0x13, 0x36, 0xc6, 0x7c, 0xe8, 0x99, 0x83, 0x13, 0x43, 0xdd, 0x56, 0xc8,
+	0x98, 0x21, 0x02, 0x6b, 0x85, 0xae, 0xa9, 0x46, 0x15, 0x12, 0x24, 0xee, 0x2e, 0x64, 0x99, 0x73,
+	0x2a, 0x82, 0x60, 0x79, 0xf3, 0xce, 0xdc, 0xd7, 0x31, 0xcd, 0xb6, 0x73, 0x2a, 0x7d, 0x05, 0x61,
+	0x88, 0x01, 0x60, 0xb1, 0x53, 0x7b, 0xc0, 0x0c, 0x04, 0xcd, 0x0b, 0xd0, 0x2f, 0xe7, 0x07, 0xdd,
+	0x16, 0x18, 0x11, 0x74, 0xc9, 0x0a, 0x69, 0xd2, 0x85, 0x92, 0xcf, 0xb8, 0x3b, 0xf1, 0x07, 0x4c,
+	0x46, 0xc2, 0xf4, 0x27, 0x26, 0x1a, 0xca, 0xd1, 0x29, 0x04, 0xd9, 0x86, 0x82, 0x08, 0x80, 0xbc,
+	0xbe, 0x74, 0x23, 0xfb, 0xad, 0xb7, 0xbd, 0x49, 0x30, 0x11, 0x5d, 0xa8, 0x92, 0x25, 0x0f, 0x61,
+	0x49, 0xaa, 0xc8, 0xeb, 0x45, 0x01, 0xf3, 0x71, 0xda, 0xe8, 0x2c, 0xa4, 0x68, 0x28, 0x8d, 0xb3,
+	0x8a, 0x81, 0x53, 0xc4, 0xcd, 0x12, 0x15, 0xff, 0xc9, 0x7b, 0x50, 0x92, 0xc9, 0x80, 0x65, 0xfb,
+	0x22, 0x4c, 0x96, 0xa8, 0xcc, 0x0e, 0xb6, 0x6d, 0x9f, 0xbc, 0x0f, 0x65, 0x99, 0xf4, 0x19, 0x22,
+	0x2a, 0x94, 0x45, 0x33, 0x48, 0xd6, 0x01, 0xc6, 0x06, 0xd9, 0x81, 0xf9, 0xbe, 0xec, 0x50, 0x89,
+	0x3a, 0x30, 0xdf, 0x17, 0x1d, 0x7e, 0x13, 0x56, 0x44, 0xaa, 0x3c, 0xf4, 0xdd, 0x89, 0x67, 0x08,
+	0x9f, 0x5a, 0x16, 0x9d, 0x96, 0x91, 0xfd, 0x10, 0xb9, 0x5d, 0x74, 0xae, 0x77, 0xa1, 0xf8, 0xc2,
+	0x3d, 0x92, 0x1d, 0xaa, 0x72, 0x1d, 0xbc, 0x70, 0x8f, 0xc2, 0xa6, 0x28, 0x5d, 0x59, 0x49, 0xa6,
+	0x2b, 0x2f, 0xe1, 0xfa, 0xec, 0xbe, 0x2b, 0xd2, 0x96, 0xda, 0xd5, 0xd3, 0x96, 0x75, 0xe7, 0x12,
+	0x2e, 0xf9, 0x0a, 0xb2, 0x96, 0xc3, 0xeb, 0xab, 0x73, 0x39, 0x47, 0xb4, 0x8e, 0x29, 0x0a, 0x93,
+	0x0d, 0x28, 0xe0, 0xc7, 0xda, 0x56, 0x9d, 0xc8, 0xd0, 0xf3, 0xc2, 0x3d, 0xea, 0x58, 0xe4, 0x3b,
+	0x50, 0xc2, 0xef, 0xe7, 0x9e, 0x39, 0x60, 0xf5, 0x35, 0xd1, 0x32, 0x65, 0xe0, 0x44, 0x39, 0xae,
+	0xc5, 0xa4, 0x89, 0xd6, 0xe5, 0x44, 0x21, 0x43, 0xd8, 0xe8, 0x1d, 0x58, 0x12, 0x8d, 0xb6, 0x55,
+	0xdf, 0x90, 0x27, 0x12, 0x24, 0x3b, 0x16, 0xd1, 0x61, 0xd9, 0x33, 0x7d, 0xe6, 0x04, 0x86, 0x1a,
+	0xf1, 0xba, 0x68, 0x2e, 0x4b, 0xe6, 0x23, 0x1c, 0xb7, 0xf1, 0x39, 0x14, 0xc3, 0xc5, 0x30, 0x4f,
+	0x98, 0x6c, 0xdc, 0x85, 0x6a, 0x72, 0x29, 0xcd, 0x15, 0x64, 0xff, 0x21, 0x03, 0xa5, 0x68, 0xd1,
+	0x10, 0x07, 0xd6, 0xc4, 0xa4, 0x9a, 0x01, 0xb3, 0x8c, 0xe9, 0x1a, 0x94, 0x09, 0xf3, 0xbd, 0x94,
+	0x66, 0xde, 0x0a, 0x11, 0xd4, 0xc9, 0x5d, 0x2d, 0x48, 0x12, 0x21, 0x4f, 0xc7, 0xfb, 0x1a, 0x56,
+	0x46, 0xb6, 0x33, 0x39, 0x8b, 0x8d, 0x25, 0x33, 0xdd, 0xdf, 0x49, 0x39, 0xd6, 0x2e, 0x4a, 0x4f,
+	0xc7, 0xa8, 0x8e, 0x12, 0x34, 0xd9, 0x81, 0xbc, 0xe7, 0xfa, 0x41, 0xb8, 0x67, 0xa6, 0xdd, 0xcd,
+	0x0e, 0x5c, 0x3f, 0xd8, 0x33, 0x3d, 0x0f, 0x0f, 0x73, 0x12, 0x40, 0xff, 0x26, 0x03, 0xd7, 0x2f,
+	0xff, 0x30, 0xd2, 0x85, 0xec, 0xc0, 0x9b, 0x28, 0x23, 0xdd, 0x9d, 0xd7, 0x48, 0x2d, 0x6f, 0x32,
+	0xd5, 0x1f, 0x81, 0xf0, 0x82, 0x7b, 0xcc, 0xc6, 0xae, 0x7f, 0xae, 0x6c, 0x71, 0x7f, 0x5e, 0xc8,
+	0x3d, 0x21, 0x3d, 0x45, 0x55, 0x70, 0x84, 0x42, 0x51, 0x2d, 0x26, 0xae, 0xc2, 0xf6, 0x9c, 0xd7,
+	0x6d, 0x21, 0x24, 0x8d, 0x70, 0xf4, 0xcf, 0x61, 0xe3, 0xd2, 0x4f, 0x21, 0xbf, 0x01, 0x30, 0xf0,
+	0x26, 0x86, 0x78, 0x0e, 0x91, 0x1e, 0x94, 0xa5, 0xa5, 0x81, 0x37, 0xe9, 0x09, 0x86, 0xfe, 0x0c,
+	0xea, 0xaf, 0xd3, 0x17, 0xd7, 0x98, 0xd4, 0xd8, 0x18, 0x1f, 0x09, 0x1b, 0x64, 0x69, 0x51, 0x32,
+	0xf6, 0x8e, 0x70, 0x29, 0x85, 0x8d, 0xe6, 0x19, 0x76, 0xc8, 0x8a, 0x0e, 0x65, 0xd5, 0xc1, 0x3c,
+	0xdb, 0x3b, 0xd2, 0x7f, 0x99, 0x81, 0x95, 0x0b, 0x2a, 0xe3, 0x91, 0x56, 0x06, 0xe0, 0xf0, 0xb2,
+	0x40, 0x52, 0x18, 0x8d, 0x07, 0xb6, 0x15, 0x5e, 0x33, 0x8b, 0xff, 0x62, 0x1f, 0xf6, 0xd4, 0x15,
+	0x70, 0xc6, 0xf6, 0x70, 0xf9, 0x8c, 0x8f, 0xec, 0x80, 0x8b, 0xa4, 0x28, 0x4f, 0x25, 0x41, 0x9e,
+	0x42, 0xd5, 0x67, 0x62, 0xff, 0xb7, 0x0c, 0xe9, 0x65, 0xf9, 0xb9, 0xbc, 0x4c, 0x69, 0x88, 0xce,
+	0x46, 0x97, 0x43, 0x24, 0xa4, 0x38, 0x79, 0x02, 0xcb, 0x61, 0xb2, 0x2d, 0x91, 0x0b, 0x0b, 0x23,
+	0x57, 0x14, 0x90, 0x00, 0xc6, 0x97, 0xa7, 0x58, 0x23, 0x7e, 0x98, 0xc8, 0xfe, 0x94, 0x4d, 0x24,
+	0x91, 0x8c, 0x16, 0x79, 0x15, 0x2d, 0xf4, 0x23, 0x28, 0xc7, 0xd6, 0xc5, 0x3c, 0xa2, 0x68, 0xcf,
+	0xc0, 0x15, 0xf6, 0xcc, 0xd3, 0x4c, 0xe0, 0x62, 0x9c, 0xc4, 0xcc, 0xcb, 0xb0, 0x3d, 0xf5, 0x0e,
+	0x57, 0x40, 0xb2, 0xe3, 0xe9, 0xbf, 0xca, 0x40, 0x35, 0xb9, 0xa4, 0x43, 0x3f, 0xf2, 0x98, 0x6f,
+	0xbb, 0x56, 0xcc, 0x8f, 0x0e, 0x04, 0x03, 0x7d, 0x05, 0x9b, 0x5f, 0x4e, 0xdc, 0xc0, 0x0c, 0x7d,
+	0x65, 0xe0, 0x4d, 0x7e, 0x17, 0xe9, 0x0b, 0x3e, 0x98, 0xbd, 0xe0, 0x83, 0xe4, 0x23, 0x20, 0xca,
+	0x95, 0x46, 0xf6, 0xd8, 0x0e, 0x8c, 0xa3, 0xf3, 0x80, 0xc9, 0x39, 0xce, 0xd2, 0x9a, 0x6c, 0xd9,
+	0xc5, 0x86, 0xaf, 0x90, 0x8f, 0x8e, 0xe7, 0xba, 0x63, 0x83, 0x0f, 0x5c, 0x9f, 0x19, 0xa6, 0xf5,
+	0x42, 0x9c, 0xe6, 0xb2, 0xb4, 0xec, 0xba, 0xe3, 0x1e, 0xf2, 0xb6, 0xac, 0x17, 0xb8, 0x11, 0x0f,
+	0xbc, 0x09, 0x67, 0x81, 0x81, 0x3f, 0x22, 0x77, 0x29, 0x51, 0x90, 0xac, 0x96, 0x37, 0xe1, 0xe4,
+	0x03, 0x58, 0x0e, 0x3b, 0x88, 0xbd, 0x58, 0x25, 0x01, 0x15, 0xd5, 0x45, 0xf0, 0x88, 0x0e, 0x95,
+	0x03, 0xe6, 0x0f, 0x98, 0x13, 0xf4, 0xed, 0xc1, 0x09, 0x17, 0xc7, 0x32, 0x8d, 0x26, 0x78, 0xea,
+	0xd4, 0x12, 0x8e, 0x36, 0x66, 0x63, 0xae, 0xff, 0x87, 0x06, 0x79, 0x91, 0xb2, 0xa0, 0x51, 0xc4,
+	0x76, 0x2f, 0xb2, 0x01, 0x95, 0xea, 0x22, 0x43, 0xe4, 0x02, 0xef, 0x41, 0x49, 0x18, 0x3f, 0x76,
+	0xc2, 0x10, 0x79, 0xb0, 0x68, 0x6c, 0x40, 0xd1, 0x67, 0xa6, 0xe5, 0x3a, 0xa3, 0xf0, 0x96, 0x2c,
+	0xa2, 0xc9, 0x6f, 0x41, 0xcd, 0xf3, 0x5d, 0xcf, 0x1c, 0x4e, 0x0f, 0xd6, 0x6a, 0xfa, 0x56, 0x62,
+	0x7c, 0x91, 0xa2, 0x7f, 0x00, 0xcb, 0x9c, 0xc9, 0xc8, 0x2e, 0x9d, 0x24, 0x2f, 0x3f, 0x53, 0x31,
+	0xc5, 0x89, 0x00, 0x2f, 0x14, 0x7c, 0x79, 0x17, 0x22, 0x77, 0x53, 0x69, 0xad, 0xb2, 0xe2, 0xe1,
+	0x86, 0xaa, 0xbf, 0x84, 0x82, 0xdc, 0xdb, 0xae, 0xf0, 0x49, 0x1f, 0x03, 0x91, 0xb6, 0x46, 0x1f,
+	0x1a, 0xdb, 0x9c, 0xab, 0x44, 0x5c, 0xbc, 0x0f, 0xcb, 0x96, 0x83, 0x69, 0x83, 0xfe, 0x9f, 0x1a,
+	0xc0, 0xf4, 0x9d, 0x0e, 0x73, 0x77, 0x5c, 0x58, 0x78, 0x3a, 0x96, 0x17, 0x82, 0x21, 0x89, 0x77,
+	0x61, 0x2a, 0xf3, 0xce, 0x2c, 0xfa, 0xcc, 0xa9, 0x00, 0xc2, 0xe7, 0x01, 0xa6, 0x2e, 0x47, 0xe6,
+	0x7d, 0x1e, 0x60, 0xf2, 0x79, 0x80, 0xa1, 0x45, 0xd5, 0x99, 0x40, 0xc2, 0xe5, 0xc4, 0x91, 0xa0,
+	0x6c, 0x45, 0x6f, 0x30, 0x4c, 0xff, 0x6f, 0x2d, 0x0a, 0x8d, 0xe1, 0x5b, 0x09, 0xf9, 0x1a, 0x8a,
+	0x18, 0x65, 0x8c, 0xb1, 0xe9, 0xa9, 0x5a, 0x80, 0xd6, 0x62, 0xcf, 0x30, 0xe1, 0xc6, 0x29, 0x33,
+	0xfa, 0x25, 0x4f, 0x52, 0x18, 0x62, 0xf1, 0x34, 0x15, 0x86, 0x58, 0xfc, 0x4f, 0x3e, 0x84, 0xaa,
+	0x39, 0x09, 0x5c, 0xc3, 0xb4, 0x4e, 0x99, 0x1f, 0xd8, 0x9c, 0x29, 0x77, 0x5b, 0x46, 0xee, 0x56,
+	0xc8, 0x6c, 0xdc, 0x81, 0x4a, 0x1c, 0xf3, 0x4d, 0xa9, 0x4d, 0x3e, 0x9e, 0xda, 0xfc, 0x11, 0xc0,
+	0xf4, 0xde, 0x11, 0x7d, 0x04, 0x2f, 0x31, 0x8d, 0x41, 0x78, 0x7c, 0xcf, 0xd3, 0x22, 0x32, 0x5a,
+	0xe8, 0xaf, 0xc9, 0x47, 0x91, 0x7c, 0xf8, 0x28, 0x82, 0x01, 0x04, 0xd7, 0xfc, 0x89, 0x3d, 0x1a,
+	0x45, 0x77, 0xa1, 0x25, 0xd7, 0x1d, 0x3f, 0x16, 0x0c, 0xfd, 0x5f, 0x33, 0xd2, 0x57, 0xe4, 0xf3,
+	0x56, 0xaa, 0xe3, 0xdb, 0xdb, 0x9a, 0xea, 0xdb, 0x00, 0x3c, 0x30, 0x7d, 0xcc, 0xd3, 0xcc, 0xf0,
+	0x36, 0xb6, 0x31, 0xf3, 0xaa, 0xd2, 0x0f, 0x0b, 0x74, 0x68, 0x49, 0xf5, 0xde, 0x0a, 0xc8, 0x3d,
+	0xa8, 0x0c, 0xdc, 0xb1, 0x37, 0x62, 0x4a, 0x38, 0xff, 0x46, 0xe1, 0x72, 0xd4, 0x7f, 0x2b, 0x88,
+	0xdd, 0x01, 0x17, 0xae, 0x7a, 0x07, 0xfc, 0x2b, 0x4d, 0xbe, 0xd2, 0xc5, 0x1f, 0x09, 0xc9, 0xf0,
+	0x92, 0xda, 0x94, 0x87, 0x0b, 0xbe, 0x38, 0x7e, 0x5b, 0x61, 0x4a, 0xe3, 0x5e, 0x9a, 0xba, 0x8f,
+	0xd7, 0x67, 0xce, 0xff, 0x92, 0x85, 0x52, 0x38, 0x2d, 0xb3, 0x73, 0xff, 0x05, 0x94, 0xa2, 0xea,
+	0xa8, 0x7a, 0xe6, 0x8d, 0x16, 0x9e, 0x76, 0x26, 0xcf, 0x81, 0x98, 0xc3, 0x61, 0x94, 0x11, 0x1b,
+	0x13, 0x6e, 0x0e, 0xc3, 0xe7, 0xd1, 0x2f, 0xe6, 0xb0, 0x43, 0xb8, 0x85, 0x1e, 0xa2, 0x3c, 0xad,
+	0x99, 0xc3, 0x61, 0x82, 0x43, 0xfe, 0x18, 0x36, 0x92, 0x63, 0x18, 0x47, 0xe7, 0x86, 0x67, 0x5b,
+	0xea, 0x9a, 0x60, 0x67, 0xde, 0x37, 0xca, 0x66, 0x02, 0xfe, 0xab, 0xf3, 0x03, 0xdb, 0x92, 0x36,
+	0x27, 0xfe, 0x4c, 0x43, 0xe3, 0x4f, 0xe1, 0x9d, 0xd7, 0x74, 0xbf, 0x64, 0x0e, 0xba, 0xc9, 0xda,
+	0x9b, 0xc5, 0x8d, 0x10, 0x9b, 0xbd, 0xbf, 0xd7, 0x60, 0x75, 0xa6, 0x03, 0xd9, 0x8a, 0xa7, 0xf2,
+	0x9f, 0xa4, 0x1c, 0xa7, 0x75, 0x70, 0x28, 0xe1, 0x51, 0x96, 0x3c, 0xba, 0x90, 0xbd, 0xa7, 0xcd,
+	0xd9, 0x64, 0x12, 0x2c, 0x81, 0x14, 0x82, 0xfe, 0x8f, 0x59, 0x28, 0x86, 0xe8, 0xe2, 0x90, 0x7f,
+	0xce, 0x03, 0x36, 0x36, 0xa2, 0x1b, 0x48, 0x8d, 0x82, 0x64, 0x89, 0x4d, 0xf7, 0x3d, 0x28, 0x4d,
+	0x38, 0xf3, 0x65, 0x73, 0x46, 0x34, 0x17, 0x91, 0x21, 0x1a, 0xdf, 0x87, 0x72, 0xe0, 0x06, 0xe6,
+	0xc8, 0x08, 0x44, 0x4a, 0x91, 0x95, 0xd2, 0x82, 0x25, 0x12, 0x0a, 0xf2, 0x03, 0x58, 0x0d, 0x8e,
+	0x7d, 0x37, 0x08, 0x46, 0x98, 0xce, 0x8a, 0xe4, 0x4a, 0xe6, 0x42, 0x39, 0x5a, 0x8b, 0x1a, 0x64,
+	0xd2, 0xc5, 0x31, 0x7a, 0x4f, 0x3b, 0xa3, 0xeb, 0x8a, 0x20, 0x92, 0xa3, 0xcb, 0x11, 0x17, 0x5d,
+	0x1b, 0x37, 0x4f, 0x4f, 0x26, 0x2d, 0x22, 0x56, 0x68, 0x34, 0x24, 0x89, 0x01, 0x2b, 0x63, 0x66,
+	0xf2, 0x89, 0xcf, 0x2c, 0xe3, 0xb9, 0xcd, 0x46, 0x96, 0xbc, 0x9b, 0xa9, 0xa6, 0x3e, 0x91, 0x84,
+	0x66, 0x69, 0x3e, 0x10, 0xd2, 0xb4, 0x1a, 0xc2, 0x49, 0x1a, 0x33, 0x07, 0xf9, 0x8f, 0xac, 0x40,
+	0xb9, 0xf7, 0xb4, 0xd7, 0x6f, 0xef, 0x19, 0x7b, 0xfb, 0xdb, 0x6d, 0x55, 0x5e, 0xd5, 0x6b, 0x53,
+	0x49, 0x6a, 0xd8, 0xde, 0xdf, 0xef, 0x6f, 0xed, 0x1a, 0xfd, 0x4e, 0xeb, 0x71, 0xaf, 0x96, 0x21,
+	0x1b, 0xb0, 0xda, 0xdf, 0xa1, 0xfb, 0xfd, 0xfe, 0x6e, 0x7b, 0xdb, 0x38, 0x68, 0xd3, 0xce, 0xfe,
+	0x76, 0xaf, 0x96, 0xc5, 0xeb, 0xe5, 0x29, 0xbb, 0xdf, 0xd9, 0x6b, 0xd7, 0x72, 0x58, 0x50, 0x73,
+	0xd0, 0xa6, 0xad, 0x76, 0xb7, 0x5f, 0xcb, 0xeb, 0xbf, 0xcc, 0x42, 0x39, 0x36, 0x8b, 0xe8, 0xc8,
+	0x3e, 0x97, 0x47, 0x9f, 0x1c, 0xc5, 0xbf, 0xe2, 0x39, 0xd8, 0x1c, 0x1c, 0xcb, 0xd9, 0xc9, 0x51,
+	0x49, 0x88, 0xe3, 0x8e, 0x79, 0x16, 0x5b, 0xe7, 0x39, 0x5a, 0x1c, 0x9b, 0x67, 0x12, 0xe4, 0x7b,
+	0x50, 0x39, 0x61, 0xbe, 0xc3, 0x46, 0xaa, 0x5d, 0xce, 0x48, 0x59, 0xf2, 0x64, 0x97, 0x9b, 0x50,
+	0x53, 0x5d, 0xa6, 0x30, 0x72, 0x3a, 0xaa, 0x92, 0xbf, 0x17, 0x82, 0xad, 0x43, 0x5e, 0x36, 0x2f,
+	0xc9, 0xf1, 0x05, 0x81, 0xdb, 0x14, 0x7f, 0x65, 0x7a, 0x22, 0xcd, 0xcc, 0x51, 0xf1, 0x9f, 0x1c,
+	0xcd, 0xce, 0x4f, 0x41, 0xcc, 0xcf, 0xed, 0xf9, 0xdd, 0xf9, 0x75, 0x53, 0x74, 0x1c, 0x4d, 0xd1,
+	0x12, 0x64, 0x69, 0x58, 0x93, 0xd4, 0xda, 0x6a, 0xed, 0xe0, 0xb4, 0x2c, 0x43, 0x69, 0x6f, 0xeb,
+	0x27, 0xc6, 0x61, 0x4f, 0x5e, 0xfc, 0xd7, 0xa0, 0xf2, 0xb8, 0x4d, 0xbb, 0xed, 0x5d, 0xc5, 0xc9,
+	0x92, 0x75, 0xa8, 0x29, 0xce, 0xb4, 0x5f, 0x0e, 0x11, 0xe4, 0xdf, 0x3c, 0x5e, 0x04, 0xf7, 0x9e,
+	0x6c, 0x1d, 0xd4, 0x0a, 0xfa, 0x7f, 0x65, 0x60, 0x45, 0x6e, 0x0b, 0x51, 0xf5, 0xc4, 0xeb, 0x5f,
+	0x8f, 0xe3, 0x17, 0x5d, 0x99, 0xe4, 0x45, 0x57, 0x98, 0x84, 0x8a, 0x5d, 0x3d, 0x3b, 0x4d, 0x42,
+	0xc5, 0xe5, 0x4f, 0x22, 0xe2, 0xe7, 0xe6, 0x89, 0xf8, 0x75, 0x58, 0x1a, 0x33, 0x1e, 0xcd, 0x5b,
+	0x89, 0x86, 0x24, 0xb1, 0xa1, 0x6c, 0x3a, 0x8e, 0x1b, 0x98, 0xf2, 0xf6, 0xb8, 0x30, 0xd7, 0x66,
+	0x78, 0xe1, 0x8b, 0x9b, 0x5b, 0x53, 0x24, 0x19, 0x98, 0xe3, 0xd8, 0x8d, 0x1f, 0x43, 0xed, 0x62,
+	0x87, 0xb9, 0xb6, 0xc3, 0x55, 0x58, 0xe9, 0x1d, 0x4f, 0x02, 0xcb, 0x7d, 0xe5, 0x84, 0x85, 0x29,
+	0x58, 0x35, 0x15, 0xb1, 0xd4, 0xe3, 0xa2, 0x0f, 0x1b, 0xad, 0xe8, 0xf9, 0x27, 0x55, 0x59, 0xc9,
+	0x7b, 0x50, 0xb2, 0xc7, 0xb8, 0x3d, 0xe1, 0xd5, 0xa8, 0x4a, 0xee, 0x05, 0x03, 0xaf, 0x46, 0x3f,
+	0x80, 0xe5, 0x11, 0x33, 0x4f, 0x99, 0xe1, 0x4f, 0x1c, 0xc7, 0x76, 0x86, 0x2a, 0x47, 0xab, 0x08,
+	0x26, 0x95, 0x3c, 0x7c, 0xea, 0xbc, 0x38, 0xa6, 0xd2, 0x46, 0x54, 0x53, 0xf2, 0xc0, 0xf5, 0xd9,
+	0xdb, 0x2f, 0x5f, 0xfc, 0x56, 0xc5, 0xf5, 0x5f, 0x6b, 0xb0, 0x96, 0x18, 0x7a, 0x5a, 0x3f, 0xa7,
+	0x4a, 0x0b, 0xb5, 0xff, 0x8f, 0xd2, 0xc2, 0xcc, 0x5b, 0xad, 0xb2, 0xfa, 0xfe, 0x0f, 0xa7, 0x29,
+	0x10, 0xc3, 0x60, 0xa8, 0xde, 0xdf, 0x6a, 0xd7, 0x90, 0xa0, 0x87, 0xdd, 0x6e, 0xa7, 0xfb, 0xb0,
+	0xa6, 0xe1, 0xab, 0x5d, 0xfb, 0x27, 0x1d, 0x2c, 0x6e, 0xcd, 0x6c, 0xfe, 0xd3, 0x06, 0x14, 0xa4,
+	0x67, 0x92, 0x97, 0x90, 0xc3, 0x52, 0x6e, 0x92, 0x76, 0x8b, 0x8c, 0x95, 0x81, 0x37, 0x3e, 0x9b,
+	0x4b, 0x46, 0x4d, 0xf7, 0x35, 0xf2, 0x8d, 0xca, 0x38, 0xe3, 0x35, 0xe1, 0xe4, 0xc7, 0x73, 0xcf,
+	0x70, 0xa2, 0xce, 0xbc, 0x71, 0x7f, 0x61, 0xf9, 0x48, 0xaf, 0xbf, 0xd0, 0xa0, 0x92, 0x78, 0x6d,
+	0x4f, 0xfb, 0x4a, 0x73, 0x49, 0x09, 0x7a, 0xe3, 0x47, 0x0b, 0xc9, 0x46, 0xba, 0xfc, 0x42, 0x83,
+	0x72, 0xac, 0xf8, 0x9a, 0xdc, 0x5e, 0xa4, 0x60, 0x5b, 0x6a, 0x72, 0x67, 0xf1, 0x5a, 0x6f, 0xfd,
+	0xda, 0xa7, 0x1a, 0xf9, 0x73, 0x0d, 0xca, 0xb1, 0xa2, 0xe3, 0xd4, 0xaa, 0xcc, 0x96, 0x48, 0x37,
+	0xee, 0x2c, 0x22, 0x1a, 0xd9, 0xe4, 0x67, 0x1a, 0x94, 0xa2, 0x02, 0x62, 0x72, 0x6b, 0xfe, 0x92,
+	0x63, 0xa9, 0xc4, 0x17, 0x8b, 0xd6, 0x2a, 0xeb, 0xd7, 0xc8, 0x9f, 0x40, 0x31, 0xac, 0xb6, 0x25,
+	0x69, 0x17, 0xf0, 0x85, 0x52, 0xde, 0xc6, 0xad, 0xb9, 0xe5, 0xe2, 0xc3, 0x87, 0x25, 0xb0, 0xa9,
+	0x87, 0xbf, 0x50, 0xac, 0xdb, 0xb8, 0x35, 0xb7, 0x5c, 0x34, 0x3c, 0x7a, 0x42, 0xac, 0x52, 0x36,
+	0xb5, 0x27, 0xcc, 0x96, 0xe8, 0x36, 0xee, 0x2c, 0x22, 0x9a, 0x50, 0x24, 0x56, 0x6b, 0x9b, 0x5a,
+	0x91, 0xd9, 0x7a, 0xde, 0xc6, 0x9d, 0x45, 0x44, 0x23, 0x45, 0x7e, 0xae, 0xc5, 0xcf, 0x9f, 0xb7,
+	0xe6, 0x2e, 0x29, 0x9d, 0xd3, 0x25, 0x67, 0x8a, 0x5a, 0xc5, 0x02, 0xfd, 0xb9, 0xba, 0x2d, 0x93,
+	0x15, 0xa9, 0x64, 0x1e, 0xb0, 0x44, 0x11, 0x6b, 0xe3, 0xf3, 0xc5, 0x92, 0x1a, 0xa1, 0x04, 0xba,
+	0xa6, 0xca, 0x33, 0xd2, 0xbb, 0x66, 0x32, 0x57, 0x69, 0xdc, 0x9a, 0x5b, 0x2e, 0x9a, 0x88, 0x3f,
+	0xd3, 0x00, 0xa6, 0xa5, 0xb3, 0xa9, 0x6d, 0x30, 0x53, 0xb3, 0xdb, 0xb8, 0xbd, 0x80, 0x64, 0x7c,
+	0x7d, 0x86, 0xa5, 0x7d, 0xa9, 0x8d, 0x70, 0xa1, 0xb4, 0xb7, 0x71, 0x6b, 0x6e, 0xb9, 0x68, 0xf8,
+	0xbf, 0xd3, 0x60, 0x75, 0xa6, 0xb4, 0x90, 0xdc, 0xbf, 0x62, 0x75, 0x69, 0xe3, 0xcb, 0xc5, 0x01,
+	0x42, 0xd5, 0x6e, 0x6a, 0x9f, 0x6a, 0xe4, 0x2f, 0x35, 0x58, 0x4e, 0x96, 0x5c, 0xa5, 0xde, 0x24,
+	0x2f, 0x29, 0x52, 0x6c, 0xdc, 0x5d, 0x4c, 0x38, 0xb2, 0xd6, 0x5f, 0x6b, 0x50, 0x55, 0xe1, 0x25,
+	0xd4, 0xe7, 0xee, 0x7c, 0x51, 0xe9, 0x82, 0x42, 0xf7, 0x16, 0x94, 0x4e, 0x68, 0x94, 0x4c, 0x92,
+	0x53, 0x6b, 0x74, 0x69, 0x3e, 0xdf, 0xb8, 0xb7, 0xa0, 0x74, 0x22, 0xd0, 0xc6, 0x32, 0xe4, 0x39,
+	0xf6, 0xfe, 0x8b, 0x09, 0x7d, 0xe3, 0xce, 0x22, 0xa2, 0xa1, 0x22, 0x5f, 0x2d, 0xfd, 0x7e, 0x5e,
+	0x9e, 0xdf, 0x0a, 0xe2, 0xe7, 0xb3, 0xff, 0x1b, 0x00, 0x30, 0x10, 0x94, 0xd7, 0x73, 0x39, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4232,6 +4444,12 @@ type DriverClient interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(ctx context.Context, in *DestroyNetworkRequest, opts ...grpc.CallOption) (*DestroyNetworkResponse, error)
+	// CheckpointTask dumps the state of a running task to an image directory.
+	// This rpc is only implemented if the driver has the checkpoint capability.
+	CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error)
+	// RestoreTask starts a task from the image written by CheckpointTask. This
+	// rpc is only implemented if the driver has the checkpoint capability.
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error)
 }
 
 type driverClient struct {
@@ -4495,6 +4713,24 @@ func (c *driverClient) DestroyNetwork(ctx context.Context, in *DestroyNetworkReq
 	return out, nil
 }
 
+func (c *driverClient) CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error) {
+	out := new(CheckpointTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error) {
+	out := new(RestoreTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/RestoreTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServer is the server API for Driver service.
 type DriverServer interface {
 	// Init is used to allow a driver plugin to perform any initialization
@@ -4557,6 +4793,12 @@ type DriverServer interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(context.Context, *DestroyNetworkRequest) (*DestroyNetworkResponse, error)
+	// CheckpointTask dumps the state of a running task to an image directory.
+	// This rpc is only implemented if the driver has the checkpoint capability.
+	CheckpointTask(context.Context, *CheckpointTaskRequest) (*CheckpointTaskResponse, error)
+	// RestoreTask starts a task from the image written by CheckpointTask. This
+	// rpc is only implemented if the driver has the checkpoint capability.
+	RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error)
 }
 
 // UnimplementedDriverServer can be embedded to have forward compatible implementations.
@@ -4617,6 +4859,12 @@ func (*UnimplementedDriverServer) CreateNetwork(ctx context.Context, req *Create
 func (*UnimplementedDriverServer) DestroyNetwork(ctx context.Context, req *DestroyNetworkRequest) (*DestroyNetworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyNetwork not implemented")
 }
+func (*UnimplementedDriverServer) CheckpointTask(ctx context.Context, req *CheckpointTaskRequest) (*CheckpointTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointTask not implemented")
+}
+func (*UnimplementedDriverServer) RestoreTask(ctx context.Context, req *RestoreTaskRequest) (*RestoreTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
 
 func RegisterDriverServer(s *grpc.Server, srv DriverServer) {
 	s.RegisterService(&_Driver_serviceDesc, srv)
@@ -4963,6 +5211,42 @@ func _Driver_DestroyNetwork_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Driver_CheckpointTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).CheckpointTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).CheckpointTask(ctx, req.(*CheckpointTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/RestoreTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Driver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.drivers.proto.Driver",
 	HandlerType: (*DriverServer)(nil),
@@ -5023,6 +5307,14 @@ var _Driver_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyNetwork",
 			Handler:    _Driver_DestroyNetwork_Handler,
 		},
+		{
+			MethodName: "CheckpointTask",
+			Handler:    _Driver_CheckpointTask_Handler,
+		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _Driver_RestoreTask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
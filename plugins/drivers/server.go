@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"math"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-plugin"
@@ -63,6 +62,7 @@ func (b *driverPluginServer) Capabilities(ctx context.Context, req *proto.Capabi
 			MustCreateNetwork:     caps.MustInitiateNetwork,
 			NetworkIsolationModes: []proto.NetworkIsolationSpec_NetworkIsolationMode{},
 			DynamicWorkloadUsers:  caps.DynamicWorkloadUsers,
+			Checkpoint:            caps.Checkpoint,
 		},
 	}
 
@@ -145,19 +145,9 @@ func (b *driverPluginServer) StartTask(ctx context.Context, req *proto.StartTask
 		return nil, err
 	}
 
-	var pbNet *proto.NetworkOverride
-	if net != nil {
-		pbNet = &proto.NetworkOverride{
-			PortMap:       map[string]int32{},
-			Addr:          net.IP,
-			AutoAdvertise: net.AutoAdvertise,
-		}
-		for k, v := range net.PortMap {
-			if v > math.MaxInt32 {
-				return nil, fmt.Errorf("port map out of bounds")
-			}
-			pbNet.PortMap[k] = int32(v)
-		}
+	pbNet, err := networkOverrideToProto(net)
+	if err != nil {
+		return nil, err
 	}
 
 	resp := &proto.StartTaskResponse{
@@ -443,6 +433,45 @@ func (b *driverPluginServer) DestroyNetwork(ctx context.Context, req *proto.Dest
 	return &proto.DestroyNetworkResponse{}, nil
 }
 
+func (b *driverPluginServer) CheckpointTask(ctx context.Context, req *proto.CheckpointTaskRequest) (*proto.CheckpointTaskResponse, error) {
+	cp, ok := b.impl.(DriverCheckpointer)
+	if !ok {
+		return nil, fmt.Errorf("CheckpointTask RPC not supported by driver")
+	}
+
+	err := cp.CheckpointTask(req.TaskId, &CheckpointOptions{
+		ImageDir:     req.ImageDir,
+		LeaveRunning: req.LeaveRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CheckpointTaskResponse{}, nil
+}
+
+func (b *driverPluginServer) RestoreTask(ctx context.Context, req *proto.RestoreTaskRequest) (*proto.RestoreTaskResponse, error) {
+	cp, ok := b.impl.(DriverCheckpointer)
+	if !ok {
+		return nil, fmt.Errorf("RestoreTask RPC not supported by driver")
+	}
+
+	handle, net, err := cp.RestoreTask(taskConfigFromProto(req.Task), req.ImageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	pbNet, err := networkOverrideToProto(net)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RestoreTaskResponse{
+		Handle:          taskHandleToProto(handle),
+		NetworkOverride: pbNet,
+	}, nil
+}
+
 func (b *driverPluginServer) Shutdown(ctx context.Context, req *proto.ShutdownRequest) (*proto.ShutdownResponse, error) {
 	// Shutdown is optional so check if the plugin has implemented
 	// the Shutdowner interface and simply return if it does not.
@@ -4,6 +4,8 @@
 package drivers
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -373,6 +375,39 @@ func taskHandleToProto(handle *TaskHandle) *proto.TaskHandle {
 	}
 }
 
+func networkOverrideFromProto(pb *proto.NetworkOverride) *DriverNetwork {
+	if pb == nil {
+		return nil
+	}
+	net := &DriverNetwork{
+		PortMap:       map[string]int{},
+		IP:            pb.Addr,
+		AutoAdvertise: pb.AutoAdvertise,
+	}
+	for k, v := range pb.PortMap {
+		net.PortMap[k] = int(v)
+	}
+	return net
+}
+
+func networkOverrideToProto(net *DriverNetwork) (*proto.NetworkOverride, error) {
+	if net == nil {
+		return nil, nil
+	}
+	pb := &proto.NetworkOverride{
+		PortMap:       map[string]int32{},
+		Addr:          net.IP,
+		AutoAdvertise: net.AutoAdvertise,
+	}
+	for k, v := range net.PortMap {
+		if v > math.MaxInt32 {
+			return nil, fmt.Errorf("port map out of bounds")
+		}
+		pb.PortMap[k] = int32(v)
+	}
+	return pb, nil
+}
+
 func exitResultToProto(result *ExitResult) *proto.ExitResult {
 	if result == nil {
 		return &proto.ExitResult{}
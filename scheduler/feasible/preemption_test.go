@@ -167,6 +167,7 @@ func TestPreemption_Normal(t *testing.T) {
 		resourceAsk          *structs.Resources
 		jobPriority          int
 		currentPreemptions   []*structs.Allocation
+		preemptionLimit      int
 		preemptedAllocIDs    map[string]struct{}
 	}
 
@@ -1008,6 +1009,45 @@ func TestPreemption_Normal(t *testing.T) {
 				allocIDs[1]: {},
 			},
 		},
+		{
+			desc: "Preemption not possible because it exceeds the namespace preemption limit",
+			currentAllocations: []*structs.Allocation{
+				tests.CreateAllocWithDevice(allocIDs[0], lowPrioJob, &structs.Resources{
+					CPU:      500,
+					MemoryMB: 512,
+					DiskMB:   4 * 1024,
+				}, &structs.AllocatedDeviceResource{
+					Type:      "gpu",
+					Vendor:    "nvidia",
+					Name:      "1080ti",
+					DeviceIDs: []string{deviceIDs[0]},
+				}),
+				tests.CreateAllocWithDevice(allocIDs[1], lowPrioJob, &structs.Resources{
+					CPU:      200,
+					MemoryMB: 512,
+					DiskMB:   4 * 1024,
+				}, &structs.AllocatedDeviceResource{
+					Type:      "gpu",
+					Vendor:    "nvidia",
+					Name:      "1080ti",
+					DeviceIDs: []string{deviceIDs[1]},
+				})},
+			nodeReservedCapacity: reservedNodeResources,
+			nodeCapacity:         defaultNodeResources,
+			jobPriority:          100,
+			preemptionLimit:      1,
+			resourceAsk: &structs.Resources{
+				CPU:      1000,
+				MemoryMB: 512,
+				DiskMB:   4 * 1024,
+				Devices: []*structs.RequestedDevice{
+					{
+						Name:  "nvidia/gpu/1080ti",
+						Count: 4,
+					},
+				},
+			},
+		},
 		{
 			desc: "Preemption multiple devices used",
 			currentAllocations: []*structs.Allocation{
@@ -1340,6 +1380,7 @@ func TestPreemption_Normal(t *testing.T) {
 			job.Priority = tc.jobPriority
 			binPackIter.SetJob(job)
 			binPackIter.SetSchedulerConfiguration(testSchedulerConfig)
+			binPackIter.SetPreemptionLimit(tc.preemptionLimit)
 
 			taskGroup := &structs.TaskGroup{
 				EphemeralDisk: &structs.EphemeralDisk{},
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"strings"
 
 	"github.com/hashicorp/go-set/v3"
 	"github.com/hashicorp/nomad/client/lib/idset"
@@ -166,7 +167,14 @@ type BinPackIterator struct {
 	jobId                  structs.NamespacedID
 	taskGroup              *structs.TaskGroup
 	memoryOversubscription bool
+	maxPreemptions         int
 	scoreFit               func(*structs.Node, *structs.ComparableResources) float64
+
+	// balanceClasses scales the fit score down for nodes whose class has
+	// already received placements from the current plan, and nodeClasses
+	// caches the class of the nodes in the plan.
+	balanceClasses bool
+	nodeClasses    map[string]string
 }
 
 // NewBinPackIterator returns a BinPackIterator which tries to fit tasks
@@ -198,15 +206,30 @@ func (iter *BinPackIterator) SetSchedulerConfiguration(schedConfig *structs.Sche
 	// Set scoring function.
 	algorithm := schedConfig.EffectiveSchedulerAlgorithm()
 	scoreFn := structs.ScoreFitBinPack
-	if algorithm == structs.SchedulerAlgorithmSpread {
+	balanceClasses := false
+	switch algorithm {
+	case structs.SchedulerAlgorithmSpread:
 		scoreFn = structs.ScoreFitSpread
+	case structs.SchedulerAlgorithmTetris:
+		scoreFn = structs.ScoreFitTetris
+	case structs.SchedulerAlgorithmLeastAllocatedByClass:
+		scoreFn = structs.ScoreFitLeastAllocated
+		balanceClasses = true
 	}
 	iter.scoreFit = scoreFn
+	iter.balanceClasses = balanceClasses
 
 	// Set memory oversubscription.
 	iter.memoryOversubscription = schedConfig != nil && schedConfig.MemoryOversubscriptionEnabled
 }
 
+// SetPreemptionLimit sets the maximum number of allocations that may be
+// preempted by the plan, including the preemptions of earlier placements.
+// Zero means there is no limit.
+func (iter *BinPackIterator) SetPreemptionLimit(limit int) {
+	iter.maxPreemptions = limit
+}
+
 func (iter *BinPackIterator) Next() *RankedNode {
 
 NEXTNODE:
@@ -775,11 +798,21 @@ NEXTNODE:
 			}
 		}
 		if len(allocsToPreempt) > 0 {
+			// Skip the node if the preemptions it requires would exceed the
+			// limit of preemptions for the evaluation
+			if iter.maxPreemptions > 0 &&
+				len(currentPreemptions)+len(allocsToPreempt) > iter.maxPreemptions {
+				iter.ctx.Metrics().ExhaustedNode(option.Node, "preemption limit")
+				continue
+			}
 			option.PreemptedAllocs = allocsToPreempt
 		}
 
 		// Score the fit normally otherwise
 		fitness := iter.scoreFit(option.Node, util)
+		if iter.balanceClasses {
+			fitness *= iter.classBalanceFactor(option.Node)
+		}
 		normalizedFit := fitness / binPackingMaxFitScore
 		option.Scores = append(option.Scores, normalizedFit)
 		iter.ctx.Metrics().ScoreNode(option.Node, "binpack", normalizedFit)
@@ -799,6 +832,36 @@ func (iter *BinPackIterator) Reset() {
 	iter.source.Reset()
 }
 
+// classBalanceFactor returns a multiplier in (0, 1] for the fit score of the
+// node, based on the share of the placements in the current plan that landed
+// on nodes of the same class. Nodes of a class that has not received any
+// placements yet are not penalized.
+func (iter *BinPackIterator) classBalanceFactor(node *structs.Node) float64 {
+	if iter.nodeClasses == nil {
+		iter.nodeClasses = make(map[string]string)
+	}
+
+	var total, sameClass int
+	for nodeID, allocs := range iter.ctx.Plan().NodeAllocation {
+		class, ok := iter.nodeClasses[nodeID]
+		if !ok {
+			planNode, err := iter.ctx.State().NodeByID(nil, nodeID)
+			if err != nil || planNode == nil {
+				continue
+			}
+			class = planNode.NodeClass
+			iter.nodeClasses[nodeID] = class
+		}
+
+		total += len(allocs)
+		if class == node.NodeClass {
+			sameClass += len(allocs)
+		}
+	}
+
+	return 1 - float64(sameClass)/float64(total+1)
+}
+
 // JobAntiAffinityIterator is used to apply an anti-affinity to allocating
 // along side other allocations from this job. This is used to help distribute
 // load across the cluster.
@@ -1001,6 +1064,112 @@ func matchesAffinity(ctx Context, affinity *structs.Affinity, option *structs.No
 	return checkAffinity(ctx, affinity.Operand, lVal, rVal, lOk, rOk)
 }
 
+// ImageLocalityIterator is used to boost the score of nodes which already hold
+// the images of the tasks in the task group, so they can start without
+// pulling them. Drivers expose the images held by a node as the
+// unique.driver.<name>.images attribute, so they don't affect the computed
+// class of the node.
+type ImageLocalityIterator struct {
+	ctx     Context
+	source  RankIterator
+	enabled bool
+
+	// images are the normalized images of the task group by driver
+	images map[string][]string
+}
+
+// NewImageLocalityIterator is used to create an ImageLocalityIterator that
+// boosts the score of nodes holding all images of the task group.
+func NewImageLocalityIterator(ctx Context, source RankIterator) *ImageLocalityIterator {
+	return &ImageLocalityIterator{
+		ctx:     ctx,
+		source:  source,
+		enabled: true,
+	}
+}
+
+// SetEnabled is used to enable or disable the scoring of nodes, following
+// the DisableImageLocality scheduler configuration.
+func (iter *ImageLocalityIterator) SetEnabled(enabled bool) {
+	iter.enabled = enabled
+}
+
+func (iter *ImageLocalityIterator) SetTaskGroup(tg *structs.TaskGroup) {
+	iter.images = nil
+	for _, task := range tg.Tasks {
+		image, ok := task.Config["image"].(string)
+		if !ok || image == "" {
+			continue
+		}
+		if iter.images == nil {
+			iter.images = make(map[string][]string)
+		}
+		iter.images[task.Driver] = append(iter.images[task.Driver], normalizeImage(image))
+	}
+}
+
+func (iter *ImageLocalityIterator) Reset() {
+	iter.source.Reset()
+}
+
+func (iter *ImageLocalityIterator) Next() *RankedNode {
+	option := iter.source.Next()
+	if option == nil || !iter.enabled {
+		return option
+	}
+
+	// Only nodes holding all images are scored, since averaging a partial
+	// score could lower the final score of a node.
+	if len(iter.images) > 0 && iter.hasImages(option.Node) {
+		option.Scores = append(option.Scores, 1)
+		iter.ctx.Metrics().ScoreNode(option.Node, "image-locality", 1)
+	} else {
+		iter.ctx.Metrics().ScoreNode(option.Node, "image-locality", 0)
+	}
+	return option
+}
+
+// hasImages returns whether the node holds all images of the task group.
+func (iter *ImageLocalityIterator) hasImages(node *structs.Node) bool {
+	for driver, images := range iter.images {
+		attr, ok := node.Attributes["unique.driver."+driver+".images"]
+		if !ok {
+			return false
+		}
+
+		held := make(map[string]struct{})
+		for image := range strings.SplitSeq(attr, ",") {
+			held[normalizeImage(image)] = struct{}{}
+		}
+		for _, image := range images {
+			if _, ok := held[image]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// normalizeImage returns the short form of an image reference with an
+// explicit tag, so the images of tasks compare to the images held by nodes.
+func normalizeImage(image string) string {
+	image = strings.TrimPrefix(strings.TrimSpace(image), "https://")
+	for _, prefix := range []string{"docker.io/library/", "index.docker.io/library/", "docker.io/", "index.docker.io/"} {
+		if short, ok := strings.CutPrefix(image, prefix); ok {
+			image = short
+			break
+		}
+	}
+
+	if strings.Contains(image, "@") {
+		return image
+	}
+	if !strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") {
+		image += ":latest"
+	}
+	return image
+}
+
 // ScoreNormalizationIterator is used to combine scores from various prior
 // iterators and combine them into one final score. The current implementation
 // averages the scores together.
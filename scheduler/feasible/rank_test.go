@@ -1322,6 +1322,77 @@ func TestBinPackIterator_PlannedAlloc(t *testing.T) {
 	}
 }
 
+func TestBinPackIterator_LeastAllocatedByClass(t *testing.T) {
+	state, ctx := MockContext(t)
+
+	newNode := func(class string) *structs.Node {
+		return &structs.Node{
+			ID:        uuid.Generate(),
+			NodeClass: class,
+			NodeResources: &structs.NodeResources{
+				Processors: processorResources2048,
+				Cpu:        legacyCpuResources2048,
+				Memory: structs.NodeMemoryResources{
+					MemoryMB: 2048,
+				},
+			},
+		}
+	}
+
+	// The planned node shares a class with the first candidate node.
+	planned := mock.Node()
+	planned.NodeClass = "a"
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1000, planned))
+
+	nodes := []*RankedNode{
+		{Node: newNode("a")},
+		{Node: newNode("b")},
+	}
+	static := NewStaticRankIterator(ctx, nodes)
+
+	ctx.Plan().NodeAllocation[planned.ID] = []*structs.Allocation{
+		{
+			AllocatedResources: &structs.AllocatedResources{
+				Tasks: map[string]*structs.AllocatedTaskResources{
+					"web": {
+						Cpu:    structs.AllocatedCpuResources{CpuShares: 1024},
+						Memory: structs.AllocatedMemoryResources{MemoryMB: 1024},
+					},
+				},
+			},
+		},
+	}
+
+	taskGroup := &structs.TaskGroup{
+		EphemeralDisk: &structs.EphemeralDisk{},
+		Tasks: []*structs.Task{
+			{
+				Name: "web",
+				Resources: &structs.Resources{
+					CPU:      1024,
+					MemoryMB: 1024,
+				},
+			},
+		},
+	}
+
+	binp := NewBinPackIterator(ctx, static, false, 0)
+	binp.SetTaskGroup(taskGroup)
+	binp.SetSchedulerConfiguration(&structs.SchedulerConfiguration{
+		SchedulerAlgorithm: structs.SchedulerAlgorithmLeastAllocatedByClass,
+	})
+
+	out := collectRanked(binp)
+	must.Len(t, 2, out)
+
+	// Both nodes are equally allocated, but the node from the class without
+	// any planned placements should score twice as high.
+	must.Eq(t, nodes[0], out[0])
+	must.Eq(t, nodes[1], out[1])
+	must.Greater(t, 0, out[0].Scores[0])
+	must.Eq(t, out[1].Scores[0], 2*out[0].Scores[0])
+}
+
 func TestBinPackIterator_ReservedCores(t *testing.T) {
 	state, ctx := MockContext(t)
 
@@ -2684,3 +2755,77 @@ func TestNodeAffinityIterator(t *testing.T) {
 		test.Less(t, out[3].FinalScore, out[4].FinalScore)
 	})
 }
+
+func TestImageLocalityIterator(t *testing.T) {
+	ci.Parallel(t)
+	_, ctx := MockContext(t)
+
+	nodes := []*RankedNode{
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+	}
+
+	// holds all images
+	nodes[0].Node.Attributes["unique.driver.docker.images"] = "docker.io/library/redis:7,example.com/app:1.0,nginx:latest"
+	// holds some images
+	nodes[1].Node.Attributes["unique.driver.docker.images"] = "redis:7"
+	// holds no images
+	nodes[2].Node.Attributes["unique.driver.docker.images"] = ""
+	// doesn't fingerprint images
+
+	job := mock.Job()
+	tg := job.TaskGroups[0]
+	tg.Tasks = []*structs.Task{
+		{Name: "redis", Driver: "docker", Config: map[string]any{"image": "redis:7"}},
+		{Name: "app", Driver: "docker", Config: map[string]any{"image": "https://example.com/app:1.0"}},
+		{Name: "nginx", Driver: "docker", Config: map[string]any{"image": "nginx"}},
+		{Name: "exec", Driver: "exec", Config: map[string]any{"command": "/bin/date"}},
+	}
+
+	static := NewStaticRankIterator(ctx, nodes)
+	imageLocality := NewImageLocalityIterator(ctx, static)
+	imageLocality.SetTaskGroup(tg)
+
+	out := collectRanked(imageLocality)
+	must.Len(t, 4, out)
+	must.Eq(t, []float64{1}, out[0].Scores)
+	must.SliceEmpty(t, out[1].Scores)
+	must.SliceEmpty(t, out[2].Scores)
+	must.SliceEmpty(t, out[3].Scores)
+
+	// scoring is disabled by the scheduler configuration
+	imageLocality.Reset()
+	nodes[0].Scores = nil
+	imageLocality.SetEnabled(false)
+	out = collectRanked(imageLocality)
+	must.SliceEmpty(t, out[0].Scores)
+	imageLocality.SetEnabled(true)
+
+	// task groups without images don't score nodes
+	imageLocality.Reset()
+	nodes[0].Scores = nil
+	imageLocality.SetTaskGroup(mock.Job().TaskGroups[0])
+	out = collectRanked(imageLocality)
+	must.SliceEmpty(t, out[0].Scores)
+}
+
+func TestNormalizeImage(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := map[string]string{
+		"redis":                         "redis:latest",
+		"redis:7":                       "redis:7",
+		"docker.io/library/redis:7":     "redis:7",
+		"index.docker.io/library/redis": "redis:latest",
+		"docker.io/hashicorp/nomad:1.9": "hashicorp/nomad:1.9",
+		"https://example.com:5000/app":  "example.com:5000/app:latest",
+		"example.com:5000/app:1.0":      "example.com:5000/app:1.0",
+		"redis@sha256:0123456789abcdef": "redis@sha256:0123456789abcdef",
+		" example.com/team/app:2.1 ":    "example.com/team/app:2.1",
+	}
+	for image, expected := range cases {
+		test.Eq(t, expected, normalizeImage(image), test.Sprint(image))
+	}
+}
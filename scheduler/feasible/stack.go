@@ -71,6 +71,7 @@ type GenericStack struct {
 	limit                         *LimitIterator
 	maxScore                      *MaxScoreIterator
 	nodeAffinity                  *NodeAffinityIterator
+	imageLocality                 *ImageLocalityIterator
 	spread                        *SpreadIterator
 	scoreNorm                     *ScoreNormalizationIterator
 	nodeLimitForFeasibilityChecks int
@@ -130,9 +131,17 @@ func (s *GenericStack) SetJob(job *structs.Job) {
 // on the node pool being used.
 func (s *GenericStack) SetSchedulerConfiguration(schedConfig *structs.SchedulerConfiguration) {
 	s.binPack.SetSchedulerConfiguration(schedConfig)
+	s.imageLocality.SetEnabled(schedConfig.ImageLocalityEnabled())
 	s.nodeLimitForFeasibilityChecks = int(schedConfig.GetNodeLimitForFeasibilityChecks())
 }
 
+// SetPreemptionLimit sets the maximum number of allocations that may be
+// preempted across the placements of the evaluation. Zero means there is no
+// limit.
+func (s *GenericStack) SetPreemptionLimit(limit int) {
+	s.binPack.SetPreemptionLimit(limit)
+}
+
 func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *RankedNode {
 
 	// This block handles trying to select from preferred nodes if options specify them
@@ -180,6 +189,7 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 		s.nodeReschedulingPenalty.SetPenaltyNodes(options.PenaltyNodeIDs)
 	}
 	s.nodeAffinity.SetTaskGroup(tg)
+	s.imageLocality.SetTaskGroup(tg)
 	s.spread.SetTaskGroup(tg)
 
 	if s.nodeAffinity.hasAffinities() || s.spread.hasSpreads() {
@@ -476,8 +486,11 @@ func NewGenericStack(batch bool, ctx Context) *GenericStack {
 	// Apply scores based on affinity block
 	s.nodeAffinity = NewNodeAffinityIterator(ctx, s.nodeReschedulingPenalty)
 
+	// Apply scores based on the images held by nodes
+	s.imageLocality = NewImageLocalityIterator(ctx, s.nodeAffinity)
+
 	// Apply scores based on spread block
-	s.spread = NewSpreadIterator(ctx, s.nodeAffinity)
+	s.spread = NewSpreadIterator(ctx, s.imageLocality)
 
 	// Add the preemption options scoring iterator
 	preemptionScorer := NewPreemptionScoringIterator(ctx, s.spread)
@@ -502,6 +502,10 @@ func (s *GenericScheduler) computePlacements(
 	// Capture current time to use as the start time for any rescheduled allocations
 	now := time.Now()
 
+	// Track the placements made for task groups in the job's gang, so they
+	// can be backed out if any member of the gang fails to place.
+	var gangPlaced []*gangPlacement
+
 	// Have to handle destructive changes first as we need to discount their
 	// resources. To understand this imagine the resources were reduced and the
 	// count was scaled up.
@@ -686,6 +690,15 @@ func (s *GenericScheduler) computePlacements(
 				// Track the placement
 				s.plan.AppendAlloc(alloc, downgradedJob)
 
+				if s.job.Gang.Includes(tg.Name) {
+					gangPlaced = append(gangPlaced, &gangPlacement{
+						alloc:        alloc,
+						prev:         prevAllocation,
+						stoppedPrev:  stopPrevAlloc,
+						rescheduling: missing.IsRescheduling(),
+					})
+				}
+
 			} else {
 				// Lazy initialize the failed map
 				if s.failedTGAllocs == nil {
@@ -719,9 +732,96 @@ func (s *GenericScheduler) computePlacements(
 		}
 	}
 
+	s.rollbackGang(gangPlaced)
 	return nil
 }
 
+// gangPlacement is a placement made for a task group that is part of the
+// job's gang, along with the plan changes that were made for it.
+type gangPlacement struct {
+	alloc        *structs.Allocation
+	prev         *structs.Allocation
+	stoppedPrev  bool
+	rescheduling bool
+}
+
+// rollbackGang removes the gang placements from the plan if any task group in
+// the job's gang failed to place, so that a plan never holds a partial gang.
+// The task groups that were placed are recorded as failed as well, so the
+// blocked evaluation retries the whole gang as a unit.
+func (s *GenericScheduler) rollbackGang(placed []*gangPlacement) {
+	if len(placed) == 0 {
+		return
+	}
+
+	gangFailed := false
+	for tg := range s.failedTGAllocs {
+		if s.job.Gang.Includes(tg) {
+			gangFailed = true
+			break
+		}
+	}
+	if !gangFailed {
+		return
+	}
+
+	s.logger.Debug("failed to place all allocations of gang, removing placements from plan",
+		"placements", len(placed))
+
+	for _, p := range placed {
+		removeAllocFromPlan(s.plan.NodeAllocation, p.alloc)
+
+		if len(p.alloc.PreemptedAllocations) > 0 {
+			preempted := slices.DeleteFunc(s.plan.NodePreemptions[p.alloc.NodeID], func(a *structs.Allocation) bool {
+				return a.PreemptedByAllocation == p.alloc.ID
+			})
+			if len(preempted) > 0 {
+				s.plan.NodePreemptions[p.alloc.NodeID] = preempted
+			} else {
+				delete(s.plan.NodePreemptions, p.alloc.NodeID)
+			}
+
+			if s.planAnnotations != nil {
+				s.planAnnotations.PreemptedAllocs = slices.DeleteFunc(s.planAnnotations.PreemptedAllocs,
+					func(a *structs.AllocListStub) bool {
+						return slices.Contains(p.alloc.PreemptedAllocations, a.ID)
+					})
+				if desired, ok := s.planAnnotations.DesiredTGUpdates[p.alloc.TaskGroup]; ok {
+					desired.Preemptions -= uint64(len(p.alloc.PreemptedAllocations))
+				}
+			}
+		}
+
+		if p.prev != nil {
+			if p.stoppedPrev {
+				removeAllocFromPlan(s.plan.NodeUpdate, p.prev)
+			}
+			if p.rescheduling {
+				markFailedToReschedule(s.plan, p.prev, s.job)
+			}
+		}
+
+		if metric, ok := s.failedTGAllocs[p.alloc.TaskGroup]; ok {
+			metric.CoalescedFailures += 1
+		} else {
+			s.failedTGAllocs[p.alloc.TaskGroup] = p.alloc.Metrics.Copy()
+		}
+	}
+}
+
+// removeAllocFromPlan removes the allocation from the per-node allocations of
+// a plan, such as Plan.NodeAllocation or Plan.NodeUpdate.
+func removeAllocFromPlan(nodeAllocs map[string][]*structs.Allocation, alloc *structs.Allocation) {
+	allocs := slices.DeleteFunc(nodeAllocs[alloc.NodeID], func(a *structs.Allocation) bool {
+		return a.ID == alloc.ID
+	})
+	if len(allocs) > 0 {
+		nodeAllocs[alloc.NodeID] = allocs
+	} else {
+		delete(nodeAllocs, alloc.NodeID)
+	}
+}
+
 // markFailedToReschedule takes a "previous" allocation that we were unable to
 // reschedule and updates the plan to annotate its reschedule tracker and to
 // move it out of the stop list and into the update list so that we don't drop
@@ -774,8 +874,16 @@ func (s *GenericScheduler) setJob(job *structs.Job) error {
 		return fmt.Errorf("failed to get scheduler configuration: %v", err)
 	}
 
+	ns, err := s.state.NamespaceByName(nil, job.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get job namespace %q: %v", job.Namespace, err)
+	}
+
 	s.stack.SetJob(job)
 	s.stack.SetSchedulerConfiguration(schedConfig.WithNodePool(pool))
+	if ns != nil {
+		s.stack.SetPreemptionLimit(ns.PreemptionConfiguration.GetMaxPreemptionsPerEval())
+	}
 	return nil
 }
 
@@ -1628,6 +1628,98 @@ func TestServiceSched_JobRegister_AllocFail(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestServiceSched_JobRegister_Gang(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name           string
+		gang           *structs.GangConfig
+		workersMemory  int
+		expectPlaced   map[string]int
+		expectFailedTG []string
+	}{
+		{
+			name:           "gang of all groups does not fit",
+			gang:           &structs.GangConfig{},
+			workersMemory:  100_000,
+			expectPlaced:   map[string]int{},
+			expectFailedTG: []string{"web", "workers"},
+		},
+		{
+			name:           "gang of failing group only",
+			gang:           &structs.GangConfig{Groups: []string{"workers"}},
+			workersMemory:  100_000,
+			expectPlaced:   map[string]int{"web": 2},
+			expectFailedTG: []string{"workers"},
+		},
+		{
+			name:          "gang of all groups fits",
+			gang:          &structs.GangConfig{},
+			workersMemory: 256,
+			expectPlaced:  map[string]int{"web": 2, "workers": 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := tests.NewHarness(t)
+
+			for range 2 {
+				node := mock.Node()
+				must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+			}
+
+			job := mock.Job()
+			job.TaskGroups[0].Count = 2
+			workers := job.TaskGroups[0].Copy()
+			workers.Name = "workers"
+			workers.Tasks[0].Resources.MemoryMB = tc.workersMemory
+			job.TaskGroups = append(job.TaskGroups, workers)
+			job.Gang = tc.gang
+			must.NoError(t, job.Validate())
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+			eval := &structs.Evaluation{
+				Namespace:   structs.DefaultNamespace,
+				ID:          uuid.Generate(),
+				Priority:    job.Priority,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       job.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+			must.NoError(t, h.Process(NewServiceScheduler, eval))
+
+			placed := map[string]int{}
+			for _, plan := range h.Plans {
+				// only the gang groups are all-or-nothing
+				must.False(t, plan.AllAtOnce)
+				for _, allocs := range plan.NodeAllocation {
+					for _, alloc := range allocs {
+						placed[alloc.TaskGroup]++
+					}
+				}
+			}
+			must.Eq(t, tc.expectPlaced, placed)
+
+			must.Len(t, 1, h.Evals)
+			outEval := h.Evals[0]
+			must.MapLen(t, len(tc.expectFailedTG), outEval.FailedTGAllocs)
+			for _, tg := range tc.expectFailedTG {
+				must.MapContainsKey(t, outEval.FailedTGAllocs, tg)
+				must.Eq(t, 2, outEval.QueuedAllocations[tg])
+			}
+
+			if len(tc.expectFailedTG) > 0 {
+				must.Len(t, 1, h.CreateEvals)
+				must.Eq(t, structs.EvalStatusBlocked, h.CreateEvals[0].Status)
+			} else {
+				must.Len(t, 0, h.CreateEvals)
+			}
+		})
+	}
+}
+
 func TestServiceSched_JobRegister_CreateBlockedEval(t *testing.T) {
 	ci.Parallel(t)
 
@@ -7205,6 +7297,104 @@ func TestServiceSched_Preemption(t *testing.T) {
 	must.Eq(t, expectedPreemptedAllocs, actualPreemptedAllocs)
 }
 
+// TestServiceSched_Preemption_NamespaceLimit asserts that the preemption limit
+// of the job's namespace bounds the allocations preempted by an evaluation.
+func TestServiceSched_Preemption_NamespaceLimit(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name         string
+		limit        int
+		expectPlaced bool
+	}{
+		{name: "no limit", limit: 0, expectPlaced: true},
+		{name: "limit reached", limit: 2, expectPlaced: false},
+		{name: "within limit", limit: 3, expectPlaced: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := tests.NewHarness(t)
+
+			ns := mock.Namespace()
+			ns.PreemptionConfiguration = &structs.NamespacePreemptionConfiguration{
+				MaxPreemptionsPerEval: tc.limit,
+			}
+			must.NoError(t, h.State.UpsertNamespaces(h.NextIndex(), []*structs.Namespace{ns}))
+
+			legacyCpuResources, processorResources := tests.CpuResources(1000)
+			node := mock.Node()
+			node.NodeResources = &structs.NodeResources{
+				Processors: processorResources,
+				Cpu:        legacyCpuResources,
+				Memory:     structs.NodeMemoryResources{MemoryMB: 2048},
+				Disk:       structs.NodeDiskResources{DiskMB: 100 * 1024},
+				Networks: []*structs.NetworkResource{
+					{Mode: "host", Device: "eth0", CIDR: "192.168.0.100/32", MBits: 1000},
+				},
+			}
+			node.ReservedResources = &structs.NodeReservedResources{
+				Memory: structs.NodeReservedMemoryResources{MemoryMB: 256},
+			}
+			must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+			// fill the node with three low priority allocations
+			low := mock.Job()
+			low.Priority = 20
+			low.TaskGroups[0].Count = 3
+			low.TaskGroups[0].Networks = nil
+			low.TaskGroups[0].Tasks[0].Resources.CPU = 100
+			low.TaskGroups[0].Tasks[0].Resources.MemoryMB = 500
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, low))
+			lowEval := &structs.Evaluation{
+				Namespace:   low.Namespace,
+				ID:          uuid.Generate(),
+				Priority:    low.Priority,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       low.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{lowEval}))
+			must.NoError(t, h.Process(NewServiceScheduler, lowEval))
+			lowAllocs, err := h.State.AllocsByJob(nil, low.Namespace, low.ID, false)
+			must.NoError(t, err)
+			must.Len(t, 3, lowAllocs)
+
+			// placing the high priority job requires preempting all three
+			high := mock.Job()
+			high.Namespace = ns.Name
+			high.Priority = 100
+			high.TaskGroups[0].Count = 1
+			high.TaskGroups[0].Networks = nil
+			high.TaskGroups[0].Tasks[0].Resources.CPU = 100
+			high.TaskGroups[0].Tasks[0].Resources.MemoryMB = 1500
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, high))
+			eval := &structs.Evaluation{
+				Namespace:   high.Namespace,
+				ID:          uuid.Generate(),
+				Priority:    high.Priority,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       high.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+			must.NoError(t, h.Process(NewServiceScheduler, eval))
+
+			out, err := h.State.AllocsByJob(nil, high.Namespace, high.ID, false)
+			must.NoError(t, err)
+			if tc.expectPlaced {
+				must.Len(t, 1, out)
+				must.Len(t, 3, out[0].PreemptedAllocations)
+				must.Len(t, 0, h.CreateEvals)
+			} else {
+				must.Len(t, 0, out)
+				must.Len(t, 1, h.CreateEvals)
+				must.Eq(t, structs.EvalStatusBlocked, h.CreateEvals[0].Status)
+			}
+		})
+	}
+}
+
 // TestServiceSched_Migrate_NonCanary asserts that when rescheduling
 // non-canary allocations, a single allocation is migrated
 func TestServiceSched_Migrate_NonCanary(t *testing.T) {
@@ -80,6 +80,9 @@ type State interface {
 	// job ID
 	LatestDeploymentByJobID(ws memdb.WatchSet, namespace, jobID string) (*structs.Deployment, error)
 
+	// NamespaceByName is used to lookup a namespace by name
+	NamespaceByName(ws memdb.WatchSet, name string) (*structs.Namespace, error)
+
 	// SchedulerConfig returns config options for the scheduler
 	SchedulerConfig() (uint64, *structs.SchedulerConfiguration, error)
 
@@ -278,6 +278,12 @@ func tasksUpdated(jobA, jobB *structs.Job, taskGroup string) comparison {
 			return difference("task log disabled", at.LogConfig.Disabled, bt.LogConfig.Disabled)
 		}
 
+		// The sinks run in the logmon process of the task, so they can only
+		// be changed by restarting log collection
+		if !slices.EqualFunc(at.LogConfig.Sinks, bt.LogConfig.Sinks, (*structs.LogSink).Equal) {
+			return difference("task log sinks", at.LogConfig.Sinks, bt.LogConfig.Sinks)
+		}
+
 		// Check volume mount updates
 		if c := volumeMountsUpdated(at.VolumeMounts, bt.VolumeMounts); c.modified {
 			return c
@@ -491,6 +497,10 @@ func networkUpdated(netA, netB []*structs.NetworkResource) comparison {
 			return difference("network cni", an.CNI, bn.CNI)
 		}
 
+		if !an.Bandwidth.Equal(bn.Bandwidth) {
+			return difference("network bandwidth", an.Bandwidth, bn.Bandwidth)
+		}
+
 		aPorts, bPorts := networkPortMap(an), networkPortMap(bn)
 		if !aPorts.Equal(bPorts) {
 			return difference("network port map", aPorts, bPorts)
@@ -595,6 +595,16 @@ func TestNetworkUpdated(t *testing.T) {
 			},
 			updated: true,
 		},
+		{
+			name: "bandwidth updated",
+			a: []*structs.NetworkResource{
+				{Bandwidth: &structs.BandwidthConfig{EgressMBits: 100}},
+			},
+			b: []*structs.NetworkResource{
+				{Bandwidth: &structs.BandwidthConfig{EgressMBits: 200}},
+			},
+			updated: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1099,6 +1109,26 @@ func TestTasksUpdated_Identity(t *testing.T) {
 	must.True(t, tasksUpdated(j1, j2, name).modified)
 }
 
+func TestTasksUpdated_LogSinks(t *testing.T) {
+	ci.Parallel(t)
+
+	j1 := mock.Job()
+	name := j1.TaskGroups[0].Name
+
+	j2 := j1.Copy()
+	j2.TaskGroups[0].Tasks[0].LogConfig.Sinks = []*structs.LogSink{
+		{Name: "journal", Type: structs.LogSinkTypeJournald},
+	}
+	must.True(t, tasksUpdated(j1, j2, name).modified)
+
+	j3 := j2.Copy()
+	must.False(t, tasksUpdated(j2, j3, name).modified)
+
+	// the sinks run in logmon, so changing them is destructive
+	j3.TaskGroups[0].Tasks[0].LogConfig.Sinks[0].BufferSize = 10
+	must.True(t, tasksUpdated(j2, j3, name).modified)
+}
+
 func TestTasksUpdated_NUMA(t *testing.T) {
 	ci.Parallel(t)
 